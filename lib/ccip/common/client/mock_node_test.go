@@ -292,6 +292,39 @@ func (_c *mockNode_RPC_Call[CHAIN_ID, HEAD, RPC]) RunAndReturn(run func() RPC) *
 	return _c
 }
 
+// RecordReadMismatch provides a mock function with given fields: method
+func (_m *mockNode[CHAIN_ID, HEAD, RPC]) RecordReadMismatch(method string) {
+	_m.Called(method)
+}
+
+// mockNode_RecordReadMismatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordReadMismatch'
+type mockNode_RecordReadMismatch_Call[CHAIN_ID types.ID, HEAD Head, RPC NodeClient[CHAIN_ID, HEAD]] struct {
+	*mock.Call
+}
+
+// RecordReadMismatch is a helper method to define mock.On call
+//   - method string
+func (_e *mockNode_Expecter[CHAIN_ID, HEAD, RPC]) RecordReadMismatch(method interface{}) *mockNode_RecordReadMismatch_Call[CHAIN_ID, HEAD, RPC] {
+	return &mockNode_RecordReadMismatch_Call[CHAIN_ID, HEAD, RPC]{Call: _e.mock.On("RecordReadMismatch", method)}
+}
+
+func (_c *mockNode_RecordReadMismatch_Call[CHAIN_ID, HEAD, RPC]) Run(run func(method string)) *mockNode_RecordReadMismatch_Call[CHAIN_ID, HEAD, RPC] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *mockNode_RecordReadMismatch_Call[CHAIN_ID, HEAD, RPC]) Return() *mockNode_RecordReadMismatch_Call[CHAIN_ID, HEAD, RPC] {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *mockNode_RecordReadMismatch_Call[CHAIN_ID, HEAD, RPC]) RunAndReturn(run func(string)) *mockNode_RecordReadMismatch_Call[CHAIN_ID, HEAD, RPC] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetPoolChainInfoProvider provides a mock function with given fields: _a0
 func (_m *mockNode[CHAIN_ID, HEAD, RPC]) SetPoolChainInfoProvider(_a0 PoolChainInfoProvider) {
 	_m.Called(_a0)
@@ -471,6 +504,51 @@ func (_c *mockNode_StateAndLatest_Call[CHAIN_ID, HEAD, RPC]) RunAndReturn(run fu
 	return _c
 }
 
+// Stats provides a mock function with given fields:
+func (_m *mockNode[CHAIN_ID, HEAD, RPC]) Stats() NodeStats {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stats")
+	}
+
+	var r0 NodeStats
+	if rf, ok := ret.Get(0).(func() NodeStats); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(NodeStats)
+	}
+
+	return r0
+}
+
+// mockNode_Stats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stats'
+type mockNode_Stats_Call[CHAIN_ID types.ID, HEAD Head, RPC NodeClient[CHAIN_ID, HEAD]] struct {
+	*mock.Call
+}
+
+// Stats is a helper method to define mock.On call
+func (_e *mockNode_Expecter[CHAIN_ID, HEAD, RPC]) Stats() *mockNode_Stats_Call[CHAIN_ID, HEAD, RPC] {
+	return &mockNode_Stats_Call[CHAIN_ID, HEAD, RPC]{Call: _e.mock.On("Stats")}
+}
+
+func (_c *mockNode_Stats_Call[CHAIN_ID, HEAD, RPC]) Run(run func()) *mockNode_Stats_Call[CHAIN_ID, HEAD, RPC] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *mockNode_Stats_Call[CHAIN_ID, HEAD, RPC]) Return(_a0 NodeStats) *mockNode_Stats_Call[CHAIN_ID, HEAD, RPC] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockNode_Stats_Call[CHAIN_ID, HEAD, RPC]) RunAndReturn(run func() NodeStats) *mockNode_Stats_Call[CHAIN_ID, HEAD, RPC] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // String provides a mock function with given fields:
 func (_m *mockNode[CHAIN_ID, HEAD, RPC]) String() string {
 	ret := _m.Called()
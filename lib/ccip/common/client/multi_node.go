@@ -67,6 +67,10 @@ type MultiNode[
 	]
 	Close() error
 	NodeStates() map[string]string
+	// NodeStats returns rolling poll latency percentiles and error rate of each primary node, keyed by node name
+	NodeStats() map[string]NodeStats
+	// VerifiedRead executes read against multiple alive nodes and returns the result at least quorum of them agreed on
+	VerifiedRead(ctx context.Context, method string, quorum int, read VerifiedReadFunc[RPC_CLIENT]) (any, error)
 	SelectNodeRPC() (RPC_CLIENT, error)
 
 	BatchCallContextAll(ctx context.Context, b []BATCH_ELEM) error
@@ -574,6 +578,14 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	return
 }
 
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) NodeStats() map[string]NodeStats {
+	stats := make(map[string]NodeStats, len(c.nodes))
+	for _, n := range c.nodes {
+		stats[n.Name()] = n.Stats()
+	}
+	return stats
+}
+
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) PendingSequenceAt(ctx context.Context, addr ADDR) (s SEQ, err error) {
 	n, err := c.selectNode()
 	if err != nil {
@@ -357,6 +357,29 @@ func TestMultiNode_CheckLease(t *testing.T) {
 		states := mn.NodeStates()
 		assert.Equal(t, expectedResult, states)
 	})
+	t.Run("NodeStats returns stats of primary nodes", func(t *testing.T) {
+		t.Parallel()
+		chainID := types.NewIDFromInt(10)
+		opts := multiNodeOpts{
+			selectionMode: NodeSelectionModeLatencyWeighted,
+			chainID:       chainID,
+		}
+
+		expectedResult := map[string]NodeStats{}
+		for i := 1; i <= 2; i++ {
+			name := fmt.Sprintf("node_%d", i)
+			stats := NodeStats{LatencyP50: time.Duration(i) * time.Millisecond, Samples: i}
+			node := newMockNode[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+			node.On("Name").Return(name).Once()
+			node.On("Stats").Return(stats).Once()
+			opts.nodes = append(opts.nodes, node)
+			expectedResult[name] = stats
+		}
+		opts.sendonlys = append(opts.sendonlys, newMockSendOnlyNode[types.ID, multiNodeRPCClient](t))
+
+		mn := newTestMultiNode(t, opts)
+		assert.Equal(t, expectedResult, mn.NodeStats())
+	})
 }
 
 func TestMultiNode_selectNode(t *testing.T) {
@@ -69,6 +69,10 @@ type Node[
 	StateAndLatest() (nodeState, ChainInfo)
 	// HighestUserObservations - returns highest ChainInfo ever observed by underlying RPC excluding results of health check requests
 	HighestUserObservations() ChainInfo
+	// Stats returns rolling poll latency percentiles and error rate observed by the Node
+	Stats() NodeStats
+	// RecordReadMismatch records that the Node disagreed with other nodes on the response to a verified read of method.
+	RecordReadMismatch(method string)
 	SetPoolChainInfoProvider(PoolChainInfoProvider)
 	// Name is a unique identifier for this node.
 	Name() string
@@ -108,6 +112,8 @@ type node[
 
 	poolInfoProvider PoolChainInfoProvider
 
+	stats nodeStatsWindow
+
 	stopCh services.StopChan
 	// wg waits for subsidiary goroutines
 	wg sync.WaitGroup
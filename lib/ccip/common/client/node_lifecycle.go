@@ -149,11 +149,13 @@ func (n *node[CHAIN_ID, HEAD, RPC]) aliveLoop() {
 			promPoolRPCNodePolls.WithLabelValues(n.chainID.String(), n.name).Inc()
 			lggr.Tracew("Polling for version", "nodeState", n.getCachedState(), "pollFailures", pollFailures)
 			var version string
+			pollStart := time.Now()
 			version, err = func(ctx context.Context) (string, error) {
 				ctx, cancel := context.WithTimeout(ctx, pollInterval)
 				defer cancel()
 				return n.RPC().ClientVersion(ctx)
 			}(ctx)
+			n.recordPollResult(time.Since(pollStart), err)
 			if err != nil {
 				// prevent overflow
 				if pollFailures < math.MaxUint32 {
@@ -367,7 +369,7 @@ func (n *node[CHAIN_ID, HEAD, RPC]) isOutOfSyncWithPool(localState ChainInfo) (o
 	ln, ci := n.poolInfoProvider.LatestChainInfo()
 	mode := n.nodePoolCfg.SelectionMode()
 	switch mode {
-	case NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel:
+	case NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel, NodeSelectionModeLatencyWeighted:
 		return localState.BlockNumber < ci.BlockNumber-int64(threshold), ln
 	case NodeSelectionModeTotalDifficulty:
 		bigThreshold := big.NewInt(int64(threshold))
@@ -11,6 +11,7 @@ const (
 	NodeSelectionModeRoundRobin      = "RoundRobin"
 	NodeSelectionModeTotalDifficulty = "TotalDifficulty"
 	NodeSelectionModePriorityLevel   = "PriorityLevel"
+	NodeSelectionModeLatencyWeighted = "LatencyWeighted"
 )
 
 type NodeSelector[
@@ -25,6 +26,16 @@ type NodeSelector[
 	Name() string
 }
 
+// activeNodeSetter is implemented by node selectors which keep track of the node they selected, so that they can be
+// told when the MultiNode switches to another node without calling Select.
+type activeNodeSetter[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+] interface {
+	setActiveNode(node Node[CHAIN_ID, HEAD, RPC])
+}
+
 func newNodeSelector[
 	CHAIN_ID types.ID,
 	HEAD Head,
@@ -39,6 +50,8 @@ func newNodeSelector[
 		return NewTotalDifficultyNodeSelector[CHAIN_ID, HEAD, RPC](nodes)
 	case NodeSelectionModePriorityLevel:
 		return NewPriorityLevelNodeSelector[CHAIN_ID, HEAD, RPC](nodes)
+	case NodeSelectionModeLatencyWeighted:
+		return NewLatencyWeightedNodeSelector[CHAIN_ID, HEAD, RPC](nodes)
 	default:
 		panic(fmt.Sprintf("unsupported NodeSelectionMode: %s", selectionMode))
 	}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// latencyWeightedSwitchThreshold is the relative score improvement a live node must offer over the previously
+// selected node before the selector switches to it. It prevents flapping between nodes with similar scores.
+const latencyWeightedSwitchThreshold = 0.2
+
+type latencyWeightedNodeSelector[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+] struct {
+	nodes []Node[CHAIN_ID, HEAD, RPC]
+
+	mu      sync.Mutex // protects current
+	current Node[CHAIN_ID, HEAD, RPC]
+}
+
+func NewLatencyWeightedNodeSelector[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+](nodes []Node[CHAIN_ID, HEAD, RPC]) NodeSelector[CHAIN_ID, HEAD, RPC] {
+	return &latencyWeightedNodeSelector[CHAIN_ID, HEAD, RPC]{
+		nodes: nodes,
+	}
+}
+
+// Select returns the alive node with the lowest score. Nodes without recorded stats are only picked if no alive node
+// has been scored yet. The previously selected node is kept while it is alive, unless another node scores better by
+// more than latencyWeightedSwitchThreshold.
+func (s *latencyWeightedNodeSelector[CHAIN_ID, HEAD, RPC]) Select() Node[CHAIN_ID, HEAD, RPC] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var best Node[CHAIN_ID, HEAD, RPC]
+	var bestScore, currentScore float64
+	var bestScored, currentScored, currentAlive bool
+	for _, n := range s.nodes {
+		if n.State() != nodeStateAlive {
+			continue
+		}
+		score, scored := n.Stats().Score()
+		if n == s.current {
+			currentAlive, currentScore, currentScored = true, score, scored
+		}
+		if best == nil || (scored && (!bestScored || score < bestScore)) {
+			best, bestScore, bestScored = n, score, scored
+		}
+	}
+
+	if best == nil {
+		s.current = nil
+		return nil
+	}
+
+	if currentAlive && best != s.current {
+		if !bestScored || (currentScored && bestScore > currentScore*(1-latencyWeightedSwitchThreshold)) {
+			return s.current
+		}
+	}
+
+	s.current = best
+	return best
+}
+
+// setActiveNode replaces the previously selected node, so that the hysteresis of Select applies to the node the
+// MultiNode actually uses, e.g. after a verified read demoted the selected one.
+func (s *latencyWeightedNodeSelector[CHAIN_ID, HEAD, RPC]) setActiveNode(node Node[CHAIN_ID, HEAD, RPC]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.current = node
+}
+
+func (s *latencyWeightedNodeSelector[CHAIN_ID, HEAD, RPC]) Name() string {
+	return NodeSelectionModeLatencyWeighted
+}
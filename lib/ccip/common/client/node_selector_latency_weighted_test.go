@@ -0,0 +1,144 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+func TestLatencyWeightedNodeSelectorName(t *testing.T) {
+	selector := newNodeSelector[types.ID, Head, NodeClient[types.ID, Head]](NodeSelectionModeLatencyWeighted, nil)
+	assert.Equal(t, selector.Name(), NodeSelectionModeLatencyWeighted)
+}
+
+func TestLatencyWeightedNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	type nodeClient NodeClient[types.ID, Head]
+
+	newNode := func(t *testing.T, state nodeState, stats NodeStats) *mockNode[types.ID, Head, nodeClient] {
+		node := newMockNode[types.ID, Head, nodeClient](t)
+		node.On("State").Return(state)
+		node.On("Stats").Return(stats).Maybe()
+		return node
+	}
+	statsWithLatency := func(latency time.Duration, errorRate float64) NodeStats {
+		return NodeStats{LatencyP50: latency, LatencyP90: latency, ErrorRate: errorRate, Samples: 10}
+	}
+
+	t.Run("selects alive node with the lowest score", func(t *testing.T) {
+		t.Parallel()
+		nodes := []Node[types.ID, Head, nodeClient]{
+			newNode(t, nodeStateAlive, statsWithLatency(100*time.Millisecond, 0)),
+			newNode(t, nodeStateOutOfSync, statsWithLatency(time.Millisecond, 0)),
+			newNode(t, nodeStateAlive, statsWithLatency(50*time.Millisecond, 0)),
+		}
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+		assert.Same(t, nodes[2], selector.Select())
+	})
+
+	t.Run("error rate inflates the score", func(t *testing.T) {
+		t.Parallel()
+		nodes := []Node[types.ID, Head, nodeClient]{
+			newNode(t, nodeStateAlive, statsWithLatency(50*time.Millisecond, 0.5)),
+			newNode(t, nodeStateAlive, statsWithLatency(100*time.Millisecond, 0)),
+		}
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("prefers scored nodes over nodes without stats", func(t *testing.T) {
+		t.Parallel()
+		nodes := []Node[types.ID, Head, nodeClient]{
+			newNode(t, nodeStateAlive, NodeStats{}),
+			newNode(t, nodeStateAlive, statsWithLatency(time.Second, 0)),
+		}
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("falls back to the first alive node if none is scored", func(t *testing.T) {
+		t.Parallel()
+		nodes := []Node[types.ID, Head, nodeClient]{
+			newNode(t, nodeStateUnreachable, NodeStats{}),
+			newNode(t, nodeStateAlive, NodeStats{}),
+			newNode(t, nodeStateAlive, NodeStats{}),
+		}
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("returns nil if no node is alive", func(t *testing.T) {
+		t.Parallel()
+		nodes := []Node[types.ID, Head, nodeClient]{
+			newNode(t, nodeStateOutOfSync, NodeStats{}),
+			newNode(t, nodeStateUnreachable, NodeStats{}),
+		}
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+		assert.Nil(t, selector.Select())
+	})
+
+	t.Run("keeps the current node unless another is significantly better", func(t *testing.T) {
+		t.Parallel()
+		node1Stats := statsWithLatency(100*time.Millisecond, 0)
+		node1 := newMockNode[types.ID, Head, nodeClient](t)
+		node1.On("State").Return(nodeStateAlive)
+		node1.On("Stats").Return(func() NodeStats { return node1Stats })
+		node2 := newNode(t, nodeStateAlive, statsWithLatency(110*time.Millisecond, 0))
+		nodes := []Node[types.ID, Head, nodeClient]{node1, node2}
+
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+		assert.Same(t, node1, selector.Select())
+
+		// node2 is now slightly better, which is within the switch threshold
+		node1Stats = statsWithLatency(120*time.Millisecond, 0)
+		assert.Same(t, node1, selector.Select())
+
+		// node2 is now significantly better
+		node1Stats = statsWithLatency(200*time.Millisecond, 0)
+		assert.Same(t, node2, selector.Select())
+	})
+
+	t.Run("switches if the current node is no longer alive", func(t *testing.T) {
+		t.Parallel()
+		state := nodeStateAlive
+		node1 := newMockNode[types.ID, Head, nodeClient](t)
+		node1.On("State").Return(func() nodeState { return state })
+		node1.On("Stats").Return(statsWithLatency(10*time.Millisecond, 0)).Maybe()
+		node2 := newNode(t, nodeStateAlive, statsWithLatency(time.Second, 0))
+		nodes := []Node[types.ID, Head, nodeClient]{node1, node2}
+
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+		assert.Same(t, node1, selector.Select())
+
+		state = nodeStateOutOfSync
+		assert.Same(t, node2, selector.Select())
+	})
+
+	t.Run("applies the switch threshold to the node set by the MultiNode", func(t *testing.T) {
+		t.Parallel()
+		state := nodeStateAlive
+		node1 := newNode(t, nodeStateAlive, statsWithLatency(90*time.Millisecond, 0))
+		node2 := newMockNode[types.ID, Head, nodeClient](t)
+		node2.On("State").Return(func() nodeState { return state })
+		node2.On("Stats").Return(statsWithLatency(110*time.Millisecond, 0)).Maybe()
+		node3Stats := statsWithLatency(80*time.Millisecond, 0)
+		node3 := newMockNode[types.ID, Head, nodeClient](t)
+		node3.On("State").Return(nodeStateAlive)
+		node3.On("Stats").Return(func() NodeStats { return node3Stats })
+		nodes := []Node[types.ID, Head, nodeClient]{node1, node2, node3}
+
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+		assert.Same(t, node3, selector.Select())
+
+		// the MultiNode demoted node3 in favour of node2, once node2 is no longer alive the best node is selected
+		// even though node3 is within the switch threshold
+		selector.(activeNodeSetter[types.ID, Head, nodeClient]).setActiveNode(node2)
+		node3Stats = statsWithLatency(100*time.Millisecond, 0)
+		state = nodeStateOutOfSync
+		assert.Same(t, node1, selector.Select())
+	})
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promPoolRPCNodeLatencyP50 = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pool_rpc_node_latency_p50_seconds",
+		Help: "The rolling 50th percentile of poll latency for the given RPC node",
+	}, []string{"chainID", "nodeName"})
+	promPoolRPCNodeLatencyP90 = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pool_rpc_node_latency_p90_seconds",
+		Help: "The rolling 90th percentile of poll latency for the given RPC node",
+	}, []string{"chainID", "nodeName"})
+	promPoolRPCNodeErrorRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pool_rpc_node_error_rate",
+		Help: "The rolling ratio of failed polls for the given RPC node",
+	}, []string{"chainID", "nodeName"})
+	promPoolRPCNodeScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pool_rpc_node_score",
+		Help: "The latency-weighted score of the given RPC node, lower is better",
+	}, []string{"chainID", "nodeName"})
+)
+
+const (
+	// nodeStatsWindowSize is the number of most recent poll results used to compute NodeStats.
+	nodeStatsWindowSize = 100
+	// nodeErrorRatePenalty controls how much the error rate inflates the score of a node.
+	// With the default of 10, a node failing 10% of its polls scores twice as bad as its latency alone.
+	nodeErrorRatePenalty = 10
+)
+
+// NodeStats is a snapshot of the rolling poll latency percentiles and error rate of a Node.
+type NodeStats struct {
+	LatencyP50 time.Duration
+	LatencyP90 time.Duration
+	ErrorRate  float64
+	// Samples is the number of poll results the stats are computed from.
+	Samples int
+}
+
+// Score returns the latency-weighted score of the node in milliseconds, lower is better.
+// The score is the p90 latency inflated by the error rate. ok is false if no poll results were recorded yet.
+func (s NodeStats) Score() (score float64, ok bool) {
+	if s.Samples == 0 {
+		return 0, false
+	}
+	latencyMs := float64(s.LatencyP90) / float64(time.Millisecond)
+	return latencyMs * (1 + nodeErrorRatePenalty*s.ErrorRate), true
+}
+
+// nodeStatsWindow is a thread-safe ring buffer of the most recent poll results of a node.
+type nodeStatsWindow struct {
+	mu        sync.Mutex
+	latencies [nodeStatsWindowSize]time.Duration
+	failures  [nodeStatsWindowSize]bool
+	next      int
+	count     int
+}
+
+func (w *nodeStatsWindow) record(latency time.Duration, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.latencies[w.next] = latency
+	w.failures[w.next] = err != nil
+	w.next = (w.next + 1) % nodeStatsWindowSize
+	if w.count < nodeStatsWindowSize {
+		w.count++
+	}
+}
+
+func (w *nodeStatsWindow) snapshot() NodeStats {
+	w.mu.Lock()
+	latencies := slices.Clone(w.latencies[:w.count])
+	var failed int
+	for _, f := range w.failures[:w.count] {
+		if f {
+			failed++
+		}
+	}
+	w.mu.Unlock()
+
+	stats := NodeStats{Samples: len(latencies)}
+	if stats.Samples == 0 {
+		return stats
+	}
+	slices.Sort(latencies)
+	stats.LatencyP50 = percentile(latencies, 0.5)
+	stats.LatencyP90 = percentile(latencies, 0.9)
+	stats.ErrorRate = float64(failed) / float64(stats.Samples)
+	return stats
+}
+
+// percentile returns the nearest-rank percentile p of sorted, which must not be empty.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	idx := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(idx, 0)]
+}
+
+// recordPollResult updates the rolling stats of the node with the outcome of a single poll and reports them as metrics.
+func (n *node[CHAIN_ID, HEAD, RPC]) recordPollResult(latency time.Duration, err error) {
+	n.stats.record(latency, err)
+	stats := n.stats.snapshot()
+	chainID := n.chainID.String()
+	promPoolRPCNodeLatencyP50.WithLabelValues(chainID, n.name).Set(stats.LatencyP50.Seconds())
+	promPoolRPCNodeLatencyP90.WithLabelValues(chainID, n.name).Set(stats.LatencyP90.Seconds())
+	promPoolRPCNodeErrorRate.WithLabelValues(chainID, n.name).Set(stats.ErrorRate)
+	if score, ok := stats.Score(); ok {
+		promPoolRPCNodeScore.WithLabelValues(chainID, n.name).Set(score)
+	}
+}
+
+// RecordReadMismatch counts a verified read mismatch as a poll that failed after QueryTimeout, to lower the score of the
+// node.
+func (n *node[CHAIN_ID, HEAD, RPC]) RecordReadMismatch(method string) {
+	promPoolRPCNodeVerifiedReadMismatches.WithLabelValues(n.chainID.String(), n.name, method).Inc()
+	n.recordPollResult(QueryTimeout, errVerifiedReadMismatch)
+}
+
+// Stats returns rolling poll latency percentiles and error rate observed by the node.
+func (n *node[CHAIN_ID, HEAD, RPC]) Stats() NodeStats {
+	return n.stats.snapshot()
+}
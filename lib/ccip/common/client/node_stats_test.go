@@ -0,0 +1,57 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeStatsWindow(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty window has no score", func(t *testing.T) {
+		t.Parallel()
+		var w nodeStatsWindow
+		stats := w.snapshot()
+		assert.Equal(t, NodeStats{}, stats)
+		_, ok := stats.Score()
+		assert.False(t, ok)
+	})
+
+	t.Run("computes percentiles and error rate", func(t *testing.T) {
+		t.Parallel()
+		var w nodeStatsWindow
+		for i := 1; i <= 10; i++ {
+			var err error
+			if i%5 == 0 {
+				err = errors.New("poll failed")
+			}
+			w.record(time.Duration(i)*time.Millisecond, err)
+		}
+		stats := w.snapshot()
+		assert.Equal(t, 10, stats.Samples)
+		assert.Equal(t, 5*time.Millisecond, stats.LatencyP50)
+		assert.Equal(t, 9*time.Millisecond, stats.LatencyP90)
+		assert.InDelta(t, 0.2, stats.ErrorRate, 1e-9)
+		score, ok := stats.Score()
+		assert.True(t, ok)
+		assert.InDelta(t, 9*(1+nodeErrorRatePenalty*0.2), score, 1e-9)
+	})
+
+	t.Run("only keeps the most recent results", func(t *testing.T) {
+		t.Parallel()
+		var w nodeStatsWindow
+		for i := 0; i < nodeStatsWindowSize; i++ {
+			w.record(time.Second, errors.New("poll failed"))
+		}
+		for i := 0; i < nodeStatsWindowSize; i++ {
+			w.record(time.Millisecond, nil)
+		}
+		stats := w.snapshot()
+		assert.Equal(t, nodeStatsWindowSize, stats.Samples)
+		assert.Equal(t, time.Millisecond, stats.LatencyP90)
+		assert.Zero(t, stats.ErrorRate)
+	})
+}
@@ -0,0 +1,176 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+var promPoolRPCNodeVerifiedReadMismatches = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "pool_rpc_node_verified_read_mismatches",
+	Help: "The total number of verified reads in which the given RPC node disagreed with the quorum",
+}, []string{"chainID", "nodeName", "method"})
+
+// ErrVerifiedReadNoQuorum is returned by VerifiedRead if not enough nodes agreed on a response.
+var ErrVerifiedReadNoQuorum = errors.New("verified read: no quorum")
+
+// errVerifiedReadMismatch is recorded in the stats of a node that disagreed with the quorum.
+var errVerifiedReadMismatch = errors.New("response disagreed with verified read quorum")
+
+// VerifiedReadFunc performs a read against a single RPC. It returns the result along with a key identifying it.
+// Responses with equal keys are considered to be in agreement.
+type VerifiedReadFunc[RPC any] func(ctx context.Context, rpc RPC) (result any, key string, err error)
+
+type verifiedReadResponse[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+] struct {
+	node   Node[CHAIN_ID, HEAD, RPC]
+	result any
+	key    string
+	err    error
+}
+
+// VerifiedRead executes read against quorum alive nodes, starting with the active one, and returns the result if they
+// all agree. If they do not, the remaining alive nodes are queried as well, and the result returned by at least quorum
+// nodes wins. Nodes that disagree with the winning result are recorded as misbehaving and, if one of them is the active
+// node, the MultiNode switches to a node that agreed.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) VerifiedRead(
+	ctx context.Context,
+	method string,
+	quorum int,
+	read VerifiedReadFunc[RPC_CLIENT],
+) (any, error) {
+	quorum = max(quorum, 1)
+	active, err := c.selectNode()
+	if err != nil {
+		return nil, err
+	}
+	nodes := []Node[CHAIN_ID, HEAD, RPC_CLIENT]{active}
+	for _, n := range c.nodes {
+		if n != active && n.State() == nodeStateAlive {
+			nodes = append(nodes, n)
+		}
+	}
+	if len(nodes) < quorum {
+		return nil, fmt.Errorf("%w for %s: %d alive nodes, need %d", ErrVerifiedReadNoQuorum, method, len(nodes), quorum)
+	}
+
+	responses := verifiedReadAll(ctx, nodes[:quorum], read)
+	winner, ok := verifiedReadWinner(responses, quorum)
+	if !ok && len(nodes) > quorum {
+		c.lggr.Debugw("Verified read did not reach quorum, querying remaining nodes", "method", method, "quorum", quorum)
+		responses = append(responses, verifiedReadAll(ctx, nodes[quorum:], read)...)
+		winner, ok = verifiedReadWinner(responses, quorum)
+	}
+	if !ok {
+		var errs []error
+		for _, r := range responses {
+			if r.err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", r.node.String(), r.err))
+			}
+		}
+		return nil, fmt.Errorf("%w for %s: %d responses: %w", ErrVerifiedReadNoQuorum, method, len(responses), errors.Join(errs...))
+	}
+
+	var agreed Node[CHAIN_ID, HEAD, RPC_CLIENT]
+	activeDisagreed := false
+	for _, r := range responses {
+		if r.err != nil {
+			continue
+		}
+		if r.key == winner.key {
+			if agreed == nil {
+				agreed = r.node
+			}
+			continue
+		}
+		c.lggr.Warnw("RPC node disagreed with verified read quorum", "node", r.node.String(), "method", method, "quorum", quorum)
+		r.node.RecordReadMismatch(method)
+		activeDisagreed = activeDisagreed || r.node == active
+	}
+	if activeDisagreed {
+		c.replaceActiveNode(active, agreed)
+	}
+
+	return winner.result, nil
+}
+
+// replaceActiveNode switches the active node to replacement, unless the active node has changed in the meantime.
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) replaceActiveNode(
+	node, replacement Node[CHAIN_ID, HEAD, RPC_CLIENT],
+) {
+	c.activeMu.Lock()
+	defer c.activeMu.Unlock()
+	if c.activeNode != node {
+		return
+	}
+	c.lggr.Warnf("Switching from %q to %q after a verified read mismatch", node.String(), replacement.String())
+	node.UnsubscribeAllExceptAliveLoop()
+	c.activeNode = replacement
+	if setter, ok := c.nodeSelector.(activeNodeSetter[CHAIN_ID, HEAD, RPC_CLIENT]); ok {
+		setter.setActiveNode(replacement)
+	}
+}
+
+// verifiedReadAll executes read against all nodes concurrently and returns their responses in the order of nodes.
+func verifiedReadAll[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+](ctx context.Context, nodes []Node[CHAIN_ID, HEAD, RPC], read VerifiedReadFunc[RPC]) []verifiedReadResponse[CHAIN_ID, HEAD, RPC] {
+	responses := make([]verifiedReadResponse[CHAIN_ID, HEAD, RPC], len(nodes))
+	var wg sync.WaitGroup
+	wg.Add(len(nodes))
+	for i, n := range nodes {
+		go func(i int, n Node[CHAIN_ID, HEAD, RPC]) {
+			defer wg.Done()
+			result, key, err := read(ctx, n.RPC())
+			responses[i] = verifiedReadResponse[CHAIN_ID, HEAD, RPC]{node: n, result: result, key: key, err: err}
+		}(i, n)
+	}
+	wg.Wait()
+	return responses
+}
+
+// verifiedReadWinner returns the first response with the key returned by the most nodes, if at least quorum nodes
+// returned it and no other key was returned by as many nodes.
+func verifiedReadWinner[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+](responses []verifiedReadResponse[CHAIN_ID, HEAD, RPC], quorum int) (winner verifiedReadResponse[CHAIN_ID, HEAD, RPC], ok bool) {
+	counts := make(map[string]int)
+	for _, r := range responses {
+		if r.err == nil {
+			counts[r.key]++
+		}
+	}
+	var best string
+	var bestCount int
+	tied := false
+	for key, count := range counts {
+		switch {
+		case count > bestCount:
+			best, bestCount, tied = key, count, false
+		case count == bestCount:
+			tied = true
+		}
+	}
+	if bestCount < quorum || tied {
+		return winner, false
+	}
+	for _, r := range responses {
+		if r.err == nil && r.key == best {
+			return r, true
+		}
+	}
+	return winner, false
+}
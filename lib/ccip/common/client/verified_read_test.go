@@ -0,0 +1,167 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+func TestMultiNode_VerifiedRead(t *testing.T) {
+	t.Parallel()
+
+	type response struct {
+		key string
+		err error
+	}
+	type testNode struct {
+		node *mockNode[types.ID, types.Head[Hashable], multiNodeRPCClient]
+		rpc  multiNodeRPCClient
+	}
+	newTestNodes := func(t *testing.T, states ...nodeState) []testNode {
+		var nodes []testNode
+		for i, state := range states {
+			rpc := newMultiNodeRPCClient(t)
+			node := newMockNode[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+			node.On("State").Return(state).Maybe()
+			node.On("String").Return(fmt.Sprintf("node_%d", i)).Maybe()
+			node.On("RPC").Return(rpc).Maybe()
+			nodes = append(nodes, testNode{node: node, rpc: rpc})
+		}
+		return nodes
+	}
+	newMultiNode := func(t *testing.T, nodes []testNode) testMultiNode {
+		opts := multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       types.RandomID(),
+		}
+		for _, n := range nodes {
+			opts.nodes = append(opts.nodes, n.node)
+		}
+		mn := newTestMultiNode(t, opts)
+		mn.activeNode = nodes[0].node
+		return mn
+	}
+	newRead := func(nodes []testNode, responses ...response) VerifiedReadFunc[multiNodeRPCClient] {
+		byRPC := make(map[multiNodeRPCClient]response)
+		for i, r := range responses {
+			byRPC[nodes[i].rpc] = r
+		}
+		return func(ctx context.Context, rpc multiNodeRPCClient) (any, string, error) {
+			r, ok := byRPC[rpc]
+			if !ok {
+				return nil, "", errors.New("unexpected read")
+			}
+			return "result " + r.key, r.key, r.err
+		}
+	}
+
+	t.Run("returns result if the first quorum nodes agree", func(t *testing.T) {
+		t.Parallel()
+		nodes := newTestNodes(t, nodeStateAlive, nodeStateAlive, nodeStateAlive)
+		mn := newMultiNode(t, nodes)
+		read := newRead(nodes, response{key: "a"}, response{key: "a"}, response{key: "b"})
+
+		result, err := mn.VerifiedRead(tests.Context(t), "eth_getLogs", 2, read)
+		require.NoError(t, err)
+		assert.Equal(t, "result a", result)
+	})
+
+	t.Run("queries remaining nodes and demotes the disagreeing active node", func(t *testing.T) {
+		t.Parallel()
+		nodes := newTestNodes(t, nodeStateAlive, nodeStateAlive, nodeStateAlive)
+		mn := newMultiNode(t, nodes)
+		read := newRead(nodes, response{key: "b"}, response{key: "a"}, response{key: "a"})
+		nodes[0].node.On("RecordReadMismatch", "eth_call").Once()
+		nodes[0].node.On("UnsubscribeAllExceptAliveLoop").Once()
+
+		result, err := mn.VerifiedRead(tests.Context(t), "eth_call", 2, read)
+		require.NoError(t, err)
+		assert.Equal(t, "result a", result)
+		assert.Same(t, nodes[1].node, mn.activeNode)
+	})
+
+	t.Run("demoting the active node updates the node selector", func(t *testing.T) {
+		t.Parallel()
+		nodes := newTestNodes(t, nodeStateAlive, nodeStateAlive, nodeStateAlive)
+		opts := multiNodeOpts{
+			selectionMode: NodeSelectionModeLatencyWeighted,
+			chainID:       types.RandomID(),
+		}
+		for _, n := range nodes {
+			opts.nodes = append(opts.nodes, n.node)
+		}
+		mn := newTestMultiNode(t, opts)
+		mn.activeNode = nodes[0].node
+		read := newRead(nodes, response{key: "b"}, response{key: "a"}, response{key: "a"})
+		nodes[0].node.On("RecordReadMismatch", "eth_call").Once()
+		nodes[0].node.On("UnsubscribeAllExceptAliveLoop").Once()
+
+		_, err := mn.VerifiedRead(tests.Context(t), "eth_call", 2, read)
+		require.NoError(t, err)
+		selector := mn.nodeSelector.(*latencyWeightedNodeSelector[types.ID, types.Head[Hashable], multiNodeRPCClient])
+		assert.Same(t, nodes[1].node, selector.current)
+	})
+
+	t.Run("errors do not count as disagreement", func(t *testing.T) {
+		t.Parallel()
+		nodes := newTestNodes(t, nodeStateAlive, nodeStateAlive, nodeStateAlive)
+		mn := newMultiNode(t, nodes)
+		read := newRead(nodes, response{err: errors.New("timeout")}, response{key: "a"}, response{key: "a"})
+
+		result, err := mn.VerifiedRead(tests.Context(t), "eth_call", 2, read)
+		require.NoError(t, err)
+		assert.Equal(t, "result a", result)
+		assert.Same(t, nodes[0].node, mn.activeNode)
+	})
+
+	t.Run("skips nodes that are not alive", func(t *testing.T) {
+		t.Parallel()
+		nodes := newTestNodes(t, nodeStateAlive, nodeStateOutOfSync, nodeStateAlive)
+		mn := newMultiNode(t, nodes)
+		read := newRead(nodes, response{key: "a"}, response{key: "b"}, response{key: "a"})
+
+		result, err := mn.VerifiedRead(tests.Context(t), "eth_getBlockByNumber", 2, read)
+		require.NoError(t, err)
+		assert.Equal(t, "result a", result)
+	})
+
+	t.Run("fails without quorum", func(t *testing.T) {
+		t.Parallel()
+		nodes := newTestNodes(t, nodeStateAlive, nodeStateAlive)
+		mn := newMultiNode(t, nodes)
+		read := newRead(nodes, response{key: "a"}, response{key: "b"})
+
+		_, err := mn.VerifiedRead(tests.Context(t), "eth_getLogs", 2, read)
+		require.ErrorIs(t, err, ErrVerifiedReadNoQuorum)
+	})
+
+	t.Run("fails if fewer nodes than quorum are alive", func(t *testing.T) {
+		t.Parallel()
+		nodes := newTestNodes(t, nodeStateAlive, nodeStateUnreachable)
+		mn := newMultiNode(t, nodes)
+		read := newRead(nodes, response{key: "a"}, response{key: "a"})
+
+		_, err := mn.VerifiedRead(tests.Context(t), "eth_getLogs", 2, read)
+		require.ErrorIs(t, err, ErrVerifiedReadNoQuorum)
+	})
+
+	t.Run("returns errors of all nodes if none agree", func(t *testing.T) {
+		t.Parallel()
+		nodes := newTestNodes(t, nodeStateAlive, nodeStateAlive)
+		mn := newMultiNode(t, nodes)
+		readErr := errors.New("execution reverted")
+		read := newRead(nodes, response{err: readErr}, response{err: readErr})
+
+		_, err := mn.VerifiedRead(tests.Context(t), "eth_call", 2, read)
+		require.ErrorIs(t, err, ErrVerifiedReadNoQuorum)
+		require.ErrorIs(t, err, readErr)
+	})
+}
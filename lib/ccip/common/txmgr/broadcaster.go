@@ -58,6 +58,10 @@ var (
 			float64(2 * time.Minute),
 		},
 	}, []string{"chainID"})
+	promNumUnstartedTxesByPriority = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_num_unstarted_txes_by_priority",
+		Help: "The number of unstarted transactions waiting to be broadcast from a key, by priority class.",
+	}, []string{"chainID", "fromAddress", "priority"})
 )
 
 var ErrTxRemoved = errors.New("tx removed")
@@ -372,6 +376,10 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) proc
 		if n > 0 {
 			eb.lggr.Debugw("Finished processUnstartedTxs", "address", fromAddress, "time", time.Since(mark), "n", n, "id", "broadcaster")
 		}
+		// the queue is counted once it has been drained, rather than for every transaction
+		if ctx.Err() == nil {
+			eb.reportUnstartedTxsByPriority(ctx, fromAddress)
+		}
 	}()
 
 	err, retryable = eb.handleAnyInProgressTx(ctx, fromAddress)
@@ -391,6 +399,8 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) proc
 					return true, fmt.Errorf("CountUnstartedTransactions failed: %w", err)
 				}
 				eb.lggr.Warnw(fmt.Sprintf(`Transaction throttling; %d transactions in-flight and %d unstarted transactions pending (maximum number of in-flight transactions is %d per key). %s`, nUnconfirmed, nUnstarted, maxInFlightTransactions, label.MaxInFlightTransactionsWarning), "maxInFlightTransactions", maxInFlightTransactions, "nUnconfirmed", nUnconfirmed, "nUnstarted", nUnstarted)
+				// the queue grows while throttled, keep its metrics up to date until it is drained
+				eb.reportUnstartedTxsByPriority(ctx, fromAddress)
 				select {
 				case <-time.After(InFlightTransactionRecheckInterval):
 				case <-ctx.Done():
@@ -414,6 +424,18 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) proc
 	}
 }
 
+// reportUnstartedTxsByPriority updates the number of unstarted transactions of each priority class in metrics
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) reportUnstartedTxsByPriority(ctx context.Context, fromAddress ADDR) {
+	counts, err := eb.txStore.CountUnstartedTransactionsByPriority(ctx, fromAddress, eb.chainID)
+	if err != nil {
+		eb.lggr.Warnw("Failed to count unstarted transactions by priority", "address", fromAddress, "err", err)
+		return
+	}
+	for _, priority := range txmgrtypes.TxPriorities {
+		promNumUnstartedTxesByPriority.WithLabelValues(eb.chainID.String(), fromAddress.String(), priority.String()).Set(float64(counts[priority]))
+	}
+}
+
 // handleInProgressTx checks if there is any transaction
 // in_progress and if so, finishes the job
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) handleAnyInProgressTx(ctx context.Context, fromAddress ADDR) (err error, retryable bool) {
@@ -675,7 +697,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) vali
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) nextUnstartedTransactionWithSequence(fromAddress ADDR) (*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
 	ctx, cancel := eb.chStop.NewCtx()
 	defer cancel()
-	etx, err := eb.txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, eb.chainID)
+	etx, err := eb.txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, eb.chainID, eb.txConfig.PriorityQueueMaxWait())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			// Finish. No more transactions left to process. Hoorah!
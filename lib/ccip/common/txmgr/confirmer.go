@@ -103,6 +103,9 @@ var (
 	}, []string{"chainID"})
 )
 
+// ErrTxNotReplaceable is returned when a manual replacement is requested for a tx that cannot currently be replaced
+var ErrTxNotReplaceable = errors.New("tx cannot be replaced")
+
 type confirmerHeadTracker[HEAD types.Head[BLOCK_HASH], BLOCK_HASH types.Hashable] interface {
 	LatestAndFinalizedBlock(ctx context.Context) (latest, finalized HEAD, err error)
 }
@@ -1258,6 +1261,110 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) sen
 	return txhash, nil
 }
 
+// CancelTransaction replaces the unconfirmed tx with a zero-value transfer to its own sender at the same sequence.
+// The replacement is saved as a purge attempt, so once it is mined the tx is marked as fatally errored.
+// This must not be run while the Confirmer is running.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CancelTransaction(ctx context.Context, txID int64) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	etx, err := ec.findReplaceableTx(ctx, txID)
+	if err != nil {
+		return attempt, err
+	}
+	purgeTx := *etx
+	purgeTx.ToAddress = purgeTx.FromAddress
+	attempt, err = ec.NewPurgeTxAttempt(ctx, purgeTx, etx.GetLogger(ec.lggr))
+	if err != nil {
+		return attempt, fmt.Errorf("failed to create cancel attempt for tx %d: %w", txID, err)
+	}
+	return attempt, ec.sendReplacementAttempt(ctx, *etx, &attempt)
+}
+
+// SpeedUpTransaction rebroadcasts the unconfirmed tx at the same sequence with the given fee, keeping the fee limit
+// and tx type of its latest attempt.
+// This must not be run while the Confirmer is running.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SpeedUpTransaction(ctx context.Context, txID int64, fee FEE) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	etx, err := ec.findReplaceableTx(ctx, txID)
+	if err != nil {
+		return attempt, err
+	}
+	previousAttempt := etx.TxAttempts[0]
+	if previousAttempt.IsPurgeAttempt {
+		return attempt, fmt.Errorf("tx %d is being purged, cancel it again to bump the purge attempt: %w", txID, ErrTxNotReplaceable)
+	}
+	lggr := etx.GetLogger(ec.lggr)
+	attempt, _, err = ec.NewCustomTxAttempt(ctx, *etx, fee, previousAttempt.ChainSpecificFeeLimit, previousAttempt.TxType, lggr)
+	if err != nil {
+		return attempt, fmt.Errorf("failed to create speed-up attempt for tx %d: %w", txID, err)
+	}
+	return attempt, ec.sendReplacementAttempt(ctx, *etx, &attempt)
+}
+
+// ReplaceTransaction rebroadcasts the unconfirmed tx at the same sequence with the given fee limit and a fee bumped
+// from its latest attempt, e.g. when the original fee limit turned out to be too low.
+// This must not be run while the Confirmer is running.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ReplaceTransaction(ctx context.Context, txID int64, feeLimit uint64) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	etx, err := ec.findReplaceableTx(ctx, txID)
+	if err != nil {
+		return attempt, err
+	}
+	previousAttempt := etx.TxAttempts[0]
+	if previousAttempt.IsPurgeAttempt {
+		return attempt, fmt.Errorf("tx %d is being purged, cancel it again to bump the purge attempt: %w", txID, ErrTxNotReplaceable)
+	}
+	// The bumped attempt inherits the fee limit of the attempt it is bumped from
+	previousAttempt.ChainSpecificFeeLimit = feeLimit
+	attempt, _, _, _, err = ec.NewBumpTxAttempt(ctx, *etx, previousAttempt, etx.TxAttempts, etx.GetLogger(ec.lggr))
+	if err != nil {
+		return attempt, fmt.Errorf("failed to create replacement attempt for tx %d: %w", txID, err)
+	}
+	return attempt, ec.sendReplacementAttempt(ctx, *etx, &attempt)
+}
+
+// findReplaceableTx loads the tx with the given ID along with its attempts, and checks that it is unconfirmed
+// and that none of its attempts are still in progress.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) findReplaceableTx(ctx context.Context, txID int64) (*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
+	etx, err := ec.txStore.GetTxByID(ctx, txID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tx %d: %w", txID, err)
+	}
+	if etx == nil {
+		return nil, fmt.Errorf("tx %d not found", txID)
+	}
+	if etx.State != TxUnconfirmed {
+		return nil, fmt.Errorf("tx %d is %s: %w", txID, etx.State, ErrTxNotReplaceable)
+	}
+	if len(etx.TxAttempts) == 0 {
+		return nil, fmt.Errorf("expected unconfirmed tx %d to have at least one attempt", txID)
+	}
+	for _, a := range etx.TxAttempts {
+		if a.State == txmgrtypes.TxAttemptInProgress {
+			return nil, fmt.Errorf("tx %d already has an in-progress attempt %d: %w", txID, a.ID, ErrTxNotReplaceable)
+		}
+	}
+	return etx, nil
+}
+
+// sendReplacementAttempt saves the new attempt for the tx and broadcasts it.
+// Unlike ForceRebroadcast the attempt is tracked, so the Confirmer keeps fetching receipts and bumping it as usual.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) sendReplacementAttempt(ctx context.Context, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt *txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error {
+	if err := ec.txStore.SaveInProgressAttempt(ctx, attempt); err != nil {
+		return fmt.Errorf("failed to save replacement attempt for tx %d: %w", etx.ID, err)
+	}
+	attempt.Tx = etx
+
+	// The block height is only used for logging, the one the previous attempt was seen at is close enough
+	var blockHeight int64
+	if etx.TxAttempts[0].BroadcastBeforeBlockNum != nil {
+		blockHeight = *etx.TxAttempts[0].BroadcastBeforeBlockNum
+	}
+	lggr := etx.GetLogger(ec.lggr)
+	lggr.Infow("Sending replacement attempt", "txAttemptID", attempt.ID, "txHash", attempt.Hash, "fee", attempt.TxFee,
+		"feeLimit", attempt.ChainSpecificFeeLimit, "isPurgeAttempt", attempt.IsPurgeAttempt)
+	if err := ec.handleInProgressAttempt(ctx, lggr, etx, *attempt, blockHeight); err != nil {
+		return fmt.Errorf("failed to send replacement attempt for tx %d: %w", etx.ID, err)
+	}
+	return nil
+}
+
 // ResumePendingTaskRuns issues callbacks to task runs that are pending waiting for receipts
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ResumePendingTaskRuns(ctx context.Context, head types.Head[BLOCK_HASH]) error {
 	receiptsPlus, err := ec.txStore.FindTxesPendingCallback(ctx, head.BlockNumber(), ec.chainID)
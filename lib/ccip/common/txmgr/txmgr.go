@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"sync"
 	"time"
 
@@ -63,6 +64,12 @@ type TxManager[
 	FindEarliestUnconfirmedTxAttemptBlock(ctx context.Context) (nullv4.Int, error)
 	CountTransactionsByState(ctx context.Context, state txmgrtypes.TxState) (count uint32, err error)
 	GetTransactionStatus(ctx context.Context, transactionID string) (state commontypes.TransactionStatus, err error)
+	// CancelTransaction replaces an unconfirmed transaction with a zero-value transfer to its own sender at the same sequence
+	CancelTransaction(ctx context.Context, txID int64) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
+	// SpeedUpTransaction rebroadcasts an unconfirmed transaction at the same sequence with the given fee
+	SpeedUpTransaction(ctx context.Context, txID int64, fee FEE) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
+	// ReplaceTransaction rebroadcasts an unconfirmed transaction at the same sequence with the given fee limit and a bumped fee
+	ReplaceTransaction(ctx context.Context, txID int64, feeLimit uint64) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 }
 
 type reset struct {
@@ -309,6 +316,30 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) HealthRepo
 	return report
 }
 
+// runPaused stops Broadcaster/Confirmer, executes f, then starts them again.
+// Unlike Reset, the error returned by f is passed back to the caller.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) runPaused(ctx context.Context, f func(ctx context.Context) error) (err error) {
+	ok := b.IfStarted(func() {
+		ctx, cancel := b.chStop.Ctx(ctx)
+		defer cancel()
+		var ferr error
+		done := make(chan error)
+		select {
+		case b.reset <- reset{func() { ferr = f(ctx) }, done}:
+		case <-ctx.Done():
+			err = ctx.Err()
+			return
+		}
+		if err = <-done; err == nil {
+			err = ferr
+		}
+	})
+	if !ok {
+		return errors.New("not started")
+	}
+	return err
+}
+
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) runLoop() {
 	ctx, cancel := b.chStop.NewCtx()
 	defer cancel()
@@ -551,6 +582,10 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 		return tx, fmt.Errorf("Txm#CreateTransaction: %w", err)
 	}
 
+	if err = b.checkPriorityQueueCapacity(ctx, txRequest); err != nil {
+		return tx, fmt.Errorf("Txm#CreateTransaction: %w", err)
+	}
+
 	tx, err = b.pruneQueueAndCreateTxn(ctx, txRequest, b.chainID)
 	if err != nil {
 		return tx, err
@@ -562,6 +597,26 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 	return tx, nil
 }
 
+// checkPriorityQueueCapacity returns an error if the queue of the priority class of txRequest is full
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) checkPriorityQueueCapacity(ctx context.Context, txRequest txmgrtypes.TxRequest[ADDR, TX_HASH]) error {
+	priority := txRequest.Meta.GetPriority()
+	if !slices.Contains(txmgrtypes.TxPriorities, priority) {
+		return fmt.Errorf("cannot create transaction; unknown priority class %s", priority)
+	}
+	maxQueued := b.txConfig.PriorityQueueMaxQueued(priority)
+	if maxQueued == 0 {
+		return nil
+	}
+	counts, err := b.txStore.CountUnstartedTransactionsByPriority(ctx, txRequest.FromAddress, b.chainID)
+	if err != nil {
+		return fmt.Errorf("CountUnstartedTransactionsByPriority failed: %w", err)
+	}
+	if count := uint64(counts[priority]); count >= maxQueued {
+		return fmt.Errorf("cannot create transaction; too many unstarted %s priority transactions in the queue (%d/%d)", priority, count, maxQueued)
+	}
+	return nil
+}
+
 // Calls forwarderMgr to get a proper forwarder for a given EOA.
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) GetForwarderForEOA(ctx context.Context, eoa ADDR) (forwarder ADDR, err error) {
 	if !b.txConfig.ForwardersEnabled() {
@@ -675,6 +730,39 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) GetTransac
 	}
 }
 
+// CancelTransaction replaces an unconfirmed transaction with a zero-value transfer to its own sender at the same sequence.
+// Broadcaster and Confirmer are paused while the replacement attempt is created and sent.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CancelTransaction(ctx context.Context, txID int64) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	err = b.runPaused(ctx, func(ctx context.Context) (ferr error) {
+		attempt, ferr = b.confirmer.CancelTransaction(ctx, txID)
+		return ferr
+	})
+	return attempt, err
+}
+
+// SpeedUpTransaction rebroadcasts an unconfirmed transaction at the same sequence with the given fee.
+// Broadcaster and Confirmer are paused while the replacement attempt is created and sent.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SpeedUpTransaction(ctx context.Context, txID int64, fee FEE) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	err = b.runPaused(ctx, func(ctx context.Context) (ferr error) {
+		attempt, ferr = b.confirmer.SpeedUpTransaction(ctx, txID, fee)
+		return ferr
+	})
+	return attempt, err
+}
+
+// ReplaceTransaction rebroadcasts an unconfirmed transaction at the same sequence with the given fee limit and a bumped fee.
+// Broadcaster and Confirmer are paused while the replacement attempt is created and sent.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ReplaceTransaction(ctx context.Context, txID int64, feeLimit uint64) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	if feeLimit == 0 {
+		return attempt, errors.New("fee limit must be greater than zero")
+	}
+	err = b.runPaused(ctx, func(ctx context.Context) (ferr error) {
+		attempt, ferr = b.confirmer.ReplaceTransaction(ctx, txID, feeLimit)
+		return ferr
+	})
+	return attempt, err
+}
+
 type NullTxManager[
 	CHAIN_ID types.ID,
 	HEAD types.Head[BLOCK_HASH],
@@ -762,6 +850,18 @@ func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Get
 	return
 }
 
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) CancelTransaction(ctx context.Context, txID int64) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return attempt, errors.New(n.ErrMsg)
+}
+
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SpeedUpTransaction(ctx context.Context, txID int64, fee FEE) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return attempt, errors.New(n.ErrMsg)
+}
+
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) ReplaceTransaction(ctx context.Context, txID int64, feeLimit uint64) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return attempt, errors.New(n.ErrMsg)
+}
+
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pruneQueueAndCreateTxn(
 	ctx context.Context,
 	txRequest txmgrtypes.TxRequest[ADDR, TX_HASH],
@@ -20,6 +20,9 @@ type TransactionManagerTransactionsConfig interface {
 
 	ForwardersEnabled() bool
 	MaxQueued() uint64
+	// PriorityQueueMaxQueued returns the maximum number of unstarted transactions of the given priority class per
+	// sending key, 0 meaning no limit besides MaxQueued.
+	PriorityQueueMaxQueued(priority TxPriority) uint64
 }
 
 type BroadcasterChainConfig interface {
@@ -33,6 +36,9 @@ type BroadcasterFeeConfig interface {
 
 type BroadcasterTransactionsConfig interface {
 	MaxInFlight() uint32
+	// PriorityQueueMaxWait returns how long an unstarted transaction may wait before it is broadcast ahead of
+	// transactions of higher priority classes, 0 meaning it waits for them indefinitely.
+	PriorityQueueMaxWait() time.Duration
 }
 
 type BroadcasterListenerConfig interface {
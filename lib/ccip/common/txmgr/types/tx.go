@@ -64,6 +64,34 @@ func (s TxAttemptState) String() (str string) {
 	return txAttemptStateStrings[0]
 }
 
+// TxPriority is the priority class of a transaction. Unstarted transactions of a sending key are broadcast in order of
+// their priority class, highest first.
+type TxPriority uint8
+
+const (
+	TxPriorityLow TxPriority = iota
+	// TxPriorityNormal is the priority class of transactions which do not set one in their metadata
+	TxPriorityNormal
+	TxPriorityHigh
+)
+
+// TxPriorities lists all priority classes, lowest first
+var TxPriorities = []TxPriority{TxPriorityLow, TxPriorityNormal, TxPriorityHigh}
+
+var txPriorityStrings = []string{
+	TxPriorityLow:    "low",
+	TxPriorityNormal: "normal",
+	TxPriorityHigh:   "high",
+}
+
+// String returns string formatted priority classes for logging and metrics
+func (p TxPriority) String() string {
+	if int(p) < len(txPriorityStrings) {
+		return txPriorityStrings[p]
+	}
+	return fmt.Sprintf("unknown_priority(%d)", p)
+}
+
 type TxRequest[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 	// IdempotencyKey is a globally unique ID set by the caller, to prevent accidental creation of duplicated Txs during retries or crash recovery.
 	// If this field is set, the TXM will first search existing Txs with this field.
@@ -159,6 +187,20 @@ type TxMeta[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 	MessageIDs []string `json:"MessageIDs,omitempty"`
 	// SeqNumbers is used by CCIP for tx to committed sequence numbers correlation in logs
 	SeqNumbers []uint64 `json:"SeqNumbers,omitempty"`
+
+	// Priority is the priority class the tx is queued and broadcast with, TxPriorityNormal if unset
+	Priority *TxPriority `json:"Priority,omitempty"`
+
+	// BalanceTopUp marks txs sent by the balance keeper to top up a sending key from the treasury key
+	BalanceTopUp *bool `json:"BalanceTopUp,omitempty"`
+}
+
+// GetPriority returns the priority class of the tx, TxPriorityNormal if the metadata does not set one.
+func (m *TxMeta[ADDR, TX_HASH]) GetPriority() TxPriority {
+	if m == nil || m.Priority == nil {
+		return TxPriorityNormal
+	}
+	return *m.Priority
 }
 
 type TxAttempt[
@@ -319,6 +361,10 @@ func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetLogger(lgr logger
 		if len(meta.SeqNumbers) > 0 {
 			lgr = logger.With(lgr, "SeqNumbers", meta.SeqNumbers)
 		}
+
+		if meta.Priority != nil {
+			lgr = logger.With(lgr, "priority", meta.Priority.String())
+		}
 	}
 
 	return logger.Sugared(lgr)
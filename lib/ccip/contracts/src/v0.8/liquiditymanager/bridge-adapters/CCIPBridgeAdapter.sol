@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+pragma solidity 0.8.24;
+
+import {IRouterClient} from "../../ccip/interfaces/IRouterClient.sol";
+import {IBridgeAdapter} from "../interfaces/IBridge.sol";
+
+import {Client} from "../../ccip/libraries/Client.sol";
+
+import {IERC20} from "../../vendor/openzeppelin-solidity/v4.8.3/contracts/token/ERC20/IERC20.sol";
+import {SafeERC20} from "../../vendor/openzeppelin-solidity/v4.8.3/contracts/token/ERC20/utils/SafeERC20.sol";
+
+/// @notice CCIPBridgeAdapter implements IBridgeAdapter with CCIP token transfers, for the chains without a native
+/// bridge between them. One adapter is deployed per lane, on both chains of the lane.
+/// @dev The tokens are sent to the remote LiquidityManager through the Router, the CCIP message carries no data so
+/// the tokens are released to the receiver when the message is executed. The message ID is returned so that the
+/// transfer can be tracked offchain, and it is the payload the transfer is received with on the remote chain.
+contract CCIPBridgeAdapter is IBridgeAdapter {
+  using SafeERC20 for IERC20;
+
+  /// @dev Reference to the CCIP Router of the local chain.
+  IRouterClient internal immutable i_router;
+
+  /// @dev The chain selector of the remote chain of the lane.
+  uint64 internal immutable i_remoteChainSelector;
+
+  constructor(IRouterClient router, uint64 remoteChainSelector) {
+    if (address(router) == address(0)) {
+      revert BridgeAddressCannotBeZero();
+    }
+    i_router = router;
+    i_remoteChainSelector = remoteChainSelector;
+  }
+
+  /// @inheritdoc IBridgeAdapter
+  /// @dev The bridgeSpecificPayload is the abi encoded (uint256 destGasLimit) of the CCIP message. msg.value pays
+  /// the CCIP fee in native tokens, overpayments are not refunded by the Router.
+  /// @return The abi encoded CCIP message ID.
+  function sendERC20(
+    address localToken,
+    address /* remoteToken */,
+    address recipient,
+    uint256 amount,
+    bytes calldata bridgeSpecificPayload
+  ) external payable override returns (bytes memory) {
+    uint256 destGasLimit = abi.decode(bridgeSpecificPayload, (uint256));
+
+    IERC20(localToken).safeTransferFrom(msg.sender, address(this), amount);
+    // The Router pulls the tokens from this contract into the token pool.
+    IERC20(localToken).safeApprove(address(i_router), amount);
+
+    Client.EVMTokenAmount[] memory tokenAmounts = new Client.EVMTokenAmount[](1);
+    tokenAmounts[0] = Client.EVMTokenAmount({token: localToken, amount: amount});
+
+    bytes32 messageId = i_router.ccipSend{value: msg.value}(
+      i_remoteChainSelector,
+      Client.EVM2AnyMessage({
+        receiver: abi.encode(recipient),
+        data: "",
+        tokenAmounts: tokenAmounts,
+        feeToken: address(0), // native
+        // Transfers don't depend on each other, they can be executed in any order.
+        extraArgs: Client._argsToBytes(Client.EVMExtraArgsV2({gasLimit: destGasLimit, allowOutOfOrderExecution: true}))
+      })
+    );
+
+    return abi.encode(messageId);
+  }
+
+  /// @notice The CCIP fee depends on the transferred amount and the gas and token prices, it is quoted offchain
+  /// with getFee on the Router.
+  function getBridgeFeeInNative() public pure returns (uint256) {
+    return 0;
+  }
+
+  /// @notice No-op since the tokens are released to the receiver when the CCIP message is executed.
+  /// @return true always.
+  function finalizeWithdrawERC20(
+    address /* remoteSender */,
+    address /* localReceiver */,
+    bytes calldata /* bridgeSpecificPayload */
+  ) external pure override returns (bool) {
+    return true;
+  }
+
+  /// @notice returns the address of the CCIP Router.
+  function getRouter() external view returns (address) {
+    return address(i_router);
+  }
+
+  /// @notice returns the chain selector of the remote chain of the lane.
+  function getRemoteChainSelector() external view returns (uint64) {
+    return i_remoteChainSelector;
+  }
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BUSL-1.1
+pragma solidity 0.8.24;
+
+import {IBridgeAdapter} from "../interfaces/IBridge.sol";
+import {IZKsyncBridgehub, L2TransactionRequestTwoBridgesOuter} from "../interfaces/zksync/IZKsyncBridgehub.sol";
+import {IZKsyncL1SharedBridge} from "../interfaces/zksync/IZKsyncL1SharedBridge.sol";
+
+import {IERC20} from "../../vendor/openzeppelin-solidity/v4.8.3/contracts/token/ERC20/IERC20.sol";
+import {SafeERC20} from "../../vendor/openzeppelin-solidity/v4.8.3/contracts/token/ERC20/utils/SafeERC20.sol";
+
+/// @notice ZKsyncL1BridgeAdapter implements IBridgeAdapter for the ZKsync L1<=>L2 shared bridge.
+/// @dev L1 -> L2 deposits are requested through the Bridgehub, which forwards the ERC20 deposit to the L1 shared
+/// bridge. The deposit is executed on L2 by the ZKsync operator, the hash of the L2 transaction is returned so
+/// that the deposit can be tracked offchain.
+/// L2 -> L1 withdrawals are finalized in a single step once the L1 batch containing the withdrawal is executed,
+/// by proving the withdrawal message with the merkle proof returned by the ZKsync node.
+/// @dev Only chains whose base token is ether are supported, the L2 transaction base cost is paid with msg.value.
+contract ZKsyncL1BridgeAdapter is IBridgeAdapter {
+  using SafeERC20 for IERC20;
+
+  /// @dev Reference to the Bridgehub contract. Deposits to L2 are requested through this contract.
+  IZKsyncBridgehub internal immutable i_bridgehub;
+
+  /// @dev Reference to the L1 shared bridge contract, which holds the deposited tokens and finalizes withdrawals.
+  IZKsyncL1SharedBridge internal immutable i_l1SharedBridge;
+
+  /// @dev The chain ID of the ZKsync chain.
+  uint256 internal immutable i_l2ChainId;
+
+  constructor(IZKsyncBridgehub bridgehub, IZKsyncL1SharedBridge l1SharedBridge, uint256 l2ChainId) {
+    if (address(bridgehub) == address(0) || address(l1SharedBridge) == address(0)) {
+      revert BridgeAddressCannotBeZero();
+    }
+    i_bridgehub = bridgehub;
+    i_l1SharedBridge = l1SharedBridge;
+    i_l2ChainId = l2ChainId;
+  }
+
+  /// @inheritdoc IBridgeAdapter
+  /// @dev The bridgeSpecificPayload is the abi encoded (uint256 l2GasLimit, uint256 l2GasPerPubdataByteLimit) of
+  /// the L2 transaction. msg.value must cover the L2 transaction base cost, the excess is refunded to the
+  /// recipient on L2.
+  /// @return The abi encoded hash of the L2 transaction of the deposit.
+  function sendERC20(
+    address localToken,
+    address /* remoteToken */,
+    address recipient,
+    uint256 amount,
+    bytes calldata bridgeSpecificPayload
+  ) external payable override returns (bytes memory) {
+    (uint256 l2GasLimit, uint256 l2GasPerPubdataByteLimit) = abi.decode(bridgeSpecificPayload, (uint256, uint256));
+
+    uint256 baseCost = i_bridgehub.l2TransactionBaseCost(i_l2ChainId, tx.gasprice, l2GasLimit, l2GasPerPubdataByteLimit);
+    if (msg.value < baseCost) {
+      revert InsufficientEthValue(baseCost, msg.value);
+    }
+
+    IERC20(localToken).safeTransferFrom(msg.sender, address(this), amount);
+    // The shared bridge pulls the tokens from this contract.
+    IERC20(localToken).safeApprove(address(i_l1SharedBridge), amount);
+
+    bytes32 l2TxHash = i_bridgehub.requestL2TransactionTwoBridges{value: msg.value}(
+      L2TransactionRequestTwoBridgesOuter({
+        chainId: i_l2ChainId,
+        mintValue: msg.value,
+        l2Value: 0,
+        l2GasLimit: l2GasLimit,
+        l2GasPerPubdataByteLimit: l2GasPerPubdataByteLimit,
+        refundRecipient: recipient,
+        secondBridgeAddress: address(i_l1SharedBridge),
+        secondBridgeValue: 0,
+        secondBridgeCalldata: abi.encode(localToken, amount, recipient)
+      })
+    );
+
+    return abi.encode(l2TxHash);
+  }
+
+  /// @notice Bridging to ZKsync is paid for with the L2 transaction base cost, which depends on the gas price of
+  /// the transaction. It is quoted offchain with l2TransactionBaseCost on the Bridgehub.
+  function getBridgeFeeInNative() public pure returns (uint256) {
+    return 0;
+  }
+
+  /// @notice Finalize an ERC20 withdrawal from L2.
+  /// @param bridgeSpecificPayload The abi encoded (bytes32 l2TxHash, uint256 l2BatchNumber, uint256 l2MessageIndex,
+  /// uint16 l2TxNumberInBatch, bytes message, bytes32[] merkleProof). The hash of the L2 withdrawal transaction
+  /// is not used onchain, it allows matching the finalized withdrawals with the sent ones offchain.
+  /// @return true, the funds are sent to the L1 receiver of the withdrawal when it is finalized.
+  function finalizeWithdrawERC20(
+    address /* remoteSender */,
+    address /* localReceiver */,
+    bytes calldata bridgeSpecificPayload
+  ) external override returns (bool) {
+    (
+      ,
+      uint256 l2BatchNumber,
+      uint256 l2MessageIndex,
+      uint16 l2TxNumberInBatch,
+      bytes memory message,
+      bytes32[] memory merkleProof
+    ) = abi.decode(bridgeSpecificPayload, (bytes32, uint256, uint256, uint16, bytes, bytes32[]));
+
+    // will revert if the proof is invalid or the withdrawal is already finalized.
+    i_l1SharedBridge.finalizeWithdrawal(
+      i_l2ChainId,
+      l2BatchNumber,
+      l2MessageIndex,
+      l2TxNumberInBatch,
+      message,
+      merkleProof
+    );
+    return true;
+  }
+
+  /// @notice returns the address of the ZKsync Bridgehub contract.
+  function getBridgehub() external view returns (address) {
+    return address(i_bridgehub);
+  }
+
+  /// @notice returns the address of the ZKsync L1 shared bridge contract.
+  function getL1SharedBridge() external view returns (address) {
+    return address(i_l1SharedBridge);
+  }
+
+  /// @notice returns the chain ID of the ZKsync chain.
+  function getL2ChainId() external view returns (uint256) {
+    return i_l2ChainId;
+  }
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+pragma solidity 0.8.24;
+
+import {IBridgeAdapter} from "../interfaces/IBridge.sol";
+import {IZKsyncL2SharedBridge} from "../interfaces/zksync/IZKsyncL2SharedBridge.sol";
+
+import {IERC20} from "../../vendor/openzeppelin-solidity/v4.8.3/contracts/token/ERC20/IERC20.sol";
+import {SafeERC20} from "../../vendor/openzeppelin-solidity/v4.8.3/contracts/token/ERC20/utils/SafeERC20.sol";
+
+/// @notice ZKsyncL2BridgeAdapter implements IBridgeAdapter for the ZKsync L2<=>L1 shared bridge.
+/// @dev L2 -> L1 withdrawals burn the tokens on L2 and send a message to L1, the withdrawal is finalized on L1 by
+/// the ZKsyncL1BridgeAdapter. The hash of the L2 transaction identifies the withdrawal offchain.
+contract ZKsyncL2BridgeAdapter is IBridgeAdapter {
+  using SafeERC20 for IERC20;
+
+  IZKsyncL2SharedBridge internal immutable i_l2SharedBridge;
+
+  constructor(IZKsyncL2SharedBridge l2SharedBridge) {
+    if (address(l2SharedBridge) == address(0)) {
+      revert BridgeAddressCannotBeZero();
+    }
+    i_l2SharedBridge = l2SharedBridge;
+  }
+
+  /// @inheritdoc IBridgeAdapter
+  function sendERC20(
+    address localToken,
+    address /* remoteToken */,
+    address recipient,
+    uint256 amount,
+    bytes calldata /* bridgeSpecificPayload */
+  ) external payable override returns (bytes memory) {
+    if (msg.value != 0) {
+      revert MsgShouldNotContainValue(msg.value);
+    }
+
+    IERC20(localToken).safeTransferFrom(msg.sender, address(this), amount);
+
+    // No approval needed, the shared bridge burns the tokens from this contract.
+    i_l2SharedBridge.withdraw(recipient, localToken, amount);
+
+    return "";
+  }
+
+  /// @notice No-op since L1 -> L2 deposits are executed on L2 by the ZKsync operator.
+  /// @return true always.
+  function finalizeWithdrawERC20(
+    address /* remoteSender */,
+    address /* localReceiver */,
+    bytes calldata /* bridgeSpecificPayload */
+  ) external pure override returns (bool) {
+    return true;
+  }
+
+  /// @notice There are no fees to bridge back to L1
+  function getBridgeFeeInNative() external pure returns (uint256) {
+    return 0;
+  }
+
+  /// @notice returns the address of the ZKsync L2 shared bridge contract.
+  function getL2SharedBridge() external view returns (address) {
+    return address(i_l2SharedBridge);
+  }
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: MIT
+// Copied from https://github.com/matter-labs/era-contracts/blob/v24.0.0/l1-contracts/contracts/bridgehub/IBridgehub.sol
+pragma solidity ^0.8.0;
+
+struct L2TransactionRequestTwoBridgesOuter {
+  uint256 chainId;
+  uint256 mintValue;
+  uint256 l2Value;
+  uint256 l2GasLimit;
+  uint256 l2GasPerPubdataByteLimit;
+  address refundRecipient;
+  address secondBridgeAddress;
+  uint256 secondBridgeValue;
+  bytes secondBridgeCalldata;
+}
+
+interface IZKsyncBridgehub {
+  /// @notice Requests an L2 transaction through a second bridge, e.g. an ERC20 deposit through the shared bridge.
+  /// @param _request The request, the second bridge calldata is forwarded to the second bridge.
+  /// @return canonicalTxHash The hash of the L2 transaction.
+  function requestL2TransactionTwoBridges(
+    L2TransactionRequestTwoBridgesOuter calldata _request
+  ) external payable returns (bytes32 canonicalTxHash);
+
+  /// @notice Returns the base cost of an L2 transaction, to be paid in the base token of the chain.
+  function l2TransactionBaseCost(
+    uint256 _chainId,
+    uint256 _gasPrice,
+    uint256 _l2GasLimit,
+    uint256 _l2GasPerPubdataByteLimit
+  ) external view returns (uint256);
+}
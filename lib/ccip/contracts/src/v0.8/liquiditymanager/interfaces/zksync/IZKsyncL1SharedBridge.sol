@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: MIT
+// Copied from https://github.com/matter-labs/era-contracts/blob/v24.0.0/l1-contracts/contracts/bridge/interfaces/IL1SharedBridge.sol
+pragma solidity ^0.8.0;
+
+interface IZKsyncL1SharedBridge {
+  /// @notice Finalizes the withdrawal of funds initiated on L2, the funds are sent to the L1 receiver of the
+  /// withdrawal message.
+  /// @param _chainId The ZKsync chain the withdrawal was initiated on.
+  /// @param _l2BatchNumber The L2 batch number where the withdrawal was processed.
+  /// @param _l2MessageIndex The position in the L2 logs Merkle tree of the l2Log that was sent with the message.
+  /// @param _l2TxNumberInBatch The L2 transaction number in the batch, in which the log was sent.
+  /// @param _message The L2 withdraw data, stored in an L2 -> L1 message.
+  /// @param _merkleProof The Merkle proof of the inclusion L2 -> L1 message about withdrawal initialization.
+  function finalizeWithdrawal(
+    uint256 _chainId,
+    uint256 _l2BatchNumber,
+    uint256 _l2MessageIndex,
+    uint16 _l2TxNumberInBatch,
+    bytes calldata _message,
+    bytes32[] calldata _merkleProof
+  ) external;
+}
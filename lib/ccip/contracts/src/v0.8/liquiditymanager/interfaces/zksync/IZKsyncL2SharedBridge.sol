@@ -0,0 +1,12 @@
+// SPDX-License-Identifier: MIT
+// Copied from https://github.com/matter-labs/era-contracts/blob/v24.0.0/l2-contracts/contracts/bridge/interfaces/IL2SharedBridge.sol
+pragma solidity ^0.8.0;
+
+interface IZKsyncL2SharedBridge {
+  /// @notice Initiates a withdrawal by burning funds on the contract and sending the message to L1
+  /// where tokens would be unlocked.
+  /// @param _l1Receiver The account address that should receive funds on L1.
+  /// @param _l2Token The L2 token address which is withdrawn.
+  /// @param _amount The total amount of tokens to be withdrawn.
+  function withdraw(address _l1Receiver, address _l2Token, uint256 _amount) external;
+}
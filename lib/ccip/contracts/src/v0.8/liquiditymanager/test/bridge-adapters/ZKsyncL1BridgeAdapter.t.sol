@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: BUSL-1.1
+pragma solidity 0.8.24;
+
+import {IBridgeAdapter} from "../../interfaces/IBridge.sol";
+
+import {ZKsyncL1BridgeAdapter} from "../../bridge-adapters/ZKsyncL1BridgeAdapter.sol";
+import {IZKsyncBridgehub, L2TransactionRequestTwoBridgesOuter} from "../../interfaces/zksync/IZKsyncBridgehub.sol";
+import {IZKsyncL1SharedBridge} from "../../interfaces/zksync/IZKsyncL1SharedBridge.sol";
+import "forge-std/Test.sol";
+
+import {ERC20} from "../../../vendor/openzeppelin-solidity/v4.8.3/contracts/token/ERC20/ERC20.sol";
+import {IERC20} from "../../../vendor/openzeppelin-solidity/v4.8.3/contracts/token/ERC20/IERC20.sol";
+
+contract ZKsyncL1BridgeAdapterSetup is Test {
+  // addresses below are fake
+  address internal constant BRIDGEHUB = address(1234);
+  address internal constant L1_SHARED_BRIDGE = address(4567);
+  address internal constant OWNER = address(0xdead);
+  address internal constant RECIPIENT = address(0xbeef);
+
+  uint256 internal constant L2_CHAIN_ID = 324;
+  uint256 internal constant L2_GAS_LIMIT = 1_000_000;
+  uint256 internal constant L2_GAS_PER_PUBDATA_BYTE_LIMIT = 800;
+  uint256 internal constant GAS_PRICE = 20 gwei;
+  uint256 internal constant BASE_COST = 1e15;
+  uint256 internal constant TOKEN_BALANCE = 10e18;
+
+  ZKsyncL1BridgeAdapter internal s_adapter;
+  IERC20 internal s_token;
+
+  function setUp() public {
+    vm.startPrank(OWNER);
+
+    s_token = new ERC20("l1", "L1");
+    s_adapter = new ZKsyncL1BridgeAdapter(
+      IZKsyncBridgehub(BRIDGEHUB),
+      IZKsyncL1SharedBridge(L1_SHARED_BRIDGE),
+      L2_CHAIN_ID
+    );
+
+    deal(address(s_token), OWNER, TOKEN_BALANCE);
+    vm.deal(OWNER, 1 ether);
+    vm.txGasPrice(GAS_PRICE);
+
+    // the base cost is quoted with the gas price of the transaction
+    vm.mockCall(
+      BRIDGEHUB,
+      abi.encodeCall(
+        IZKsyncBridgehub.l2TransactionBaseCost,
+        (L2_CHAIN_ID, GAS_PRICE, L2_GAS_LIMIT, L2_GAS_PER_PUBDATA_BYTE_LIMIT)
+      ),
+      abi.encode(BASE_COST)
+    );
+
+    vm.label(OWNER, "Owner");
+    vm.label(BRIDGEHUB, "Bridgehub");
+    vm.label(L1_SHARED_BRIDGE, "L1SharedBridge");
+  }
+}
+
+contract ZKsyncL1BridgeAdapter_constructor is ZKsyncL1BridgeAdapterSetup {
+  function test_constructorSuccess() public view {
+    assertEq(s_adapter.getBridgehub(), BRIDGEHUB);
+    assertEq(s_adapter.getL1SharedBridge(), L1_SHARED_BRIDGE);
+    assertEq(s_adapter.getL2ChainId(), L2_CHAIN_ID);
+    assertEq(s_adapter.getBridgeFeeInNative(), 0);
+  }
+
+  function test_ZeroAddressReverts() public {
+    vm.expectRevert(IBridgeAdapter.BridgeAddressCannotBeZero.selector);
+    new ZKsyncL1BridgeAdapter(IZKsyncBridgehub(address(0)), IZKsyncL1SharedBridge(L1_SHARED_BRIDGE), L2_CHAIN_ID);
+
+    vm.expectRevert(IBridgeAdapter.BridgeAddressCannotBeZero.selector);
+    new ZKsyncL1BridgeAdapter(IZKsyncBridgehub(BRIDGEHUB), IZKsyncL1SharedBridge(address(0)), L2_CHAIN_ID);
+  }
+}
+
+contract ZKsyncL1BridgeAdapter_sendERC20 is ZKsyncL1BridgeAdapterSetup {
+  bytes32 internal constant L2_TX_HASH = bytes32(uint256(0x1234));
+
+  function _request(uint256 mintValue) internal view returns (L2TransactionRequestTwoBridgesOuter memory) {
+    return
+      L2TransactionRequestTwoBridgesOuter({
+        chainId: L2_CHAIN_ID,
+        mintValue: mintValue,
+        l2Value: 0,
+        l2GasLimit: L2_GAS_LIMIT,
+        l2GasPerPubdataByteLimit: L2_GAS_PER_PUBDATA_BYTE_LIMIT,
+        refundRecipient: RECIPIENT,
+        secondBridgeAddress: L1_SHARED_BRIDGE,
+        secondBridgeValue: 0,
+        secondBridgeCalldata: abi.encode(address(s_token), TOKEN_BALANCE, RECIPIENT)
+      });
+  }
+
+  function test_sendERC20Success() public {
+    s_token.approve(address(s_adapter), TOKEN_BALANCE);
+
+    // the excess over the base cost is minted on L2 and refunded to the recipient
+    uint256 value = BASE_COST * 2;
+    bytes memory requestData = abi.encodeCall(IZKsyncBridgehub.requestL2TransactionTwoBridges, (_request(value)));
+    vm.mockCall(BRIDGEHUB, value, requestData, abi.encode(L2_TX_HASH));
+    vm.expectCall(BRIDGEHUB, value, requestData);
+
+    bytes memory result = s_adapter.sendERC20{value: value}(
+      address(s_token),
+      address(0),
+      RECIPIENT,
+      TOKEN_BALANCE,
+      abi.encode(L2_GAS_LIMIT, L2_GAS_PER_PUBDATA_BYTE_LIMIT)
+    );
+
+    assertEq(abi.decode(result, (bytes32)), L2_TX_HASH);
+    assertEq(s_token.balanceOf(OWNER), 0);
+    assertEq(s_token.balanceOf(address(s_adapter)), TOKEN_BALANCE);
+    // the shared bridge pulls the tokens from the adapter
+    assertEq(s_token.allowance(address(s_adapter), L1_SHARED_BRIDGE), TOKEN_BALANCE);
+  }
+
+  function test_sendERC20_InsufficientEthValueReverts() public {
+    s_token.approve(address(s_adapter), TOKEN_BALANCE);
+
+    vm.expectRevert(abi.encodeWithSelector(IBridgeAdapter.InsufficientEthValue.selector, BASE_COST, BASE_COST - 1));
+    s_adapter.sendERC20{value: BASE_COST - 1}(
+      address(s_token),
+      address(0),
+      RECIPIENT,
+      TOKEN_BALANCE,
+      abi.encode(L2_GAS_LIMIT, L2_GAS_PER_PUBDATA_BYTE_LIMIT)
+    );
+  }
+
+  function test_sendERC20_BadPayloadReverts() public {
+    s_token.approve(address(s_adapter), TOKEN_BALANCE);
+
+    vm.expectRevert();
+    s_adapter.sendERC20{value: BASE_COST}(address(s_token), address(0), RECIPIENT, TOKEN_BALANCE, abi.encode(1));
+  }
+}
+
+contract ZKsyncL1BridgeAdapter_finalizeWithdrawERC20 is ZKsyncL1BridgeAdapterSetup {
+  uint256 internal constant L2_BATCH_NUMBER = 5000;
+  uint256 internal constant L2_MESSAGE_INDEX = 12;
+  uint16 internal constant L2_TX_NUMBER_IN_BATCH = 345;
+
+  function _proof() internal pure returns (bytes32[] memory proof) {
+    proof = new bytes32[](2);
+    proof[0] = bytes32(uint256(500));
+    proof[1] = bytes32(uint256(600));
+  }
+
+  function _payload(bytes memory message) internal pure returns (bytes memory) {
+    return
+      abi.encode(
+        bytes32(uint256(0x1234)),
+        L2_BATCH_NUMBER,
+        L2_MESSAGE_INDEX,
+        L2_TX_NUMBER_IN_BATCH,
+        message,
+        _proof()
+      );
+  }
+
+  function test_finalizeWithdrawERC20Success() public {
+    bytes memory message = hex"11a2ccc1deadbeef";
+    bytes memory finalizeData = abi.encodeCall(
+      IZKsyncL1SharedBridge.finalizeWithdrawal,
+      (L2_CHAIN_ID, L2_BATCH_NUMBER, L2_MESSAGE_INDEX, L2_TX_NUMBER_IN_BATCH, message, _proof())
+    );
+    vm.mockCall(L1_SHARED_BRIDGE, finalizeData, "");
+    // the hash of the L2 transaction is only used offchain, the rest of the payload is forwarded
+    vm.expectCall(L1_SHARED_BRIDGE, finalizeData);
+
+    assertTrue(s_adapter.finalizeWithdrawERC20(address(0), address(0), _payload(message)));
+  }
+
+  function test_finalizeWithdrawERC20Reverts() public {
+    // case 1: badly encoded payload
+    vm.expectRevert();
+    s_adapter.finalizeWithdrawERC20(address(0), address(0), abi.encode(1, 2, 3));
+
+    // case 2: the shared bridge rejects the proof
+    bytes memory revertData = abi.encodeWithSignature("Error(string)", "ShB withd w proof");
+    vm.mockCallRevert(
+      L1_SHARED_BRIDGE,
+      abi.encodeWithSelector(IZKsyncL1SharedBridge.finalizeWithdrawal.selector),
+      revertData
+    );
+    vm.expectRevert(revertData);
+    s_adapter.finalizeWithdrawERC20(address(0), address(0), _payload(hex"deadbeef"));
+  }
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+pragma solidity 0.8.24;
+
+import {IBridgeAdapter} from "../../interfaces/IBridge.sol";
+
+import {ZKsyncL2BridgeAdapter} from "../../bridge-adapters/ZKsyncL2BridgeAdapter.sol";
+import {IZKsyncL2SharedBridge} from "../../interfaces/zksync/IZKsyncL2SharedBridge.sol";
+import "forge-std/Test.sol";
+
+import {ERC20} from "../../../vendor/openzeppelin-solidity/v4.8.3/contracts/token/ERC20/ERC20.sol";
+import {IERC20} from "../../../vendor/openzeppelin-solidity/v4.8.3/contracts/token/ERC20/IERC20.sol";
+
+contract ZKsyncL2BridgeAdapterSetup is Test {
+  // addresses below are fake
+  address internal constant L2_SHARED_BRIDGE = address(1234);
+  address internal constant OWNER = address(0xdead);
+  address internal constant RECIPIENT = address(0xbeef);
+
+  uint256 internal constant TOKEN_BALANCE = 10e18;
+
+  ZKsyncL2BridgeAdapter internal s_adapter;
+  IERC20 internal s_token;
+
+  function setUp() public {
+    vm.startPrank(OWNER);
+
+    s_token = new ERC20("l2", "L2");
+    s_adapter = new ZKsyncL2BridgeAdapter(IZKsyncL2SharedBridge(L2_SHARED_BRIDGE));
+
+    deal(address(s_token), OWNER, TOKEN_BALANCE);
+    vm.deal(OWNER, 1 ether);
+
+    vm.label(OWNER, "Owner");
+    vm.label(L2_SHARED_BRIDGE, "L2SharedBridge");
+  }
+}
+
+contract ZKsyncL2BridgeAdapter_constructor is ZKsyncL2BridgeAdapterSetup {
+  function test_constructorSuccess() public view {
+    assertEq(s_adapter.getL2SharedBridge(), L2_SHARED_BRIDGE);
+    assertEq(s_adapter.getBridgeFeeInNative(), 0);
+  }
+
+  function test_ZeroAddressReverts() public {
+    vm.expectRevert(IBridgeAdapter.BridgeAddressCannotBeZero.selector);
+    new ZKsyncL2BridgeAdapter(IZKsyncL2SharedBridge(address(0)));
+  }
+}
+
+contract ZKsyncL2BridgeAdapter_sendERC20 is ZKsyncL2BridgeAdapterSetup {
+  function test_sendERC20Success() public {
+    s_token.approve(address(s_adapter), TOKEN_BALANCE);
+
+    bytes memory withdrawData = abi.encodeCall(
+      IZKsyncL2SharedBridge.withdraw,
+      (RECIPIENT, address(s_token), TOKEN_BALANCE)
+    );
+    vm.mockCall(L2_SHARED_BRIDGE, withdrawData, "");
+    vm.expectCall(L2_SHARED_BRIDGE, 0, withdrawData);
+
+    bytes memory result = s_adapter.sendERC20(address(s_token), address(0), RECIPIENT, TOKEN_BALANCE, "");
+
+    assertEq(result.length, 0);
+    assertEq(s_token.balanceOf(OWNER), 0);
+    // the shared bridge burns the tokens from the adapter, no approval is given
+    assertEq(s_token.balanceOf(address(s_adapter)), TOKEN_BALANCE);
+    assertEq(s_token.allowance(address(s_adapter), L2_SHARED_BRIDGE), 0);
+  }
+
+  function test_sendERC20_MsgShouldNotContainValueReverts() public {
+    s_token.approve(address(s_adapter), TOKEN_BALANCE);
+
+    vm.expectRevert(abi.encodeWithSelector(IBridgeAdapter.MsgShouldNotContainValue.selector, 1));
+    s_adapter.sendERC20{value: 1}(address(s_token), address(0), RECIPIENT, TOKEN_BALANCE, "");
+  }
+}
+
+contract ZKsyncL2BridgeAdapter_finalizeWithdrawERC20 is ZKsyncL2BridgeAdapterSetup {
+  function test_finalizeWithdrawERC20Success() public view {
+    assertTrue(s_adapter.finalizeWithdrawERC20(address(0), address(0), abi.encode(bytes32(uint256(0x1234)))));
+  }
+}
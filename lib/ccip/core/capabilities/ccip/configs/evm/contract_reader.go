@@ -0,0 +1,302 @@
+package evm
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/fee_quoter"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/nonce_manager"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/rmn_proxy_contract"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/rmn_remote"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/router"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/token_admin_registry"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/aggregator_v3_interface"
+	evmrelaytypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+// Contract and method names read by the CCIP plugins, they must match the names in
+// github.com/smartcontractkit/chainlink-ccip/pkg/consts.
+const (
+	ContractNameOffRamp            = "OffRamp"
+	ContractNameFeeQuoter          = "FeeQuoter"
+	ContractNameNonceManager       = "NonceManager"
+	ContractNameRMNRemote          = "RMNRemote"
+	ContractNameRMNProxy           = "RMNProxy"
+	ContractNameRouter             = "Router"
+	ContractNameTokenAdminRegistry = "TokenAdminRegistry"
+	ContractNameTokenPool          = "TokenPool"
+	ContractNameTokenRateGetter    = "TokenRateGetter"
+	ContractNamePriceAggregator    = "AggregatorV3Interface"
+
+	MethodNameOffRampGetStaticConfig            = "OffRampGetStaticConfig"
+	MethodNameOffRampGetDynamicConfig           = "OffRampGetDynamicConfig"
+	MethodNameOffRampLatestConfigDetails        = "OffRampLatestConfigDetails"
+	MethodNameGetSourceChainConfig              = "GetSourceChainConfig"
+	MethodNameGetLatestPriceSequenceNumber      = "GetLatestPriceSequenceNumber"
+	MethodNameFeeQuoterGetStaticConfig          = "GetStaticConfig"
+	MethodNameFeeQuoterGetTokenPrice            = "GetTokenPrice"
+	MethodNameFeeQuoterGetTokenPrices           = "GetTokenPrices"
+	MethodNameGetFeePriceUpdate                 = "GetDestinationChainGasPrice"
+	MethodNameGetInboundNonce                   = "GetInboundNonce"
+	MethodNameGetVersionedConfig                = "GetVersionedConfig"
+	MethodNameGetReportDigestHeader             = "GetReportDigestHeader"
+	MethodNameGetCursedSubjects                 = "GetCursedSubjects"
+	MethodNameGetARM                            = "GetARM"
+	MethodNameRouterGetWrappedNative            = "GetWrappedNative"
+	MethodNameGetPool                           = "GetPool"
+	MethodNameGetCurrentInboundRateLimiterState = "GetCurrentInboundRateLimiterState"
+	MethodNameGetTokenDecimals                  = "GetTokenDecimals"
+	MethodNameGetRate                           = "getRate"
+	MethodNameGetLatestRoundData                = "latestRoundData"
+	MethodNameGetDecimals                       = "decimals"
+
+	EventNameCommitReportAccepted  = "CommitReportAccepted"
+	EventNameExecutionStateChanged = "ExecutionStateChanged"
+
+	EventAttributeSourceChain    = "SourceChain"
+	EventAttributeSequenceNumber = "SequenceNumber"
+	EventAttributeState          = "State"
+)
+
+// MergeReaderConfigs returns a ChainReaderConfig with the contracts of all the configs, a contract defined by
+// more than one config is taken from the last one.
+func MergeReaderConfigs(configs ...evmrelaytypes.ChainReaderConfig) evmrelaytypes.ChainReaderConfig {
+	contracts := make(map[string]evmrelaytypes.ChainContractReader)
+	for _, cfg := range configs {
+		for name, contract := range cfg.Contracts {
+			contracts[name] = contract
+		}
+	}
+	return evmrelaytypes.ChainReaderConfig{Contracts: contracts}
+}
+
+// DestReaderConfig is the chain reader config of the destination chain of the commit and exec plugins. It
+// includes the token pool reads of the exec plugin, see destTokenPoolReaderConfig.
+var DestReaderConfig = MergeReaderConfigs(destRampReaderConfig, destTokenPoolReaderConfig)
+
+// destRampReaderConfig covers the OffRamp and the contracts it references on the destination chain.
+var destRampReaderConfig = evmrelaytypes.ChainReaderConfig{
+	Contracts: map[string]evmrelaytypes.ChainContractReader{
+		ContractNameOffRamp: {
+			ContractABI: offramp.OffRampABI,
+			ContractPollingFilter: evmrelaytypes.ContractPollingFilter{
+				GenericEventNames: []string{EventNameCommitReportAccepted, EventNameExecutionStateChanged},
+			},
+			Configs: map[string]*evmrelaytypes.ChainReaderDefinition{
+				MethodNameOffRampGetStaticConfig: {
+					ChainSpecificName: "getStaticConfig",
+					ReadType:          evmrelaytypes.Method,
+				},
+				MethodNameOffRampGetDynamicConfig: {
+					ChainSpecificName: "getDynamicConfig",
+					ReadType:          evmrelaytypes.Method,
+				},
+				MethodNameOffRampLatestConfigDetails: {
+					ChainSpecificName: "latestConfigDetails",
+					ReadType:          evmrelaytypes.Method,
+				},
+				MethodNameGetSourceChainConfig: {
+					ChainSpecificName: "getSourceChainConfig",
+					ReadType:          evmrelaytypes.Method,
+				},
+				MethodNameGetLatestPriceSequenceNumber: {
+					ChainSpecificName: "getLatestPriceSequenceNumber",
+					ReadType:          evmrelaytypes.Method,
+				},
+				EventNameCommitReportAccepted: {
+					ChainSpecificName: EventNameCommitReportAccepted,
+					ReadType:          evmrelaytypes.Event,
+				},
+				EventNameExecutionStateChanged: {
+					ChainSpecificName: EventNameExecutionStateChanged,
+					ReadType:          evmrelaytypes.Event,
+					EventDefinitions: &evmrelaytypes.EventDefinitions{
+						GenericTopicNames: map[string]string{
+							"sourceChainSelector": EventAttributeSourceChain,
+							"sequenceNumber":      EventAttributeSequenceNumber,
+						},
+						GenericDataWordNames: map[string]string{
+							EventAttributeState: "state",
+						},
+					},
+				},
+			},
+		},
+		ContractNameFeeQuoter: {
+			ContractABI: fee_quoter.FeeQuoterABI,
+			Configs: map[string]*evmrelaytypes.ChainReaderDefinition{
+				MethodNameFeeQuoterGetStaticConfig: {
+					ChainSpecificName: "getStaticConfig",
+					ReadType:          evmrelaytypes.Method,
+				},
+				MethodNameFeeQuoterGetTokenPrice: {
+					ChainSpecificName: "getTokenPrice",
+					ReadType:          evmrelaytypes.Method,
+				},
+				MethodNameFeeQuoterGetTokenPrices: {
+					ChainSpecificName: "getTokenPrices",
+					ReadType:          evmrelaytypes.Method,
+				},
+				MethodNameGetFeePriceUpdate: {
+					ChainSpecificName: "getDestinationChainGasPrice",
+					ReadType:          evmrelaytypes.Method,
+				},
+			},
+		},
+		ContractNameNonceManager: {
+			ContractABI: nonce_manager.NonceManagerABI,
+			Configs: map[string]*evmrelaytypes.ChainReaderDefinition{
+				MethodNameGetInboundNonce: {
+					ChainSpecificName: "getInboundNonce",
+					ReadType:          evmrelaytypes.Method,
+				},
+			},
+		},
+		ContractNameRMNRemote: {
+			ContractABI: rmn_remote.RMNRemoteABI,
+			Configs: map[string]*evmrelaytypes.ChainReaderDefinition{
+				MethodNameGetVersionedConfig: {
+					ChainSpecificName: "getVersionedConfig",
+					ReadType:          evmrelaytypes.Method,
+				},
+				MethodNameGetReportDigestHeader: {
+					ChainSpecificName: "getReportDigestHeader",
+					ReadType:          evmrelaytypes.Method,
+				},
+				MethodNameGetCursedSubjects: {
+					ChainSpecificName: "getCursedSubjects",
+					ReadType:          evmrelaytypes.Method,
+				},
+			},
+		},
+		ContractNameRMNProxy: {
+			ContractABI: rmn_proxy_contract.RMNProxyContractABI,
+			Configs: map[string]*evmrelaytypes.ChainReaderDefinition{
+				MethodNameGetARM: {
+					ChainSpecificName: "getARM",
+					ReadType:          evmrelaytypes.Method,
+				},
+			},
+		},
+		ContractNameRouter: {
+			ContractABI: router.RouterABI,
+			Configs: map[string]*evmrelaytypes.ChainReaderDefinition{
+				MethodNameRouterGetWrappedNative: {
+					ChainSpecificName: "getWrappedNative",
+					ReadType:          evmrelaytypes.Method,
+				},
+			},
+		},
+	},
+}
+
+// tokenPoolABI only contains the TokenPool methods read by the exec plugin. getTokenDecimals is available
+// from the 1.5.1 pools on, the generated 1.5 bindings don't have it.
+const tokenPoolABI = `[
+	{
+		"inputs": [{"internalType": "uint64", "name": "remoteChainSelector", "type": "uint64"}],
+		"name": "getCurrentInboundRateLimiterState",
+		"outputs": [{
+			"components": [
+				{"internalType": "uint128", "name": "tokens", "type": "uint128"},
+				{"internalType": "uint32", "name": "lastUpdated", "type": "uint32"},
+				{"internalType": "bool", "name": "isEnabled", "type": "bool"},
+				{"internalType": "uint128", "name": "capacity", "type": "uint128"},
+				{"internalType": "uint128", "name": "rate", "type": "uint128"}
+			],
+			"internalType": "struct RateLimiter.TokenBucket",
+			"name": "",
+			"type": "tuple"
+		}],
+		"stateMutability": "view",
+		"type": "function"
+	},
+	{
+		"inputs": [],
+		"name": "getTokenDecimals",
+		"outputs": [{"internalType": "uint8", "name": "decimals", "type": "uint8"}],
+		"stateMutability": "view",
+		"type": "function"
+	}
+]`
+
+// destTokenPoolReaderConfig is used by the exec plugin to resolve the pools of the destination tokens and read their
+// inbound rate limits. The TokenAdminRegistry is bound to the address in the OffRamp static config and the TokenPool
+// contract to the address of every pool returned by getPool, the pools are bound by the plugin as they are resolved.
+var destTokenPoolReaderConfig = evmrelaytypes.ChainReaderConfig{
+	Contracts: map[string]evmrelaytypes.ChainContractReader{
+		ContractNameTokenAdminRegistry: {
+			ContractABI: token_admin_registry.TokenAdminRegistryABI,
+			Configs: map[string]*evmrelaytypes.ChainReaderDefinition{
+				MethodNameGetPool: {
+					ChainSpecificName: "getPool",
+					ReadType:          evmrelaytypes.Method,
+				},
+			},
+		},
+		ContractNameTokenPool: {
+			ContractABI: tokenPoolABI,
+			Configs: map[string]*evmrelaytypes.ChainReaderDefinition{
+				MethodNameGetCurrentInboundRateLimiterState: {
+					ChainSpecificName: "getCurrentInboundRateLimiterState",
+					ReadType:          evmrelaytypes.Method,
+				},
+				MethodNameGetTokenDecimals: {
+					ChainSpecificName: "getTokenDecimals",
+					ReadType:          evmrelaytypes.Method,
+				},
+			},
+		},
+	},
+}
+
+// tokenRateGetterABI is the exchange rate method of the yield bearing tokens, e.g. wstETH and rETH, or of the
+// rate providers in front of them. The rate has the decimals configured in the token info of the commit plugin.
+const tokenRateGetterABI = `[
+	{
+		"inputs": [],
+		"name": "getRate",
+		"outputs": [{"internalType": "uint256", "name": "", "type": "uint256"}],
+		"stateMutability": "view",
+		"type": "function"
+	}
+]`
+
+// FeedReaderConfig is the chain reader config of the feed chain of the commit plugin, it reads the price feeds and
+// the exchange rates of the tokens priced with them.
+var FeedReaderConfig = MergeReaderConfigs(feedPriceAggregatorReaderConfig, feedTokenRateGetterReaderConfig)
+
+// feedPriceAggregatorReaderConfig reads the USD price feeds of the tokens. The AggregatorV3Interface contract is
+// bound to multiple addresses, one per feed.
+var feedPriceAggregatorReaderConfig = evmrelaytypes.ChainReaderConfig{
+	Contracts: map[string]evmrelaytypes.ChainContractReader{
+		ContractNamePriceAggregator: {
+			ContractABI: aggregator_v3_interface.AggregatorV3InterfaceABI,
+			Configs: map[string]*evmrelaytypes.ChainReaderDefinition{
+				MethodNameGetLatestRoundData: {
+					ChainSpecificName: "latestRoundData",
+					ReadType:          evmrelaytypes.Method,
+				},
+				MethodNameGetDecimals: {
+					ChainSpecificName: "decimals",
+					ReadType:          evmrelaytypes.Method,
+				},
+			},
+		},
+	},
+}
+
+// feedTokenRateGetterReaderConfig is used by the commit plugin to price exchange rate tokens. The TokenRateGetter
+// contract is bound to multiple addresses, one per rate getter. getRate is read without params and its single
+// output is decoded into a *big.Int.
+var feedTokenRateGetterReaderConfig = evmrelaytypes.ChainReaderConfig{
+	Contracts: map[string]evmrelaytypes.ChainContractReader{
+		ContractNameTokenRateGetter: {
+			ContractABI: tokenRateGetterABI,
+			Configs: map[string]*evmrelaytypes.ChainReaderDefinition{
+				MethodNameGetRate: {
+					ChainSpecificName: "getRate",
+					ReadType:          evmrelaytypes.Method,
+				},
+			},
+		},
+	},
+}
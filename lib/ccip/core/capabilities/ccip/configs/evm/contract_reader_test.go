@@ -0,0 +1,86 @@
+package evm
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	evmrelaytypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+func TestReaderConfigs_MethodsInABI(t *testing.T) {
+	for _, cfg := range []evmrelaytypes.ChainReaderConfig{DestReaderConfig, FeedReaderConfig} {
+		for contractName, contract := range cfg.Contracts {
+			parsed, err := abi.JSON(strings.NewReader(contract.ContractABI))
+			require.NoError(t, err, contractName)
+
+			for readName, definition := range contract.Configs {
+				if definition.ReadType == evmrelaytypes.Event {
+					_, ok := parsed.Events[definition.ChainSpecificName]
+					require.True(t, ok, "%s.%s: event %s not found in ABI", contractName, readName, definition.ChainSpecificName)
+					continue
+				}
+				_, ok := parsed.Methods[definition.ChainSpecificName]
+				require.True(t, ok, "%s.%s: method %s not found in ABI", contractName, readName, definition.ChainSpecificName)
+			}
+		}
+	}
+}
+
+func TestDestReaderConfig_TokenPoolReads(t *testing.T) {
+	// the OffRamp reads are kept alongside the token pool reads.
+	require.Contains(t, DestReaderConfig.Contracts[ContractNameOffRamp].Configs, MethodNameOffRampGetStaticConfig)
+
+	registry, ok := DestReaderConfig.Contracts[ContractNameTokenAdminRegistry]
+	require.True(t, ok)
+	require.Equal(t, "getPool", registry.Configs[MethodNameGetPool].ChainSpecificName)
+
+	pool, ok := DestReaderConfig.Contracts[ContractNameTokenPool]
+	require.True(t, ok)
+	require.Equal(t, "getCurrentInboundRateLimiterState",
+		pool.Configs[MethodNameGetCurrentInboundRateLimiterState].ChainSpecificName)
+	require.Equal(t, "getTokenDecimals", pool.Configs[MethodNameGetTokenDecimals].ChainSpecificName)
+}
+
+func TestMergeReaderConfigs(t *testing.T) {
+	a := evmrelaytypes.ChainReaderConfig{Contracts: map[string]evmrelaytypes.ChainContractReader{
+		"A": {ContractABI: "a"},
+		"B": {ContractABI: "b"},
+	}}
+	b := evmrelaytypes.ChainReaderConfig{Contracts: map[string]evmrelaytypes.ChainContractReader{
+		"B": {ContractABI: "b2"},
+		"C": {ContractABI: "c"},
+	}}
+
+	merged := MergeReaderConfigs(a, b)
+	require.Len(t, merged.Contracts, 3)
+	require.Equal(t, "a", merged.Contracts["A"].ContractABI)
+	require.Equal(t, "b2", merged.Contracts["B"].ContractABI)
+	require.Equal(t, "c", merged.Contracts["C"].ContractABI)
+	// the inputs are left untouched.
+	require.Len(t, a.Contracts, 2)
+}
+
+func TestFeedReaderConfig_GetRate(t *testing.T) {
+	// the price feed reads are kept alongside the rate getter reads.
+	require.Contains(t, FeedReaderConfig.Contracts[ContractNamePriceAggregator].Configs, MethodNameGetLatestRoundData)
+
+	contract, ok := FeedReaderConfig.Contracts[ContractNameTokenRateGetter]
+	require.True(t, ok)
+	parsed, err := abi.JSON(strings.NewReader(contract.ContractABI))
+	require.NoError(t, err)
+
+	// the commit plugin reads the rate with nil params into a *big.Int.
+	method := parsed.Methods[contract.Configs[MethodNameGetRate].ChainSpecificName]
+	require.Empty(t, method.Inputs)
+	require.Len(t, method.Outputs, 1)
+	require.Equal(t, "uint256", method.Outputs[0].Type.String())
+
+	out, err := method.Outputs.Unpack(common.LeftPadBytes(big.NewInt(11e17).Bytes(), 32))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(11e17), out[0])
+}
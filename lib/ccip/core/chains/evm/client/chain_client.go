@@ -17,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
 
@@ -36,6 +37,9 @@ type Client interface {
 	// NodeStates returns a map of node Name->node state
 	// It might be nil or empty, e.g. for mock clients etc
 	NodeStates() map[string]string
+	// NodeStats returns a map of node Name->rolling latency and error rate stats
+	// It might be nil or empty, e.g. for mock clients etc
+	NodeStats() map[string]commonclient.NodeStats
 
 	TokenBalance(ctx context.Context, address common.Address, contractAddress common.Address) (*big.Int, error)
 	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
@@ -118,9 +122,10 @@ type chainClient struct {
 		RPCClient,
 		rpc.BatchElem,
 	]
-	logger       logger.SugaredLogger
-	chainType    chaintype.ChainType
-	clientErrors evmconfig.ClientErrors
+	logger        logger.SugaredLogger
+	chainType     chaintype.ChainType
+	clientErrors  evmconfig.ClientErrors
+	verifiedReads evmconfig.VerifiedReads
 }
 
 func NewChainClient(
@@ -134,6 +139,7 @@ func NewChainClient(
 	chainType chaintype.ChainType,
 	clientErrors evmconfig.ClientErrors,
 	deathDeclarationDelay time.Duration,
+	verifiedReads evmconfig.VerifiedReads,
 ) Client {
 	multiNode := commonclient.NewMultiNode(
 		lggr,
@@ -151,9 +157,10 @@ func NewChainClient(
 		deathDeclarationDelay,
 	)
 	return &chainClient{
-		multiNode:    multiNode,
-		logger:       logger.Sugared(lggr),
-		clientErrors: clientErrors,
+		multiNode:     multiNode,
+		logger:        logger.Sugared(lggr),
+		clientErrors:  clientErrors,
+		verifiedReads: verifiedReads,
 	}
 }
 
@@ -188,6 +195,9 @@ func (c *chainClient) BlockByHash(ctx context.Context, hash common.Hash) (b *typ
 
 // TODO-1663: return custom Block type instead of geth's once client.go is deprecated.
 func (c *chainClient) BlockByNumber(ctx context.Context, number *big.Int) (b *types.Block, err error) {
+	if isPinnedBlock(number) && c.verifyRead(ctx, toml.VerifiedReadMethodGetBlockByNumber) {
+		return c.verifiedBlockByNumber(ctx, number)
+	}
 	rpc, err := c.multiNode.SelectNodeRPC()
 	if err != nil {
 		return b, err
@@ -200,6 +210,9 @@ func (c *chainClient) CallContext(ctx context.Context, result interface{}, metho
 }
 
 func (c *chainClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if isPinnedBlock(blockNumber) && c.verifyRead(ctx, toml.VerifiedReadMethodCall) {
+		return c.verifiedCallContract(ctx, msg, blockNumber)
+	}
 	return c.multiNode.CallContract(ctx, msg, blockNumber)
 }
 
@@ -233,6 +246,9 @@ func (c *chainClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (u
 	return c.multiNode.EstimateGas(ctx, call)
 }
 func (c *chainClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	if isPinnedFilterQuery(q) && c.verifyRead(ctx, toml.VerifiedReadMethodGetLogs) {
+		return c.verifiedFilterLogs(ctx, q)
+	}
 	return c.multiNode.FilterEvents(ctx, q)
 }
 
@@ -245,6 +261,9 @@ func (c *chainClient) HeaderByHash(ctx context.Context, h common.Hash) (head *ty
 }
 
 func (c *chainClient) HeaderByNumber(ctx context.Context, n *big.Int) (head *types.Header, err error) {
+	if isPinnedBlock(n) && c.verifyRead(ctx, toml.VerifiedReadMethodGetBlockByNumber) {
+		return c.verifiedHeaderByNumber(ctx, n)
+	}
 	rpc, err := c.multiNode.SelectNodeRPC()
 	if err != nil {
 		return head, err
@@ -257,6 +276,9 @@ func (c *chainClient) HeadByHash(ctx context.Context, h common.Hash) (*evmtypes.
 }
 
 func (c *chainClient) HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error) {
+	if isPinnedBlock(n) && c.verifyRead(ctx, toml.VerifiedReadMethodGetBlockByNumber) {
+		return c.verifiedHeadByNumber(ctx, n)
+	}
 	return c.multiNode.BlockByNumber(ctx, n)
 }
 
@@ -276,6 +298,10 @@ func (c *chainClient) NodeStates() map[string]string {
 	return c.multiNode.NodeStates()
 }
 
+func (c *chainClient) NodeStats() map[string]commonclient.NodeStats {
+	return c.multiNode.NodeStats()
+}
+
 func (c *chainClient) PendingCodeAt(ctx context.Context, account common.Address) (b []byte, err error) {
 	rpc, err := c.multiNode.SelectNodeRPC()
 	if err != nil {
@@ -40,7 +40,7 @@ func NewEvmClient(cfg evmconfig.NodePool, chainCfg commonclient.ChainConfig, cli
 	}
 
 	return NewChainClient(lggr, cfg.SelectionMode(), cfg.LeaseDuration(), chainCfg.NodeNoNewHeadsThreshold(),
-		primaries, sendonlys, chainID, chainType, clientErrors, cfg.DeathDeclarationDelay())
+		primaries, sendonlys, chainID, chainType, clientErrors, cfg.DeathDeclarationDelay(), cfg.VerifiedReads())
 }
 
 func getRPCTimeouts(chainType chaintype.ChainType) (largePayload, defaultTimeout time.Duration) {
@@ -94,6 +94,7 @@ type TestNodePoolConfig struct {
 	EnforceRepeatableReadVal       bool
 	NodeDeathDeclarationDelay      time.Duration
 	NodeNewHeadsPollInterval       time.Duration
+	NodeVerifiedReads              config.VerifiedReads
 }
 
 func (tc TestNodePoolConfig) PollFailureThreshold() uint32 { return tc.NodePollFailureThreshold }
@@ -128,6 +129,10 @@ func (tc TestNodePoolConfig) DeathDeclarationDelay() time.Duration {
 	return tc.NodeDeathDeclarationDelay
 }
 
+func (tc TestNodePoolConfig) VerifiedReads() config.VerifiedReads {
+	return tc.NodeVerifiedReads
+}
+
 func NewChainClientWithTestNode(
 	t *testing.T,
 	nodeCfg commonclient.NodeConfig,
@@ -169,7 +174,7 @@ func NewChainClientWithTestNode(
 
 	var chainType chaintype.ChainType
 	clientErrors := NewTestClientErrors()
-	c := NewChainClient(lggr, nodeCfg.SelectionMode(), leaseDuration, noNewHeadsThreshold, primaries, sendonlys, chainID, chainType, &clientErrors, 0)
+	c := NewChainClient(lggr, nodeCfg.SelectionMode(), leaseDuration, noNewHeadsThreshold, primaries, sendonlys, chainID, chainType, &clientErrors, 0, nil)
 	t.Cleanup(c.Close)
 	return c, nil
 }
@@ -184,7 +189,7 @@ func NewChainClientWithEmptyNode(
 	lggr := logger.Test(t)
 
 	var chainType chaintype.ChainType
-	c := NewChainClient(lggr, selectionMode, leaseDuration, noNewHeadsThreshold, nil, nil, chainID, chainType, nil, 0)
+	c := NewChainClient(lggr, selectionMode, leaseDuration, noNewHeadsThreshold, nil, nil, chainID, chainType, nil, 0, nil)
 	t.Cleanup(c.Close)
 	return c
 }
@@ -210,7 +215,7 @@ func NewChainClientWithMockedRpc(
 		cfg, clientMocks.ChainConfig{NoNewHeadsThresholdVal: noNewHeadsThreshold}, lggr, parsed, nil, "eth-primary-node-0", 1, chainID, 1, rpc, "EVM")
 	primaries := []commonclient.Node[*big.Int, *evmtypes.Head, RPCClient]{n}
 	clientErrors := NewTestClientErrors()
-	c := NewChainClient(lggr, selectionMode, leaseDuration, noNewHeadsThreshold, primaries, nil, chainID, chainType, &clientErrors, 0)
+	c := NewChainClient(lggr, selectionMode, leaseDuration, noNewHeadsThreshold, primaries, nil, chainID, chainType, &clientErrors, 0, nil)
 	t.Cleanup(c.Close)
 	return c
 }
@@ -1403,6 +1403,53 @@ func (_c *Client_NodeStates_Call) RunAndReturn(run func() map[string]string) *Cl
 	return _c
 }
 
+// NodeStats provides a mock function with given fields:
+func (_m *Client) NodeStats() map[string]commonclient.NodeStats {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NodeStats")
+	}
+
+	var r0 map[string]commonclient.NodeStats
+	if rf, ok := ret.Get(0).(func() map[string]commonclient.NodeStats); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]commonclient.NodeStats)
+		}
+	}
+
+	return r0
+}
+
+// Client_NodeStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NodeStats'
+type Client_NodeStats_Call struct {
+	*mock.Call
+}
+
+// NodeStats is a helper method to define mock.On call
+func (_e *Client_Expecter) NodeStats() *Client_NodeStats_Call {
+	return &Client_NodeStats_Call{Call: _e.mock.On("NodeStats")}
+}
+
+func (_c *Client_NodeStats_Call) Run(run func()) *Client_NodeStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Client_NodeStats_Call) Return(_a0 map[string]commonclient.NodeStats) *Client_NodeStats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Client_NodeStats_Call) RunAndReturn(run func() map[string]commonclient.NodeStats) *Client_NodeStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PendingCallContract provides a mock function with given fields: ctx, msg
 func (_m *Client) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
 	ret := _m.Called(ctx, msg)
@@ -223,6 +223,9 @@ func (nc *NullClient) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, er
 // NodeStates implements evmclient.Client
 func (nc *NullClient) NodeStates() map[string]string { return nil }
 
+// NodeStats implements evmclient.Client
+func (nc *NullClient) NodeStats() map[string]commonclient.NodeStats { return nil }
+
 func (nc *NullClient) IsL2() bool {
 	nc.lggr.Debug("IsL2")
 	return false
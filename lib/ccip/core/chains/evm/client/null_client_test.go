@@ -163,5 +163,6 @@ func TestNullClient(t *testing.T) {
 
 		m := nc.NodeStates()
 		require.Nil(t, m)
+		require.Nil(t, nc.NodeStats())
 	})
 }
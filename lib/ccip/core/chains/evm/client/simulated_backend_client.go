@@ -528,6 +528,9 @@ func (c *SimulatedBackendClient) Backend() *backends.SimulatedBackend {
 // NodeStates implements evmclient.Client
 func (c *SimulatedBackendClient) NodeStates() map[string]string { return nil }
 
+// NodeStats implements evmclient.Client
+func (c *SimulatedBackendClient) NodeStats() map[string]commonclient.NodeStats { return nil }
+
 // Commit imports all the pending transactions as a single block and starts a
 // fresh new state.
 func (c *SimulatedBackendClient) Commit() common.Hash {
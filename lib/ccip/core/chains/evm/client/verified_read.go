@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+type verifiedReadCtxKey struct{}
+
+// WithVerifiedRead returns a context that requests reads made with it to be verified across nodes, even if their RPC
+// method is not listed in NodePool.VerifiedReads.Methods. It has no effect if NodePool.VerifiedReads.Quorum is 0,
+// or if the read is not pinned to a specific block, as responses for the latest block legitimately differ between
+// nodes.
+func WithVerifiedRead(ctx context.Context) context.Context {
+	return context.WithValue(ctx, verifiedReadCtxKey{}, true)
+}
+
+// VerifiedReader is implemented by clients that can verify reads across nodes.
+type VerifiedReader interface {
+	// VerifiedReadsEnabled returns true if reads made with a WithVerifiedRead context are verified.
+	VerifiedReadsEnabled() bool
+}
+
+var _ VerifiedReader = (*chainClient)(nil)
+
+func (c *chainClient) VerifiedReadsEnabled() bool {
+	return c.verifiedReads != nil && c.verifiedReads.Quorum() > 0
+}
+
+// verifyRead returns true if a read of method pinned to a specific block must be verified across nodes.
+func (c *chainClient) verifyRead(ctx context.Context, method string) bool {
+	if !c.VerifiedReadsEnabled() {
+		return false
+	}
+	requested, _ := ctx.Value(verifiedReadCtxKey{}).(bool)
+	return requested || c.verifiedReads.Enabled(method)
+}
+
+// verifiedRead executes read against multiple nodes and returns the result they agreed on. Results are compared by
+// the value returned from key.
+func verifiedRead[T any](
+	ctx context.Context,
+	c *chainClient,
+	method string,
+	read func(ctx context.Context, rpc RPCClient) (T, error),
+	key func(T) (string, error),
+) (result T, err error) {
+	r, err := c.multiNode.VerifiedRead(ctx, method, int(c.verifiedReads.Quorum()), func(ctx context.Context, rpc RPCClient) (any, string, error) {
+		res, err := read(ctx, rpc)
+		if err != nil {
+			return nil, "", err
+		}
+		k, err := key(res)
+		return res, k, err
+	})
+	if err != nil {
+		return result, err
+	}
+	return r.(T), nil
+}
+
+// isPinnedBlock returns true if n refers to a specific block rather than to a tag like latest or pending.
+func isPinnedBlock(n *big.Int) bool {
+	return n != nil && n.Sign() >= 0
+}
+
+func isPinnedFilterQuery(q ethereum.FilterQuery) bool {
+	return q.BlockHash != nil || isPinnedBlock(q.ToBlock)
+}
+
+func logsKey(logs []types.Log) (string, error) {
+	b, err := json.Marshal(logs)
+	if err != nil {
+		return "", err
+	}
+	return crypto.Keccak256Hash(b).Hex(), nil
+}
+
+func callResultKey(b []byte) (string, error) {
+	return hexutil.Encode(b), nil
+}
+
+func headKey(h *evmtypes.Head) (string, error) {
+	if h == nil {
+		return "", nil
+	}
+	return h.Hash.Hex(), nil
+}
+
+func headerKey(h *types.Header) (string, error) {
+	if h == nil {
+		return "", nil
+	}
+	return h.Hash().Hex(), nil
+}
+
+func blockKey(b *types.Block) (string, error) {
+	if b == nil {
+		return "", nil
+	}
+	return b.Hash().Hex(), nil
+}
+
+func (c *chainClient) verifiedFilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return verifiedRead(ctx, c, toml.VerifiedReadMethodGetLogs, func(ctx context.Context, rpc RPCClient) ([]types.Log, error) {
+		return rpc.FilterEvents(ctx, q)
+	}, logsKey)
+}
+
+func (c *chainClient) verifiedCallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return verifiedRead(ctx, c, toml.VerifiedReadMethodCall, func(ctx context.Context, rpc RPCClient) ([]byte, error) {
+		return rpc.CallContract(ctx, msg, blockNumber)
+	}, callResultKey)
+}
+
+func (c *chainClient) verifiedHeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error) {
+	return verifiedRead(ctx, c, toml.VerifiedReadMethodGetBlockByNumber, func(ctx context.Context, rpc RPCClient) (*evmtypes.Head, error) {
+		return rpc.BlockByNumber(ctx, n)
+	}, headKey)
+}
+
+func (c *chainClient) verifiedBlockByNumber(ctx context.Context, n *big.Int) (*types.Block, error) {
+	return verifiedRead(ctx, c, toml.VerifiedReadMethodGetBlockByNumber, func(ctx context.Context, rpc RPCClient) (*types.Block, error) {
+		return rpc.BlockByNumberGeth(ctx, n)
+	}, blockKey)
+}
+
+func (c *chainClient) verifiedHeaderByNumber(ctx context.Context, n *big.Int) (*types.Header, error) {
+	return verifiedRead(ctx, c, toml.VerifiedReadMethodGetBlockByNumber, func(ctx context.Context, rpc RPCClient) (*types.Header, error) {
+		return rpc.HeaderByNumber(ctx, n)
+	}, headerKey)
+}
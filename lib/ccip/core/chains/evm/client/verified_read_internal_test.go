@@ -0,0 +1,71 @@
+package client
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+)
+
+type testVerifiedReads struct {
+	quorum  uint32
+	methods []string
+}
+
+func (v testVerifiedReads) Quorum() uint32 { return v.quorum }
+
+func (v testVerifiedReads) Enabled(method string) bool {
+	return v.quorum > 0 && slices.Contains(v.methods, method)
+}
+
+func TestChainClient_VerifyRead(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+
+	t.Run("disabled without config", func(t *testing.T) {
+		c := &chainClient{}
+		assert.False(t, c.VerifiedReadsEnabled())
+		assert.False(t, c.verifyRead(WithVerifiedRead(ctx), toml.VerifiedReadMethodGetLogs))
+	})
+
+	t.Run("disabled with zero quorum", func(t *testing.T) {
+		c := &chainClient{verifiedReads: testVerifiedReads{methods: []string{toml.VerifiedReadMethodGetLogs}}}
+		assert.False(t, c.VerifiedReadsEnabled())
+		assert.False(t, c.verifyRead(WithVerifiedRead(ctx), toml.VerifiedReadMethodGetLogs))
+	})
+
+	t.Run("verifies configured methods", func(t *testing.T) {
+		c := &chainClient{verifiedReads: testVerifiedReads{quorum: 2, methods: []string{toml.VerifiedReadMethodGetLogs}}}
+		assert.True(t, c.VerifiedReadsEnabled())
+		assert.True(t, c.verifyRead(ctx, toml.VerifiedReadMethodGetLogs))
+		assert.False(t, c.verifyRead(ctx, toml.VerifiedReadMethodCall))
+	})
+
+	t.Run("verifies any method if requested by context", func(t *testing.T) {
+		c := &chainClient{verifiedReads: testVerifiedReads{quorum: 2}}
+		assert.False(t, c.verifyRead(ctx, toml.VerifiedReadMethodCall))
+		assert.True(t, c.verifyRead(WithVerifiedRead(ctx), toml.VerifiedReadMethodCall))
+	})
+}
+
+func TestIsPinnedFilterQuery(t *testing.T) {
+	t.Parallel()
+
+	blockHash := common.HexToHash("0x1")
+	assert.False(t, isPinnedFilterQuery(ethereum.FilterQuery{}))
+	assert.False(t, isPinnedFilterQuery(ethereum.FilterQuery{FromBlock: big.NewInt(1)}))
+	assert.False(t, isPinnedFilterQuery(ethereum.FilterQuery{ToBlock: big.NewInt(rpc.LatestBlockNumber.Int64())}))
+	assert.False(t, isPinnedFilterQuery(ethereum.FilterQuery{ToBlock: big.NewInt(rpc.FinalizedBlockNumber.Int64())}))
+	assert.True(t, isPinnedFilterQuery(ethereum.FilterQuery{ToBlock: big.NewInt(0)}))
+	assert.True(t, isPinnedFilterQuery(ethereum.FilterQuery{FromBlock: big.NewInt(1), ToBlock: big.NewInt(10)}))
+	assert.True(t, isPinnedFilterQuery(ethereum.FilterQuery{BlockHash: &blockHash}))
+}
@@ -1,6 +1,10 @@
 package config
 
-import "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
 
 type balanceMonitorConfig struct {
 	c toml.BalanceMonitor
@@ -9,3 +13,27 @@ type balanceMonitorConfig struct {
 func (b *balanceMonitorConfig) Enabled() bool {
 	return *b.c.Enabled
 }
+
+func (b *balanceMonitorConfig) TopUp() BalanceTopUp {
+	return &balanceTopUpConfig{c: b.c.TopUp}
+}
+
+type balanceTopUpConfig struct {
+	c toml.BalanceTopUp
+}
+
+func (b *balanceTopUpConfig) TreasuryAddress() *types.EIP55Address {
+	return b.c.TreasuryAddress
+}
+
+func (b *balanceTopUpConfig) Threshold() *assets.Wei {
+	return b.c.Threshold
+}
+
+func (b *balanceTopUpConfig) Target() *assets.Wei {
+	return b.c.Target
+}
+
+func (b *balanceTopUpConfig) DailySpendCap() *assets.Wei {
+	return b.c.DailySpendCap
+}
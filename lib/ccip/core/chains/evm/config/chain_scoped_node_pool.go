@@ -44,6 +44,10 @@ func (n *NodePoolConfig) NewHeadsPollInterval() time.Duration {
 
 func (n *NodePoolConfig) Errors() ClientErrors { return &clientErrorsConfig{c: n.C.Errors} }
 
+func (n *NodePoolConfig) VerifiedReads() VerifiedReads {
+	return &verifiedReadsConfig{c: n.C.VerifiedReads}
+}
+
 func (n *NodePoolConfig) EnforceRepeatableRead() bool {
 	return *n.C.EnforceRepeatableRead
 }
@@ -4,6 +4,7 @@ import (
 	"net/url"
 	"time"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 )
 
@@ -39,6 +40,29 @@ func (t *transactionsConfig) AutoPurge() AutoPurgeConfig {
 	return &autoPurgeConfig{c: t.c.AutoPurge}
 }
 
+func (t *transactionsConfig) PriorityQueueMaxWait() time.Duration {
+	if t.c.PriorityQueues.MaxWait == nil {
+		return 0
+	}
+	return t.c.PriorityQueues.MaxWait.Duration()
+}
+
+func (t *transactionsConfig) PriorityQueueMaxQueued(priority txmgrtypes.TxPriority) uint64 {
+	var v *uint32
+	switch priority {
+	case txmgrtypes.TxPriorityHigh:
+		v = t.c.PriorityQueues.MaxQueuedHigh
+	case txmgrtypes.TxPriorityNormal:
+		v = t.c.PriorityQueues.MaxQueuedNormal
+	case txmgrtypes.TxPriorityLow:
+		v = t.c.PriorityQueues.MaxQueuedLow
+	}
+	if v == nil {
+		return 0
+	}
+	return uint64(*v)
+}
+
 type autoPurgeConfig struct {
 	c toml.AutoPurgeConfig
 }
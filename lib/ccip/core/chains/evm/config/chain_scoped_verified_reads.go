@@ -0,0 +1,22 @@
+package config
+
+import (
+	"slices"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+)
+
+type verifiedReadsConfig struct {
+	c toml.VerifiedReads
+}
+
+func (v *verifiedReadsConfig) Quorum() uint32 {
+	if v.c.Quorum == nil {
+		return 0
+	}
+	return *v.c.Quorum
+}
+
+func (v *verifiedReadsConfig) Enabled(method string) bool {
+	return v.Quorum() > 0 && slices.Contains(v.c.Methods, method)
+}
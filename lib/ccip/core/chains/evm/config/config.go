@@ -9,6 +9,7 @@ import (
 
 	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -80,6 +81,15 @@ type HeadTracker interface {
 
 type BalanceMonitor interface {
 	Enabled() bool
+	TopUp() BalanceTopUp
+}
+
+type BalanceTopUp interface {
+	// TreasuryAddress returns the key top-ups are sent from, nil if top-ups are disabled
+	TreasuryAddress() *types.EIP55Address
+	Threshold() *assets.Wei
+	Target() *assets.Wei
+	DailySpendCap() *assets.Wei
 }
 
 type ClientErrors interface {
@@ -108,6 +118,8 @@ type Transactions interface {
 	MaxInFlight() uint32
 	MaxQueued() uint64
 	AutoPurge() AutoPurgeConfig
+	PriorityQueueMaxWait() time.Duration
+	PriorityQueueMaxQueued(priority txmgrtypes.TxPriority) uint64
 }
 
 type AutoPurgeConfig interface {
@@ -183,6 +195,14 @@ type NodePool interface {
 	EnforceRepeatableRead() bool
 	DeathDeclarationDelay() time.Duration
 	NewHeadsPollInterval() time.Duration
+	VerifiedReads() VerifiedReads
+}
+
+type VerifiedReads interface {
+	// Quorum returns the number of nodes that must agree on a verified read response, 0 if verified reads are disabled.
+	Quorum() uint32
+	// Enabled returns true if all reads of the given RPC method must be verified.
+	Enabled(method string) bool
 }
 
 // TODO BCF-2509 does the chainscopedconfig really need the entire app config?
@@ -10,6 +10,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
@@ -404,4 +407,146 @@ func TestClientErrorsConfig(t *testing.T) {
 	})
 }
 
+func TestVerifiedReadsConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled by default", func(t *testing.T) {
+		cfg := testutils.NewTestChainScopedConfig(t, nil)
+
+		verifiedReads := cfg.EVM().NodePool().VerifiedReads()
+		assert.Equal(t, uint32(0), verifiedReads.Quorum())
+		assert.False(t, verifiedReads.Enabled(toml.VerifiedReadMethodGetLogs))
+	})
+
+	t.Run("EVM().NodePool().VerifiedReads()", func(t *testing.T) {
+		cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+			c.NodePool.VerifiedReads = toml.VerifiedReads{
+				Quorum:  ptr[uint32](2),
+				Methods: []string{toml.VerifiedReadMethodGetLogs, toml.VerifiedReadMethodCall},
+			}
+		})
+
+		verifiedReads := cfg.EVM().NodePool().VerifiedReads()
+		assert.Equal(t, uint32(2), verifiedReads.Quorum())
+		assert.True(t, verifiedReads.Enabled(toml.VerifiedReadMethodGetLogs))
+		assert.True(t, verifiedReads.Enabled(toml.VerifiedReadMethodCall))
+		assert.False(t, verifiedReads.Enabled(toml.VerifiedReadMethodGetBlockByNumber))
+	})
+
+	t.Run("ValidateConfig", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			reads   toml.VerifiedReads
+			wantErr string
+		}{
+			{name: "empty", reads: toml.VerifiedReads{}},
+			{name: "quorum only", reads: toml.VerifiedReads{Quorum: ptr[uint32](3)}},
+			{name: "quorum of one", reads: toml.VerifiedReads{Quorum: ptr[uint32](1)}, wantErr: "Quorum: invalid value (1)"},
+			{name: "methods without quorum", reads: toml.VerifiedReads{Methods: []string{toml.VerifiedReadMethodCall}},
+				wantErr: "Quorum: missing"},
+			{name: "unknown method", reads: toml.VerifiedReads{Quorum: ptr[uint32](2), Methods: []string{"eth_getBalance"}},
+				wantErr: "Methods: invalid value (eth_getBalance)"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				err := tc.reads.ValidateConfig()
+				if tc.wantErr == "" {
+					assert.NoError(t, err)
+					return
+				}
+				assert.ErrorContains(t, err, tc.wantErr)
+			})
+		}
+	})
+}
+
+func TestPriorityQueuesConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled by default", func(t *testing.T) {
+		cfg := testutils.NewTestChainScopedConfig(t, nil)
+
+		transactions := cfg.EVM().Transactions()
+		assert.Equal(t, time.Duration(0), transactions.PriorityQueueMaxWait())
+		for _, priority := range txmgrtypes.TxPriorities {
+			assert.Equal(t, uint64(0), transactions.PriorityQueueMaxQueued(priority))
+		}
+	})
+
+	t.Run("EVM().Transactions().PriorityQueues", func(t *testing.T) {
+		cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+			c.Transactions.PriorityQueues = toml.PriorityQueuesConfig{
+				MaxWait:         commonconfig.MustNewDuration(time.Minute),
+				MaxQueuedHigh:   ptr[uint32](10),
+				MaxQueuedNormal: ptr[uint32](50),
+				MaxQueuedLow:    ptr[uint32](20),
+			}
+		})
+
+		transactions := cfg.EVM().Transactions()
+		assert.Equal(t, time.Minute, transactions.PriorityQueueMaxWait())
+		assert.Equal(t, uint64(10), transactions.PriorityQueueMaxQueued(txmgrtypes.TxPriorityHigh))
+		assert.Equal(t, uint64(50), transactions.PriorityQueueMaxQueued(txmgrtypes.TxPriorityNormal))
+		assert.Equal(t, uint64(20), transactions.PriorityQueueMaxQueued(txmgrtypes.TxPriorityLow))
+	})
+}
+
+func TestBalanceTopUpConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled by default", func(t *testing.T) {
+		cfg := testutils.NewTestChainScopedConfig(t, nil)
+
+		assert.Nil(t, cfg.EVM().BalanceMonitor().TopUp().TreasuryAddress())
+	})
+
+	t.Run("EVM().BalanceMonitor().TopUp()", func(t *testing.T) {
+		treasury := testutils.NewAddress()
+		cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+			c.BalanceMonitor.TopUp = toml.BalanceTopUp{
+				TreasuryAddress: ptr(types.EIP55AddressFromAddress(treasury)),
+				Threshold:       assets.NewWeiI(100),
+				Target:          assets.NewWeiI(1000),
+				DailySpendCap:   assets.NewWeiI(2000),
+			}
+		})
+
+		topUp := cfg.EVM().BalanceMonitor().TopUp()
+		require.NotNil(t, topUp.TreasuryAddress())
+		assert.Equal(t, treasury, topUp.TreasuryAddress().Address())
+		assert.Equal(t, assets.NewWeiI(100), topUp.Threshold())
+		assert.Equal(t, assets.NewWeiI(1000), topUp.Target())
+		assert.Equal(t, assets.NewWeiI(2000), topUp.DailySpendCap())
+	})
+
+	t.Run("ValidateConfig", func(t *testing.T) {
+		treasury := ptr(types.EIP55AddressFromAddress(testutils.NewAddress()))
+		for _, tt := range []struct {
+			name string
+			cfg  toml.BalanceMonitor
+			errs []string
+		}{
+			{name: "disabled", cfg: toml.BalanceMonitor{}},
+			{name: "valid", cfg: toml.BalanceMonitor{Enabled: ptr(true), TopUp: toml.BalanceTopUp{TreasuryAddress: treasury,
+				Threshold: assets.NewWeiI(100), Target: assets.NewWeiI(1000), DailySpendCap: assets.NewWeiI(2000)}}},
+			{name: "missing", cfg: toml.BalanceMonitor{TopUp: toml.BalanceTopUp{TreasuryAddress: treasury}},
+				errs: []string{"TopUp.Threshold", "TopUp.Target", "TopUp.DailySpendCap"}},
+			{name: "invalid", cfg: toml.BalanceMonitor{Enabled: ptr(false), TopUp: toml.BalanceTopUp{TreasuryAddress: treasury,
+				Threshold: assets.NewWeiI(1000), Target: assets.NewWeiI(100), DailySpendCap: assets.NewWeiI(2000)}},
+				errs: []string{"TopUp.TreasuryAddress", "must be greater than TopUp.Threshold"}},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				err := tt.cfg.ValidateConfig()
+				if len(tt.errs) == 0 {
+					require.NoError(t, err)
+					return
+				}
+				require.Error(t, err)
+				for _, e := range tt.errs {
+					assert.Contains(t, err.Error(), e)
+				}
+			})
+		}
+	})
+}
+
 func ptr[T any](t T) *T { return &t }
@@ -478,7 +478,8 @@ type Transactions struct {
 	ReaperThreshold      *commonconfig.Duration
 	ResendAfterThreshold *commonconfig.Duration
 
-	AutoPurge AutoPurgeConfig `toml:",omitempty"`
+	AutoPurge      AutoPurgeConfig      `toml:",omitempty"`
+	PriorityQueues PriorityQueuesConfig `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -501,6 +502,7 @@ func (t *Transactions) setFrom(f *Transactions) {
 		t.ResendAfterThreshold = v
 	}
 	t.AutoPurge.setFrom(&f.AutoPurge)
+	t.PriorityQueues.setFrom(&f.PriorityQueues)
 }
 
 type AutoPurgeConfig struct {
@@ -525,6 +527,30 @@ func (a *AutoPurgeConfig) setFrom(f *AutoPurgeConfig) {
 	}
 }
 
+// PriorityQueuesConfig caps the unstarted transactions of each priority class per sending key, and bounds how long a
+// transaction waits behind transactions of higher priority classes.
+type PriorityQueuesConfig struct {
+	MaxWait         *commonconfig.Duration
+	MaxQueuedHigh   *uint32
+	MaxQueuedNormal *uint32
+	MaxQueuedLow    *uint32
+}
+
+func (p *PriorityQueuesConfig) setFrom(f *PriorityQueuesConfig) {
+	if v := f.MaxWait; v != nil {
+		p.MaxWait = v
+	}
+	if v := f.MaxQueuedHigh; v != nil {
+		p.MaxQueuedHigh = v
+	}
+	if v := f.MaxQueuedNormal; v != nil {
+		p.MaxQueuedNormal = v
+	}
+	if v := f.MaxQueuedLow; v != nil {
+		p.MaxQueuedLow = v
+	}
+}
+
 type OCR2 struct {
 	Automation Automation `toml:",omitempty"`
 }
@@ -564,12 +590,61 @@ func (m *Workflow) setFrom(f *Workflow) {
 
 type BalanceMonitor struct {
 	Enabled *bool
+	TopUp   BalanceTopUp `toml:",omitempty"`
 }
 
 func (m *BalanceMonitor) setFrom(f *BalanceMonitor) {
 	if v := f.Enabled; v != nil {
 		m.Enabled = v
 	}
+	m.TopUp.setFrom(&f.TopUp)
+}
+
+func (m *BalanceMonitor) ValidateConfig() (err error) {
+	if m.TopUp.TreasuryAddress == nil {
+		return
+	}
+	if m.Enabled != nil && !*m.Enabled {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "TopUp.TreasuryAddress", Value: m.TopUp.TreasuryAddress,
+			Msg: "requires the balance monitor to be enabled"})
+	}
+	if m.TopUp.Threshold == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "TopUp.Threshold", Msg: "must be set if TopUp.TreasuryAddress is set"})
+	}
+	if m.TopUp.Target == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "TopUp.Target", Msg: "must be set if TopUp.TreasuryAddress is set"})
+	} else if m.TopUp.Threshold != nil && m.TopUp.Target.Cmp(m.TopUp.Threshold) <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "TopUp.Target", Value: m.TopUp.Target,
+			Msg: "must be greater than TopUp.Threshold"})
+	}
+	if m.TopUp.DailySpendCap == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "TopUp.DailySpendCap", Msg: "must be set if TopUp.TreasuryAddress is set"})
+	}
+	return
+}
+
+// BalanceTopUp configures the balance keeper, which sends funds from a treasury key to enabled keys whose balance
+// dropped below Threshold. It is disabled if TreasuryAddress is not set.
+type BalanceTopUp struct {
+	TreasuryAddress *types.EIP55Address
+	Threshold       *assets.Wei
+	Target          *assets.Wei
+	DailySpendCap   *assets.Wei
+}
+
+func (t *BalanceTopUp) setFrom(f *BalanceTopUp) {
+	if v := f.TreasuryAddress; v != nil {
+		t.TreasuryAddress = v
+	}
+	if v := f.Threshold; v != nil {
+		t.Threshold = v
+	}
+	if v := f.Target; v != nil {
+		t.Target = v
+	}
+	if v := f.DailySpendCap; v != nil {
+		t.DailySpendCap = v
+	}
 }
 
 type GasEstimator struct {
@@ -910,6 +985,7 @@ type NodePool struct {
 	EnforceRepeatableRead      *bool
 	DeathDeclarationDelay      *commonconfig.Duration
 	NewHeadsPollInterval       *commonconfig.Duration
+	VerifiedReads              VerifiedReads `toml:",omitempty"`
 }
 
 func (p *NodePool) setFrom(f *NodePool) {
@@ -948,6 +1024,48 @@ func (p *NodePool) setFrom(f *NodePool) {
 	}
 
 	p.Errors.setFrom(&f.Errors)
+	p.VerifiedReads.setFrom(&f.VerifiedReads)
+}
+
+// RPC methods whose responses can be verified across nodes with VerifiedReads.
+const (
+	VerifiedReadMethodGetLogs          = "eth_getLogs"
+	VerifiedReadMethodCall             = "eth_call"
+	VerifiedReadMethodGetBlockByNumber = "eth_getBlockByNumber"
+)
+
+type VerifiedReads struct {
+	Quorum  *uint32  `toml:",omitempty"`
+	Methods []string `toml:",omitempty"`
+}
+
+func (v *VerifiedReads) setFrom(f *VerifiedReads) {
+	if f.Quorum != nil {
+		v.Quorum = f.Quorum
+	}
+	if f.Methods != nil {
+		v.Methods = f.Methods
+	}
+}
+
+func (v *VerifiedReads) ValidateConfig() (err error) {
+	if v.Quorum != nil && *v.Quorum == 1 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Quorum", Value: *v.Quorum,
+			Msg: "must be 0 to disable verified reads, or at least 2"})
+	}
+	if len(v.Methods) > 0 && (v.Quorum == nil || *v.Quorum == 0) {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Quorum", Msg: "must be set if Methods are set"})
+	}
+	for _, m := range v.Methods {
+		switch m {
+		case VerifiedReadMethodGetLogs, VerifiedReadMethodCall, VerifiedReadMethodGetBlockByNumber:
+		default:
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Methods", Value: m,
+				Msg: fmt.Sprintf("must be one of %s, %s or %s", VerifiedReadMethodGetLogs, VerifiedReadMethodCall,
+					VerifiedReadMethodGetBlockByNumber)})
+		}
+	}
+	return
 }
 
 type OCR struct {
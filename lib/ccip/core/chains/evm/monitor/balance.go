@@ -43,6 +43,7 @@ type (
 		ethBalances    map[gethCommon.Address]*assets.Eth
 		ethBalancesMtx sync.RWMutex
 		sleeperTask    *utils.SleeperTask
+		keeper         *BalanceKeeper
 	}
 
 	NullBalanceMonitor struct{}
@@ -50,8 +51,9 @@ type (
 
 var _ BalanceMonitor = (*balanceMonitor)(nil)
 
-// NewBalanceMonitor returns a new balanceMonitor
-func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, lggr logger.Logger) *balanceMonitor {
+// NewBalanceMonitor returns a new balanceMonitor. If keeper is not nil, it tops up keys with a low balance after each
+// check.
+func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, lggr logger.Logger, keeper *BalanceKeeper) *balanceMonitor {
 	chainId := ethClient.ConfiguredChainID()
 	bm := &balanceMonitor{
 		ethClient:   ethClient,
@@ -59,6 +61,7 @@ func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, lgg
 		chainIDStr:  chainId.String(),
 		ethKeyStore: ethKeyStore,
 		ethBalances: make(map[gethCommon.Address]*assets.Eth),
+		keeper:      keeper,
 	}
 	bm.Service, bm.eng = services.Config{
 		Name:  "BalanceMonitor",
@@ -167,6 +170,16 @@ func (w *worker) WorkCtx(ctx context.Context) {
 		}(address)
 	}
 	wg.Wait()
+
+	if w.bm.keeper != nil {
+		balances := make(map[gethCommon.Address]*assets.Eth, len(enabledAddresses))
+		for _, address := range enabledAddresses {
+			if bal := w.bm.GetEthBalance(address); bal != nil {
+				balances[address] = bal
+			}
+		}
+		w.bm.keeper.TopUp(ctx, balances)
+	}
 }
 
 // Approximately ETH block time
@@ -0,0 +1,168 @@
+package monitor
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"time"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+)
+
+// balanceTopUpMetaField is the TxMeta field marking top-up transactions
+const balanceTopUpMetaField = "BalanceTopUp"
+
+// topUpSpendWindow is the window the daily spend cap applies to
+const topUpSpendWindow = 24 * time.Hour
+
+var (
+	// topUpStates are the states of top-ups which count towards the daily spend cap
+	topUpStates = []txmgrtypes.TxState{txmgrcommon.TxUnstarted, txmgrcommon.TxInProgress, txmgrcommon.TxUnconfirmed,
+		txmgrcommon.TxConfirmedMissingReceipt, txmgrcommon.TxConfirmed, txmgrcommon.TxFinalized}
+	// pendingTopUpStates are the states of top-ups whose funds have not arrived yet
+	pendingTopUpStates = []txmgrtypes.TxState{txmgrcommon.TxUnstarted, txmgrcommon.TxInProgress, txmgrcommon.TxUnconfirmed,
+		txmgrcommon.TxConfirmedMissingReceipt}
+)
+
+var promBalanceTopUps = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "eth_balance_top_ups",
+		Help: "The number of top-ups sent to each Ethereum account from the treasury key",
+	},
+	[]string{"account", "evmChainID"},
+)
+
+// BalanceKeeper tops up enabled keys from a treasury key when their balance drops below a threshold. Top-ups are sent
+// through the txmgr and marked in their metadata, which is what the daily spend cap and the check for pending top-ups
+// are based on, so both hold across restarts. Every top-up is recorded in the audit log.
+type BalanceKeeper struct {
+	lggr        logger.Logger
+	auditLogger audit.AuditLogger
+	txm         txmgr.TxManager
+	cfg         config.BalanceTopUp
+	chainID     *big.Int
+	gasLimit    uint64
+}
+
+// NewBalanceKeeper returns a new BalanceKeeper sending top-ups with gasLimit, or nil if top-ups are disabled.
+func NewBalanceKeeper(txm txmgr.TxManager, cfg config.BalanceTopUp, chainID *big.Int, gasLimit uint64, auditLogger audit.AuditLogger, lggr logger.Logger) *BalanceKeeper {
+	if cfg.TreasuryAddress() == nil {
+		return nil
+	}
+	if auditLogger == nil {
+		auditLogger = audit.NoopLogger
+	}
+	return &BalanceKeeper{
+		lggr:        logger.Named(lggr, "BalanceKeeper"),
+		auditLogger: auditLogger,
+		txm:         txm,
+		cfg:         cfg,
+		chainID:     chainID,
+		gasLimit:    gasLimit,
+	}
+}
+
+// TopUp sends funds from the treasury key to each key whose balance is below the threshold, enough to bring it up to
+// the target. Keys with a pending top-up are skipped, as are top-ups that would exceed the daily spend cap.
+// Only the value of the top-ups counts towards the daily spend cap, the gas they cost the treasury key does not.
+// A warning is logged when the treasury key, if it is in balances, holds less than the daily spend cap.
+func (k *BalanceKeeper) TopUp(ctx context.Context, balances map[gethCommon.Address]*assets.Eth) {
+	treasury := k.cfg.TreasuryAddress().Address()
+	threshold, target := k.cfg.Threshold().ToInt(), k.cfg.Target().ToInt()
+
+	if bal, ok := balances[treasury]; ok && bal.ToInt().Cmp(k.cfg.DailySpendCap().ToInt()) < 0 {
+		k.lggr.Warnw("Treasury balance is below the daily spend cap, top-ups may fail for lack of funds",
+			"treasury", treasury, "balance", bal.String(), "dailySpendCap", k.cfg.DailySpendCap())
+	}
+
+	var addresses []gethCommon.Address
+	for address, bal := range balances {
+		if address != treasury && bal.ToInt().Cmp(threshold) < 0 {
+			addresses = append(addresses, address)
+		}
+	}
+	if len(addresses) == 0 {
+		return
+	}
+	// lowest balance first, in case the daily spend cap does not allow topping up all of them
+	slices.SortFunc(addresses, func(a, b gethCommon.Address) int {
+		return balances[a].ToInt().Cmp(balances[b].ToInt())
+	})
+
+	topUps, err := k.txm.FindTxesWithMetaFieldByStates(ctx, balanceTopUpMetaField, topUpStates, k.chainID)
+	if err != nil {
+		k.lggr.Errorw("Failed to find previous top-ups", "err", err)
+		return
+	}
+	pending := make(map[gethCommon.Address]bool)
+	spent := new(big.Int)
+	since := time.Now().Add(-topUpSpendWindow)
+	for _, tx := range topUps {
+		if slices.Contains(pendingTopUpStates, tx.State) {
+			pending[tx.ToAddress] = true
+		}
+		if tx.CreatedAt.After(since) {
+			spent.Add(spent, &tx.Value)
+		}
+	}
+
+	dailySpendCap := k.cfg.DailySpendCap().ToInt()
+	for _, address := range addresses {
+		bal := balances[address]
+		lggr := logger.With(k.lggr, "treasury", treasury, "address", address, "balance", bal.String())
+		if pending[address] {
+			lggr.Debugw("Skipping top-up, a previous top-up is still pending")
+			continue
+		}
+		amount := new(big.Int).Sub(target, bal.ToInt())
+		if newSpent := new(big.Int).Add(spent, amount); newSpent.Cmp(dailySpendCap) > 0 {
+			lggr.Errorw("Skipping top-up, it would exceed the daily spend cap", "amount", assets.NewWei(amount),
+				"spent", assets.NewWei(spent), "dailySpendCap", k.cfg.DailySpendCap())
+			continue
+		}
+		etx, err := k.txm.CreateTransaction(ctx, txmgr.TxRequest{
+			FromAddress:    treasury,
+			ToAddress:      address,
+			EncodedPayload: []byte{},
+			Value:          *amount,
+			FeeLimit:       k.gasLimit,
+			Meta: &txmgr.TxMeta{
+				BalanceTopUp: ptr(true),
+				Priority:     ptr(txmgrtypes.TxPriorityHigh),
+			},
+			Strategy: txmgrcommon.NewSendEveryStrategy(),
+		})
+		if err != nil {
+			lggr.Errorw("Failed to create top-up transaction", "amount", assets.NewWei(amount), "err", err)
+			continue
+		}
+		spent.Add(spent, amount)
+		promBalanceTopUps.WithLabelValues(address.Hex(), k.chainID.String()).Inc()
+		lggr.Infow("Topping up key from treasury", "txID", etx.ID, "amount", assets.NewWei(amount),
+			"spent", assets.NewWei(spent))
+		k.auditLogger.Audit(audit.EthBalanceTopUpCreated, map[string]interface{}{
+			"ethTX":         etx,
+			"evmChainID":    k.chainID.String(),
+			"treasury":      treasury,
+			"address":       address,
+			"balance":       bal.String(),
+			"amount":        assets.NewWei(amount).String(),
+			"target":        k.cfg.Target().String(),
+			"spent":         assets.NewWei(spent).String(),
+			"dailySpendCap": k.cfg.DailySpendCap().String(),
+		})
+	}
+}
+
+func ptr[T any](t T) *T { return &t }
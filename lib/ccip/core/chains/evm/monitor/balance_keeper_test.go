@@ -0,0 +1,160 @@
+package monitor_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	ksmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/monitor"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+)
+
+func newTopUpConfig(t *testing.T, treasury common.Address) config.BalanceTopUp {
+	cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+		treasuryAddress := types.EIP55AddressFromAddress(treasury)
+		c.BalanceMonitor.TopUp = toml.BalanceTopUp{
+			TreasuryAddress: &treasuryAddress,
+			Threshold:       assets.NewWeiI(100),
+			Target:          assets.NewWeiI(1000),
+			DailySpendCap:   assets.NewWeiI(2000),
+		}
+	})
+	return cfg.EVM().BalanceMonitor().TopUp()
+}
+
+func matchTopUp(from, to common.Address, amount int64) interface{} {
+	return mock.MatchedBy(func(r txmgr.TxRequest) bool {
+		return r.FromAddress == from && r.ToAddress == to && r.Value.Cmp(big.NewInt(amount)) == 0 &&
+			r.Meta != nil && r.Meta.BalanceTopUp != nil && *r.Meta.BalanceTopUp &&
+			r.Meta.GetPriority() == txmgrtypes.TxPriorityHigh
+	})
+}
+
+type auditEventsRecorder struct {
+	audit.AuditLogger
+	events []audit.EventID
+}
+
+func (r *auditEventsRecorder) Audit(eventID audit.EventID, data audit.Data) {
+	r.events = append(r.events, eventID)
+}
+
+func TestBalanceKeeper_TopUp(t *testing.T) {
+	t.Parallel()
+
+	treasury := testutils.NewAddress()
+
+	t.Run("disabled without treasury address", func(t *testing.T) {
+		cfg := testutils.NewTestChainScopedConfig(t, nil)
+		keeper := monitor.NewBalanceKeeper(txmmocks.NewMockEvmTxManager(t), cfg.EVM().BalanceMonitor().TopUp(), testutils.FixtureChainID, 21000, audit.NoopLogger, logger.Test(t))
+		assert.Nil(t, keeper)
+	})
+
+	t.Run("tops up keys below the threshold", func(t *testing.T) {
+		txm := txmmocks.NewMockEvmTxManager(t)
+		auditLogger := &auditEventsRecorder{AuditLogger: audit.NoopLogger}
+		lggr, observed := logger.TestObserved(t, zapcore.WarnLevel)
+		keeper := monitor.NewBalanceKeeper(txm, newTopUpConfig(t, treasury), testutils.FixtureChainID, 21000, auditLogger, lggr)
+		k0Addr := testutils.NewAddress()
+		k1Addr := testutils.NewAddress()
+
+		txm.On("FindTxesWithMetaFieldByStates", mock.Anything, "BalanceTopUp", mock.Anything, testutils.FixtureChainID).Return(nil, nil).Once()
+		txm.On("CreateTransaction", mock.Anything, matchTopUp(treasury, k0Addr, 990)).Return(txmgr.Tx{ID: 1}, nil).Once()
+
+		keeper.TopUp(tests.Context(t), map[common.Address]*assets.Eth{
+			treasury: assets.NewEth(10),
+			k0Addr:   assets.NewEth(10),
+			k1Addr:   assets.NewEth(500),
+		})
+
+		assert.Equal(t, []audit.EventID{audit.EthBalanceTopUpCreated}, auditLogger.events)
+		// the treasury holds less than the daily spend cap
+		assert.Equal(t, 1, observed.FilterMessageSnippet("Treasury balance is below the daily spend cap").Len())
+	})
+
+	t.Run("does nothing if all keys are above the threshold", func(t *testing.T) {
+		txm := txmmocks.NewMockEvmTxManager(t)
+		keeper := monitor.NewBalanceKeeper(txm, newTopUpConfig(t, treasury), testutils.FixtureChainID, 21000, audit.NoopLogger, logger.Test(t))
+
+		keeper.TopUp(tests.Context(t), map[common.Address]*assets.Eth{
+			treasury:               assets.NewEth(10_000),
+			testutils.NewAddress(): assets.NewEth(100),
+		})
+	})
+
+	t.Run("skips keys with a pending top-up", func(t *testing.T) {
+		txm := txmmocks.NewMockEvmTxManager(t)
+		keeper := monitor.NewBalanceKeeper(txm, newTopUpConfig(t, treasury), testutils.FixtureChainID, 21000, audit.NoopLogger, logger.Test(t))
+		k0Addr := testutils.NewAddress()
+
+		txm.On("FindTxesWithMetaFieldByStates", mock.Anything, "BalanceTopUp", mock.Anything, testutils.FixtureChainID).Return([]*txmgr.Tx{
+			{ID: 1, ToAddress: k0Addr, State: txmgrcommon.TxUnconfirmed, Value: *big.NewInt(990), CreatedAt: time.Now()},
+		}, nil).Once()
+
+		keeper.TopUp(tests.Context(t), map[common.Address]*assets.Eth{
+			treasury: assets.NewEth(10_000),
+			k0Addr:   assets.NewEth(10),
+		})
+	})
+
+	t.Run("stays within the daily spend cap", func(t *testing.T) {
+		txm := txmmocks.NewMockEvmTxManager(t)
+		keeper := monitor.NewBalanceKeeper(txm, newTopUpConfig(t, treasury), testutils.FixtureChainID, 21000, audit.NoopLogger, logger.Test(t))
+		k0Addr := testutils.NewAddress()
+		k1Addr := testutils.NewAddress()
+
+		txm.On("FindTxesWithMetaFieldByStates", mock.Anything, "BalanceTopUp", mock.Anything, testutils.FixtureChainID).Return([]*txmgr.Tx{
+			{ID: 1, ToAddress: testutils.NewAddress(), State: txmgrcommon.TxConfirmed, Value: *big.NewInt(1000), CreatedAt: time.Now().Add(-time.Hour)},
+			{ID: 2, ToAddress: testutils.NewAddress(), State: txmgrcommon.TxFinalized, Value: *big.NewInt(5000), CreatedAt: time.Now().Add(-48 * time.Hour)},
+		}, nil).Once()
+		// k0 has the lowest balance and is topped up first, which leaves no room for k1
+		txm.On("CreateTransaction", mock.Anything, matchTopUp(treasury, k0Addr, 990)).Return(txmgr.Tx{ID: 3}, nil).Once()
+
+		keeper.TopUp(tests.Context(t), map[common.Address]*assets.Eth{
+			treasury: assets.NewEth(10_000),
+			k0Addr:   assets.NewEth(10),
+			k1Addr:   assets.NewEth(50),
+		})
+	})
+}
+
+func TestBalanceMonitor_TopUp(t *testing.T) {
+	t.Parallel()
+
+	treasury := testutils.NewAddress()
+	k0Addr := testutils.NewAddress()
+	ethKeyStore := ksmocks.NewEth(t)
+	ethKeyStore.On("EnabledAddressesForChain", mock.Anything, mock.Anything).
+		Return([]common.Address{treasury, k0Addr}, nil)
+	ethClient := newEthClientMock(t)
+	txm := txmmocks.NewMockEvmTxManager(t)
+
+	keeper := monitor.NewBalanceKeeper(txm, newTopUpConfig(t, treasury), testutils.FixtureChainID, 21000, audit.NoopLogger, logger.Test(t))
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t), keeper)
+
+	ethClient.On("BalanceAt", mock.Anything, treasury, nilBigInt).Once().Return(big.NewInt(10_000), nil)
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(40), nil)
+	txm.On("FindTxesWithMetaFieldByStates", mock.Anything, "BalanceTopUp", mock.Anything, testutils.FixtureChainID).Return(nil, nil).Once()
+	txm.On("CreateTransaction", mock.Anything, matchTopUp(treasury, k0Addr, 960)).Return(txmgr.Tx{ID: 1}, nil).Once()
+
+	servicetest.RunHealthy(t, bm)
+}
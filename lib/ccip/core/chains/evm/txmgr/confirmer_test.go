@@ -3005,6 +3005,106 @@ func TestEthConfirmer_ForceRebroadcast(t *testing.T) {
 	})
 }
 
+func TestEthConfirmer_ManualReplacement(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	gconfig, config := newTestChainScopedConfig(t)
+	ctx := tests.Context(t)
+
+	t.Run("cancels an unconfirmed tx with a zero value self transfer at the same nonce", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ec := newEthConfirmer(t, txStore, ethClient, gconfig, config, ethKeyStore, nil)
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, fromAddress)
+
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == uint64(*etx.Sequence) &&
+				*tx.To() == fromAddress &&
+				tx.Value().Cmp(big.NewInt(0)) == 0 &&
+				len(tx.Data()) == 0
+		}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+		attempt, err := ec.CancelTransaction(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.True(t, attempt.IsPurgeAttempt)
+
+		dbTx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		require.Len(t, dbTx.TxAttempts, 2)
+		assert.Equal(t, attempt.Hash, dbTx.TxAttempts[0].Hash)
+		assert.Equal(t, txmgrtypes.TxAttemptBroadcast, dbTx.TxAttempts[0].State)
+		assert.True(t, dbTx.TxAttempts[0].IsPurgeAttempt)
+	})
+
+	t.Run("speeds up an unconfirmed tx with the given fee and its previous fee limit", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ec := newEthConfirmer(t, txStore, ethClient, gconfig, config, ethKeyStore, nil)
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, fromAddress)
+		fee := gas.EvmFee{Legacy: assets.GWei(52)}
+
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == uint64(*etx.Sequence) &&
+				tx.GasPrice().Cmp(fee.Legacy.ToInt()) == 0 &&
+				tx.Gas() == etx.TxAttempts[0].ChainSpecificFeeLimit &&
+				reflect.DeepEqual(tx.Data(), etx.EncodedPayload) &&
+				*tx.To() == etx.ToAddress
+		}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+		attempt, err := ec.SpeedUpTransaction(ctx, etx.ID, fee)
+		require.NoError(t, err)
+		assert.Equal(t, fee.Legacy, attempt.TxFee.Legacy)
+		assert.False(t, attempt.IsPurgeAttempt)
+
+		dbTx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		require.Len(t, dbTx.TxAttempts, 2)
+		assert.Equal(t, attempt.Hash, dbTx.TxAttempts[0].Hash)
+		assert.Equal(t, txmgrtypes.TxAttemptBroadcast, dbTx.TxAttempts[0].State)
+	})
+
+	t.Run("replaces an unconfirmed tx with the given fee limit and a bumped fee", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ec := newEthConfirmer(t, txStore, ethClient, gconfig, config, ethKeyStore, nil)
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 2, fromAddress)
+		feeLimit := uint64(500_000)
+
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == uint64(*etx.Sequence) &&
+				tx.GasPrice().Cmp(etx.TxAttempts[0].TxFee.Legacy.ToInt()) > 0 &&
+				tx.Gas() == feeLimit &&
+				reflect.DeepEqual(tx.Data(), etx.EncodedPayload)
+		}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+		attempt, err := ec.ReplaceTransaction(ctx, etx.ID, feeLimit)
+		require.NoError(t, err)
+		assert.Equal(t, feeLimit, attempt.ChainSpecificFeeLimit)
+	})
+
+	t.Run("does not replace a purge attempt with a regular one", func(t *testing.T) {
+		ec := newEthConfirmer(t, txStore, testutils.NewEthClientMockWithDefaultChain(t), gconfig, config, ethKeyStore, nil)
+		etx, err := txStore.FindTxWithSequence(ctx, fromAddress, evmtypes.Nonce(0))
+		require.NoError(t, err)
+
+		_, err = ec.SpeedUpTransaction(ctx, etx.ID, gas.EvmFee{Legacy: assets.GWei(52)})
+		require.ErrorIs(t, err, txmgrcommon.ErrTxNotReplaceable)
+		_, err = ec.ReplaceTransaction(ctx, etx.ID, 500_000)
+		require.ErrorIs(t, err, txmgrcommon.ErrTxNotReplaceable)
+	})
+
+	t.Run("does not replace a confirmed tx", func(t *testing.T) {
+		ec := newEthConfirmer(t, txStore, testutils.NewEthClientMockWithDefaultChain(t), gconfig, config, ethKeyStore, nil)
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 3, 1, fromAddress)
+
+		_, err := ec.CancelTransaction(ctx, etx.ID)
+		require.ErrorIs(t, err, txmgrcommon.ErrTxNotReplaceable)
+	})
+}
+
 func TestEthConfirmer_ResumePendingRuns(t *testing.T) {
 	t.Parallel()
 
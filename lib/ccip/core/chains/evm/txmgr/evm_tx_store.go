@@ -1590,13 +1590,20 @@ func (o *evmTxStore) SaveReplacementInProgressAttempt(ctx context.Context, oldAt
 	})
 }
 
-// Finds earliest saved transaction that has yet to be broadcast from the given address
-func (o *evmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int) (*Tx, error) {
+// Finds earliest saved transaction of the highest priority class that has yet to be broadcast from the given address.
+// Transactions that have waited for longer than priorityMaxWait go first regardless of their priority class, so that
+// a steady stream of high priority transactions cannot starve the lower classes. A priorityMaxWait of 0 disables this.
+func (o *evmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int, priorityMaxWait time.Duration) (*Tx, error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
+	var starvedBefore time.Time
+	if priorityMaxWait > 0 {
+		starvedBefore = time.Now().Add(-priorityMaxWait)
+	}
 	var dbEtx DbEthTx
-	err := o.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
+	err := o.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2
+ORDER BY created_at < $3 DESC, COALESCE((meta->>'Priority')::int, $4) DESC, value ASC, created_at ASC, id ASC`, fromAddress, chainID.String(), starvedBefore, int(txmgrtypes.TxPriorityNormal))
 	etx := new(Tx)
 	dbEtx.ToTx(etx)
 	if err != nil {
@@ -1812,6 +1819,27 @@ func (o *evmTxStore) CountUnstartedTransactions(ctx context.Context, fromAddress
 	return o.countTransactionsWithState(ctx, fromAddress, txmgr.TxUnstarted, chainID)
 }
 
+// CountUnstartedTransactionsByPriority returns the number of unstarted transactions of each priority class
+func (o *evmTxStore) CountUnstartedTransactionsByPriority(ctx context.Context, fromAddress common.Address, chainID *big.Int) (counts map[txmgrtypes.TxPriority]uint32, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	var rows []struct {
+		Priority txmgrtypes.TxPriority `db:"priority"`
+		Count    uint32                `db:"count"`
+	}
+	err = o.q.SelectContext(ctx, &rows, `SELECT COALESCE((meta->>'Priority')::int, $3) AS priority, count(*) AS count FROM evm.txes
+WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 GROUP BY 1`, fromAddress, chainID.String(), int(txmgrtypes.TxPriorityNormal))
+	if err != nil {
+		return nil, fmt.Errorf("failed to CountUnstartedTransactionsByPriority: %w", err)
+	}
+	counts = make(map[txmgrtypes.TxPriority]uint32, len(rows))
+	for _, r := range rows {
+		counts[r.Priority] = r.Count
+	}
+	return counts, nil
+}
+
 func (o *evmTxStore) CheckTxQueueCapacity(ctx context.Context, fromAddress common.Address, maxQueuedTransactions uint64, chainID *big.Int) (err error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
@@ -1242,17 +1242,44 @@ func TestORM_FindNextUnstartedTransactionFromAddress(t *testing.T) {
 	t.Run("cannot find unstarted tx", func(t *testing.T) {
 		mustInsertInProgressEthTxWithAttempt(t, txStore, 13, fromAddress)
 
-		resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID())
+		resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID(), 0)
 		assert.ErrorIs(t, err, sql.ErrNoRows)
 		assert.Nil(t, resultEtx)
 	})
 
 	t.Run("finds unstarted tx", func(t *testing.T) {
 		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
-		resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID())
+		resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID(), 0)
 		require.NoError(t, err)
 		assert.NotNil(t, resultEtx)
 	})
+
+	t.Run("finds unstarted tx of the highest priority class first", func(t *testing.T) {
+		_, priorityAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+		mustCreateUnstartedGeneratedTx(t, txStore, priorityAddress, testutils.FixtureChainID, txRequestWithPriority(txmgrtypes.TxPriorityLow))
+		mustCreateUnstartedGeneratedTx(t, txStore, priorityAddress, testutils.FixtureChainID)
+		highEtx := mustCreateUnstartedGeneratedTx(t, txStore, priorityAddress, testutils.FixtureChainID, txRequestWithPriority(txmgrtypes.TxPriorityHigh))
+
+		resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), priorityAddress, ethClient.ConfiguredChainID(), 0)
+		require.NoError(t, err)
+		assert.Equal(t, highEtx.ID, resultEtx.ID)
+	})
+
+	t.Run("finds starved unstarted tx before higher priority classes", func(t *testing.T) {
+		_, priorityAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+		lowEtx := mustCreateUnstartedGeneratedTx(t, txStore, priorityAddress, testutils.FixtureChainID, txRequestWithPriority(txmgrtypes.TxPriorityLow))
+		highEtx := mustCreateUnstartedGeneratedTx(t, txStore, priorityAddress, testutils.FixtureChainID, txRequestWithPriority(txmgrtypes.TxPriorityHigh))
+		_, err := db.Exec(`UPDATE evm.txes SET created_at = $1 WHERE id = $2`, time.Now().Add(-time.Hour), lowEtx.ID)
+		require.NoError(t, err)
+
+		resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), priorityAddress, ethClient.ConfiguredChainID(), 2*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, highEtx.ID, resultEtx.ID)
+
+		resultEtx, err = txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), priorityAddress, ethClient.ConfiguredChainID(), time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, lowEtx.ID, resultEtx.ID)
+	})
 }
 
 func TestORM_UpdateTxFatalError(t *testing.T) {
@@ -1606,6 +1633,32 @@ func TestORM_CountUnstartedTransactions(t *testing.T) {
 	assert.Equal(t, int(count), 2)
 }
 
+func TestORM_CountUnstartedTransactionsByPriority(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, otherAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+	mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithPriority(txmgrtypes.TxPriorityNormal))
+	mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithPriority(txmgrtypes.TxPriorityHigh))
+	mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithPriority(txmgrtypes.TxPriorityLow))
+	mustCreateUnstartedGeneratedTx(t, txStore, otherAddress, testutils.FixtureChainID, txRequestWithPriority(txmgrtypes.TxPriorityHigh))
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 2, fromAddress)
+
+	counts, err := txStore.CountUnstartedTransactionsByPriority(tests.Context(t), fromAddress, testutils.FixtureChainID)
+	require.NoError(t, err)
+	assert.Equal(t, map[txmgrtypes.TxPriority]uint32{
+		txmgrtypes.TxPriorityLow:    1,
+		txmgrtypes.TxPriorityNormal: 2,
+		txmgrtypes.TxPriorityHigh:   1,
+	}, counts)
+}
+
 func TestORM_CheckTxQueueCapacity(t *testing.T) {
 	t.Parallel()
 
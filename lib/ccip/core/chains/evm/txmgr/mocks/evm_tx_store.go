@@ -341,6 +341,66 @@ func (_c *EvmTxStore_CountUnstartedTransactions_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// CountUnstartedTransactionsByPriority provides a mock function with given fields: ctx, fromAddress, chainID
+func (_m *EvmTxStore) CountUnstartedTransactionsByPriority(ctx context.Context, fromAddress common.Address, chainID *big.Int) (map[types.TxPriority]uint32, error) {
+	ret := _m.Called(ctx, fromAddress, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnstartedTransactionsByPriority")
+	}
+
+	var r0 map[types.TxPriority]uint32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) (map[types.TxPriority]uint32, error)); ok {
+		return rf(ctx, fromAddress, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) map[types.TxPriority]uint32); ok {
+		r0 = rf(ctx, fromAddress, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[types.TxPriority]uint32)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int) error); ok {
+		r1 = rf(ctx, fromAddress, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_CountUnstartedTransactionsByPriority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnstartedTransactionsByPriority'
+type EvmTxStore_CountUnstartedTransactionsByPriority_Call struct {
+	*mock.Call
+}
+
+// CountUnstartedTransactionsByPriority is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress common.Address
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) CountUnstartedTransactionsByPriority(ctx interface{}, fromAddress interface{}, chainID interface{}) *EvmTxStore_CountUnstartedTransactionsByPriority_Call {
+	return &EvmTxStore_CountUnstartedTransactionsByPriority_Call{Call: _e.mock.On("CountUnstartedTransactionsByPriority", ctx, fromAddress, chainID)}
+}
+
+func (_c *EvmTxStore_CountUnstartedTransactionsByPriority_Call) Run(run func(ctx context.Context, fromAddress common.Address, chainID *big.Int)) *EvmTxStore_CountUnstartedTransactionsByPriority_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_CountUnstartedTransactionsByPriority_Call) Return(count map[types.TxPriority]uint32, err error) *EvmTxStore_CountUnstartedTransactionsByPriority_Call {
+	_c.Call.Return(count, err)
+	return _c
+}
+
+func (_c *EvmTxStore_CountUnstartedTransactionsByPriority_Call) RunAndReturn(run func(context.Context, common.Address, *big.Int) (map[types.TxPriority]uint32, error)) *EvmTxStore_CountUnstartedTransactionsByPriority_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateTransaction provides a mock function with given fields: ctx, txRequest, chainID
 func (_m *EvmTxStore) CreateTransaction(ctx context.Context, txRequest types.TxRequest[common.Address, common.Hash], chainID *big.Int) (types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, txRequest, chainID)
@@ -678,9 +738,9 @@ func (_c *EvmTxStore_FindLatestSequence_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
-// FindNextUnstartedTransactionFromAddress provides a mock function with given fields: ctx, fromAddress, chainID
-func (_m *EvmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
-	ret := _m.Called(ctx, fromAddress, chainID)
+// FindNextUnstartedTransactionFromAddress provides a mock function with given fields: ctx, fromAddress, chainID, priorityMaxWait
+func (_m *EvmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int, priorityMaxWait time.Duration) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, fromAddress, chainID, priorityMaxWait)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindNextUnstartedTransactionFromAddress")
@@ -688,19 +748,19 @@ func (_m *EvmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Contex
 
 	var r0 *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
-		return rf(ctx, fromAddress, chainID)
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, time.Duration) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, fromAddress, chainID, priorityMaxWait)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
-		r0 = rf(ctx, fromAddress, chainID)
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, time.Duration) *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, fromAddress, chainID, priorityMaxWait)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int) error); ok {
-		r1 = rf(ctx, fromAddress, chainID)
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int, time.Duration) error); ok {
+		r1 = rf(ctx, fromAddress, chainID, priorityMaxWait)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -717,13 +777,14 @@ type EvmTxStore_FindNextUnstartedTransactionFromAddress_Call struct {
 //   - ctx context.Context
 //   - fromAddress common.Address
 //   - chainID *big.Int
-func (_e *EvmTxStore_Expecter) FindNextUnstartedTransactionFromAddress(ctx interface{}, fromAddress interface{}, chainID interface{}) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
-	return &EvmTxStore_FindNextUnstartedTransactionFromAddress_Call{Call: _e.mock.On("FindNextUnstartedTransactionFromAddress", ctx, fromAddress, chainID)}
+//   - priorityMaxWait time.Duration
+func (_e *EvmTxStore_Expecter) FindNextUnstartedTransactionFromAddress(ctx interface{}, fromAddress interface{}, chainID interface{}, priorityMaxWait interface{}) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
+	return &EvmTxStore_FindNextUnstartedTransactionFromAddress_Call{Call: _e.mock.On("FindNextUnstartedTransactionFromAddress", ctx, fromAddress, chainID, priorityMaxWait)}
 }
 
-func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) Run(run func(ctx context.Context, fromAddress common.Address, chainID *big.Int)) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
+func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) Run(run func(ctx context.Context, fromAddress common.Address, chainID *big.Int, priorityMaxWait time.Duration)) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(common.Address), args[2].(*big.Int))
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(*big.Int), args[3].(time.Duration))
 	})
 	return _c
 }
@@ -733,7 +794,7 @@ func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) Return(_a0 *t
 	return _c
 }
 
-func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) RunAndReturn(run func(context.Context, common.Address, *big.Int) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
+func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) RunAndReturn(run func(context.Context, common.Address, *big.Int, time.Duration) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -52,6 +52,11 @@ const (
 	// chain.
 	TransmitCheckerTypeSimulate = txmgrtypes.TransmitCheckerType("simulate")
 
+	// TransmitCheckerTypeCCIP is a checker that simulates CCIP commit and exec report transmissions and will not
+	// submit those that would revert for a reason that retrying cannot fix, such as the report being stale or the
+	// lane being cursed.
+	TransmitCheckerTypeCCIP = txmgrtypes.TransmitCheckerType("ccip")
+
 	// TransmitCheckerTypeVRFV1 is a checker that will not submit VRF V1 fulfillment requests that
 	// have already been fulfilled. This could happen if the request was fulfilled by another node.
 	TransmitCheckerTypeVRFV1 = txmgrtypes.TransmitCheckerType("vrf_v1")
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
@@ -54,6 +55,8 @@ type TestEvmConfig struct {
 	MinAttempts          uint32
 	DetectionApiUrl      *url.URL
 	RpcDefaultBatchSize  uint32
+	PriorityMaxWait      time.Duration
+	PriorityMaxQueued    map[txmgrtypes.TxPriority]uint64
 }
 
 func (e *TestEvmConfig) Transactions() evmconfig.Transactions {
@@ -147,6 +150,10 @@ func (t *transactionsConfig) ReaperInterval() time.Duration        { return t.e.
 func (t *transactionsConfig) ReaperThreshold() time.Duration       { return t.e.ReaperThreshold }
 func (t *transactionsConfig) ResendAfterThreshold() time.Duration  { return t.e.ResendAfterThreshold }
 func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig { return t.autoPurge }
+func (t *transactionsConfig) PriorityQueueMaxWait() time.Duration  { return t.e.PriorityMaxWait }
+func (t *transactionsConfig) PriorityQueueMaxQueued(priority txmgrtypes.TxPriority) uint64 {
+	return t.e.PriorityMaxQueued[priority]
+}
 
 type autoPurgeConfig struct {
 	evmconfig.AutoPurgeConfig
@@ -37,6 +37,7 @@ var (
 
 	_ TransmitCheckerFactory = &CheckerFactory{}
 	_ TransmitChecker        = &SimulateChecker{}
+	_ TransmitChecker        = &CCIPChecker{}
 	_ TransmitChecker        = &VRFV1Checker{}
 	_ TransmitChecker        = &VRFV2Checker{}
 )
@@ -51,6 +52,8 @@ func (c *CheckerFactory) BuildChecker(spec TransmitCheckerSpec) (TransmitChecker
 	switch spec.CheckerType {
 	case TransmitCheckerTypeSimulate:
 		return &SimulateChecker{c.Client}, nil
+	case TransmitCheckerTypeCCIP:
+		return &CCIPChecker{c.Client}, nil
 	case TransmitCheckerTypeVRFV1:
 		if spec.VRFCoordinatorAddress == nil {
 			return nil, pkgerrors.Errorf("malformed checker, expected non-nil VRFCoordinatorAddress, got: %v", spec)
@@ -129,23 +132,9 @@ func (s *SimulateChecker) Check(
 	tx Tx,
 	a TxAttempt,
 ) error {
-	// See: https://github.com/ethereum/go-ethereum/blob/acdf9238fb03d79c9b1c20c2fa476a7e6f4ac2ac/ethclient/gethclient/gethclient.go#L193
-	callArg := map[string]interface{}{
-		"from": tx.FromAddress,
-		"to":   &tx.ToAddress,
-		"gas":  hexutil.Uint64(a.ChainSpecificFeeLimit),
-		// NOTE: Deliberately do not include gas prices. We never want to fatally error a
-		// transaction just because the wallet has insufficient eth.
-		// Relevant info regarding EIP1559 transactions: https://github.com/ethereum/go-ethereum/pull/23027
-		"gasPrice":             nil,
-		"maxFeePerGas":         nil,
-		"maxPriorityFeePerGas": nil,
-		"value":                (*hexutil.Big)(&tx.Value),
-		"data":                 hexutil.Bytes(tx.EncodedPayload),
-	}
 	var b hexutil.Bytes
 	// always run simulation on "latest" block
-	err := s.Client.CallContext(ctx, &b, "eth_call", callArg, evmclient.ToBlockNumArg(nil))
+	err := s.Client.CallContext(ctx, &b, "eth_call", simulationCallArg(tx, a), evmclient.ToBlockNumArg(nil))
 	if err != nil {
 		if jErr := evmclient.ExtractRPCErrorOrNil(err); jErr != nil {
 			l.Criticalw("Transaction reverted during simulation",
@@ -161,6 +150,24 @@ func (s *SimulateChecker) Check(
 	return nil
 }
 
+// simulationCallArg returns the eth_call argument used to simulate the given transaction attempt.
+func simulationCallArg(tx Tx, a TxAttempt) map[string]interface{} {
+	// See: https://github.com/ethereum/go-ethereum/blob/acdf9238fb03d79c9b1c20c2fa476a7e6f4ac2ac/ethclient/gethclient/gethclient.go#L193
+	return map[string]interface{}{
+		"from": tx.FromAddress,
+		"to":   &tx.ToAddress,
+		"gas":  hexutil.Uint64(a.ChainSpecificFeeLimit),
+		// NOTE: Deliberately do not include gas prices. We never want to fatally error a
+		// transaction just because the wallet has insufficient eth.
+		// Relevant info regarding EIP1559 transactions: https://github.com/ethereum/go-ethereum/pull/23027
+		"gasPrice":             nil,
+		"maxFeePerGas":         nil,
+		"maxPriorityFeePerGas": nil,
+		"value":                (*hexutil.Big)(&tx.Value),
+		"data":                 hexutil.Bytes(tx.EncodedPayload),
+	}
+}
+
 // VRFV1Checker is an implementation of TransmitChecker that checks whether a VRF V1 fulfillment
 // has already been fulfilled.
 type VRFV1Checker struct {
@@ -0,0 +1,175 @@
+package txmgr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/commit_store"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/commit_store_1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp_1_2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/offramp"
+)
+
+// ccipFatalRevertReasons are the CCIP contract errors a commit or exec report transmission keeps reverting with no
+// matter how often it is retried.
+var ccipFatalRevertReasons = map[string]bool{
+	"AlreadyAttempted":     true,
+	"AlreadyExecuted":      true,
+	"BadARMSignal":         true,
+	"ConfigDigestMismatch": true,
+	"CursedByRMN":          true,
+	"RootAlreadyCommitted": true,
+	"StaleCommitReport":    true,
+	"StaleReport":          true,
+}
+
+// ccipWrappingErrors are the CCIP contract errors which carry the revert data of a nested call as their last argument.
+var ccipWrappingErrors = map[string]bool{
+	"ExecutionError":     true,
+	"ReceiverError":      true,
+	"TokenHandlingError": true,
+}
+
+var ccipErrorABIs = sync.OnceValues(func() ([]*abi.ABI, error) {
+	var abis []*abi.ABI
+	for _, md := range []*bind.MetaData{
+		commit_store.CommitStoreMetaData,
+		commit_store_1_2_0.CommitStoreMetaData,
+		evm_2_evm_offramp.EVM2EVMOffRampMetaData,
+		evm_2_evm_offramp_1_2_0.EVM2EVMOffRampMetaData,
+		offramp.OffRampMetaData,
+	} {
+		parsed, err := md.GetAbi()
+		if err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to parse CCIP ABI")
+		}
+		abis = append(abis, parsed)
+	}
+	return abis, nil
+})
+
+// CCIPChecker simulates CCIP commit and exec report transmissions and decodes the revert reason with the CCIP contract
+// ABIs. It produces an error, which ends up on the transaction record, if the transaction would revert for a reason
+// that retrying cannot fix. Transactions reverting for any other reason are sent anyway, as the plugins handle those.
+type CCIPChecker struct {
+	Client evmclient.Client
+}
+
+// Check satisfies the TransmitChecker interface.
+func (c *CCIPChecker) Check(
+	ctx context.Context,
+	l logger.SugaredLogger,
+	tx Tx,
+	a TxAttempt,
+) error {
+	var b hexutil.Bytes
+	// always run simulation on "latest" block
+	err := c.Client.CallContext(ctx, &b, "eth_call", simulationCallArg(tx, a), evmclient.ToBlockNumArg(nil))
+	if err == nil {
+		l.Debugw("Transaction simulation succeeded",
+			"ethTxAttemptID", a.ID, "txHash", a.Hash, "returnValue", b.String())
+		return nil
+	}
+	jErr := evmclient.ExtractRPCErrorOrNil(err)
+	if jErr == nil {
+		l.Warnw("Transaction simulation failed, will attempt to send anyway",
+			"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "returnValue", b.String())
+		return nil
+	}
+	name, reason, err := DecodeCCIPRevertReason(jErr.Data)
+	if err != nil {
+		l.Warnw("Transaction reverted during simulation with an unknown reason, will attempt to send anyway",
+			"ethTxAttemptID", a.ID, "txHash", a.Hash, "rpcErr", jErr.String(), "err", err)
+		return nil
+	}
+	if !ccipFatalRevertReasons[name] {
+		l.Warnw("Transaction reverted during simulation, will attempt to send anyway",
+			"ethTxAttemptID", a.ID, "txHash", a.Hash, "rpcErr", jErr.String(), "revertReason", reason)
+		return nil
+	}
+	l.Criticalw("Transaction reverted during simulation",
+		"ethTxAttemptID", a.ID, "txHash", a.Hash, "rpcErr", jErr.String(), "revertReason", reason)
+	return pkgerrors.Errorf("transaction reverted during simulation: %s", reason)
+}
+
+// DecodeCCIPRevertReason decodes the data of a reverted eth_call, as returned by the RPC, with the CCIP contract ABIs.
+// It returns the name of the error and a human-readable reason including its arguments. Errors wrapping the revert
+// data of a nested call are returned by their own name, with the nested reason appended.
+func DecodeCCIPRevertReason(data interface{}) (name string, reason string, err error) {
+	s, ok := data.(string)
+	if !ok {
+		return "", "", pkgerrors.Errorf("unexpected revert data type %T", data)
+	}
+	// Some RPCs prefix the revert data, see evmclient.ExtractRPCError
+	s = strings.TrimPrefix(s, "Reverted ")
+	if !strings.HasPrefix(s, "0x") {
+		s = "0x" + s
+	}
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		return "", "", pkgerrors.Wrap(err, "failed to decode revert data")
+	}
+	return decodeCCIPRevertData(b)
+}
+
+func decodeCCIPRevertData(data []byte) (name string, reason string, err error) {
+	if len(data) < 4 {
+		return "", "", pkgerrors.Errorf("revert data too short: %x", data)
+	}
+	abis, err := ccipErrorABIs()
+	if err != nil {
+		return "", "", err
+	}
+	for _, parsed := range abis {
+		for errName, abiErr := range parsed.Errors {
+			if !bytes.Equal(data[:4], abiErr.ID[:4]) {
+				continue
+			}
+			args, err := abiErr.Unpack(data)
+			if err != nil {
+				return "", "", pkgerrors.Wrapf(err, "failed to unpack %s", errName)
+			}
+			values, _ := args.([]interface{})
+			reason = formatCCIPRevertReason(errName, values)
+			if ccipWrappingErrors[errName] && len(values) > 0 {
+				if nested, ok := values[len(values)-1].([]byte); ok {
+					if _, nestedReason, nestedErr := decodeCCIPRevertData(nested); nestedErr == nil {
+						reason = fmt.Sprintf("%s: %s", errName, nestedReason)
+					}
+				}
+			}
+			return errName, reason, nil
+		}
+	}
+	if msg, err := abi.UnpackRevert(data); err == nil {
+		return "Error", fmt.Sprintf("Error(%s)", msg), nil
+	}
+	return "", "", pkgerrors.Errorf("revert data does not match any CCIP error: %x", data)
+}
+
+func formatCCIPRevertReason(name string, values []interface{}) string {
+	args := make([]string, len(values))
+	for i, v := range values {
+		switch t := v.(type) {
+		case []byte:
+			args[i] = hexutil.Encode(t)
+		case [32]byte:
+			args[i] = hexutil.Encode(t[:])
+		default:
+			args[i] = fmt.Sprint(v)
+		}
+	}
+	return fmt.Sprintf("%s(%s)", name, strings.Join(args, ", "))
+}
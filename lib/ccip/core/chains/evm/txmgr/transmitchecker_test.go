@@ -2,6 +2,7 @@ package txmgr_test
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
@@ -27,6 +29,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_offramp_1_2_0"
 	v1 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/solidity_vrf_coordinator_interface"
 )
 
@@ -93,6 +96,14 @@ func TestFactory(t *testing.T) {
 		require.Equal(t, &txmgr.SimulateChecker{Client: client}, c)
 	})
 
+	t.Run("ccip checker", func(t *testing.T) {
+		c, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{
+			CheckerType: txmgr.TransmitCheckerTypeCCIP,
+		})
+		require.NoError(t, err)
+		require.Equal(t, &txmgr.CCIPChecker{Client: client}, c)
+	})
+
 	t.Run("invalid checker type", func(t *testing.T) {
 		_, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{
 			CheckerType: "invalid",
@@ -170,6 +181,80 @@ func TestTransmitCheckers(t *testing.T) {
 		})
 	})
 
+	t.Run("ccip", func(t *testing.T) {
+		checker := txmgr.CCIPChecker{Client: client}
+
+		tx := txmgr.Tx{
+			FromAddress:    common.HexToAddress("0xfe0629509E6CB8dfa7a99214ae58Ceb465d5b5A9"),
+			ToAddress:      common.HexToAddress("0xff0Aac13eab788cb9a2D662D3FB661Aa5f58FA21"),
+			EncodedPayload: []byte{42, 0, 0},
+			FeeLimit:       1e9,
+			CreatedAt:      time.Unix(0, 0),
+			State:          txmgrcommon.TxUnstarted,
+		}
+		attempt := txmgr.TxAttempt{
+			Tx:        tx,
+			Hash:      common.Hash{},
+			CreatedAt: tx.CreatedAt,
+			State:     txmgrtypes.TxAttemptInProgress,
+		}
+
+		offRampABI, err := evm_2_evm_offramp_1_2_0.EVM2EVMOffRampMetaData.GetAbi()
+		require.NoError(t, err)
+		revertData := func(name string, args ...interface{}) []byte {
+			abiErr := offRampABI.Errors[name]
+			packed, err := abiErr.Inputs.Pack(args...)
+			require.NoError(t, err)
+			return append(abiErr.ID[:4:4], packed...)
+		}
+		mockRevert := func(data string) {
+			client.On("CallContext", mock.Anything,
+				mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.Anything, "latest").
+				Return(&evmclient.JsonError{Code: 3, Message: "execution reverted", Data: data}).Once()
+		}
+
+		t.Run("success", func(t *testing.T) {
+			client.On("CallContext", mock.Anything,
+				mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.Anything, "latest").Return(nil).Once()
+
+			require.NoError(t, checker.Check(ctx, log, tx, attempt))
+		})
+
+		t.Run("fatal revert reason", func(t *testing.T) {
+			mockRevert(hexutil.Encode(revertData("AlreadyExecuted", uint64(5))))
+
+			err := checker.Check(ctx, log, tx, attempt)
+			require.EqualError(t, err, "transaction reverted during simulation: AlreadyExecuted(5)")
+		})
+
+		t.Run("fatal revert reason with prefixed data", func(t *testing.T) {
+			mockRevert("Reverted " + hex.EncodeToString(revertData("BadARMSignal")))
+
+			err := checker.Check(ctx, log, tx, attempt)
+			require.EqualError(t, err, "transaction reverted during simulation: BadARMSignal()")
+		})
+
+		t.Run("non fatal revert reason", func(t *testing.T) {
+			mockRevert(hexutil.Encode(revertData("ReceiverError", revertData("AlreadyExecuted", uint64(5)))))
+
+			require.NoError(t, checker.Check(ctx, log, tx, attempt))
+		})
+
+		t.Run("unknown revert reason", func(t *testing.T) {
+			mockRevert("0x12345678")
+
+			require.NoError(t, checker.Check(ctx, log, tx, attempt))
+		})
+
+		t.Run("non revert error", func(t *testing.T) {
+			client.On("CallContext", mock.Anything,
+				mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.Anything, "latest").
+				Return(pkgerrors.New("error")).Once()
+
+			require.NoError(t, checker.Check(ctx, log, tx, attempt))
+		})
+	})
+
 	t.Run("VRF V1", func(t *testing.T) {
 		testDefaultSubID := uint64(2)
 		testDefaultMaxLink := "1000000000000000000"
@@ -186,6 +186,38 @@ func TestTxm_CreateTransaction(t *testing.T) {
 		assert.Contains(t, err.Error(), "Txm#CreateTransaction: cannot create transaction; too many unstarted transactions in the queue (1/1). WARNING: Hitting EVM.Transactions.MaxQueued")
 	})
 
+	t.Run("with priority class queue at capacity does not insert eth_tx of that class", func(t *testing.T) {
+		_, priorityAddress := cltest.MustInsertRandomKey(t, kst.Eth())
+		evmConfig.MaxQueued = uint64(3)
+		evmConfig.PriorityMaxQueued = map[txmgrtypes.TxPriority]uint64{txmgrtypes.TxPriorityNormal: 1}
+		t.Cleanup(func() { evmConfig.PriorityMaxQueued = nil })
+		mustCreateUnstartedGeneratedTx(t, txStore, priorityAddress, testutils.FixtureChainID)
+
+		_, err := txm.CreateTransaction(tests.Context(t), txmgr.TxRequest{
+			FromAddress:    priorityAddress,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			FeeLimit:       21000,
+			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Txm#CreateTransaction: cannot create transaction; too many unstarted normal priority transactions in the queue (1/1)")
+
+		priority := txmgrtypes.TxPriorityHigh
+		etx, err := txm.CreateTransaction(tests.Context(t), txmgr.TxRequest{
+			FromAddress:    priorityAddress,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			FeeLimit:       21000,
+			Meta:           &txmgr.TxMeta{Priority: &priority},
+			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		})
+		require.NoError(t, err)
+		meta, err := etx.GetMeta()
+		require.NoError(t, err)
+		assert.Equal(t, txmgrtypes.TxPriorityHigh, meta.GetPriority())
+	})
+
 	t.Run("doesn't insert eth_tx if a matching tx already exists for that pipeline_task_run_id", func(t *testing.T) {
 		evmConfig.MaxQueued = uint64(3)
 		id := uuid.New()
@@ -1099,6 +1131,12 @@ func txRequestWithChecker(checker txmgr.TransmitCheckerSpec) func(*txmgr.TxReque
 		tx.Checker = checker
 	}
 }
+func txRequestWithPriority(priority txmgrtypes.TxPriority) func(*txmgr.TxRequest) {
+	return func(tx *txmgr.TxRequest) {
+		tx.Meta = &txmgr.TxMeta{Priority: &priority}
+	}
+}
+
 func txRequestWithValue(value big.Int) func(*txmgr.TxRequest) {
 	return func(tx *txmgr.TxRequest) {
 		tx.Value = value
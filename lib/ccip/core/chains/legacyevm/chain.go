@@ -32,6 +32,7 @@ import (
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 )
 
 type Chain interface {
@@ -157,6 +158,8 @@ type ChainOpts struct {
 
 	MailMon      *mailbox.Monitor
 	GasEstimator gas.EvmFeeEstimator
+	// AuditLogger records the balance top-ups, it is optional.
+	AuditLogger audit.AuditLogger
 
 	DS sqlutil.DataSource
 
@@ -264,7 +267,8 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 
 	var balanceMonitor monitor.BalanceMonitor
 	if opts.AppConfig.EVMRPCEnabled() && cfg.EVM().BalanceMonitor().Enabled() {
-		balanceMonitor = monitor.NewBalanceMonitor(client, opts.KeyStore, l)
+		balanceKeeper := monitor.NewBalanceKeeper(txm, cfg.EVM().BalanceMonitor().TopUp(), chainID, cfg.EVM().GasEstimator().LimitTransfer(), opts.AuditLogger, l)
+		balanceMonitor = monitor.NewBalanceMonitor(client, opts.KeyStore, l, balanceKeeper)
 		headBroadcaster.Subscribe(balanceMonitor)
 	}
 
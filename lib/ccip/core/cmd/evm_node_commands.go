@@ -1,9 +1,13 @@
 package cmd
 
 import (
+	"strconv"
+
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
+var evmNodeHeaders = []string{"Name", "Chain ID", "State", "Score", "Config"}
+
 // EVMNodePresenter implements TableRenderer for an EVMNodeResource.
 type EVMNodePresenter struct {
 	presenters.EVMNodeResource
@@ -11,14 +15,18 @@ type EVMNodePresenter struct {
 
 // ToRow presents the EVMNodeResource as a slice of strings.
 func (p *EVMNodePresenter) ToRow() []string {
-	return []string{p.Name, p.ChainID, p.State, p.Config}
+	score := "N/A"
+	if p.Score != nil {
+		score = strconv.FormatFloat(*p.Score, 'f', 2, 64)
+	}
+	return []string{p.Name, p.ChainID, p.State, score, p.Config}
 }
 
 // RenderTable implements TableRenderer
 func (p EVMNodePresenter) RenderTable(rt RendererTable) error {
 	var rows [][]string
 	rows = append(rows, p.ToRow())
-	renderList(nodeHeaders, rows, rt.Writer)
+	renderList(evmNodeHeaders, rows, rt.Writer)
 
 	return nil
 }
@@ -34,7 +42,7 @@ func (ps EVMNodePresenters) RenderTable(rt RendererTable) error {
 		rows = append(rows, p.ToRow())
 	}
 
-	renderList(nodeHeaders, rows, rt.Writer)
+	renderList(evmNodeHeaders, rows, rt.Writer)
 
 	return nil
 }
@@ -62,6 +62,37 @@ func initEVMTxSubCmd(s *Shell) cli.Command {
 				Usage:  "get information on a specific Ethereum Transaction",
 				Action: s.ShowTransaction,
 			},
+			{
+				Name:   "cancel",
+				Usage:  "Cancel an unconfirmed Ethereum Transaction by sending a zero-value self-transfer at the same nonce",
+				Action: s.CancelTransaction,
+			},
+			{
+				Name:   "speedup",
+				Usage:  "Rebroadcast an unconfirmed Ethereum Transaction at the same nonce with a higher fee",
+				Action: s.SpeedUpTransaction,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "fee-cap",
+						Usage: "gas price for legacy transactions or max fee per gas for EIP-1559 transactions, e.g. \"50 gwei\"",
+					},
+					cli.StringFlag{
+						Name:  "tip-cap",
+						Usage: "max priority fee per gas, required for EIP-1559 transactions",
+					},
+				},
+			},
+			{
+				Name:   "replace",
+				Usage:  "Rebroadcast an unconfirmed Ethereum Transaction at the same nonce with a new gas limit and a bumped fee",
+				Action: s.ReplaceTransaction,
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "gas-limit",
+						Usage: "gas limit of the replacement transaction",
+					},
+				},
+			},
 		},
 	}
 }
@@ -131,6 +162,74 @@ func (s *Shell) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+// CancelTransaction replaces the unconfirmed transaction with the given hash
+// with a zero-value self-transfer at the same nonce
+func (s *Shell) CancelTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the hash of the transaction"))
+	}
+	return s.postTransactionAction(c.Args().First(), "cancel", nil)
+}
+
+// SpeedUpTransaction rebroadcasts the unconfirmed transaction with the given
+// hash at the same nonce with the given fee
+func (s *Shell) SpeedUpTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the hash of the transaction"))
+	}
+	if !c.IsSet("fee-cap") {
+		return s.errorOut(errors.New("must pass the --fee-cap of the replacement"))
+	}
+
+	var request models.SpeedUpEVMTxRequest
+	request.FeeCap = new(assets.Wei)
+	if err = request.FeeCap.UnmarshalText([]byte(c.String("fee-cap"))); err != nil {
+		return s.errorOut(multierr.Combine(errors.New("while parsing fee cap"), err))
+	}
+	if c.IsSet("tip-cap") {
+		request.TipCap = new(assets.Wei)
+		if err = request.TipCap.UnmarshalText([]byte(c.String("tip-cap"))); err != nil {
+			return s.errorOut(multierr.Combine(errors.New("while parsing tip cap"), err))
+		}
+	}
+	return s.postTransactionAction(c.Args().First(), "speedup", request)
+}
+
+// ReplaceTransaction rebroadcasts the unconfirmed transaction with the given
+// hash at the same nonce with the given gas limit
+func (s *Shell) ReplaceTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the hash of the transaction"))
+	}
+	gasLimit := c.Uint64("gas-limit")
+	if gasLimit == 0 {
+		return s.errorOut(errors.New("must pass the --gas-limit of the replacement"))
+	}
+	return s.postTransactionAction(c.Args().First(), "replace", models.ReplaceEVMTxRequest{GasLimit: gasLimit})
+}
+
+func (s *Shell) postTransactionAction(hash string, action string, request interface{}) (err error) {
+	var buf bytes.Buffer
+	if request != nil {
+		if err = json.NewEncoder(&buf).Encode(request); err != nil {
+			return s.errorOut(err)
+		}
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/transactions/evm/"+hash+"/"+action, &buf)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = s.renderAPIResponse(resp, &EthTxPresenter{})
+	return err
+}
+
 // SendEther transfers ETH from the node's account to a specified address.
 func (s *Shell) SendEther(c *cli.Context) (err error) {
 	if c.NArg() < 3 {
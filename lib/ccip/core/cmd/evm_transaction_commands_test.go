@@ -89,6 +89,38 @@ func TestShell_ShowTransaction(t *testing.T) {
 	assert.Equal(t, &tx.FromAddress, renderedTx.From)
 }
 
+func TestShell_SpeedUpTransaction(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewShellAndRenderer()
+
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	txStore := cltest.NewTestTxStore(t, app.GetDB())
+	tx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, from)
+	attempt := tx.TxAttempts[0]
+
+	t.Run("requires a fee cap", func(t *testing.T) {
+		set := flag.NewFlagSet("test speedup tx", 0)
+		flagSetApplyFromAction(client.SpeedUpTransaction, set, "")
+		require.NoError(t, set.Parse([]string{attempt.Hash.String()}))
+
+		c := cli.NewContext(nil, set, nil)
+		require.ErrorContains(t, client.SpeedUpTransaction(c), "fee-cap")
+	})
+
+	t.Run("fails for confirmed transactions", func(t *testing.T) {
+		set := flag.NewFlagSet("test speedup tx", 0)
+		flagSetApplyFromAction(client.SpeedUpTransaction, set, "")
+		require.NoError(t, set.Set("fee-cap", "50 gwei"))
+		require.NoError(t, set.Parse([]string{attempt.Hash.String()}))
+
+		c := cli.NewContext(nil, set, nil)
+		require.Error(t, client.SpeedUpTransaction(c))
+	})
+}
+
 func TestShell_IndexTxAttempts(t *testing.T) {
 	t.Parallel()
 
@@ -8,10 +8,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
@@ -50,6 +53,21 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:   "simulate",
+			Usage:  "Dry-run a job's pipeline locally with stubbed task inputs",
+			Action: s.SimulatePipeline,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "fixtures, f",
+					Usage: "`FILE` containing JSON fixtures for http, bridge and ethcall tasks, keyed by task name",
+				},
+				cli.StringFlag{
+					Name:  "field",
+					Usage: "TOML field holding the pipeline, e.g. pluginConfig.tokenPricesUSDPipeline (defaults to observationSource)",
+				},
+			},
+		},
 	}
 }
 
@@ -313,3 +331,152 @@ func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	err = s.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// PipelineSimulationPresenter renders the per-task results of a simulated pipeline run
+type PipelineSimulationPresenter []PipelineSimulationTaskPresenter
+
+// PipelineSimulationTaskPresenter is the result of a single simulated task
+type PipelineSimulationTaskPresenter struct {
+	DotID   string        `json:"dotId"`
+	Type    string        `json:"type"`
+	Stubbed bool          `json:"stubbed"`
+	Inputs  []interface{} `json:"inputs"`
+	Output  interface{}   `json:"output"`
+	Error   string        `json:"error,omitempty"`
+}
+
+// RenderTable implements TableRenderer
+func (ps PipelineSimulationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Stubbed", "Inputs", "Output", "Error"})
+	for _, p := range ps {
+		inputs := make([]string, len(p.Inputs))
+		for i, input := range p.Inputs {
+			inputs[i] = fmt.Sprintf("%v", input)
+		}
+		output := ""
+		if p.Output != nil {
+			output = fmt.Sprintf("%v", p.Output)
+		}
+		table.Append([]string{
+			p.DotID,
+			p.Type,
+			fmt.Sprintf("%t", p.Stubbed),
+			strings.Join(inputs, "\n"),
+			output,
+			p.Error,
+		})
+	}
+
+	render("Pipeline Simulation", table)
+	return nil
+}
+
+// SimulatePipeline parses a job spec's pipeline and runs it locally, replacing
+// network-bound tasks with fixtures. No node connection is required.
+// Valid input is a TOML job spec, a path to a TOML file or a path to a DOT file
+func (s *Shell) SimulatePipeline(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	source, err := pipelineSourceFromArg(c.Args().First(), c.String("field"))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	p, err := pipeline.Parse(source)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to parse pipeline"))
+	}
+
+	var fixtures pipeline.SimulationFixtures
+	if path := c.String("fixtures"); path != "" {
+		buf, ferr := fromFile(path)
+		if ferr != nil {
+			return s.errorOut(errors.Wrapf(ferr, "error reading fixtures from '%s'", path))
+		}
+		fixtures, err = pipeline.ParseSimulationFixtures(buf.Bytes())
+		if err != nil {
+			return s.errorOut(err)
+		}
+	}
+
+	lggr := s.Logger
+	if lggr == nil {
+		lggr = logger.NullLogger
+	}
+	runs, err := pipeline.Simulate(s.ctx(), lggr, p, fixtures)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	presenter := make(PipelineSimulationPresenter, len(runs))
+	var failed bool
+	for i, run := range runs {
+		inputs := make([]interface{}, len(run.Inputs))
+		for j, input := range run.Inputs {
+			if input.Error != nil {
+				inputs[j] = "error: " + input.Error.Error()
+				continue
+			}
+			inputs[j] = simulationValue(input.Value)
+		}
+		presenter[i] = PipelineSimulationTaskPresenter{
+			DotID:   run.DotID,
+			Type:    run.Type.String(),
+			Stubbed: run.Stubbed,
+			Inputs:  inputs,
+			Output:  simulationValue(run.Result.Value),
+		}
+		if run.Result.Error != nil {
+			presenter[i].Error = run.Result.Error.Error()
+			if len(p.ByDotID(run.DotID).Outputs()) == 0 {
+				failed = true
+			}
+		}
+	}
+
+	if err = s.Render(&presenter); err != nil {
+		return s.errorOut(err)
+	}
+	if failed {
+		return s.errorOut(errors.New("pipeline simulation finished with fatal errors"))
+	}
+	return nil
+}
+
+// pipelineSourceFromArg resolves the DOT source of a pipeline from a TOML job
+// spec (inline or file) or a raw DOT file.
+func pipelineSourceFromArg(arg string, field string) (string, error) {
+	spec, err := getTOMLString(arg)
+	if err != nil {
+		return "", err
+	}
+
+	tree, err := toml.Load(spec)
+	if err != nil {
+		// not a TOML job spec, treat the file contents as the DOT source
+		return spec, nil
+	}
+
+	if field == "" {
+		field = "observationSource"
+	}
+	source, ok := tree.Get(field).(string)
+	if !ok || strings.TrimSpace(source) == "" {
+		return "", errors.Errorf("job spec has no pipeline in field '%s'", field)
+	}
+	return source, nil
+}
+
+// simulationValue makes task values readable when rendered
+func simulationValue(v interface{}) interface{} {
+	switch typed := v.(type) {
+	case []byte:
+		return hexutil.Encode(typed)
+	case fmt.Stringer:
+		return typed.String()
+	default:
+		return v
+	}
+}
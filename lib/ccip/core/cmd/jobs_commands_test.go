@@ -5,6 +5,8 @@ import (
 	_ "embed"
 	"flag"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -452,6 +454,43 @@ func TestShell_DeleteJob(t *testing.T) {
 	requireJobsCount(t, app.JobORM(), 0)
 }
 
+func TestShell_SimulatePipeline(t *testing.T) {
+	t.Parallel()
+
+	r := &cltest.RendererMock{}
+	client := &cmd.Shell{Renderer: r}
+
+	dir := t.TempDir()
+	fixturesPath := filepath.Join(dir, "fixtures.json")
+	require.NoError(t, os.WriteFile(fixturesPath, []byte(`{"tasks": {"ds1": {"value": {"usd": "2"}}}}`), 0600))
+
+	spec := `
+type = "webhook"
+schemaVersion = 1
+observationSource = """
+ds1       [type=http method=GET url="https://example.com"];
+ds1_parse [type=jsonparse path="usd"];
+ds1_mul   [type=multiply times=3];
+ds1 -> ds1_parse -> ds1_mul;
+"""
+`
+
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	flagSetApplyFromAction(client.SimulatePipeline, fs, "")
+	require.NoError(t, fs.Parse([]string{"--fixtures", fixturesPath, spec}))
+
+	require.NoError(t, client.SimulatePipeline(cli.NewContext(nil, fs, nil)))
+
+	require.Len(t, r.Renders, 1)
+	output := *r.Renders[0].(*cmd.PipelineSimulationPresenter)
+	require.Len(t, output, 3)
+	assert.Equal(t, "ds1", output[0].DotID)
+	assert.True(t, output[0].Stubbed)
+	assert.Equal(t, "ds1_mul", output[2].DotID)
+	assert.Equal(t, "6", output[2].Output)
+	assert.Empty(t, output[2].Error)
+}
+
 func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	ctx := testutils.Context(t)
 	jobs, _, err := orm.FindJobs(ctx, 0, 1000)
@@ -210,6 +210,12 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 
 	capabilitiesRegistry := capabilities.NewRegistry(appLggr)
 
+	// Configure and optionally start the audit log forwarder service
+	auditLogger, err := audit.NewAuditLogger(appLggr, cfg.AuditLogger())
+	if err != nil {
+		return nil, err
+	}
+
 	unrestrictedClient := clhttp.NewUnrestrictedHTTPClient()
 	// create the relayer-chain interoperators from application configuration
 	relayerFactory := chainlink.RelayerFactory{
@@ -223,7 +229,7 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 
 	evmFactoryCfg := chainlink.EVMFactoryConfig{
 		CSAETHKeystore:     keyStore,
-		ChainOpts:          legacyevm.ChainOpts{AppConfig: cfg, MailMon: mailMon, DS: ds},
+		ChainOpts:          legacyevm.ChainOpts{AppConfig: cfg, MailMon: mailMon, AuditLogger: auditLogger, DS: ds},
 		MercuryTransmitter: cfg.Mercury().Transmitter(),
 	}
 	// evm always enabled for backward compatibility
@@ -265,12 +271,6 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 		return nil, err
 	}
 
-	// Configure and optionally start the audit log forwarder service
-	auditLogger, err := audit.NewAuditLogger(appLggr, cfg.AuditLogger())
-	if err != nil {
-		return nil, err
-	}
-
 	restrictedClient := clhttp.NewRestrictedHTTPClient(cfg.Database(), appLggr)
 	externalInitiatorManager := webhook.NewExternalInitiatorManager(ds, unrestrictedClient)
 	return chainlink.NewApplication(chainlink.ApplicationOpts{
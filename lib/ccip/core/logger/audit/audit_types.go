@@ -49,6 +49,8 @@ const (
 	KeyDeleted  EventID = "KEY_DELETED"
 
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
+	EthTransactionReplaced   EventID = "ETH_TRANSACTION_REPLACED"
+	EthBalanceTopUpCreated   EventID = "ETH_BALANCE_TOP_UP_CREATED"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
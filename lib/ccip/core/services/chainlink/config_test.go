@@ -529,6 +529,12 @@ func TestConfig_Marshal(t *testing.T) {
 				AutoCreateKey: ptr(false),
 				BalanceMonitor: evmcfg.BalanceMonitor{
 					Enabled: ptr(true),
+					TopUp: evmcfg.BalanceTopUp{
+						TreasuryAddress: mustAddress("0x7d2E1b4a9C3f5e6d8a0B1C2d3e4F5A6B7C8d9E0F"),
+						Threshold:       assets.NewWeiI(1_000_000_000_000_000_000),
+						Target:          assets.NewWeiI(3_000_000_000_000_000_000),
+						DailySpendCap:   assets.NewWeiI(9_000_000_000_000_000_000),
+					},
 				},
 				BlockBackfillDepth:   ptr[uint32](100),
 				BlockBackfillSkip:    ptr(true),
@@ -614,6 +620,12 @@ func TestConfig_Marshal(t *testing.T) {
 					AutoPurge: evmcfg.AutoPurgeConfig{
 						Enabled: ptr(false),
 					},
+					PriorityQueues: evmcfg.PriorityQueuesConfig{
+						MaxWait:         &minute,
+						MaxQueuedHigh:   ptr[uint32](10),
+						MaxQueuedNormal: ptr[uint32](50),
+						MaxQueuedLow:    ptr[uint32](20),
+					},
 				},
 
 				HeadTracker: evmcfg.HeadTracker{
@@ -652,6 +664,10 @@ func TestConfig_Marshal(t *testing.T) {
 						ServiceUnavailable:                ptr[string]("(: |^)service unavailable"),
 						TooManyResults:                    ptr[string]("(: |^)too many results"),
 					},
+					VerifiedReads: evmcfg.VerifiedReads{
+						Quorum:  ptr[uint32](2),
+						Methods: []string{"eth_getLogs", "eth_call"},
+					},
 				},
 				OCR: evmcfg.OCR{
 					ContractConfirmations:              ptr[uint16](11),
@@ -1054,9 +1070,21 @@ ResendAfterThreshold = '1h0m0s'
 [EVM.Transactions.AutoPurge]
 Enabled = false
 
+[EVM.Transactions.PriorityQueues]
+MaxWait = '1m0s'
+MaxQueuedHigh = 10
+MaxQueuedNormal = 50
+MaxQueuedLow = 20
+
 [EVM.BalanceMonitor]
 Enabled = true
 
+[EVM.BalanceMonitor.TopUp]
+TreasuryAddress = '0x7d2E1b4a9C3f5e6d8a0B1C2d3e4F5A6B7C8d9E0F'
+Threshold = '1 ether'
+Target = '3 ether'
+DailySpendCap = '9 ether'
+
 [EVM.GasEstimator]
 Mode = 'SuggestedPrice'
 PriceDefault = '9.223372036854775807 ether'
@@ -1137,6 +1165,10 @@ Fatal = '(: |^)fatal'
 ServiceUnavailable = '(: |^)service unavailable'
 TooManyResults = '(: |^)too many results'
 
+[EVM.NodePool.VerifiedReads]
+Quorum = 2
+Methods = ['eth_getLogs', 'eth_call']
+
 [EVM.OCR]
 ContractConfirmations = 11
 ContractTransmitterTransmitTimeout = '1m0s'
@@ -1,12 +1,17 @@
 package ccipdata
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -76,3 +81,56 @@ func ParseLogs[T any](logs []logpoller.Log, lggr logger.Logger, parseFunc func(l
 	}
 	return reqs, nil
 }
+
+// VerifyLogs confirms that every log was emitted on chain by querying its block with a verified read, which requires
+// multiple RPC nodes to agree on the response. The logs of the same block and event are verified with a single read.
+// It is a no-op if the client does not have verified reads enabled.
+func VerifyLogs(ctx context.Context, ec client.Client, logs []logpoller.Log) error {
+	verifier, ok := ec.(client.VerifiedReader)
+	if !ok || !verifier.VerifiedReadsEnabled() {
+		return nil
+	}
+	ctx = client.WithVerifiedRead(ctx)
+
+	type blockEvent struct {
+		blockHash common.Hash
+		address   common.Address
+		eventSig  common.Hash
+	}
+	var keys []blockEvent
+	logsByBlockEvent := make(map[blockEvent][]logpoller.Log)
+	for _, log := range logs {
+		k := blockEvent{blockHash: log.BlockHash, address: log.Address, eventSig: log.EventSig}
+		if _, ok := logsByBlockEvent[k]; !ok {
+			keys = append(keys, k)
+		}
+		logsByBlockEvent[k] = append(logsByBlockEvent[k], log)
+	}
+
+	for _, k := range keys {
+		blockHash := k.blockHash
+		onchainLogs, err := ec.FilterLogs(ctx, ethereum.FilterQuery{
+			BlockHash: &blockHash,
+			Addresses: []common.Address{k.address},
+			Topics:    [][]common.Hash{{k.eventSig}},
+		})
+		if err != nil {
+			return fmt.Errorf("verify logs of block %s: %w", blockHash, err)
+		}
+		for _, log := range logsByBlockEvent[k] {
+			if !containsLog(onchainLogs, log) {
+				return fmt.Errorf("log %s:%d not found in block %s by verified read", log.TxHash, log.LogIndex, log.BlockHash)
+			}
+		}
+	}
+	return nil
+}
+
+func containsLog(logs []types.Log, log logpoller.Log) bool {
+	for _, l := range logs {
+		if l.TxHash == log.TxHash && int64(l.Index) == log.LogIndex && bytes.Equal(l.Data, log.Data) {
+			return true
+		}
+	}
+	return false
+}
@@ -5,11 +5,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	evmclientmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
@@ -70,3 +76,63 @@ func Test_parseLogs_withErrors(t *testing.T) {
 		assert.Contains(t, contextMap["err"], fmt.Sprintf("cannot parse %d", (i+1)*2), "each error should be logged as a warning")
 	}
 }
+
+type verifiedReadClient struct {
+	*evmclientmocks.Client
+	enabled bool
+}
+
+func (c verifiedReadClient) VerifiedReadsEnabled() bool { return c.enabled }
+
+func TestVerifyLogs(t *testing.T) {
+	log := logpoller.Log{
+		BlockHash: common.HexToHash("0x1"),
+		TxHash:    common.HexToHash("0x2"),
+		LogIndex:  3,
+		Address:   common.HexToAddress("0x4"),
+		EventSig:  common.HexToHash("0x5"),
+		Data:      []byte{6},
+	}
+	matchesQuery := mock.MatchedBy(func(q ethereum.FilterQuery) bool {
+		return *q.BlockHash == log.BlockHash && q.Addresses[0] == log.Address && q.Topics[0][0] == log.EventSig
+	})
+
+	t.Run("no-op without verified reads", func(t *testing.T) {
+		ec := verifiedReadClient{Client: evmclientmocks.NewClient(t)}
+		require.NoError(t, VerifyLogs(tests.Context(t), ec, []logpoller.Log{log}))
+		require.NoError(t, VerifyLogs(tests.Context(t), evmclientmocks.NewClient(t), []logpoller.Log{log}))
+	})
+
+	t.Run("log confirmed by verified read", func(t *testing.T) {
+		ec := verifiedReadClient{Client: evmclientmocks.NewClient(t), enabled: true}
+		ec.On("FilterLogs", mock.Anything, matchesQuery).
+			Return([]types.Log{{TxHash: log.TxHash, Index: 3, Data: []byte{6}}}, nil).Once()
+		require.NoError(t, VerifyLogs(tests.Context(t), ec, []logpoller.Log{log}))
+	})
+
+	t.Run("log not confirmed by verified read", func(t *testing.T) {
+		ec := verifiedReadClient{Client: evmclientmocks.NewClient(t), enabled: true}
+		ec.On("FilterLogs", mock.Anything, matchesQuery).
+			Return([]types.Log{{TxHash: log.TxHash, Index: 3, Data: []byte{7}}}, nil).Once()
+		require.ErrorContains(t, VerifyLogs(tests.Context(t), ec, []logpoller.Log{log}), "not found")
+	})
+
+	t.Run("logs of the same block verified with a single read", func(t *testing.T) {
+		other := log
+		other.TxHash = common.HexToHash("0x7")
+		other.LogIndex = 8
+		ec := verifiedReadClient{Client: evmclientmocks.NewClient(t), enabled: true}
+		ec.On("FilterLogs", mock.Anything, matchesQuery).
+			Return([]types.Log{
+				{TxHash: log.TxHash, Index: 3, Data: []byte{6}},
+				{TxHash: other.TxHash, Index: 8, Data: []byte{6}},
+			}, nil).Once()
+		require.NoError(t, VerifyLogs(tests.Context(t), ec, []logpoller.Log{log, other}))
+	})
+
+	t.Run("verified read fails", func(t *testing.T) {
+		ec := verifiedReadClient{Client: evmclientmocks.NewClient(t), enabled: true}
+		ec.On("FilterLogs", mock.Anything, matchesQuery).Return(nil, fmt.Errorf("no quorum")).Once()
+		require.ErrorContains(t, VerifyLogs(tests.Context(t), ec, []logpoller.Log{log}), "no quorum")
+	})
+}
@@ -40,6 +40,7 @@ type CommitStore struct {
 	commitStore               *commit_store_1_2_0.CommitStore
 	lggr                      logger.Logger
 	lp                        logpoller.LogPoller
+	ec                        client.Client
 	address                   common.Address
 	estimator                 *gas.EvmFeeEstimator
 	sourceMaxGasPrice         *big.Int
@@ -320,6 +321,9 @@ func (c *CommitStore) GetCommitReportMatchingSeqNum(ctx context.Context, seqNr u
 	if err != nil {
 		return nil, err
 	}
+	if err = ccipdata.VerifyLogs(ctx, c.ec, logs); err != nil {
+		return nil, err
+	}
 
 	parsedLogs, err := ccipdata.ParseLogs[cciptypes.CommitStoreReport](
 		logs,
@@ -371,6 +375,9 @@ func (c *CommitStore) GetAcceptedCommitReportsGteTimestamp(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if err = ccipdata.VerifyLogs(ctx, c.ec, logs); err != nil {
+		return nil, err
+	}
 
 	parsedLogs, err := ccipdata.ParseLogs[cciptypes.CommitStoreReport](logs, c.lggr, c.parseReport)
 	if err != nil {
@@ -454,6 +461,7 @@ func NewCommitStore(lggr logger.Logger, addr common.Address, ec client.Client, l
 		address:     addr,
 		lggr:        lggr,
 		lp:          lp,
+		ec:          ec,
 
 		// Note that sourceMaxGasPrice and estimator now have explicit setters (CCIP-2493)
 
@@ -9,14 +9,17 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	chainsel "github.com/smartcontractkit/chain-selectors"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/bridge/arb"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/bridge/ccipbridge"
 	bridgecommon "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/bridge/common"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/bridge/opstack"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/bridge/testonlybridge"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/bridge/zksync"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/models"
 )
 
@@ -70,16 +73,25 @@ type evmDep struct {
 	bridgeAdapters          map[models.NetworkSelector]models.Address
 }
 
+// ccipLaneDep holds the dependencies of a CCIP lane used as a bridge.
+type ccipLaneDep struct {
+	router        models.Address
+	onRampReader  cciptypes.OnRampReader
+	offRampReader cciptypes.OffRampReader
+}
+
 type factory struct {
 	evmDeps       map[models.NetworkSelector]evmDep
+	ccipLanes     map[string]ccipLaneDep
 	cachedBridges sync.Map
 	lggr          logger.Logger
 }
 
 func NewFactory(lggr logger.Logger, opts ...Opt) Factory {
 	c := &factory{
-		evmDeps: make(map[models.NetworkSelector]evmDep),
-		lggr:    lggr,
+		evmDeps:   make(map[models.NetworkSelector]evmDep),
+		ccipLanes: make(map[string]ccipLaneDep),
+		lggr:      lggr,
 	}
 	for _, opt := range opts {
 		opt(c)
@@ -104,6 +116,25 @@ func WithEvmDep(
 	}
 }
 
+// WithCCIPLane allows rebalancing from source to dest with CCIP token transfers when the chains have no native
+// bridge. The source Router and the readers of the lane onRamp and offRamp are used to send and track the
+// transfers, the evm dependencies of both chains must be provided as well.
+func WithCCIPLane(
+	source,
+	dest models.NetworkSelector,
+	router models.Address,
+	onRampReader cciptypes.OnRampReader,
+	offRampReader cciptypes.OffRampReader,
+) Opt {
+	return func(f *factory) {
+		f.ccipLanes[f.cacheKey(source, dest)] = ccipLaneDep{
+			router:        router,
+			onRampReader:  onRampReader,
+			offRampReader: offRampReader,
+		}
+	}
+}
+
 func (f *factory) NewBridge(ctx context.Context, source, dest models.NetworkSelector) (Bridge, error) {
 	if source == dest {
 		return nil, fmt.Errorf("no bridge between the same network and itself: %d", source)
@@ -123,6 +154,16 @@ func (f *factory) initBridge(ctx context.Context, source, dest models.NetworkSel
 	var bridge Bridge
 	var err error
 
+	// Native bridges are preferred, CCIP is used for the lanes without one.
+	if lane, ok := f.ccipLanes[f.cacheKey(source, dest)]; ok && !bridgecommon.Supports(source, dest) {
+		bridge, err = f.initCCIPBridge(ctx, source, dest, lane)
+		if err != nil {
+			return nil, err
+		}
+		f.cachedBridges.Store(f.cacheKey(source, dest), bridge)
+		return bridge, nil
+	}
+
 	switch source {
 	// Arbitrum L2 --> Ethereum L1 bridge
 	case models.NetworkSelector(chainsel.ETHEREUM_MAINNET_ARBITRUM_1.Selector),
@@ -208,8 +249,49 @@ func (f *factory) initBridge(ctx context.Context, source, dest models.NetworkSel
 			l1Deps.lp,        // l1 log poller
 			l2Deps.lp,        // l2 log poller
 		)
+	// ZKsync L2 --> Ethereum L1 bridge
+	case models.NetworkSelector(chainsel.ETHEREUM_MAINNET_ZKSYNC_1.Selector),
+		models.NetworkSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA_ZKSYNC_1.Selector):
+		if !bridgecommon.Supports(source, dest) {
+			return nil, fmt.Errorf("unsupported destination for zksync l2 -> l1 bridge: %d", dest)
+		}
+		l2Deps, ok := f.evmDeps[source]
+		if !ok {
+			return nil, fmt.Errorf("evm dependencies not found for source selector %d", source)
+		}
+		l1Deps, ok := f.evmDeps[dest]
+		if !ok {
+			return nil, fmt.Errorf("evm dependencies not found for dest selector %d", dest)
+		}
+		l1BridgeAdapter, ok := l1Deps.bridgeAdapters[source]
+		if !ok {
+			return nil, fmt.Errorf("bridge adapter not found for source selector %d in deps for dest selector %d", dest, source)
+		}
+		l2BridgeAdapter, ok := l2Deps.bridgeAdapters[dest]
+		if !ok {
+			return nil, fmt.Errorf("bridge adapter not found for dest selector %d in deps for source selector %d", source, dest)
+		}
+		f.lggr.Infow("addresses check",
+			"l1liquidityManagerAddress", l1Deps.liquidityManagerAddress,
+			"l2liquidityManagerAddress", l2Deps.liquidityManagerAddress,
+			"l1BridgeAdapter", l1BridgeAdapter,
+			"l2BridgeAdapter", l2BridgeAdapter,
+		)
+		bridge, err = zksync.NewL2ToL1Bridge(
+			ctx,
+			f.lggr,
+			source,
+			dest,
+			common.Address(l1Deps.liquidityManagerAddress), // l1 liquidityManager address
+			common.Address(l2Deps.liquidityManagerAddress), // l2 liquidityManager address
+			l1Deps.ethClient, // l1 eth client
+			l2Deps.ethClient, // l2 eth client
+			l1Deps.lp,        // l1 log poller
+			l2Deps.lp,        // l2 log poller
+		)
 	// Ethereum L1 --> Arbitrum L2 bridge OR
-	// Ethereum L1 --> Optimism L2 bridge
+	// Ethereum L1 --> Optimism L2 bridge OR
+	// Ethereum L1 --> ZKsync L2 bridge
 	case models.NetworkSelector(chainsel.ETHEREUM_MAINNET.Selector),
 		models.NetworkSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA.Selector):
 		if !bridgecommon.Supports(source, dest) {
@@ -281,6 +363,20 @@ func (f *factory) initBridge(ctx context.Context, source, dest models.NetworkSel
 				l1Deps.lp,        // l1 log poller
 				l2Deps.lp,        // l2 log poller
 			)
+		case models.NetworkSelector(chainsel.ETHEREUM_MAINNET_ZKSYNC_1.Selector),
+			models.NetworkSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA_ZKSYNC_1.Selector):
+			bridge, err = zksync.NewL1ToL2Bridge(
+				ctx,
+				f.lggr,
+				source,
+				dest,
+				common.Address(l1Deps.liquidityManagerAddress), // l1 liquidityManager address
+				common.Address(l2Deps.liquidityManagerAddress), // l2 liquidityManager address
+				l1Deps.ethClient, // l1 eth client
+				l2Deps.ethClient, // l2 eth client
+				l1Deps.lp,        // l1 log poller
+				l2Deps.lp,        // l2 log poller
+			)
 		default:
 			return nil, fmt.Errorf("unsupported destination for eth l1 -> l2 bridge: %d", dest)
 		}
@@ -337,6 +433,47 @@ func (f *factory) initBridge(ctx context.Context, source, dest models.NetworkSel
 	return bridge, nil
 }
 
+func (f *factory) initCCIPBridge(
+	ctx context.Context,
+	source,
+	dest models.NetworkSelector,
+	lane ccipLaneDep,
+) (Bridge, error) {
+	sourceDeps, ok := f.evmDeps[source]
+	if !ok {
+		return nil, fmt.Errorf("evm dependencies not found for source selector %d", source)
+	}
+	destDeps, ok := f.evmDeps[dest]
+	if !ok {
+		return nil, fmt.Errorf("evm dependencies not found for dest selector %d", dest)
+	}
+	sourceAdapter, ok := sourceDeps.bridgeAdapters[dest]
+	if !ok {
+		return nil, fmt.Errorf("bridge adapter not found for source selector %d in deps for selector %d", source, dest)
+	}
+	f.lggr.Infow("addresses check",
+		"router", lane.router,
+		"sourceLiquidityManagerAddress", sourceDeps.liquidityManagerAddress,
+		"destLiquidityManagerAddress", destDeps.liquidityManagerAddress,
+		"sourceBridgeAdapter", sourceAdapter,
+	)
+	return ccipbridge.New(
+		ctx,
+		f.lggr,
+		source,
+		dest,
+		common.Address(sourceDeps.liquidityManagerAddress), // source liquidityManager address
+		common.Address(destDeps.liquidityManagerAddress),   // dest liquidityManager address
+		common.Address(lane.router),                        // source router address
+		lane.onRampReader,
+		lane.offRampReader,
+		sourceDeps.ethClient, // source eth client
+		destDeps.ethClient,   // dest eth client
+		sourceDeps.lp,        // source log poller
+		destDeps.lp,          // dest log poller
+	)
+}
+
 func (f *factory) GetBridge(source, dest models.NetworkSelector) (Bridge, error) {
 	bridge, exists := f.cachedBridges.Load(f.cacheKey(source, dest))
 	if !exists {
@@ -0,0 +1,509 @@
+package ccipbridge
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_onramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/router"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/liquiditymanager/generated/liquiditymanager"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	bridgecommon "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/bridge/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/models"
+)
+
+var ccipSendRequestedTopic = evm_2_evm_onramp.EVM2EVMOnRampCCIPSendRequested{}.Topic()
+
+type ccipBridge struct {
+	sourceSelector         models.NetworkSelector
+	destSelector           models.NetworkSelector
+	sourceLiquidityManager liquiditymanager.LiquidityManagerInterface
+	destLiquidityManager   liquiditymanager.LiquidityManagerInterface
+	router                 router.RouterInterface
+	onRamp                 evm_2_evm_onramp.EVM2EVMOnRampInterface
+	onRampReader           cciptypes.OnRampReader
+	offRampReader          cciptypes.OffRampReader
+	sourceClient           client.Client
+	sourceLogPoller        logpoller.LogPoller
+	destLogPoller          logpoller.LogPoller
+	sourceFilterName       string
+	destFilterName         string
+	sourceToken, destToken common.Address
+	lggr                   logger.Logger
+
+	// seqNums caches the sequence numbers of the pending messages by message ID.
+	seqNumsMu sync.Mutex
+	seqNums   map[common.Hash]uint64
+}
+
+// pendingMessage is the CCIP message of a transfer that was sent but not received yet.
+type pendingMessage struct {
+	sent      *liquiditymanager.LiquidityManagerLiquidityTransferred
+	messageID common.Hash
+	seqNum    uint64
+}
+
+// New returns a bridge which moves liquidity between two chains with CCIP token transfers. The CCIP bridge adapter
+// of the source LiquidityManager sends the tokens to the destination LiquidityManager through the source Router
+// and returns the CCIP message ID, which is used to track the message through the onRamp and offRamp of the lane.
+func New(
+	ctx context.Context,
+	lggr logger.Logger,
+	sourceSelector,
+	destSelector models.NetworkSelector,
+	sourceLiquidityManagerAddress,
+	destLiquidityManagerAddress,
+	routerAddress common.Address,
+	onRampReader cciptypes.OnRampReader,
+	offRampReader cciptypes.OffRampReader,
+	sourceClient,
+	destClient client.Client,
+	sourceLogPoller,
+	destLogPoller logpoller.LogPoller,
+) (*ccipBridge, error) {
+	sourceChain, ok := chainsel.ChainBySelector(uint64(sourceSelector))
+	if !ok {
+		return nil, fmt.Errorf("unknown chain selector for source chain: %d", sourceSelector)
+	}
+	destChain, ok := chainsel.ChainBySelector(uint64(destSelector))
+	if !ok {
+		return nil, fmt.Errorf("unknown chain selector for dest chain: %d", destSelector)
+	}
+
+	onRampAddress, err := onRampReader.Address(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get onRamp address: %w", err)
+	}
+	onRamp, err := evm_2_evm_onramp.NewEVM2EVMOnRamp(common.HexToAddress(string(onRampAddress)), sourceClient)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate onRamp at %s: %w", onRampAddress, err)
+	}
+
+	routerWrapper, err := router.NewRouter(routerAddress, sourceClient)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate router at %s: %w", routerAddress, err)
+	}
+
+	sourceLiquidityManager, err := liquiditymanager.NewLiquidityManager(sourceLiquidityManagerAddress, sourceClient)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate source LiquidityManager: %w", err)
+	}
+	destLiquidityManager, err := liquiditymanager.NewLiquidityManager(destLiquidityManagerAddress, destClient)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate dest LiquidityManager: %w", err)
+	}
+
+	sourceToken, err := sourceLiquidityManager.ILocalToken(nil)
+	if err != nil {
+		return nil, fmt.Errorf("get source local token address: %w", err)
+	}
+	destToken, err := destLiquidityManager.ILocalToken(nil)
+	if err != nil {
+		return nil, fmt.Errorf("get dest local token address: %w", err)
+	}
+
+	sourceFilterName := bridgecommon.GetBridgeFilterName(
+		"CCIPBridge",
+		"Source",
+		sourceLiquidityManagerAddress,
+		sourceChain.Name,
+		destChain.Name,
+		"",
+	)
+	err = sourceLogPoller.RegisterFilter(ctx, logpoller.Filter{
+		Name: sourceFilterName,
+		EventSigs: []common.Hash{
+			bridgecommon.LiquidityTransferredTopic,
+		},
+		Addresses: []common.Address{sourceLiquidityManagerAddress},
+		Retention: bridgecommon.DurationMonth,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("register source LM filter for CCIP bridge: %w", err)
+	}
+
+	destFilterName := bridgecommon.GetBridgeFilterName(
+		"CCIPBridge",
+		"Dest",
+		destLiquidityManagerAddress,
+		sourceChain.Name,
+		destChain.Name,
+		"",
+	)
+	err = destLogPoller.RegisterFilter(ctx, logpoller.Filter{
+		Name: destFilterName,
+		EventSigs: []common.Hash{
+			bridgecommon.LiquidityTransferredTopic,
+		},
+		Addresses: []common.Address{destLiquidityManagerAddress},
+		Retention: bridgecommon.DurationMonth,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("register dest LM filter for CCIP bridge: %w", err)
+	}
+
+	lggr = lggr.Named("CCIPBridge").With(
+		"sourceSelector", sourceSelector,
+		"destSelector", destSelector,
+		"sourceLiquidityManager", sourceLiquidityManagerAddress.Hex(),
+		"destLiquidityManager", destLiquidityManagerAddress.Hex(),
+		"router", routerAddress.Hex(),
+		"onRamp", onRampAddress,
+	)
+	lggr.Infow("Initialized CCIP bridge")
+
+	return &ccipBridge{
+		sourceSelector:         sourceSelector,
+		destSelector:           destSelector,
+		sourceLiquidityManager: sourceLiquidityManager,
+		destLiquidityManager:   destLiquidityManager,
+		router:                 routerWrapper,
+		onRamp:                 onRamp,
+		onRampReader:           onRampReader,
+		offRampReader:          offRampReader,
+		sourceClient:           sourceClient,
+		sourceLogPoller:        sourceLogPoller,
+		destLogPoller:          destLogPoller,
+		sourceFilterName:       sourceFilterName,
+		destFilterName:         destFilterName,
+		sourceToken:            sourceToken,
+		destToken:              destToken,
+		lggr:                   lggr,
+		seqNums:                make(map[common.Hash]uint64),
+	}, nil
+}
+
+func (b *ccipBridge) GetTransfers(
+	ctx context.Context,
+	localToken,
+	remoteToken models.Address,
+) ([]models.PendingTransfer, error) {
+	lggr := b.lggr.With("localToken", localToken, "remoteToken", remoteToken)
+	if b.sourceToken.Cmp(common.Address(localToken)) != 0 {
+		return nil, fmt.Errorf("local token mismatch: expected %s, got %s", b.sourceToken, localToken)
+	}
+	if b.destToken.Cmp(common.Address(remoteToken)) != 0 {
+		return nil, fmt.Errorf("remote token mismatch: expected %s, got %s", b.destToken, remoteToken)
+	}
+
+	sendLogs, receivedLogs, err := b.getLogs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get logs: %w", err)
+	}
+
+	parsedSent, parsedToLP, err := bridgecommon.ParseLiquidityTransferred(b.sourceLiquidityManager.ParseLiquidityTransferred, sendLogs)
+	if err != nil {
+		return nil, fmt.Errorf("parse CCIP transfer sent logs: %w", err)
+	}
+	parsedReceived, _, err := bridgecommon.ParseLiquidityTransferred(b.destLiquidityManager.ParseLiquidityTransferred, receivedLogs)
+	if err != nil {
+		return nil, fmt.Errorf("parse CCIP transfer received logs: %w", err)
+	}
+
+	// The message ID is piped through the events:
+	//   sent_LiquidityTransferred.bridgeReturnData == received_LiquidityTransferred.bridgeSpecificData
+	received := make(map[string]struct{}, len(parsedReceived))
+	for _, r := range parsedReceived {
+		received[string(r.BridgeSpecificData)] = struct{}{}
+	}
+	var messages []pendingMessage
+	for _, sent := range parsedSent {
+		if sent.To != b.destLiquidityManager.Address() {
+			lggr.Warnw("skipping sent log with mismatched 'To' address", "sentLog", sent)
+			continue
+		}
+		if _, ok := received[string(sent.BridgeReturnData)]; ok {
+			continue
+		}
+
+		messageID, err := UnpackMessageID(sent.BridgeReturnData)
+		if err != nil {
+			return nil, fmt.Errorf("unpack message ID from source LiquidityTransferred log (%s): %w, data: %s",
+				sent.Raw.TxHash, err, hexutil.Encode(sent.BridgeReturnData))
+		}
+		seqNum, err := b.sequenceNumber(ctx, sent.Raw.TxHash, messageID)
+		if err != nil {
+			return nil, fmt.Errorf("get sequence number of CCIP message %s: %w", messageID, err)
+		}
+		messages = append(messages, pendingMessage{sent: sent, messageID: messageID, seqNum: seqNum})
+	}
+	b.pruneSeqNums(messages)
+
+	executed, err := b.executedMessages(ctx, lggr, messages)
+	if err != nil {
+		return nil, fmt.Errorf("get execution state of CCIP messages: %w", err)
+	}
+
+	var transfers []models.PendingTransfer
+	var ready int
+	for _, msg := range messages {
+		sent := msg.sent
+		stage, status := bridgecommon.StageRebalanceConfirmed, models.TransferStatusNotReady
+		if executed[msg.messageID] {
+			// the tokens are released to the dest LiquidityManager when the message is executed, receiving the
+			// transfer only records it.
+			stage, status = bridgecommon.StageFinalizeReady, models.TransferStatusReady
+			ready++
+		}
+		transfers = append(transfers, models.PendingTransfer{
+			Transfer: models.Transfer{
+				From:               b.sourceSelector,
+				To:                 b.destSelector,
+				Sender:             models.Address(b.sourceLiquidityManager.Address()),
+				Receiver:           models.Address(b.destLiquidityManager.Address()),
+				LocalTokenAddress:  localToken,
+				RemoteTokenAddress: remoteToken,
+				Amount:             ubig.New(sent.Amount),
+				Date: parsedToLP[bridgecommon.LogKey{
+					TxHash:   sent.Raw.TxHash,
+					LogIndex: int64(sent.Raw.Index),
+				}].BlockTimestamp,
+				BridgeData:      sent.BridgeReturnData, // message ID
+				Stage:           stage,
+				NativeBridgeFee: ubig.NewI(0),
+			},
+			Status: status,
+			ID:     fmt.Sprintf("%s-%d", sent.Raw.TxHash.Hex(), sent.Raw.Index),
+		})
+	}
+
+	lggr.Infow("partitioned CCIP transfers",
+		"parsedSent", len(parsedSent),
+		"parsedReceived", len(parsedReceived),
+		"notReady", len(transfers)-ready,
+		"ready", ready,
+	)
+
+	return transfers, nil
+}
+
+func (b *ccipBridge) getLogs(ctx context.Context) (sendLogs, receivedLogs []logpoller.Log, err error) {
+	// Transfers sent by the source LM over the lane in the past 14 days
+	sendLogs, err = b.sourceLogPoller.IndexedLogsCreatedAfter(
+		ctx,
+		bridgecommon.LiquidityTransferredTopic,
+		b.sourceLiquidityManager.Address(),
+		bridgecommon.LiquidityTransferredToChainSelectorTopicIndex,
+		[]common.Hash{
+			bridgecommon.NetworkSelectorToHash(b.destSelector),
+		},
+		time.Now().Add(-bridgecommon.DurationMonth/2),
+		evmtypes.Finalized,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get CCIP transfers from log poller on source: %w", err)
+	}
+
+	// Transfers received by the dest LM from the source chain in the past 14 days
+	receivedLogs, err = b.destLogPoller.IndexedLogsCreatedAfter(
+		ctx,
+		bridgecommon.LiquidityTransferredTopic,
+		b.destLiquidityManager.Address(),
+		bridgecommon.LiquidityTransferredFromChainSelectorTopicIndex,
+		[]common.Hash{
+			bridgecommon.NetworkSelectorToHash(b.sourceSelector),
+		},
+		time.Now().Add(-bridgecommon.DurationMonth/2),
+		evmtypes.Finalized,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get CCIP transfers from log poller on dest: %w", err)
+	}
+
+	return sendLogs, receivedLogs, nil
+}
+
+// executedMessages returns the pending messages that were executed successfully on the destination chain.
+// The send requests and the execution state changes of all the messages are read from the log pollers in one
+// query each, only the messages with a state change are checked against the offRamp, so that transfers waiting
+// for CCIP don't cost any RPC call.
+func (b *ccipBridge) executedMessages(
+	ctx context.Context,
+	lggr logger.Logger,
+	messages []pendingMessage,
+) (map[common.Hash]bool, error) {
+	executed := make(map[common.Hash]bool)
+	if len(messages) == 0 {
+		return executed, nil
+	}
+	seqNumMin, seqNumMax := messages[0].seqNum, messages[0].seqNum
+	for _, msg := range messages[1:] {
+		seqNumMin = min(seqNumMin, msg.seqNum)
+		seqNumMax = max(seqNumMax, msg.seqNum)
+	}
+
+	requests, err := b.onRampReader.GetSendRequestsBetweenSeqNums(ctx, seqNumMin, seqNumMax, true)
+	if err != nil {
+		return nil, fmt.Errorf("get send requests [%d, %d] from onRamp: %w", seqNumMin, seqNumMax, err)
+	}
+	finalized := make(map[uint64]cciptypes.Hash, len(requests))
+	for _, req := range requests {
+		finalized[req.SequenceNumber] = req.MessageID
+	}
+
+	stateChanges, err := b.offRampReader.GetExecutionStateChangesBetweenSeqNums(ctx, seqNumMin, seqNumMax, 0)
+	if err != nil {
+		return nil, fmt.Errorf("get execution state changes [%d, %d] from offRamp: %w", seqNumMin, seqNumMax, err)
+	}
+	changed := make(map[uint64]struct{}, len(stateChanges))
+	for _, sc := range stateChanges {
+		changed[sc.SequenceNumber] = struct{}{}
+	}
+
+	for _, msg := range messages {
+		messageID, ok := finalized[msg.seqNum]
+		if !ok {
+			lggr.Debugw("CCIP send request not finalized yet", "messageID", msg.messageID, "seqNum", msg.seqNum)
+			continue
+		}
+		if messageID != cciptypes.Hash(msg.messageID) {
+			return nil, fmt.Errorf("onRamp message ID mismatch for sequence number %d: expected %s, got %s",
+				msg.seqNum, msg.messageID, messageID)
+		}
+		if _, ok := changed[msg.seqNum]; !ok {
+			continue
+		}
+
+		state, err := b.offRampReader.GetExecutionState(ctx, msg.seqNum)
+		if err != nil {
+			return nil, fmt.Errorf("get execution state of sequence number %d from offRamp: %w", msg.seqNum, err)
+		}
+		switch cciptypes.MessageExecutionState(state) {
+		case cciptypes.ExecutionStateSuccess:
+			executed[msg.messageID] = true
+		case cciptypes.ExecutionStateFailure:
+			lggr.Errorw("CCIP message execution failed, it must be manually executed",
+				"messageID", msg.messageID, "seqNum", msg.seqNum)
+		default:
+		}
+	}
+	return executed, nil
+}
+
+// sequenceNumber returns the sequence number of the CCIP message, from the CCIPSendRequested event emitted by the
+// onRamp in the source transaction. The transfers are read from finalized logs so the sequence numbers are cached
+// until the transfers are received.
+func (b *ccipBridge) sequenceNumber(ctx context.Context, txHash, messageID common.Hash) (uint64, error) {
+	b.seqNumsMu.Lock()
+	seqNum, ok := b.seqNums[messageID]
+	b.seqNumsMu.Unlock()
+	if ok {
+		return seqNum, nil
+	}
+
+	receipt, err := b.sourceClient.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return 0, fmt.Errorf("get transaction receipt %s: %w", txHash, err)
+	}
+	for _, lg := range receipt.Logs {
+		if lg.Address != b.onRamp.Address() || len(lg.Topics) == 0 || lg.Topics[0] != ccipSendRequestedTopic {
+			continue
+		}
+		sendRequested, err := b.onRamp.ParseCCIPSendRequested(*lg)
+		if err != nil {
+			return 0, fmt.Errorf("parse CCIPSendRequested log: %w", err)
+		}
+		if sendRequested.Message.MessageId == messageID {
+			b.seqNumsMu.Lock()
+			b.seqNums[messageID] = sendRequested.Message.SequenceNumber
+			b.seqNumsMu.Unlock()
+			return sendRequested.Message.SequenceNumber, nil
+		}
+	}
+	return 0, fmt.Errorf("no CCIPSendRequested log for message %s in tx %s", messageID, txHash)
+}
+
+// pruneSeqNums drops the cached sequence numbers of the messages that are no longer pending.
+func (b *ccipBridge) pruneSeqNums(pending []pendingMessage) {
+	keep := make(map[common.Hash]struct{}, len(pending))
+	for _, msg := range pending {
+		keep[msg.messageID] = struct{}{}
+	}
+	b.seqNumsMu.Lock()
+	defer b.seqNumsMu.Unlock()
+	for messageID := range b.seqNums {
+		if _, ok := keep[messageID]; !ok {
+			delete(b.seqNums, messageID)
+		}
+	}
+}
+
+// GetBridgePayloadAndFee returns the destination gas limit of the CCIP message and the fee quoted by the Router
+// for the token transfer, paid in native tokens.
+func (b *ccipBridge) GetBridgePayloadAndFee(
+	ctx context.Context,
+	transfer models.Transfer,
+) ([]byte, *big.Int, error) {
+	destGasLimit := big.NewInt(DefaultDestGasLimit)
+	args, err := extraArgs(destGasLimit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("encode extra args: %w", err)
+	}
+	receiver, err := receiverBytes(b.destLiquidityManager.Address())
+	if err != nil {
+		return nil, nil, fmt.Errorf("encode receiver: %w", err)
+	}
+
+	fee, err := b.router.GetFee(&bind.CallOpts{Context: ctx}, uint64(b.destSelector), router.ClientEVM2AnyMessage{
+		Receiver: receiver,
+		Data:     []byte{},
+		TokenAmounts: []router.ClientEVMTokenAmount{{
+			Token:  common.Address(transfer.LocalTokenAddress),
+			Amount: transfer.Amount.ToInt(),
+		}},
+		FeeToken:  common.Address{}, // native
+		ExtraArgs: args,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("get fee from router %s: %w", b.router.Address(), err)
+	}
+	fee = new(big.Int).Div(new(big.Int).Mul(fee, big.NewInt(100+feeBufferPercent)), big.NewInt(100))
+
+	payload, err := PackSendBridgePayload(destGasLimit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("pack CCIP bridge payload: %w", err)
+	}
+	return payload, fee, nil
+}
+
+// QuorumizedBridgePayload returns the f-th highest destination gas limit of the payloads.
+func (b *ccipBridge) QuorumizedBridgePayload(payloads [][]byte, f int) ([]byte, error) {
+	if len(payloads) <= f {
+		return nil, fmt.Errorf("not enough payloads to quorumize, need at least f+1: len(payloads) = %d, f = %d", len(payloads), f)
+	}
+	gasLimits := make([]*big.Int, 0, len(payloads))
+	for _, payload := range payloads {
+		gasLimit, err := UnpackSendBridgePayload(payload)
+		if err != nil {
+			return nil, fmt.Errorf("decode bridge payload: %w", err)
+		}
+		gasLimits = append(gasLimits, gasLimit)
+	}
+	slices.SortFunc(gasLimits, func(i, j *big.Int) int {
+		return i.Cmp(j)
+	})
+	return PackSendBridgePayload(gasLimits[len(gasLimits)-f-1])
+}
+
+// Close implements bridge.Bridge. The CCIP readers are owned by the caller and are not closed.
+func (b *ccipBridge) Close(ctx context.Context) error {
+	return multierr.Combine(
+		b.sourceLogPoller.UnregisterFilter(ctx, b.sourceFilterName),
+		b.destLogPoller.UnregisterFilter(ctx, b.destFilterName),
+	)
+}
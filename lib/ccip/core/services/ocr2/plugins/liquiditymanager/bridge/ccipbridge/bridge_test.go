@@ -0,0 +1,289 @@
+package ccipbridge
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclientmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/evm_2_evm_onramp"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/ccip/generated/router"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/liquiditymanager/generated/liquiditymanager"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/abihelpers"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/models"
+)
+
+// fakeOnRampReader and fakeOffRampReader are local stand-ins for the CCIP readers, only the methods used by the
+// bridge are implemented.
+type fakeOnRampReader struct {
+	cciptypes.OnRampReader
+	requests map[uint64]cciptypes.EVM2EVMMessageWithTxMeta
+}
+
+func (r *fakeOnRampReader) GetSendRequestsBetweenSeqNums(
+	_ context.Context, seqNumMin, seqNumMax uint64, _ bool,
+) ([]cciptypes.EVM2EVMMessageWithTxMeta, error) {
+	var res []cciptypes.EVM2EVMMessageWithTxMeta
+	for seqNum := seqNumMin; seqNum <= seqNumMax; seqNum++ {
+		if req, ok := r.requests[seqNum]; ok {
+			res = append(res, req)
+		}
+	}
+	return res, nil
+}
+
+type fakeOffRampReader struct {
+	cciptypes.OffRampReader
+	states          map[uint64]cciptypes.MessageExecutionState
+	stateReadsCount int
+}
+
+func (r *fakeOffRampReader) GetExecutionStateChangesBetweenSeqNums(
+	_ context.Context, seqNumMin, seqNumMax uint64, _ int,
+) ([]cciptypes.ExecutionStateChangedWithTxMeta, error) {
+	var res []cciptypes.ExecutionStateChangedWithTxMeta
+	for seqNum := seqNumMin; seqNum <= seqNumMax; seqNum++ {
+		if _, ok := r.states[seqNum]; ok {
+			res = append(res, cciptypes.ExecutionStateChangedWithTxMeta{
+				ExecutionStateChanged: cciptypes.ExecutionStateChanged{SequenceNumber: seqNum},
+			})
+		}
+	}
+	return res, nil
+}
+
+func (r *fakeOffRampReader) GetExecutionState(_ context.Context, seqNum uint64) (uint8, error) {
+	r.stateReadsCount++
+	return uint8(r.states[seqNum]), nil
+}
+
+func Test_ccipBridge_QuorumizedBridgePayload(t *testing.T) {
+	mustPack := func(gasLimit int64) []byte {
+		payload, err := PackSendBridgePayload(big.NewInt(gasLimit))
+		require.NoError(t, err)
+		return payload
+	}
+	tests := []struct {
+		name     string
+		payloads [][]byte
+		f        int
+		want     []byte
+		wantErr  bool
+	}{
+		{"not enough payloads", [][]byte{mustPack(0)}, 1, nil, true},
+		{"invalid payload", [][]byte{{0x1}, {0x2}}, 1, nil, true},
+		{"same payloads", [][]byte{mustPack(0), mustPack(0), mustPack(0)}, 1, mustPack(0), false},
+		{"f-th highest", [][]byte{mustPack(5), mustPack(100), mustPack(10), mustPack(1)}, 1, mustPack(10), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &ccipBridge{}
+			got, err := b.QuorumizedBridgePayload(tt.payloads, tt.f)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_ccipBridge_GetBridgePayloadAndFee(t *testing.T) {
+	routerAddress := common.HexToAddress("0x80226fc0Ee2b096224EeAc085Bb9a8cba1146f7D")
+	destLMAddress := common.HexToAddress("0xd1")
+	token := common.HexToAddress("0x70")
+	routerABI := abihelpers.MustParseABI(router.RouterABI)
+
+	sourceClient := evmclientmocks.NewClient(t)
+	destClient := evmclientmocks.NewClient(t)
+	routerWrapper, err := router.NewRouter(routerAddress, sourceClient)
+	require.NoError(t, err)
+	destLM, err := liquiditymanager.NewLiquidityManager(destLMAddress, destClient)
+	require.NoError(t, err)
+
+	encodedFee, err := routerABI.Methods["getFee"].Outputs.Pack(big.NewInt(1000))
+	require.NoError(t, err)
+	sourceClient.On("CallContract", mock.Anything, mock.Anything, mock.Anything).
+		Return(encodedFee, nil).Once()
+
+	b := &ccipBridge{
+		destSelector:         models.NetworkSelector(5009297550715157269),
+		destLiquidityManager: destLM,
+		router:               routerWrapper,
+	}
+	payload, fee, err := b.GetBridgePayloadAndFee(testutils.Context(t), models.Transfer{
+		LocalTokenAddress: models.Address(token),
+		Amount:            ubig.NewI(1e18),
+	})
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1100), fee, "fee buffer must be added")
+	gasLimit, err := UnpackSendBridgePayload(payload)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(DefaultDestGasLimit), gasLimit)
+
+	sourceClient.On("CallContract", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("error")).Once()
+	_, _, err = b.GetBridgePayloadAndFee(testutils.Context(t), models.Transfer{
+		LocalTokenAddress: models.Address(token),
+		Amount:            ubig.NewI(1e18),
+	})
+	require.Error(t, err)
+}
+
+func Test_ccipBridge_executedMessages(t *testing.T) {
+	messageID := func(i int64) common.Hash { return common.BigToHash(big.NewInt(i)) }
+	request := func(seqNum uint64, id common.Hash) cciptypes.EVM2EVMMessageWithTxMeta {
+		return cciptypes.EVM2EVMMessageWithTxMeta{
+			EVM2EVMMessage: cciptypes.EVM2EVMMessage{SequenceNumber: seqNum, MessageID: cciptypes.Hash(id)},
+		}
+	}
+	messages := []pendingMessage{
+		{messageID: messageID(1), seqNum: 7},
+		{messageID: messageID(2), seqNum: 9},
+		{messageID: messageID(3), seqNum: 10},
+		{messageID: messageID(4), seqNum: 12},
+	}
+
+	tests := []struct {
+		name           string
+		messages       []pendingMessage
+		requests       map[uint64]cciptypes.EVM2EVMMessageWithTxMeta
+		states         map[uint64]cciptypes.MessageExecutionState
+		want           map[common.Hash]bool
+		wantStateReads int
+		wantErr        bool
+	}{
+		{
+			name:     "no messages",
+			messages: nil,
+			want:     map[common.Hash]bool{},
+		},
+		{
+			name:     "only the executed messages with a state change are read from the offRamp",
+			messages: messages,
+			requests: map[uint64]cciptypes.EVM2EVMMessageWithTxMeta{
+				7:  request(7, messageID(1)),
+				8:  request(8, messageID(100)), // not a transfer of the bridge
+				9:  request(9, messageID(2)),
+				10: request(10, messageID(3)),
+				// 12 is not finalized yet
+			},
+			states: map[uint64]cciptypes.MessageExecutionState{
+				7:  cciptypes.ExecutionStateSuccess,
+				8:  cciptypes.ExecutionStateSuccess,
+				9:  cciptypes.ExecutionStateFailure,
+				12: cciptypes.ExecutionStateSuccess,
+			},
+			want:           map[common.Hash]bool{messageID(1): true},
+			wantStateReads: 2,
+		},
+		{
+			name:     "message ID mismatch",
+			messages: messages[:1],
+			requests: map[uint64]cciptypes.EVM2EVMMessageWithTxMeta{7: request(7, messageID(100))},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offRampReader := &fakeOffRampReader{states: tt.states}
+			b := &ccipBridge{
+				onRampReader:  &fakeOnRampReader{requests: tt.requests},
+				offRampReader: offRampReader,
+			}
+			got, err := b.executedMessages(testutils.Context(t), logger.TestLogger(t), tt.messages)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.wantStateReads, offRampReader.stateReadsCount)
+		})
+	}
+}
+
+func Test_ccipBridge_sequenceNumber(t *testing.T) {
+	onRampAddress := common.HexToAddress("0x0a")
+	txHash := common.HexToHash("0xabcd")
+	messageID := common.HexToHash("0x1d")
+	onRampABI := abihelpers.MustParseABI(evm_2_evm_onramp.EVM2EVMOnRampABI)
+
+	sendRequestedLog := func(t *testing.T, seqNum uint64, id common.Hash) *gethtypes.Log {
+		data, err := onRampABI.Events["CCIPSendRequested"].Inputs.Pack(evm_2_evm_onramp.InternalEVM2EVMMessage{
+			SourceChainSelector: 1,
+			SequenceNumber:      seqNum,
+			GasLimit:            big.NewInt(0),
+			FeeTokenAmount:      big.NewInt(0),
+			Data:                []byte{},
+			TokenAmounts:        []evm_2_evm_onramp.ClientEVMTokenAmount{},
+			SourceTokenData:     [][]byte{},
+			MessageId:           id,
+		})
+		require.NoError(t, err)
+		return &gethtypes.Log{Address: onRampAddress, Topics: []common.Hash{ccipSendRequestedTopic}, Data: data}
+	}
+
+	newBridge := func(t *testing.T, logs []*gethtypes.Log) *ccipBridge {
+		sourceClient := evmclientmocks.NewClient(t)
+		sourceClient.On("TransactionReceipt", mock.Anything, txHash).
+			Return(&gethtypes.Receipt{TxHash: txHash, Logs: logs}, nil).Once()
+		onRamp, err := evm_2_evm_onramp.NewEVM2EVMOnRamp(onRampAddress, sourceClient)
+		require.NoError(t, err)
+		return &ccipBridge{
+			onRamp:       onRamp,
+			sourceClient: sourceClient,
+			seqNums:      make(map[common.Hash]uint64),
+		}
+	}
+
+	t.Run("cached until the message is no longer pending", func(t *testing.T) {
+		b := newBridge(t, []*gethtypes.Log{
+			sendRequestedLog(t, 6, common.HexToHash("0x01")),
+			sendRequestedLog(t, 7, messageID),
+		})
+		for i := 0; i < 2; i++ {
+			seqNum, err := b.sequenceNumber(testutils.Context(t), txHash, messageID)
+			require.NoError(t, err)
+			require.Equal(t, uint64(7), seqNum)
+		}
+
+		b.pruneSeqNums([]pendingMessage{{messageID: messageID, seqNum: 7}})
+		require.Len(t, b.seqNums, 1)
+		b.pruneSeqNums(nil)
+		require.Empty(t, b.seqNums)
+	})
+
+	t.Run("no send requested log", func(t *testing.T) {
+		b := newBridge(t, []*gethtypes.Log{sendRequestedLog(t, 7, common.HexToHash("0x01"))})
+		_, err := b.sequenceNumber(testutils.Context(t), txHash, messageID)
+		require.Error(t, err)
+		require.Empty(t, b.seqNums)
+	})
+}
+
+func Test_ccipBridge_Close(t *testing.T) {
+	sourceLogPoller := lpmocks.NewLogPoller(t)
+	destLogPoller := lpmocks.NewLogPoller(t)
+	sourceLogPoller.On("UnregisterFilter", mock.Anything, "source").Return(nil)
+	destLogPoller.On("UnregisterFilter", mock.Anything, "dest").Return(nil)
+	b := &ccipBridge{
+		sourceLogPoller:  sourceLogPoller,
+		destLogPoller:    destLogPoller,
+		sourceFilterName: "source",
+		destFilterName:   "dest",
+	}
+	require.NoError(t, b.Close(testutils.Context(t)))
+}
@@ -0,0 +1,81 @@
+package ccipbridge
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+)
+
+const (
+	// DefaultDestGasLimit is the gas limit of the CCIP message on the destination chain. The receiver is the remote
+	// LiquidityManager which doesn't implement ccipReceive, so no gas is needed besides the token release.
+	DefaultDestGasLimit = 0
+
+	// feeBufferPercent is added to the quoted CCIP fee, the fee can increase between the quote and the send if the
+	// gas or token prices move. The excess is kept by the onRamp.
+	feeBufferPercent = 10
+
+	// Payload encodings, the CCIP bridge adapter decodes these.
+	sendPayloadEncoding = `[{"type": "uint256"}]`
+	messageIDEncoding   = `[{"type": "bytes32"}]`
+	extraArgsEncoding   = `[{"type": "uint256"}, {"type": "bool"}]`
+	receiverEncoding    = `[{"type": "address"}]`
+)
+
+// evmExtraArgsV2Tag is the tag of the Client.EVMExtraArgsV2 struct, see Client.sol.
+var evmExtraArgsV2Tag = []byte{0x18, 0x1d, 0xcf, 0x10}
+
+// PackSendBridgePayload encodes the bridge specific data of a CCIP transfer, the CCIP bridge adapter builds the
+// message extra args from it.
+func PackSendBridgePayload(destGasLimit *big.Int) ([]byte, error) {
+	return utils.ABIEncode(sendPayloadEncoding, destGasLimit)
+}
+
+// UnpackSendBridgePayload decodes the bridge specific data of a CCIP transfer.
+func UnpackSendBridgePayload(payload []byte) (*big.Int, error) {
+	decoded, err := utils.ABIDecode(sendPayloadEncoding, payload)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) != 1 {
+		return nil, fmt.Errorf("expected 1 element, got %d", len(decoded))
+	}
+	return *abi.ConvertType(decoded[0], new(*big.Int)).(**big.Int), nil
+}
+
+// PackMessageID encodes the CCIP message ID, which is returned by the CCIP bridge adapter when a transfer is sent
+// and used as bridge data to receive it on the destination chain.
+func PackMessageID(messageID [32]byte) ([]byte, error) {
+	return utils.ABIEncode(messageIDEncoding, messageID)
+}
+
+// UnpackMessageID decodes the CCIP message ID of a transfer.
+func UnpackMessageID(data []byte) (common.Hash, error) {
+	decoded, err := utils.ABIDecode(messageIDEncoding, data)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(decoded) != 1 {
+		return common.Hash{}, fmt.Errorf("expected 1 element, got %d", len(decoded))
+	}
+	return *abi.ConvertType(decoded[0], new([32]byte)).(*[32]byte), nil
+}
+
+// extraArgs returns the Client.EVMExtraArgsV2 the CCIP bridge adapter sends the message with. Transfers don't
+// depend on each other, so they are sent with out of order execution allowed.
+func extraArgs(destGasLimit *big.Int) ([]byte, error) {
+	encoded, err := utils.ABIEncode(extraArgsEncoding, destGasLimit, true)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, evmExtraArgsV2Tag...), encoded...), nil
+}
+
+// receiverBytes returns the receiver of a CCIP message to an EVM chain, the ABI encoded address.
+func receiverBytes(receiver common.Address) ([]byte, error) {
+	return utils.ABIEncode(receiverEncoding, receiver)
+}
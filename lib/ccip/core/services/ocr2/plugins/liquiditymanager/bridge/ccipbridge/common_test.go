@@ -0,0 +1,45 @@
+package ccipbridge
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SendBridgePayload_RoundTrip(t *testing.T) {
+	payload, err := PackSendBridgePayload(big.NewInt(200_000))
+	require.NoError(t, err)
+
+	gasLimit, err := UnpackSendBridgePayload(payload)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(200_000), gasLimit)
+
+	_, err = UnpackSendBridgePayload([]byte{0x1})
+	require.Error(t, err)
+}
+
+func Test_MessageID_RoundTrip(t *testing.T) {
+	messageID := common.HexToHash("0xc0ffee")
+	data, err := PackMessageID(messageID)
+	require.NoError(t, err)
+
+	got, err := UnpackMessageID(data)
+	require.NoError(t, err)
+	require.Equal(t, messageID, got)
+
+	_, err = UnpackMessageID([]byte{})
+	require.Error(t, err)
+}
+
+func Test_extraArgs(t *testing.T) {
+	args, err := extraArgs(big.NewInt(0))
+	require.NoError(t, err)
+	require.Equal(t, "0x181dcf10"+
+		"0000000000000000000000000000000000000000000000000000000000000000"+
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		hexutil.Encode(args))
+	require.Equal(t, []byte{0x18, 0x1d, 0xcf, 0x10}, evmExtraArgsV2Tag, "tag must not be modified")
+}
@@ -26,10 +26,12 @@ var chains = Chains{
 	chainsel.ETHEREUM_MAINNET.Selector: []uint64{
 		chainsel.ETHEREUM_MAINNET_ARBITRUM_1.Selector,
 		chainsel.ETHEREUM_MAINNET_OPTIMISM_1.Selector,
+		chainsel.ETHEREUM_MAINNET_ZKSYNC_1.Selector,
 	},
 	chainsel.ETHEREUM_TESTNET_SEPOLIA.Selector: []uint64{
 		chainsel.ETHEREUM_TESTNET_SEPOLIA_ARBITRUM_1.Selector,
 		chainsel.ETHEREUM_TESTNET_SEPOLIA_OPTIMISM_1.Selector,
+		chainsel.ETHEREUM_TESTNET_SEPOLIA_ZKSYNC_1.Selector,
 	},
 	// Source = Arbitrum
 	chainsel.ETHEREUM_MAINNET_ARBITRUM_1.Selector: []uint64{
@@ -45,4 +47,11 @@ var chains = Chains{
 	chainsel.ETHEREUM_TESTNET_SEPOLIA_OPTIMISM_1.Selector: []uint64{
 		chainsel.ETHEREUM_TESTNET_SEPOLIA.Selector,
 	},
+	// Source = ZKsync
+	chainsel.ETHEREUM_MAINNET_ZKSYNC_1.Selector: []uint64{
+		chainsel.ETHEREUM_MAINNET.Selector,
+	},
+	chainsel.ETHEREUM_TESTNET_SEPOLIA_ZKSYNC_1.Selector: []uint64{
+		chainsel.ETHEREUM_TESTNET_SEPOLIA.Selector,
+	},
 }
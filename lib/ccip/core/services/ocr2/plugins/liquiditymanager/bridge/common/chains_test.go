@@ -53,11 +53,36 @@ func TestSupports(t *testing.T) {
 			dest:     models.NetworkSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA.Selector),
 			expected: true,
 		},
+		{
+			src:      models.NetworkSelector(chainsel.ETHEREUM_MAINNET.Selector),
+			dest:     models.NetworkSelector(chainsel.ETHEREUM_MAINNET_ZKSYNC_1.Selector),
+			expected: true,
+		},
+		{
+			src:      models.NetworkSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA.Selector),
+			dest:     models.NetworkSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA_ZKSYNC_1.Selector),
+			expected: true,
+		},
+		{
+			src:      models.NetworkSelector(chainsel.ETHEREUM_MAINNET_ZKSYNC_1.Selector),
+			dest:     models.NetworkSelector(chainsel.ETHEREUM_MAINNET.Selector),
+			expected: true,
+		},
+		{
+			src:      models.NetworkSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA_ZKSYNC_1.Selector),
+			dest:     models.NetworkSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA.Selector),
+			expected: true,
+		},
 		{
 			src:      models.NetworkSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA_OPTIMISM_1.Selector),
 			dest:     models.NetworkSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA_ARBITRUM_1.Selector),
 			expected: false,
 		},
+		{
+			src:      models.NetworkSelector(chainsel.ETHEREUM_MAINNET_ZKSYNC_1.Selector),
+			dest:     models.NetworkSelector(chainsel.ETHEREUM_MAINNET_OPTIMISM_1.Selector),
+			expected: false,
+		},
 		{
 			src:      models.NetworkSelector(chainsel.ETHEREUM_MAINNET.Selector),
 			dest:     models.NetworkSelector(chainsel.ETHEREUM_MAINNET.Selector),
@@ -0,0 +1,230 @@
+package zksync
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/abihelpers"
+)
+
+const (
+	// RequiredL2GasPricePerPubdata is the gas per pubdata byte limit required by ZKsync for L1 to L2 transactions.
+	RequiredL2GasPricePerPubdata = 800
+
+	// DefaultL2DepositGasLimit is the L2 gas limit of the L1 to L2 deposit transactions, it covers the finalizeDeposit
+	// call of the L2 shared bridge, including the deployment of the bridged token on its first deposit.
+	DefaultL2DepositGasLimit = 1_000_000
+
+	// Function calls
+	L2TransactionBaseCostFunction = "l2TransactionBaseCost"
+
+	// Payload encodings, the ZKsync bridge adapters decode these.
+	l1ToL2SendPayloadEncoding         = `[{"type": "uint256"}, {"type": "uint256"}]`
+	finalizeWithdrawalPayloadEncoding = `[{"type": "bytes32"}, {"type": "uint256"}, {"type": "uint256"}, ` +
+		`{"type": "uint16"}, {"type": "bytes"}, {"type": "bytes32[]"}]`
+	l2TxHashPayloadEncoding   = `[{"type": "bytes32"}]`
+	l1MessageSentDataEncoding = `[{"type": "bytes"}]`
+)
+
+var (
+	// l1GasPriceMultiplier is applied to the suggested L1 gas price when quoting the L1 to L2 base cost, the
+	// Bridgehub charges the base cost at the gas price of the transaction which is only known when it is sent.
+	l1GasPriceMultiplier = big.NewInt(2)
+
+	// ZKsync events emitted on L2
+	L1MessageSentTopic = crypto.Keccak256Hash([]byte("L1MessageSent(address,bytes32,bytes)"))
+
+	// ABIs
+	bridgehubABI = abihelpers.MustParseABI(`[{
+		"type": "function",
+		"name": "l2TransactionBaseCost",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "_chainId", "type": "uint256"},
+			{"name": "_gasPrice", "type": "uint256"},
+			{"name": "_l2GasLimit", "type": "uint256"},
+			{"name": "_l2GasPerPubdataByteLimit", "type": "uint256"}
+		],
+		"outputs": [{"name": "", "type": "uint256"}]
+	}]`)
+)
+
+// bridgeContracts is the response of the zks_getBridgeContracts RPC method.
+type bridgeContracts struct {
+	L1SharedDefaultBridge common.Address `json:"l1SharedDefaultBridge"`
+	L2SharedDefaultBridge common.Address `json:"l2SharedDefaultBridge"`
+}
+
+// l2ToL1LogProof is the response of the zks_getL2ToL1LogProof RPC method. ID is the index of the message in the
+// L1 batch, it is the l2MessageIndex of the withdrawal finalization.
+type l2ToL1LogProof struct {
+	Proof []common.Hash `json:"proof"`
+	ID    uint64        `json:"id"`
+	Root  common.Hash   `json:"root"`
+}
+
+// l1BatchDetails is the part of the zks_getL1BatchDetails response needed to know if a batch was executed on L1.
+type l1BatchDetails struct {
+	Number        uint64       `json:"number"`
+	Status        string       `json:"status"`
+	ExecuteTxHash *common.Hash `json:"executeTxHash"`
+}
+
+func (d *l1BatchDetails) executed() bool {
+	return d != nil && d.ExecuteTxHash != nil && *d.ExecuteTxHash != (common.Hash{})
+}
+
+type rpcLog struct {
+	Address common.Address `json:"address"`
+	Topics  []common.Hash  `json:"topics"`
+	Data    hexutil.Bytes  `json:"data"`
+}
+
+type l2ToL1Log struct {
+	Sender common.Address `json:"sender"`
+	Key    common.Hash    `json:"key"`
+	Value  common.Hash    `json:"value"`
+}
+
+// receipt is a ZKsync transaction receipt, it extends the Ethereum receipt with the L1 batch of the transaction
+// and the L2 to L1 logs. The batch fields are null until the transaction is included in a sealed batch.
+type receipt struct {
+	TxHash         common.Hash    `json:"transactionHash"`
+	Status         hexutil.Uint64 `json:"status"`
+	L1BatchNumber  *hexutil.Big   `json:"l1BatchNumber"`
+	L1BatchTxIndex *hexutil.Big   `json:"l1BatchTxIndex"`
+	Logs           []rpcLog       `json:"logs"`
+	L2ToL1Logs     []l2ToL1Log    `json:"l2ToL1Logs"`
+}
+
+func getBridgehubContract(ctx context.Context, l2Client client.Client) (common.Address, error) {
+	var bridgehub common.Address
+	if err := l2Client.CallContext(ctx, &bridgehub, "zks_getBridgehubContract"); err != nil {
+		return common.Address{}, fmt.Errorf("zks_getBridgehubContract: %w", err)
+	}
+	return bridgehub, nil
+}
+
+func getBridgeContracts(ctx context.Context, l2Client client.Client) (bridgeContracts, error) {
+	var contracts bridgeContracts
+	if err := l2Client.CallContext(ctx, &contracts, "zks_getBridgeContracts"); err != nil {
+		return bridgeContracts{}, fmt.Errorf("zks_getBridgeContracts: %w", err)
+	}
+	return contracts, nil
+}
+
+// getReceipt returns the ZKsync receipt of the transaction, or nil if it is not found.
+func getReceipt(ctx context.Context, l2Client client.Client, txHash common.Hash) (*receipt, error) {
+	var r *receipt
+	if err := l2Client.CallContext(ctx, &r, "eth_getTransactionReceipt", txHash); err != nil {
+		return nil, fmt.Errorf("eth_getTransactionReceipt(%s): %w", txHash, err)
+	}
+	return r, nil
+}
+
+func getL1BatchDetails(ctx context.Context, l2Client client.Client, batchNumber uint64) (*l1BatchDetails, error) {
+	var details *l1BatchDetails
+	if err := l2Client.CallContext(ctx, &details, "zks_getL1BatchDetails", batchNumber); err != nil {
+		return nil, fmt.Errorf("zks_getL1BatchDetails(%d): %w", batchNumber, err)
+	}
+	return details, nil
+}
+
+func getL2ToL1LogProof(
+	ctx context.Context, l2Client client.Client, txHash common.Hash, logIndex int,
+) (*l2ToL1LogProof, error) {
+	var proof *l2ToL1LogProof
+	if err := l2Client.CallContext(ctx, &proof, "zks_getL2ToL1LogProof", txHash, logIndex); err != nil {
+		return nil, fmt.Errorf("zks_getL2ToL1LogProof(%s, %d): %w", txHash, logIndex, err)
+	}
+	return proof, nil
+}
+
+// PackL1ToL2SendBridgePayload encodes the bridge specific data of an L1 to L2 deposit.
+func PackL1ToL2SendBridgePayload(l2GasLimit, l2GasPerPubdataByteLimit *big.Int) ([]byte, error) {
+	return utils.ABIEncode(l1ToL2SendPayloadEncoding, l2GasLimit, l2GasPerPubdataByteLimit)
+}
+
+// UnpackL1ToL2SendBridgePayload decodes the bridge specific data of an L1 to L2 deposit.
+func UnpackL1ToL2SendBridgePayload(payload []byte) (l2GasLimit, l2GasPerPubdataByteLimit *big.Int, err error) {
+	decoded, err := utils.ABIDecode(l1ToL2SendPayloadEncoding, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(decoded) != 2 {
+		return nil, nil, fmt.Errorf("expected 2 elements, got %d", len(decoded))
+	}
+	l2GasLimit = *abi.ConvertType(decoded[0], new(*big.Int)).(**big.Int)
+	l2GasPerPubdataByteLimit = *abi.ConvertType(decoded[1], new(*big.Int)).(**big.Int)
+	return l2GasLimit, l2GasPerPubdataByteLimit, nil
+}
+
+// UnpackL2TxHash decodes the hash of the L2 transaction of an L1 to L2 deposit, which is returned by the L1 bridge
+// adapter when the deposit is requested.
+func UnpackL2TxHash(data []byte) (common.Hash, error) {
+	decoded, err := utils.ABIDecode(l2TxHashPayloadEncoding, data)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(decoded) != 1 {
+		return common.Hash{}, fmt.Errorf("expected 1 element, got %d", len(decoded))
+	}
+	return *abi.ConvertType(decoded[0], new([32]byte)).(*[32]byte), nil
+}
+
+// unpackL1MessageSentData decodes the message of an L1MessageSent event, the only non indexed field of the event.
+func unpackL1MessageSentData(data []byte) ([]byte, error) {
+	decoded, err := utils.ABIDecode(l1MessageSentDataEncoding, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) != 1 {
+		return nil, fmt.Errorf("expected 1 element, got %d", len(decoded))
+	}
+	return *abi.ConvertType(decoded[0], new([]byte)).(*[]byte), nil
+}
+
+// FinalizeWithdrawalPayload holds the parameters of the finalizeWithdrawal call of the L1 shared bridge.
+// L2TxHash is ignored by the L1 bridge adapter, it is the hash of the L2 withdrawal transaction and allows
+// matching the finalized withdrawals, whose payload is emitted by the L1 LiquidityManager, with the sent ones.
+type FinalizeWithdrawalPayload struct {
+	L2TxHash          common.Hash
+	L2BatchNumber     *big.Int
+	L2MessageIndex    *big.Int
+	L2TxNumberInBatch uint16
+	Message           []byte
+	MerkleProof       [][32]byte
+}
+
+// PackFinalizeWithdrawalPayload encodes the bridge specific data of an L2 to L1 withdrawal finalization.
+func PackFinalizeWithdrawalPayload(p FinalizeWithdrawalPayload) ([]byte, error) {
+	return utils.ABIEncode(finalizeWithdrawalPayloadEncoding,
+		[32]byte(p.L2TxHash), p.L2BatchNumber, p.L2MessageIndex, p.L2TxNumberInBatch, p.Message, p.MerkleProof)
+}
+
+// UnpackFinalizeWithdrawalPayload decodes the bridge specific data of an L2 to L1 withdrawal finalization.
+func UnpackFinalizeWithdrawalPayload(data []byte) (FinalizeWithdrawalPayload, error) {
+	decoded, err := utils.ABIDecode(finalizeWithdrawalPayloadEncoding, data)
+	if err != nil {
+		return FinalizeWithdrawalPayload{}, err
+	}
+	if len(decoded) != 6 {
+		return FinalizeWithdrawalPayload{}, fmt.Errorf("expected 6 elements, got %d", len(decoded))
+	}
+	return FinalizeWithdrawalPayload{
+		L2TxHash:          *abi.ConvertType(decoded[0], new([32]byte)).(*[32]byte),
+		L2BatchNumber:     *abi.ConvertType(decoded[1], new(*big.Int)).(**big.Int),
+		L2MessageIndex:    *abi.ConvertType(decoded[2], new(*big.Int)).(**big.Int),
+		L2TxNumberInBatch: *abi.ConvertType(decoded[3], new(uint16)).(*uint16),
+		Message:           *abi.ConvertType(decoded[4], new([]byte)).(*[]byte),
+		MerkleProof:       *abi.ConvertType(decoded[5], new([][32]byte)).(*[][32]byte),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package zksync
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+)
+
+func Test_L1ToL2SendBridgePayload_RoundTrip(t *testing.T) {
+	payload, err := PackL1ToL2SendBridgePayload(
+		big.NewInt(DefaultL2DepositGasLimit), big.NewInt(RequiredL2GasPricePerPubdata))
+	require.NoError(t, err)
+
+	l2GasLimit, gasPerPubdata, err := UnpackL1ToL2SendBridgePayload(payload)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(DefaultL2DepositGasLimit), l2GasLimit)
+	require.Equal(t, big.NewInt(RequiredL2GasPricePerPubdata), gasPerPubdata)
+
+	_, _, err = UnpackL1ToL2SendBridgePayload([]byte{0x1})
+	require.Error(t, err)
+}
+
+func Test_FinalizeWithdrawalPayload_RoundTrip(t *testing.T) {
+	payload := FinalizeWithdrawalPayload{
+		L2TxHash:          common.HexToHash("0xabcd"),
+		L2BatchNumber:     big.NewInt(491234),
+		L2MessageIndex:    big.NewInt(17),
+		L2TxNumberInBatch: 312,
+		Message:           common.FromHex("0x11a2ccc1aabbccdd"),
+		MerkleProof:       [][32]byte{common.HexToHash("0x01"), common.HexToHash("0x02")},
+	}
+	encoded, err := PackFinalizeWithdrawalPayload(payload)
+	require.NoError(t, err)
+
+	decoded, err := UnpackFinalizeWithdrawalPayload(encoded)
+	require.NoError(t, err)
+	require.Equal(t, payload, decoded)
+
+	_, err = UnpackFinalizeWithdrawalPayload([]byte{0x1})
+	require.Error(t, err)
+}
+
+func Test_UnpackL2TxHash(t *testing.T) {
+	txHash := common.HexToHash("0xdeadbeef")
+	data, err := utils.ABIEncode(l2TxHashPayloadEncoding, txHash)
+	require.NoError(t, err)
+
+	got, err := UnpackL2TxHash(data)
+	require.NoError(t, err)
+	require.Equal(t, txHash, got)
+
+	_, err = UnpackL2TxHash([]byte{})
+	require.Error(t, err)
+}
+
+func Test_l1BatchDetails_executed(t *testing.T) {
+	executeTxHash := common.HexToHash("0x01")
+	require.False(t, (*l1BatchDetails)(nil).executed())
+	require.False(t, (&l1BatchDetails{Status: "sealed"}).executed())
+	require.False(t, (&l1BatchDetails{ExecuteTxHash: &common.Hash{}}).executed())
+	require.True(t, (&l1BatchDetails{Status: "verified", ExecuteTxHash: &executeTxHash}).executed())
+}
@@ -0,0 +1,14 @@
+package zksync
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// ZKsync system contracts, they have the same address on every ZKsync chain:
+	// https://docs.zksync.io/zksync-protocol/contracts/system-contracts
+	L1MessengerAddress = common.HexToAddress("0x0000000000000000000000000000000000008008")
+)
+
+// The L1 Bridgehub and the shared bridges are not hardcoded, they are discovered from the ZKsync node with the
+// zks_getBridgehubContract and zks_getBridgeContracts RPC methods.
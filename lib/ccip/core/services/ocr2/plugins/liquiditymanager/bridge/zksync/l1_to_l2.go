@@ -0,0 +1,437 @@
+package zksync
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/liquiditymanager/generated/liquiditymanager"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	bridgecommon "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/bridge/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/models"
+)
+
+type l1ToL2Bridge struct {
+	localSelector      models.NetworkSelector
+	remoteSelector     models.NetworkSelector
+	l2ChainID          *big.Int
+	l1LiquidityManager liquiditymanager.LiquidityManagerInterface
+	l2LiquidityManager liquiditymanager.LiquidityManagerInterface
+	l1BridgeAdapter    common.Address
+	bridgehub          common.Address
+	l1Client           client.Client
+	l2Client           client.Client
+	l1LogPoller        logpoller.LogPoller
+	l2LogPoller        logpoller.LogPoller
+	l1FilterName       string
+	l2FilterName       string
+	l1Token, l2Token   common.Address
+	lggr               logger.Logger
+}
+
+// NewL1ToL2Bridge returns a bridge for deposits from Ethereum to ZKsync through the ZKsync shared bridge. The L1
+// bridge adapter requests the deposit from the Bridgehub and returns the hash of the resulting L2 transaction,
+// which is used to know when the deposit has been executed on L2.
+func NewL1ToL2Bridge(
+	ctx context.Context,
+	lggr logger.Logger,
+	localSelector,
+	remoteSelector models.NetworkSelector,
+	l1LiquidityManagerAddress,
+	l2LiquidityManagerAddress common.Address,
+	l1Client,
+	l2Client client.Client,
+	l1LogPoller,
+	l2LogPoller logpoller.LogPoller,
+) (*l1ToL2Bridge, error) {
+	localChain, ok := chainsel.ChainBySelector(uint64(localSelector))
+	if !ok {
+		return nil, fmt.Errorf("unknown chain selector for local chain: %d", localSelector)
+	}
+	remoteChain, ok := chainsel.ChainBySelector(uint64(remoteSelector))
+	if !ok {
+		return nil, fmt.Errorf("unknown chain selector for remote chain: %d", remoteSelector)
+	}
+
+	bridgehub, err := getBridgehubContract(ctx, l2Client)
+	if err != nil {
+		return nil, fmt.Errorf("get ZKsync Bridgehub address: %w", err)
+	}
+
+	l1LiquidityManager, err := liquiditymanager.NewLiquidityManager(l1LiquidityManagerAddress, l1Client)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate L1 liquidityManager at %s: %w", l1LiquidityManagerAddress, err)
+	}
+
+	xchainRebal, err := l1LiquidityManager.GetCrossChainRebalancer(nil, uint64(remoteSelector))
+	if err != nil {
+		return nil, fmt.Errorf("get cross chain liquidityManager for remote chain %s: %w", remoteChain.Name, err)
+	}
+
+	l1Token, err := l1LiquidityManager.ILocalToken(nil)
+	if err != nil {
+		return nil, fmt.Errorf("get local token from L1 LiquidityManager: %w", err)
+	}
+
+	l2LiquidityManager, err := liquiditymanager.NewLiquidityManager(l2LiquidityManagerAddress, l2Client)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate L2 liquidityManager at %s: %w", l2LiquidityManagerAddress, err)
+	}
+
+	l2Token, err := l2LiquidityManager.ILocalToken(nil)
+	if err != nil {
+		return nil, fmt.Errorf("get local token from L2 LiquidityManager: %w", err)
+	}
+
+	l1FilterName := bridgecommon.GetBridgeFilterName(
+		"ZKsyncL1ToL2Bridge",
+		"L1",
+		l1LiquidityManagerAddress,
+		localChain.Name,
+		remoteChain.Name,
+		"",
+	)
+	err = l1LogPoller.RegisterFilter(ctx, logpoller.Filter{
+		Addresses: []common.Address{l1LiquidityManagerAddress}, // emits LiquidityTransferred
+		Name:      l1FilterName,
+		EventSigs: []common.Hash{
+			bridgecommon.LiquidityTransferredTopic,
+		},
+		Retention: bridgecommon.DurationMonth,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to register L1 log filter: %w", err)
+	}
+
+	l2FilterName := bridgecommon.GetBridgeFilterName(
+		"ZKsyncL1ToL2Bridge",
+		"L2",
+		l2LiquidityManagerAddress,
+		localChain.Name,
+		remoteChain.Name,
+		"",
+	)
+	err = l2LogPoller.RegisterFilter(ctx, logpoller.Filter{
+		Addresses: []common.Address{l2LiquidityManagerAddress}, // emits LiquidityTransferred
+		Name:      l2FilterName,
+		EventSigs: []common.Hash{
+			bridgecommon.LiquidityTransferredTopic,
+		},
+		Retention: bridgecommon.DurationMonth,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to register L2 log filter: %w", err)
+	}
+
+	lggr = lggr.Named("ZKsyncL1ToL2Bridge").With(
+		"localSelector", localSelector,
+		"remoteSelector", remoteSelector,
+		"l1LiquidityManager", l1LiquidityManagerAddress.Hex(),
+		"l2LiquidityManager", l2LiquidityManagerAddress.Hex(),
+		"l1BridgeAdapter", xchainRebal.LocalBridge.Hex(),
+		"bridgehub", bridgehub.Hex(),
+	)
+	lggr.Infow("Initialized ZKsync L1 to L2 bridge")
+
+	return &l1ToL2Bridge{
+		localSelector:      localSelector,
+		remoteSelector:     remoteSelector,
+		l2ChainID:          new(big.Int).SetUint64(remoteChain.EvmChainID),
+		l1LiquidityManager: l1LiquidityManager,
+		l2LiquidityManager: l2LiquidityManager,
+		l1BridgeAdapter:    xchainRebal.LocalBridge,
+		bridgehub:          bridgehub,
+		l1Client:           l1Client,
+		l2Client:           l2Client,
+		l1LogPoller:        l1LogPoller,
+		l2LogPoller:        l2LogPoller,
+		l1FilterName:       l1FilterName,
+		l2FilterName:       l2FilterName,
+		l1Token:            l1Token,
+		l2Token:            l2Token,
+		lggr:               lggr,
+	}, nil
+}
+
+func (l *l1ToL2Bridge) GetTransfers(
+	ctx context.Context,
+	localToken,
+	remoteToken models.Address,
+) ([]models.PendingTransfer, error) {
+	lggr := l.lggr.With("localToken", localToken, "remoteToken", remoteToken)
+
+	if l.l1Token.Cmp(common.Address(localToken)) != 0 {
+		return nil, fmt.Errorf("local token mismatch: expected %s, got %s", l.l1Token, localToken)
+	}
+	if l.l2Token.Cmp(common.Address(remoteToken)) != 0 {
+		return nil, fmt.Errorf("remote token mismatch: expected %s, got %s", l.l2Token, remoteToken)
+	}
+
+	fromTs := time.Now().Add(-24 * time.Hour) // last day
+	sendLogs, receiveLogs, err := l.getLogs(ctx, fromTs)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedSent, parsedToLP, err := bridgecommon.ParseLiquidityTransferred(l.l1LiquidityManager.ParseLiquidityTransferred, sendLogs)
+	if err != nil {
+		return nil, fmt.Errorf("parse L1 -> L2 LiquidityTransferred sent logs: %w", err)
+	}
+
+	parsedReceived, _, err := bridgecommon.ParseLiquidityTransferred(l.l2LiquidityManager.ParseLiquidityTransferred, receiveLogs)
+	if err != nil {
+		return nil, fmt.Errorf("parse L1 -> L2 LiquidityTransferred received logs: %w", err)
+	}
+
+	notReady, ready, err := l.partitionTransfers(ctx, lggr, parsedSent, parsedReceived)
+	if err != nil {
+		return nil, fmt.Errorf("partition transfers: %w", err)
+	}
+
+	lggr.Infow("partitioned L1 -> L2 transfers",
+		"parsedSent", len(parsedSent),
+		"parsedReceived", len(parsedReceived),
+		"notReady", len(notReady),
+		"ready", len(ready),
+	)
+
+	return l.toPendingTransfers(localToken, remoteToken, notReady, ready, parsedToLP), nil
+}
+
+func (l *l1ToL2Bridge) getLogs(ctx context.Context, fromTs time.Time) (sendLogs, receiveLogs []logpoller.Log, err error) {
+	// LiquidityTransferred events emitted by the L1 LiquidityManager. Represents transfers that have been initiated
+	// from L1 to L2.
+	sendLogs, err = l.l1LogPoller.IndexedLogsCreatedAfter(
+		ctx,
+		bridgecommon.LiquidityTransferredTopic,
+		l.l1LiquidityManager.Address(),
+		bridgecommon.LiquidityTransferredToChainSelectorTopicIndex,
+		[]common.Hash{
+			bridgecommon.NetworkSelectorToHash(l.remoteSelector),
+		},
+		fromTs,
+		1,
+	)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, fmt.Errorf("get LiquidityTransferred events from L1 LiquidityManager: %w", err)
+	}
+
+	// LiquidityTransferred events emitted by the L2 LiquidityManager. Represents transfers that have been received on L2.
+	receiveLogs, err = l.l2LogPoller.IndexedLogsCreatedAfter(
+		ctx,
+		bridgecommon.LiquidityTransferredTopic,
+		l.l2LiquidityManager.Address(),
+		bridgecommon.LiquidityTransferredFromChainSelectorTopicIndex,
+		[]common.Hash{
+			bridgecommon.NetworkSelectorToHash(l.localSelector),
+		},
+		fromTs,
+		1,
+	)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, fmt.Errorf("get LiquidityTransferred events from L2 LiquidityManager: %w", err)
+	}
+
+	return sendLogs, receiveLogs, nil
+}
+
+/**
+ * partitionTransfers divides the sent transfers which have not been received by the L2 LiquidityManager into:
+ *   - notReady: the L2 transaction of the deposit is not executed yet
+ *   - ready:    the L2 transaction of the deposit is executed, the tokens can be received by the L2 LiquidityManager
+ *
+ * The L2 transaction hash of the deposit is piped through the events:
+ *   sent_LiquidityTransferred.bridgeReturnData == received_LiquidityTransferred.bridgeSpecificData
+ * Deposits whose L2 transaction failed are not pending, the tokens have to be claimed back on L1.
+ */
+func (l *l1ToL2Bridge) partitionTransfers(
+	ctx context.Context,
+	lggr logger.Logger,
+	sentLogs []*liquiditymanager.LiquidityManagerLiquidityTransferred,
+	receivedLogs []*liquiditymanager.LiquidityManagerLiquidityTransferred,
+) (notReady, ready []*liquiditymanager.LiquidityManagerLiquidityTransferred, err error) {
+	for _, sentLog := range sentLogs {
+		if sentLog.To != l.l2LiquidityManager.Address() {
+			continue
+		}
+		received := slices.ContainsFunc(receivedLogs, func(r *liquiditymanager.LiquidityManagerLiquidityTransferred) bool {
+			return bytes.Equal(r.BridgeSpecificData, sentLog.BridgeReturnData)
+		})
+		if received {
+			continue
+		}
+
+		l2TxHash, err := UnpackL2TxHash(sentLog.BridgeReturnData)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unpack L2 tx hash from L1 LiquidityTransferred log (%s): %w, data: %s",
+				sentLog.Raw.TxHash, err, hexutil.Encode(sentLog.BridgeReturnData))
+		}
+		r, err := getReceipt(ctx, l.l2Client, l2TxHash)
+		if err != nil {
+			return nil, nil, fmt.Errorf("get receipt of deposit L2 tx: %w", err)
+		}
+		switch {
+		case r == nil:
+			notReady = append(notReady, sentLog)
+		case uint64(r.Status) == 1:
+			ready = append(ready, sentLog)
+		default:
+			lggr.Errorw("deposit failed on L2, tokens must be claimed back on L1",
+				"l1TxHash", sentLog.Raw.TxHash, "l2TxHash", l2TxHash)
+		}
+	}
+	return notReady, ready, nil
+}
+
+func (l *l1ToL2Bridge) toPendingTransfers(
+	localToken,
+	remoteToken models.Address,
+	notReady,
+	ready []*liquiditymanager.LiquidityManagerLiquidityTransferred,
+	parsedToLP map[bridgecommon.LogKey]logpoller.Log,
+) []models.PendingTransfer {
+	var transfers []models.PendingTransfer
+	toPendingTransfer := func(
+		transfer *liquiditymanager.LiquidityManagerLiquidityTransferred, stage int, status models.TransferStatus,
+	) models.PendingTransfer {
+		return models.PendingTransfer{
+			Transfer: models.Transfer{
+				From:               l.localSelector,
+				To:                 l.remoteSelector,
+				Sender:             models.Address(l.l1LiquidityManager.Address()),
+				Receiver:           models.Address(l.l2LiquidityManager.Address()),
+				LocalTokenAddress:  localToken,
+				RemoteTokenAddress: remoteToken,
+				Amount:             ubig.New(transfer.Amount),
+				Date: parsedToLP[bridgecommon.LogKey{
+					TxHash:   transfer.Raw.TxHash,
+					LogIndex: int64(transfer.Raw.Index),
+				}].BlockTimestamp,
+				BridgeData:      transfer.BridgeReturnData, // L2 tx hash of the deposit
+				Stage:           stage,
+				NativeBridgeFee: ubig.NewI(0),
+			},
+			Status: status,
+			ID:     fmt.Sprintf("%s-%d", transfer.Raw.TxHash.Hex(), transfer.Raw.Index),
+		}
+	}
+	for _, transfer := range notReady {
+		transfers = append(transfers,
+			toPendingTransfer(transfer, bridgecommon.StageRebalanceConfirmed, models.TransferStatusNotReady))
+	}
+	for _, transfer := range ready {
+		// ready == finalized for L1 -> L2 transfers, deposits are executed on L2 by the ZKsync operator
+		transfers = append(transfers,
+			toPendingTransfer(transfer, bridgecommon.StageFinalizeReady, models.TransferStatusReady))
+	}
+	return transfers
+}
+
+// GetBridgePayloadAndFee returns the L2 gas parameters of the deposit and the base cost of the L2 transaction
+// charged by the Bridgehub, which must be sent along with the deposit.
+func (l *l1ToL2Bridge) GetBridgePayloadAndFee(
+	ctx context.Context,
+	_ models.Transfer,
+) ([]byte, *big.Int, error) {
+	l2GasLimit := big.NewInt(DefaultL2DepositGasLimit)
+	l2GasPerPubdataByteLimit := big.NewInt(RequiredL2GasPricePerPubdata)
+
+	gasPrice, err := l.l1Client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get suggested gas price: %w", err)
+	}
+	gasPrice = new(big.Int).Mul(gasPrice, l1GasPriceMultiplier)
+
+	baseCost, err := l.l2TransactionBaseCost(ctx, gasPrice, l2GasLimit, l2GasPerPubdataByteLimit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get L2 transaction base cost: %w", err)
+	}
+
+	payload, err := PackL1ToL2SendBridgePayload(l2GasLimit, l2GasPerPubdataByteLimit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("pack L1 -> L2 bridge payload: %w", err)
+	}
+	return payload, baseCost, nil
+}
+
+func (l *l1ToL2Bridge) l2TransactionBaseCost(
+	ctx context.Context,
+	gasPrice, l2GasLimit, l2GasPerPubdataByteLimit *big.Int,
+) (*big.Int, error) {
+	calldata, err := bridgehubABI.Pack(
+		L2TransactionBaseCostFunction, l.l2ChainID, gasPrice, l2GasLimit, l2GasPerPubdataByteLimit)
+	if err != nil {
+		return nil, fmt.Errorf("pack %s call: %w", L2TransactionBaseCostFunction, err)
+	}
+
+	res, err := l.l1Client.CallContract(ctx, ethereum.CallMsg{
+		To:   &l.bridgehub,
+		Data: calldata,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("call %s on Bridgehub %s: %w", L2TransactionBaseCostFunction, l.bridgehub, err)
+	}
+
+	out, err := bridgehubABI.Unpack(L2TransactionBaseCostFunction, res)
+	if err != nil {
+		return nil, fmt.Errorf("unpack %s result: %w", L2TransactionBaseCostFunction, err)
+	}
+	if len(out) != 1 {
+		return nil, fmt.Errorf("expected 1 output from %s, got %d", L2TransactionBaseCostFunction, len(out))
+	}
+	baseCost, ok := out[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected %s output type %T", L2TransactionBaseCostFunction, out[0])
+	}
+	return baseCost, nil
+}
+
+// QuorumizedBridgePayload returns the f-th highest L2 gas parameters of the payloads.
+func (l *l1ToL2Bridge) QuorumizedBridgePayload(payloads [][]byte, f int) ([]byte, error) {
+	if len(payloads) <= f {
+		return nil, fmt.Errorf("not enough payloads to quorumize, need at least f+1: len(payloads) = %d, f = %d", len(payloads), f)
+	}
+	var (
+		gasLimits         []*big.Int
+		gasPerPubdataList []*big.Int
+	)
+	for _, payload := range payloads {
+		gasLimit, gasPerPubdata, err := UnpackL1ToL2SendBridgePayload(payload)
+		if err != nil {
+			return nil, fmt.Errorf("decode bridge payload: %w", err)
+		}
+		gasLimits = append(gasLimits, gasLimit)
+		gasPerPubdataList = append(gasPerPubdataList, gasPerPubdata)
+	}
+	slices.SortFunc(gasLimits, func(i, j *big.Int) int {
+		return i.Cmp(j)
+	})
+	slices.SortFunc(gasPerPubdataList, func(i, j *big.Int) int {
+		return i.Cmp(j)
+	})
+	return PackL1ToL2SendBridgePayload(
+		gasLimits[len(gasLimits)-f-1],
+		gasPerPubdataList[len(gasPerPubdataList)-f-1],
+	)
+}
+
+func (l *l1ToL2Bridge) Close(ctx context.Context) error {
+	return multierr.Combine(
+		l.l1LogPoller.UnregisterFilter(ctx, l.l1FilterName),
+		l.l2LogPoller.UnregisterFilter(ctx, l.l2FilterName),
+	)
+}
@@ -0,0 +1,207 @@
+package zksync
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclientmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/models"
+)
+
+func mustPackSendPayload(t *testing.T, l2GasLimit, gasPerPubdata int64) []byte {
+	payload, err := PackL1ToL2SendBridgePayload(big.NewInt(l2GasLimit), big.NewInt(gasPerPubdata))
+	require.NoError(t, err)
+	return payload
+}
+
+func Test_l1ToL2Bridge_QuorumizedBridgePayload(t *testing.T) {
+	type args struct {
+		payloads [][]byte
+		f        int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []byte
+		wantErr bool
+	}{
+		{
+			"not enough payloads",
+			args{
+				[][]byte{},
+				1,
+			},
+			nil,
+			true,
+		},
+		{
+			"invalid payload",
+			args{
+				[][]byte{{0x1}, {0x2}},
+				1,
+			},
+			nil,
+			true,
+		},
+		{
+			"f-th highest of each field",
+			args{
+				[][]byte{
+					mustPackSendPayload(t, 900_000, 800),
+					mustPackSendPayload(t, 1_200_000, 700),
+					mustPackSendPayload(t, 1_000_000, 900),
+					mustPackSendPayload(t, 5_000_000, 800),
+				},
+				1,
+			},
+			mustPackSendPayload(t, 1_200_000, 800),
+			false,
+		},
+		{
+			"f = 0 picks the highest",
+			args{
+				[][]byte{
+					mustPackSendPayload(t, 900_000, 800),
+					mustPackSendPayload(t, 1_200_000, 700),
+				},
+				0,
+			},
+			mustPackSendPayload(t, 1_200_000, 800),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &l1ToL2Bridge{}
+			got, err := l.QuorumizedBridgePayload(tt.args.payloads, tt.args.f)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_l1ToL2Bridge_GetBridgePayloadAndFee(t *testing.T) {
+	bridgehub := common.HexToAddress("0x303a465B659cBB0ab36eE643eA362c509EEb5213")
+	l2ChainID := big.NewInt(324)
+	gasPrice := assets.GWei(10).ToInt()
+	baseCost := big.NewInt(123_456_789)
+
+	expectedCalldata, err := bridgehubABI.Pack(L2TransactionBaseCostFunction,
+		l2ChainID,
+		new(big.Int).Mul(gasPrice, l1GasPriceMultiplier),
+		big.NewInt(DefaultL2DepositGasLimit),
+		big.NewInt(RequiredL2GasPricePerPubdata),
+	)
+	require.NoError(t, err)
+	encodedBaseCost, err := bridgehubABI.Methods[L2TransactionBaseCostFunction].Outputs.Pack(baseCost)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		before     func(*testing.T, *evmclientmocks.Client)
+		wantFee    *big.Int
+		wantErr    bool
+		assertions func(*testing.T, []byte)
+	}{
+		{
+			"happy path",
+			func(t *testing.T, c *evmclientmocks.Client) {
+				c.On("SuggestGasPrice", mock.Anything).Return(gasPrice, nil)
+				c.On("CallContract", mock.Anything, ethereum.CallMsg{To: &bridgehub, Data: expectedCalldata}, (*big.Int)(nil)).
+					Return(encodedBaseCost, nil)
+			},
+			baseCost,
+			false,
+			func(t *testing.T, payload []byte) {
+				l2GasLimit, gasPerPubdata, err := UnpackL1ToL2SendBridgePayload(payload)
+				require.NoError(t, err)
+				require.Equal(t, big.NewInt(DefaultL2DepositGasLimit), l2GasLimit)
+				require.Equal(t, big.NewInt(RequiredL2GasPricePerPubdata), gasPerPubdata)
+			},
+		},
+		{
+			"gas price error",
+			func(t *testing.T, c *evmclientmocks.Client) {
+				c.On("SuggestGasPrice", mock.Anything).Return(nil, errors.New("error"))
+			},
+			nil,
+			true,
+			nil,
+		},
+		{
+			"base cost call error",
+			func(t *testing.T, c *evmclientmocks.Client) {
+				c.On("SuggestGasPrice", mock.Anything).Return(gasPrice, nil)
+				c.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+			},
+			nil,
+			true,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l1Client := evmclientmocks.NewClient(t)
+			tt.before(t, l1Client)
+			l := &l1ToL2Bridge{
+				l2ChainID: l2ChainID,
+				bridgehub: bridgehub,
+				l1Client:  l1Client,
+			}
+			payload, fee, err := l.GetBridgePayloadAndFee(testutils.Context(t), models.Transfer{})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantFee, fee)
+			tt.assertions(t, payload)
+		})
+	}
+}
+
+func Test_l1ToL2Bridge_Close(t *testing.T) {
+	tests := []struct {
+		name    string
+		l1Err   error
+		l2Err   error
+		wantErr bool
+	}{
+		{"happy path", nil, nil, false},
+		{"l1 unregister error", errors.New("unregister error"), nil, true},
+		{"l2 unregister error", nil, errors.New("unregister error"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l1LogPoller := lpmocks.NewLogPoller(t)
+			l2LogPoller := lpmocks.NewLogPoller(t)
+			l1LogPoller.On("UnregisterFilter", mock.Anything, "l1FilterName").Return(tt.l1Err)
+			l2LogPoller.On("UnregisterFilter", mock.Anything, "l2FilterName").Return(tt.l2Err)
+			l := &l1ToL2Bridge{
+				l1LogPoller:  l1LogPoller,
+				l2LogPoller:  l2LogPoller,
+				l1FilterName: "l1FilterName",
+				l2FilterName: "l2FilterName",
+			}
+			err := l.Close(testutils.Context(t))
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,451 @@
+package zksync
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/liquiditymanager/generated/liquiditymanager"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	bridgecommon "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/bridge/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/models"
+)
+
+type l2ToL1Bridge struct {
+	localSelector      models.NetworkSelector
+	remoteSelector     models.NetworkSelector
+	l1LiquidityManager liquiditymanager.LiquidityManagerInterface
+	l2LiquidityManager liquiditymanager.LiquidityManagerInterface
+	l2SharedBridge     common.Address
+	l1Client           client.Client
+	l2Client           client.Client
+	l1LogPoller        logpoller.LogPoller
+	l2LogPoller        logpoller.LogPoller
+	l1FilterName       string
+	l2FilterName       string
+	l1Token, l2Token   common.Address
+	lggr               logger.Logger
+}
+
+// NewL2ToL1Bridge returns a bridge for withdrawals from ZKsync to Ethereum. A withdrawal can be finalized on L1
+// once the L1 batch containing it is executed, the finalization proves the withdrawal message with the merkle proof
+// returned by the ZKsync node.
+func NewL2ToL1Bridge(
+	ctx context.Context,
+	lggr logger.Logger,
+	localSelector,
+	remoteSelector models.NetworkSelector,
+	l1LiquidityManagerAddress,
+	l2LiquidityManagerAddress common.Address,
+	l1Client,
+	l2Client client.Client,
+	l1LogPoller,
+	l2LogPoller logpoller.LogPoller,
+) (*l2ToL1Bridge, error) {
+	localChain, ok := chainsel.ChainBySelector(uint64(localSelector))
+	if !ok {
+		return nil, fmt.Errorf("unknown chain selector for local chain: %d", localSelector)
+	}
+	remoteChain, ok := chainsel.ChainBySelector(uint64(remoteSelector))
+	if !ok {
+		return nil, fmt.Errorf("unknown chain selector for remote chain: %d", remoteSelector)
+	}
+
+	contracts, err := getBridgeContracts(ctx, l2Client)
+	if err != nil {
+		return nil, fmt.Errorf("get ZKsync shared bridge addresses: %w", err)
+	}
+
+	l2FilterName := bridgecommon.GetBridgeFilterName(
+		"ZKsyncL2ToL1Bridge",
+		"L2",
+		l2LiquidityManagerAddress,
+		localChain.Name,
+		remoteChain.Name,
+		"",
+	)
+	err = l2LogPoller.RegisterFilter(
+		ctx,
+		logpoller.Filter{
+			Name: l2FilterName,
+			EventSigs: []common.Hash{
+				bridgecommon.LiquidityTransferredTopic,
+			},
+			Addresses: []common.Address{l2LiquidityManagerAddress},
+			Retention: bridgecommon.DurationMonth,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("register L2 LM filter for ZKsync L2 to L1 bridge: %w", err)
+	}
+
+	l1FilterName := bridgecommon.GetBridgeFilterName(
+		"ZKsyncL2ToL1Bridge",
+		"L1",
+		l1LiquidityManagerAddress,
+		localChain.Name,
+		remoteChain.Name,
+		"",
+	)
+	err = l1LogPoller.RegisterFilter(
+		ctx,
+		logpoller.Filter{
+			Name: l1FilterName,
+			EventSigs: []common.Hash{
+				bridgecommon.LiquidityTransferredTopic,
+			},
+			Addresses: []common.Address{l1LiquidityManagerAddress},
+			Retention: bridgecommon.DurationMonth,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("register L1 LM filter for ZKsync L2 to L1 bridge: %w", err)
+	}
+
+	l1LiquidityManager, err := liquiditymanager.NewLiquidityManager(l1LiquidityManagerAddress, l1Client)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate L1 LiquidityManager: %w", err)
+	}
+
+	l2LiquidityManager, err := liquiditymanager.NewLiquidityManager(l2LiquidityManagerAddress, l2Client)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate L2 LiquidityManager: %w", err)
+	}
+
+	l2Token, err := l2LiquidityManager.ILocalToken(nil)
+	if err != nil {
+		return nil, fmt.Errorf("get L2 local token address: %w", err)
+	}
+	l1Token, err := l1LiquidityManager.ILocalToken(nil)
+	if err != nil {
+		return nil, fmt.Errorf("get L1 local token address: %w", err)
+	}
+
+	lggr = lggr.Named("ZKsyncL2ToL1Bridge").With(
+		"localSelector", localSelector,
+		"remoteSelector", remoteSelector,
+		"l1LiquidityManager", l1LiquidityManagerAddress.Hex(),
+		"l2LiquidityManager", l2LiquidityManagerAddress.Hex(),
+		"l2SharedBridge", contracts.L2SharedDefaultBridge.Hex(),
+		"l1Token", l1Token.Hex(),
+		"l2Token", l2Token.Hex(),
+	)
+	lggr.Infow("Initialized ZKsync L2 to L1 bridge")
+
+	return &l2ToL1Bridge{
+		localSelector:      localSelector,
+		remoteSelector:     remoteSelector,
+		l1LiquidityManager: l1LiquidityManager,
+		l2LiquidityManager: l2LiquidityManager,
+		l2SharedBridge:     contracts.L2SharedDefaultBridge,
+		l1Client:           l1Client,
+		l2Client:           l2Client,
+		l1LogPoller:        l1LogPoller,
+		l2LogPoller:        l2LogPoller,
+		l1FilterName:       l1FilterName,
+		l2FilterName:       l2FilterName,
+		l1Token:            l1Token,
+		l2Token:            l2Token,
+		lggr:               lggr,
+	}, nil
+}
+
+func (l *l2ToL1Bridge) GetTransfers(
+	ctx context.Context,
+	localToken,
+	remoteToken models.Address,
+) ([]models.PendingTransfer, error) {
+	lggr := l.lggr.With("l2Token", localToken, "l1Token", remoteToken)
+	if l.l2Token.Cmp(common.Address(localToken)) != 0 {
+		return nil, fmt.Errorf("local token mismatch: expected %s, got %s", l.l2Token, localToken)
+	}
+	if l.l1Token.Cmp(common.Address(remoteToken)) != 0 {
+		return nil, fmt.Errorf("remote token mismatch: expected %s, got %s", l.l1Token, remoteToken)
+	}
+
+	sendLogs, receivedLogs, err := l.getLogs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get logs: %w", err)
+	}
+
+	parsedSent, parsedToLP, err := bridgecommon.ParseLiquidityTransferred(l.l2LiquidityManager.ParseLiquidityTransferred, sendLogs)
+	if err != nil {
+		return nil, fmt.Errorf("parse L2 -> L1 transfer sent logs: %w", err)
+	}
+
+	parsedReceived, _, err := bridgecommon.ParseLiquidityTransferred(l.l1LiquidityManager.ParseLiquidityTransferred, receivedLogs)
+	if err != nil {
+		return nil, fmt.Errorf("parse L2 -> L1 transfer received logs: %w", err)
+	}
+
+	unfinalized := l.filterUnfinalizedWithdrawals(lggr, parsedSent, parsedReceived)
+	transfers, notReady, failed := l.partitionWithdrawals(ctx, lggr, localToken, remoteToken, unfinalized, parsedToLP)
+
+	lggr.Infow("partitioned L2 -> L1 transfers",
+		"parsedSent", len(parsedSent),
+		"parsedReceived", len(parsedReceived),
+		"unfinalized", len(unfinalized),
+		"notReady", notReady,
+		"failed", failed,
+		"ready", len(transfers)-notReady,
+	)
+
+	return transfers, nil
+}
+
+// filterUnfinalizedWithdrawals returns the withdrawals sent to the L1 LiquidityManager which have not been
+// finalized on L1 yet. They are filtered out before building the finalization payloads, which takes a few RPC
+// calls per withdrawal. The L1 LiquidityManager emits the finalization payload as bridgeSpecificData when a
+// withdrawal is finalized, the payload carries the hash of the L2 withdrawal transaction.
+func (l *l2ToL1Bridge) filterUnfinalizedWithdrawals(
+	lggr logger.Logger,
+	sentLogs,
+	receivedLogs []*liquiditymanager.LiquidityManagerLiquidityTransferred,
+) []*liquiditymanager.LiquidityManagerLiquidityTransferred {
+	finalized := make(map[common.Hash]struct{}, len(receivedLogs))
+	for _, received := range receivedLogs {
+		payload, err := UnpackFinalizeWithdrawalPayload(received.BridgeSpecificData)
+		if err != nil {
+			lggr.Warnw("skipping received log with undecodable bridgeSpecificData",
+				"txHash", received.Raw.TxHash, "err", err)
+			continue
+		}
+		finalized[payload.L2TxHash] = struct{}{}
+	}
+
+	var unfinalized []*liquiditymanager.LiquidityManagerLiquidityTransferred
+	for _, sent := range sentLogs {
+		if sent.To != l.l1LiquidityManager.Address() {
+			lggr.Warnw("skipping sent log with mismatched 'To' address", "sentLog", sent)
+			continue
+		}
+		if _, ok := finalized[sent.Raw.TxHash]; ok {
+			continue
+		}
+		unfinalized = append(unfinalized, sent)
+	}
+	return unfinalized
+}
+
+// partitionWithdrawals builds the pending transfers of the unfinalized withdrawals, they are ready once their
+// finalization payload is available. Withdrawals whose payload can't be built are skipped, so that they don't
+// block the others, and retried in the next round.
+func (l *l2ToL1Bridge) partitionWithdrawals(
+	ctx context.Context,
+	lggr logger.Logger,
+	localToken,
+	remoteToken models.Address,
+	unfinalized []*liquiditymanager.LiquidityManagerLiquidityTransferred,
+	parsedToLP map[bridgecommon.LogKey]logpoller.Log,
+) (transfers []models.PendingTransfer, notReady, failed int) {
+	for _, sent := range unfinalized {
+		payload, err := l.finalizeWithdrawalPayload(ctx, lggr, sent.Raw.TxHash)
+		if err != nil {
+			lggr.Errorw("failed to build finalize withdrawal payload, skipping transfer",
+				"txHash", sent.Raw.TxHash, "err", err)
+			failed++
+			continue
+		}
+		if payload == nil {
+			notReady++
+			transfers = append(transfers, l.toPendingTransfer(
+				localToken, remoteToken, sent, parsedToLP, []byte{},
+				bridgecommon.StageRebalanceConfirmed, models.TransferStatusNotReady))
+			continue
+		}
+		encoded, err := PackFinalizeWithdrawalPayload(*payload)
+		if err != nil {
+			lggr.Errorw("failed to pack finalize withdrawal payload, skipping transfer",
+				"txHash", sent.Raw.TxHash, "err", err)
+			failed++
+			continue
+		}
+		transfers = append(transfers, l.toPendingTransfer(
+			localToken, remoteToken, sent, parsedToLP, encoded,
+			bridgecommon.StageFinalizeReady, models.TransferStatusReady))
+	}
+	return transfers, notReady, failed
+}
+
+func (l *l2ToL1Bridge) getLogs(ctx context.Context) (sendLogs, receivedLogs []logpoller.Log, err error) {
+	// Get all L2 -> L1 transfers that have been sent from the L2 LM in the past 14 days
+	sendLogs, err = l.l2LogPoller.IndexedLogsCreatedAfter(
+		ctx,
+		bridgecommon.LiquidityTransferredTopic,
+		l.l2LiquidityManager.Address(),
+		bridgecommon.LiquidityTransferredToChainSelectorTopicIndex,
+		[]common.Hash{
+			bridgecommon.NetworkSelectorToHash(l.remoteSelector),
+		},
+		time.Now().Add(-bridgecommon.DurationMonth/2),
+		evmtypes.Finalized,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get L2 -> L1 transfers from log poller on L2: %w", err)
+	}
+
+	// Get all L2 -> L1 transfers that have been finalized on L1 in the past 14 days
+	receivedLogs, err = l.l1LogPoller.IndexedLogsCreatedAfter(
+		ctx,
+		bridgecommon.LiquidityTransferredTopic,
+		l.l1LiquidityManager.Address(),
+		bridgecommon.LiquidityTransferredFromChainSelectorTopicIndex,
+		[]common.Hash{
+			bridgecommon.NetworkSelectorToHash(l.localSelector),
+		},
+		time.Now().Add(-bridgecommon.DurationMonth/2),
+		evmtypes.Finalized,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get L2 -> L1 transfers from log poller on L1: %w", err)
+	}
+
+	return sendLogs, receivedLogs, nil
+}
+
+// finalizeWithdrawalPayload returns the parameters to finalize the withdrawal sent in the L2 transaction, or nil
+// if the L1 batch containing the transaction is not executed on L1 yet.
+func (l *l2ToL1Bridge) finalizeWithdrawalPayload(
+	ctx context.Context,
+	lggr logger.Logger,
+	txHash common.Hash,
+) (*FinalizeWithdrawalPayload, error) {
+	r, err := getReceipt(ctx, l.l2Client, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil || r.L1BatchNumber == nil || r.L1BatchTxIndex == nil {
+		lggr.Debugw("withdrawal not included in a sealed L1 batch yet", "txHash", txHash)
+		return nil, nil
+	}
+
+	batchNumber := r.L1BatchNumber.ToInt()
+	details, err := getL1BatchDetails(ctx, l.l2Client, batchNumber.Uint64())
+	if err != nil {
+		return nil, err
+	}
+	if !details.executed() {
+		lggr.Debugw("L1 batch of withdrawal not executed yet", "txHash", txHash, "l1BatchNumber", batchNumber)
+		return nil, nil
+	}
+
+	message, err := l.withdrawalMessage(r)
+	if err != nil {
+		return nil, err
+	}
+	logIndex, err := l2ToL1LogIndex(r, message)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := getL2ToL1LogProof(ctx, l.l2Client, txHash, logIndex)
+	if err != nil {
+		return nil, err
+	}
+	if proof == nil {
+		return nil, fmt.Errorf("no L2 to L1 log proof for tx %s, log index %d", txHash, logIndex)
+	}
+
+	merkleProof := make([][32]byte, len(proof.Proof))
+	for i, p := range proof.Proof {
+		merkleProof[i] = p
+	}
+	return &FinalizeWithdrawalPayload{
+		L2TxHash:          txHash,
+		L2BatchNumber:     batchNumber,
+		L2MessageIndex:    new(big.Int).SetUint64(proof.ID),
+		L2TxNumberInBatch: uint16(r.L1BatchTxIndex.ToInt().Uint64()),
+		Message:           message,
+		MerkleProof:       merkleProof,
+	}, nil
+}
+
+// withdrawalMessage returns the message sent to L1 by the L2 shared bridge, it is emitted by the L1Messenger
+// system contract in the L1MessageSent(address indexed sender, bytes32 indexed hash, bytes message) event.
+func (l *l2ToL1Bridge) withdrawalMessage(r *receipt) ([]byte, error) {
+	sender := common.BytesToHash(l.l2SharedBridge.Bytes())
+	for _, lg := range r.Logs {
+		if lg.Address != L1MessengerAddress || len(lg.Topics) != 3 ||
+			lg.Topics[0] != L1MessageSentTopic || lg.Topics[1] != sender {
+			continue
+		}
+		message, err := unpackL1MessageSentData(lg.Data)
+		if err != nil {
+			return nil, fmt.Errorf("decode L1MessageSent data: %w", err)
+		}
+		return message, nil
+	}
+	return nil, fmt.Errorf("no L1MessageSent log from the L2 shared bridge %s in tx %s", l.l2SharedBridge, r.TxHash)
+}
+
+// l2ToL1LogIndex returns the index of the L2 to L1 log of the message among the L2 to L1 logs of the transaction.
+func l2ToL1LogIndex(r *receipt, message []byte) (int, error) {
+	hash := crypto.Keccak256Hash(message)
+	for i, lg := range r.L2ToL1Logs {
+		if lg.Value == hash {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("no L2 to L1 log of the withdrawal message in tx %s", r.TxHash)
+}
+
+func (l *l2ToL1Bridge) toPendingTransfer(
+	localToken, remoteToken models.Address,
+	transfer *liquiditymanager.LiquidityManagerLiquidityTransferred,
+	parsedToLP map[bridgecommon.LogKey]logpoller.Log,
+	bridgeData []byte,
+	stage int,
+	status models.TransferStatus,
+) models.PendingTransfer {
+	return models.PendingTransfer{
+		Transfer: models.Transfer{
+			From:               l.localSelector,
+			To:                 l.remoteSelector,
+			Sender:             models.Address(l.l2LiquidityManager.Address()),
+			Receiver:           models.Address(l.l1LiquidityManager.Address()),
+			LocalTokenAddress:  localToken,
+			RemoteTokenAddress: remoteToken,
+			Amount:             ubig.New(transfer.Amount),
+			Date: parsedToLP[bridgecommon.LogKey{
+				TxHash:   transfer.Raw.TxHash,
+				LogIndex: int64(transfer.Raw.Index),
+			}].BlockTimestamp,
+			BridgeData:      bridgeData,
+			Stage:           stage,
+			NativeBridgeFee: ubig.NewI(0),
+		},
+		Status: status,
+		ID:     fmt.Sprintf("%s-%d", transfer.Raw.TxHash.Hex(), transfer.Raw.Index),
+	}
+}
+
+// GetBridgePayloadAndFee implements bridge.Bridge.
+func (l *l2ToL1Bridge) GetBridgePayloadAndFee(
+	_ context.Context,
+	_ models.Transfer,
+) ([]byte, *big.Int, error) {
+	// ZKsync L2 to L1 transfers require no bridge specific payload.
+	return []byte{}, big.NewInt(0), nil
+}
+
+// QuorumizedBridgePayload implements bridge.Bridge.
+func (l *l2ToL1Bridge) QuorumizedBridgePayload(_ [][]byte, _ int) ([]byte, error) {
+	// ZKsync L2 to L1 transfers require no bridge specific payload.
+	return []byte{}, nil
+}
+
+// Close implements bridge.Bridge.
+func (l *l2ToL1Bridge) Close(ctx context.Context) error {
+	return multierr.Combine(
+		l.l1LogPoller.UnregisterFilter(ctx, l.l1FilterName),
+		l.l2LogPoller.UnregisterFilter(ctx, l.l2FilterName),
+	)
+}
@@ -0,0 +1,269 @@
+package zksync
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclientmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/liquiditymanager/generated/liquiditymanager"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/models"
+)
+
+func Test_L2ToL1Bridge_finalizeWithdrawalPayload(t *testing.T) {
+	l2SharedBridge := common.HexToAddress("0x11f943b2c77b743AB90f4A0Ae7d5A4e7FCA3E102")
+	txHash := common.HexToHash("0xabcd")
+	message := common.FromHex("0x11a2ccc1" + "000000000000000000000000000000000000000000000000000000000000000a")
+	messageData, err := utils.ABIEncode(l1MessageSentDataEncoding, message)
+	require.NoError(t, err)
+	executeTxHash := common.HexToHash("0x01")
+
+	includedReceipt := func() *receipt {
+		return &receipt{
+			TxHash:         txHash,
+			Status:         1,
+			L1BatchNumber:  (*hexutil.Big)(big.NewInt(500)),
+			L1BatchTxIndex: (*hexutil.Big)(big.NewInt(42)),
+			Logs: []rpcLog{
+				{Address: common.HexToAddress("0x800a"), Topics: []common.Hash{crypto.Keccak256Hash([]byte("Transfer"))}},
+				{
+					Address: L1MessengerAddress,
+					Topics: []common.Hash{
+						L1MessageSentTopic,
+						common.BytesToHash(l2SharedBridge.Bytes()),
+						crypto.Keccak256Hash(message),
+					},
+					Data: messageData,
+				},
+			},
+			L2ToL1Logs: []l2ToL1Log{
+				{Sender: common.HexToAddress("0x800a"), Value: common.HexToHash("0x02")},
+				{Sender: L1MessengerAddress, Value: crypto.Keccak256Hash(message)},
+			},
+		}
+	}
+	onReceipt := func(c *evmclientmocks.Client, r *receipt) {
+		c.On("CallContext", mock.Anything, mock.Anything, "eth_getTransactionReceipt", txHash).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(**receipt) = r
+			}).Return(nil)
+	}
+	onBatchDetails := func(c *evmclientmocks.Client, d *l1BatchDetails) {
+		c.On("CallContext", mock.Anything, mock.Anything, "zks_getL1BatchDetails", uint64(500)).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(**l1BatchDetails) = d
+			}).Return(nil)
+	}
+
+	tests := []struct {
+		name    string
+		before  func(*testing.T, *evmclientmocks.Client)
+		want    *FinalizeWithdrawalPayload
+		wantErr bool
+	}{
+		{
+			"receipt not found",
+			func(t *testing.T, c *evmclientmocks.Client) {
+				onReceipt(c, nil)
+			},
+			nil,
+			false,
+		},
+		{
+			"not included in a batch",
+			func(t *testing.T, c *evmclientmocks.Client) {
+				r := includedReceipt()
+				r.L1BatchNumber, r.L1BatchTxIndex = nil, nil
+				onReceipt(c, r)
+			},
+			nil,
+			false,
+		},
+		{
+			"batch not executed",
+			func(t *testing.T, c *evmclientmocks.Client) {
+				onReceipt(c, includedReceipt())
+				onBatchDetails(c, &l1BatchDetails{Number: 500, Status: "sealed"})
+			},
+			nil,
+			false,
+		},
+		{
+			"happy path",
+			func(t *testing.T, c *evmclientmocks.Client) {
+				onReceipt(c, includedReceipt())
+				onBatchDetails(c, &l1BatchDetails{Number: 500, Status: "verified", ExecuteTxHash: &executeTxHash})
+				c.On("CallContext", mock.Anything, mock.Anything, "zks_getL2ToL1LogProof", txHash, 1).
+					Run(func(args mock.Arguments) {
+						*args.Get(1).(**l2ToL1LogProof) = &l2ToL1LogProof{
+							Proof: []common.Hash{common.HexToHash("0x03"), common.HexToHash("0x04")},
+							ID:    7,
+						}
+					}).Return(nil)
+			},
+			&FinalizeWithdrawalPayload{
+				L2TxHash:          txHash,
+				L2BatchNumber:     big.NewInt(500),
+				L2MessageIndex:    big.NewInt(7),
+				L2TxNumberInBatch: 42,
+				Message:           message,
+				MerkleProof:       [][32]byte{common.HexToHash("0x03"), common.HexToHash("0x04")},
+			},
+			false,
+		},
+		{
+			"no withdrawal message",
+			func(t *testing.T, c *evmclientmocks.Client) {
+				r := includedReceipt()
+				r.Logs = r.Logs[:1]
+				onReceipt(c, r)
+				onBatchDetails(c, &l1BatchDetails{Number: 500, Status: "verified", ExecuteTxHash: &executeTxHash})
+			},
+			nil,
+			true,
+		},
+		{
+			"receipt error",
+			func(t *testing.T, c *evmclientmocks.Client) {
+				c.On("CallContext", mock.Anything, mock.Anything, "eth_getTransactionReceipt", txHash).
+					Return(errors.New("error"))
+			},
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l2Client := evmclientmocks.NewClient(t)
+			tt.before(t, l2Client)
+			l := &l2ToL1Bridge{
+				l2SharedBridge: l2SharedBridge,
+				l2Client:       l2Client,
+			}
+			got, err := l.finalizeWithdrawalPayload(testutils.Context(t), logger.TestLogger(t), txHash)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_L2ToL1Bridge_filterUnfinalizedWithdrawals(t *testing.T) {
+	l1LiquidityManager, err := liquiditymanager.NewLiquidityManager(testutils.NewAddress(), nil)
+	require.NoError(t, err)
+	l := &l2ToL1Bridge{l1LiquidityManager: l1LiquidityManager}
+
+	sent := func(txHash common.Hash, to common.Address) *liquiditymanager.LiquidityManagerLiquidityTransferred {
+		return &liquiditymanager.LiquidityManagerLiquidityTransferred{
+			To:     to,
+			Amount: big.NewInt(1),
+			Raw:    types.Log{TxHash: txHash},
+		}
+	}
+	received := func(l2TxHash common.Hash) *liquiditymanager.LiquidityManagerLiquidityTransferred {
+		payload, err := PackFinalizeWithdrawalPayload(FinalizeWithdrawalPayload{
+			L2TxHash:       l2TxHash,
+			L2BatchNumber:  big.NewInt(1),
+			L2MessageIndex: big.NewInt(1),
+		})
+		require.NoError(t, err)
+		return &liquiditymanager.LiquidityManagerLiquidityTransferred{BridgeSpecificData: payload}
+	}
+
+	finalizedTx, unfinalizedTx, otherTx := common.HexToHash("0x01"), common.HexToHash("0x02"), common.HexToHash("0x03")
+	unfinalized := l.filterUnfinalizedWithdrawals(
+		logger.TestLogger(t),
+		[]*liquiditymanager.LiquidityManagerLiquidityTransferred{
+			sent(finalizedTx, l1LiquidityManager.Address()),
+			sent(unfinalizedTx, l1LiquidityManager.Address()),
+			sent(otherTx, testutils.NewAddress()),
+		},
+		[]*liquiditymanager.LiquidityManagerLiquidityTransferred{
+			received(finalizedTx),
+			{BridgeSpecificData: []byte{0x1}},
+		},
+	)
+	require.Len(t, unfinalized, 1)
+	require.Equal(t, unfinalizedTx, unfinalized[0].Raw.TxHash)
+}
+
+func Test_L2ToL1Bridge_partitionWithdrawals(t *testing.T) {
+	l1LiquidityManager, err := liquiditymanager.NewLiquidityManager(testutils.NewAddress(), nil)
+	require.NoError(t, err)
+	l2LiquidityManager, err := liquiditymanager.NewLiquidityManager(testutils.NewAddress(), nil)
+	require.NoError(t, err)
+	l2Client := evmclientmocks.NewClient(t)
+	l := &l2ToL1Bridge{
+		l1LiquidityManager: l1LiquidityManager,
+		l2LiquidityManager: l2LiquidityManager,
+		l2Client:           l2Client,
+	}
+
+	failingTx, notReadyTx := common.HexToHash("0x01"), common.HexToHash("0x02")
+	l2Client.On("CallContext", mock.Anything, mock.Anything, "eth_getTransactionReceipt", failingTx).
+		Return(errors.New("error"))
+	l2Client.On("CallContext", mock.Anything, mock.Anything, "eth_getTransactionReceipt", notReadyTx).
+		Run(func(args mock.Arguments) {
+			*args.Get(1).(**receipt) = nil
+		}).Return(nil)
+
+	transfers, notReady, failed := l.partitionWithdrawals(
+		testutils.Context(t),
+		logger.TestLogger(t),
+		models.Address(testutils.NewAddress()),
+		models.Address(testutils.NewAddress()),
+		[]*liquiditymanager.LiquidityManagerLiquidityTransferred{
+			{Amount: big.NewInt(1), Raw: types.Log{TxHash: failingTx}},
+			{Amount: big.NewInt(2), Raw: types.Log{TxHash: notReadyTx}},
+		},
+		nil,
+	)
+	require.Equal(t, 1, notReady)
+	require.Equal(t, 1, failed)
+	require.Len(t, transfers, 1)
+	require.Equal(t, models.TransferStatusNotReady, transfers[0].Status)
+	require.Equal(t, big.NewInt(2), transfers[0].Amount.ToInt())
+}
+
+func Test_L2ToL1Bridge_GetBridgePayloadAndFee(t *testing.T) {
+	l := &l2ToL1Bridge{}
+	payload, fee, err := l.GetBridgePayloadAndFee(testutils.Context(t), models.Transfer{})
+	require.NoError(t, err)
+	require.Empty(t, payload)
+	require.Equal(t, big.NewInt(0), fee)
+}
+
+func Test_L2ToL1Bridge_QuorumizedBridgePayload(t *testing.T) {
+	l := &l2ToL1Bridge{}
+	payload, err := l.QuorumizedBridgePayload([][]byte{{1}, {2}}, 1)
+	require.NoError(t, err)
+	require.Empty(t, payload)
+}
+
+func Test_L2ToL1Bridge_Close(t *testing.T) {
+	l1LogPoller := lpmocks.NewLogPoller(t)
+	l2LogPoller := lpmocks.NewLogPoller(t)
+	l1LogPoller.On("UnregisterFilter", mock.Anything, "l1FilterName").Return(nil)
+	l2LogPoller.On("UnregisterFilter", mock.Anything, "l2FilterName").Return(errors.New("unregister error"))
+	l := &l2ToL1Bridge{
+		l1LogPoller:  l1LogPoller,
+		l2LogPoller:  l2LogPoller,
+		l1FilterName: "l1FilterName",
+		l2FilterName: "l2FilterName",
+	}
+	require.Error(t, l.Close(testutils.Context(t)))
+}
@@ -27,6 +27,13 @@ type Discoverer interface {
 	DiscoverBalances(context.Context, graph.Graph) error
 }
 
+// AccrualRateDiscoverer is implemented by discoverers that can read the accrual rates of rebasing tokens.
+type AccrualRateDiscoverer interface {
+	// DiscoverAccrualRates reads the accrual rate of the networks with a configured getter and sets it on the graph.
+	DiscoverAccrualRates(
+		ctx context.Context, g graph.Graph, getters map[models.NetworkSelector]models.AccrualRateGetter) error
+}
+
 type evmDep struct {
 	ethClient client.Client
 }
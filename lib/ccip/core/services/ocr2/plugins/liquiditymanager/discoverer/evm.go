@@ -6,8 +6,10 @@ import (
 	"math/big"
 	"sync"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/liquiditymanager/generated/liquiditymanager"
@@ -22,6 +24,9 @@ const (
 
 type evmLiquidityGetter func(ctx context.Context, selector models.NetworkSelector, lmAddress common.Address) (*big.Int, error)
 
+type evmAccrualRateGetter func(
+	ctx context.Context, selector models.NetworkSelector, getter models.AccrualRateGetter) (*big.Int, error)
+
 type evmDiscoverer struct {
 	lggr                   logger.Logger
 	lock                   sync.RWMutex
@@ -29,6 +34,7 @@ type evmDiscoverer struct {
 	masterLiquidityManager models.Address
 	masterSelector         models.NetworkSelector
 	liquidityGetter        evmLiquidityGetter
+	accrualRateGetter      evmAccrualRateGetter
 }
 
 func newEvmDiscoverer(lggr logger.Logger, evmDeps map[models.NetworkSelector]evmDep, lmAddress models.Address, selector models.NetworkSelector) *evmDiscoverer {
@@ -94,6 +100,32 @@ func (e *evmDiscoverer) DiscoverBalances(ctx context.Context, g graph.Graph) err
 	return errs
 }
 
+// DiscoverAccrualRates reads the accrual rates of the networks in the graph that have a configured getter.
+func (e *evmDiscoverer) DiscoverAccrualRates(
+	ctx context.Context, g graph.Graph, getters map[models.NetworkSelector]models.AccrualRateGetter) error {
+	accrualRateGetter := e.accrualRateGetter
+	if accrualRateGetter == nil {
+		accrualRateGetter = e.defaultAccrualRateGetter
+	}
+
+	var errs error
+	for _, selector := range g.GetNetworks() {
+		getter, ok := getters[selector]
+		if !ok {
+			continue
+		}
+		rate, err := accrualRateGetter(ctx, selector, getter)
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("get accrual rate (%d, %s): %w", selector, getter.Method, err))
+			continue
+		}
+		e.lggr.Debugw("Updating accrual rate", "rate", rate, "selector", selector, "chainID", selector.ChainID())
+		_ = g.SetAccrualRate(selector, rate)
+	}
+
+	return errs
+}
+
 func (e *evmDiscoverer) getVertexData(ctx context.Context, v graph.Vertex) (graph.Data, []graph.Vertex, error) {
 	selector, lmAddress := v.NetworkSelector, v.LiquidityManager
 	dep, ok := e.getDep(selector)
@@ -203,3 +235,23 @@ func (e *evmDiscoverer) defaultLiquidityGetter(ctx context.Context, selector mod
 		Context: ctx,
 	})
 }
+
+func (e *evmDiscoverer) defaultAccrualRateGetter(
+	ctx context.Context, selector models.NetworkSelector, getter models.AccrualRateGetter) (*big.Int, error) {
+	dep, ok := e.getDep(selector)
+	if !ok {
+		return nil, fmt.Errorf("no client for chain %d", selector)
+	}
+	contract := common.Address(getter.Contract)
+	res, err := dep.ethClient.CallContract(ctx, ethereum.CallMsg{
+		To:   &contract,
+		Data: crypto.Keccak256([]byte(getter.Method))[:4],
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("call %s on %s: %w", getter.Method, contract, err)
+	}
+	if len(res) != 32 {
+		return nil, fmt.Errorf("expected a uint256 from %s on %s, got %d bytes", getter.Method, contract, len(res))
+	}
+	return new(big.Int).SetBytes(res), nil
+}
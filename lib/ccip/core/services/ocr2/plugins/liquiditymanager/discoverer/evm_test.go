@@ -126,3 +126,36 @@ func Test_EvmDiscoverer_DiscoverBalances(t *testing.T) {
 		})
 	}
 }
+
+func Test_EvmDiscoverer_DiscoverAccrualRates(t *testing.T) {
+	getters := map[models.NetworkSelector]models.AccrualRateGetter{
+		1: {Contract: models.Address(common.HexToAddress("0x1")), Method: "rebaseRate()"},
+		3: {Contract: models.Address(common.HexToAddress("0x3")), Method: "rebaseRate()"},
+	}
+
+	g := graph.NewGraph()
+	for _, network := range []models.NetworkSelector{1, 2, 3} {
+		g.(graph.GraphTest).AddNetwork(network, graph.Data{Liquidity: big.NewInt(100)})
+	}
+	d := &evmDiscoverer{
+		lggr: logger.TestLogger(t),
+		accrualRateGetter: func(
+			ctx context.Context, network models.NetworkSelector, getter models.AccrualRateGetter) (*big.Int, error) {
+			require.Equal(t, getters[network], getter)
+			return big.NewInt(int64(network) * 1e9), nil
+		},
+	}
+	require.NoError(t, d.DiscoverAccrualRates(testutils.Context(t), g, getters))
+
+	for network, wantRate := range map[models.NetworkSelector]*big.Int{1: big.NewInt(1e9), 2: nil, 3: big.NewInt(3e9)} {
+		data, err := g.GetData(network)
+		require.NoError(t, err)
+		require.Equalf(t, wantRate, data.AccrualRate, "wrong accrual rate for network %d", network)
+	}
+
+	d.accrualRateGetter = func(
+		ctx context.Context, network models.NetworkSelector, getter models.AccrualRateGetter) (*big.Int, error) {
+		return nil, fmt.Errorf("dummy test error")
+	}
+	require.Error(t, d.DiscoverAccrualRates(testutils.Context(t), g, getters))
+}
@@ -51,6 +51,11 @@ func NewPluginFactory(
 	if err := json.Unmarshal(pluginConfigBytes, &pluginConfig); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal plugin config: %w", err)
 	}
+	if pluginConfig.BalanceAccrual != nil {
+		if err := models.ValidateBalanceAccrualConfig(*pluginConfig.BalanceAccrual); err != nil {
+			return nil, fmt.Errorf("invalid balance accrual config: %w", err)
+		}
+	}
 	return &PluginFactory{
 		lggr:              lggr.Named(PluginName),
 		config:            pluginConfig,
@@ -68,6 +73,8 @@ func (p PluginFactory) buildRebalancer() (rebalalgo.RebalancingAlgo, error) {
 		return rebalalgo.NewMinLiquidityRebalancer(p.lggr), nil
 	case models.RebalancerTypeTargetAndMin:
 		return rebalalgo.NewTargetMinBalancer(p.lggr, p.config), nil
+	case models.RebalancerTypeMinCostFlow:
+		return rebalalgo.NewMinCostFlowBalancer(p.lggr, p.config), nil
 	default:
 		return nil, fmt.Errorf("invalid rebalancer type %s", p.config.RebalancerConfig.Type)
 	}
@@ -99,6 +106,7 @@ func (p PluginFactory) NewReportingPlugin(config ocr3types.ReportingPluginConfig
 			p.bridgeFactory,
 			liquidityRebalancer,
 			liquiditymanager.NewEvmReportCodec(),
+			p.config.BalanceAccrual,
 			p.lggr,
 		),
 		ocr3types.ReportingPluginInfo{
@@ -0,0 +1,25 @@
+package graph
+
+import (
+	"math/big"
+	"time"
+)
+
+// AccrualRatePrecision is the precision of the accrual rates, a rate of AccrualRatePrecision doubles the liquidity
+// every second.
+var AccrualRatePrecision = big.NewInt(1e18)
+
+// ProjectLiquidity returns the liquidity after it accrued for the provided duration at the provided rate.
+// The growth is linear, it doesn't compound, since the projected durations are short compared to the rebase periods.
+// The liquidity is returned unchanged if the rate is nil.
+func ProjectLiquidity(liquidity, accrualRate *big.Int, elapsed time.Duration) *big.Int {
+	projected := new(big.Int).Set(liquidity)
+	if accrualRate == nil || elapsed <= 0 {
+		return projected
+	}
+
+	growth := new(big.Int).Mul(liquidity, accrualRate)
+	growth.Mul(growth, big.NewInt(int64(elapsed/time.Second)))
+	growth.Div(growth, AccrualRatePrecision)
+	return projected.Add(projected, growth)
+}
@@ -0,0 +1,46 @@
+package graph
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectLiquidity(t *testing.T) {
+	tests := []struct {
+		name        string
+		liquidity   *big.Int
+		accrualRate *big.Int
+		elapsed     time.Duration
+		want        *big.Int
+	}{
+		{"no accrual", big.NewInt(1000), nil, time.Hour, big.NewInt(1000)},
+		{"no elapsed time", big.NewInt(1000), big.NewInt(1e15), 0, big.NewInt(1000)},
+		{"0.1% per second for a minute", big.NewInt(1000), big.NewInt(1e15), time.Minute, big.NewInt(1060)},
+		{"sub second durations are ignored", big.NewInt(1000), big.NewInt(1e15), 1500 * time.Millisecond, big.NewInt(1001)},
+		{"rounded down", big.NewInt(999), big.NewInt(1e15), time.Second, big.NewInt(999)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			liquidity := new(big.Int).Set(tt.liquidity)
+			require.Equal(t, tt.want.String(), ProjectLiquidity(liquidity, tt.accrualRate, tt.elapsed).String())
+			require.Equal(t, tt.liquidity, liquidity, "liquidity must not be modified")
+		})
+	}
+}
+
+func TestGraph_SetAccrualRate(t *testing.T) {
+	g := NewGraph()
+	require.False(t, g.SetAccrualRate(1, big.NewInt(1)))
+
+	require.True(t, g.(GraphTest).AddNetwork(1, Data{Liquidity: big.NewInt(100)}))
+	require.True(t, g.SetAccrualRate(1, big.NewInt(5)))
+	require.True(t, g.SetLiquidity(1, big.NewInt(200)))
+
+	data, err := g.GetData(1)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(5), data.AccrualRate, "accrual rate must be kept when the liquidity is updated")
+	require.Equal(t, big.NewInt(5), g.Clone().(*liquidityGraph).data[1].AccrualRate)
+}
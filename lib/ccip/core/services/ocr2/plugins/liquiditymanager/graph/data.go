@@ -33,6 +33,9 @@ type Data struct {
 	NetworkSelector         models.NetworkSelector
 	MinimumLiquidity        *big.Int
 	TargetLiquidity         *big.Int
+	// AccrualRate is the per second growth rate of the liquidity scaled by AccrualRatePrecision.
+	// It is nil if the liquidity doesn't accrue.
+	AccrualRate *big.Int
 }
 
 func (d Data) Equals(other Data) bool {
@@ -65,6 +68,10 @@ func (d Data) Clone() Data {
 	if targetLiq == nil {
 		targetLiq = big.NewInt(0)
 	}
+	var accrualRate *big.Int
+	if d.AccrualRate != nil {
+		accrualRate = big.NewInt(0).Set(d.AccrualRate)
+	}
 	return Data{
 		Liquidity:               big.NewInt(0).Set(liq),
 		TokenAddress:            tokenAddr,
@@ -74,5 +81,6 @@ func (d Data) Clone() Data {
 		NetworkSelector:         d.NetworkSelector,
 		MinimumLiquidity:        big.NewInt(0).Set(minLiq),
 		TargetLiquidity:         big.NewInt(0).Set(targetLiq),
+		AccrualRate:             accrualRate,
 	}
 }
@@ -16,6 +16,8 @@ type GraphWriter interface {
 	SetLiquidity(n models.NetworkSelector, liquidity *big.Int) bool
 	// SetTargetLiquidity sets the target liquidity of the provided network.
 	SetTargetLiquidity(n models.NetworkSelector, liquidity *big.Int) bool
+	// SetAccrualRate sets the accrual rate of the liquidity of the provided network.
+	SetAccrualRate(n models.NetworkSelector, rate *big.Int) bool
 }
 
 // NodeReader provides read access to the data saved in the graph nodes.
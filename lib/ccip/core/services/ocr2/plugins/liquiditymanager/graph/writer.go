@@ -37,6 +37,7 @@ func (g *liquidityGraph) SetLiquidity(n models.NetworkSelector, liquidity *big.I
 		NetworkSelector:         prev.NetworkSelector,
 		MinimumLiquidity:        prev.MinimumLiquidity,
 		TargetLiquidity:         prev.TargetLiquidity,
+		AccrualRate:             prev.AccrualRate,
 	}
 	return true
 }
@@ -58,10 +59,25 @@ func (g *liquidityGraph) SetTargetLiquidity(n models.NetworkSelector, target *bi
 		NetworkSelector:         prev.NetworkSelector,
 		MinimumLiquidity:        prev.MinimumLiquidity,
 		TargetLiquidity:         target,
+		AccrualRate:             prev.AccrualRate,
 	}
 	return true
 }
 
+func (g *liquidityGraph) SetAccrualRate(n models.NetworkSelector, rate *big.Int) bool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if !g.hasNetwork(n) {
+		return false
+	}
+
+	data := g.data[n]
+	data.AccrualRate = rate
+	g.data[n] = data
+	return true
+}
+
 func (g *liquidityGraph) AddNetwork(n models.NetworkSelector, data Data) bool {
 	g.lock.Lock()
 	defer g.lock.Unlock()
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"slices"
+	"strings"
 )
 
 const PluginName = "liquidityRebalancer"
@@ -14,6 +15,69 @@ type PluginConfig struct {
 	LiquidityManagerNetwork NetworkSelector  `json:"liquidityManagerNetwork,string"`
 	ClosePluginTimeoutSec   int              `json:"closePluginTimeoutSec"`
 	RebalancerConfig        RebalancerConfig `json:"rebalancerConfig"`
+	// BalanceAccrual enables the projection of growing balances, it is only needed for rebasing tokens.
+	BalanceAccrual *BalanceAccrualConfig `json:"balanceAccrual,omitempty"`
+	// CCIPLanes are used to rebalance between networks without a native bridge, with CCIP token transfers.
+	CCIPLanes []CCIPLaneConfig `json:"ccipLanes,omitempty"`
+}
+
+// CCIPLaneConfig configures a CCIP lane used as a bridge from Source to Dest. The LiquidityManager of the source
+// network must have a CCIP bridge adapter set for the dest network.
+type CCIPLaneConfig struct {
+	Source  NetworkSelector `json:"source,string"`
+	Dest    NetworkSelector `json:"dest,string"`
+	Router  Address         `json:"router"`
+	OnRamp  Address         `json:"onRamp"`
+	OffRamp Address         `json:"offRamp"`
+}
+
+func ValidateCCIPLanes(lanes []CCIPLaneConfig) error {
+	seen := make(map[Edge]bool)
+	for _, lane := range lanes {
+		if lane.Source == 0 || lane.Dest == 0 || lane.Source == lane.Dest {
+			return fmt.Errorf("invalid CCIP lane %d -> %d", lane.Source, lane.Dest)
+		}
+		k := NewEdge(lane.Source, lane.Dest)
+		if seen[k] {
+			return fmt.Errorf("duplicated CCIP lane %d -> %d", lane.Source, lane.Dest)
+		}
+		seen[k] = true
+		if lane.Router == (Address{}) || lane.OnRamp == (Address{}) || lane.OffRamp == (Address{}) {
+			return fmt.Errorf("router, onRamp and offRamp of CCIP lane %d -> %d must be provided", lane.Source, lane.Dest)
+		}
+	}
+	return nil
+}
+
+// BalanceAccrualConfig configures the projection of the liquidity of rebasing tokens, whose balances grow between
+// the observation of the liquidity and the settlement of the transfers.
+type BalanceAccrualConfig struct {
+	// SettlementDelaySec is the expected time between the observation and the settlement of the transfers,
+	// the liquidity is projected by this delay.
+	SettlementDelaySec int64 `json:"settlementDelaySec"`
+	// RateGetters configures where the accrual rate of each network is read from.
+	// The liquidity of networks without a getter is not projected.
+	RateGetters map[NetworkSelector]AccrualRateGetter `json:"rateGetters"`
+}
+
+// AccrualRateGetter is a contract view function without arguments, e.g. "rebaseRatePerSecond()", that returns the
+// per second growth rate of the token balances as a uint256 scaled by 1e18.
+type AccrualRateGetter struct {
+	Contract Address `json:"contract"`
+	Method   string  `json:"method"`
+}
+
+func ValidateBalanceAccrualConfig(config BalanceAccrualConfig) error {
+	if config.SettlementDelaySec < 0 {
+		return errors.New("settlementDelaySec cannot be negative")
+	}
+	for net, getter := range config.RateGetters {
+		if !strings.HasSuffix(getter.Method, "()") || len(getter.Method) == len("()") {
+			return fmt.Errorf("accrual rate getter of network %d must be a function signature without arguments, got %q",
+				net, getter.Method)
+		}
+	}
+	return nil
 }
 
 type RebalancerConfig struct {
@@ -21,6 +85,28 @@ type RebalancerConfig struct {
 	DefaultTarget *big.Int `json:"defaultTarget"`
 	// NetworkTargetOverrides is a map of NetworkSelector to big Int amounts
 	NetworkTargetOverrides map[NetworkSelector]*big.Int `json:"networkTargetOverrides"`
+	// MinCostFlow configures the min-cost-flow rebalancer, it is ignored by the other rebalancers.
+	MinCostFlow *MinCostFlowConfig `json:"minCostFlow,omitempty"`
+}
+
+// MinCostFlowConfig configures how the min-cost-flow rebalancer weights the edges of the liquidity graph.
+type MinCostFlowConfig struct {
+	// LatencyCost is the cost of one second of expected transfer latency, in the same unit as the weighted bridge fees.
+	LatencyCost *big.Int `json:"latencyCost"`
+	// FeeMultipliers converts the native bridge fees of each source network to a common unit, defaults to 1.
+	FeeMultipliers map[NetworkSelector]*big.Int `json:"feeMultipliers"`
+	// Edges configures the expected latency and the transfer cap of the graph edges.
+	Edges []EdgeConfig `json:"edges"`
+}
+
+// EdgeConfig is the min-cost-flow rebalancer configuration of a single graph edge.
+type EdgeConfig struct {
+	Source NetworkSelector `json:"source,string"`
+	Dest   NetworkSelector `json:"dest,string"`
+	// ExpectedLatencySec is the expected time it takes for a transfer over the edge to settle.
+	ExpectedLatencySec int64 `json:"expectedLatencySec"`
+	// MaxInflightAmount caps the amount that can be in flight over the edge, the edge is uncapped if not set.
+	MaxInflightAmount *big.Int `json:"maxInflightAmount"`
 }
 
 func ValidateRebalancerConfig(config RebalancerConfig) error {
@@ -32,6 +118,38 @@ func ValidateRebalancerConfig(config RebalancerConfig) error {
 		return fmt.Errorf("rebalancerType %s is not supported, supported types are %+v", config.Type, AllRebalancerTypes)
 	}
 
+	if config.Type == RebalancerTypeMinCostFlow && config.MinCostFlow != nil {
+		if err := validateMinCostFlowConfig(*config.MinCostFlow); err != nil {
+			return fmt.Errorf("invalid minCostFlow config: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func validateMinCostFlowConfig(config MinCostFlowConfig) error {
+	if config.LatencyCost != nil && config.LatencyCost.Sign() < 0 {
+		return errors.New("latencyCost cannot be negative")
+	}
+	for net, multiplier := range config.FeeMultipliers {
+		if multiplier == nil || multiplier.Sign() < 0 {
+			return fmt.Errorf("fee multiplier of network %d must be a non-negative number", net)
+		}
+	}
+	seen := make(map[Edge]bool)
+	for _, edge := range config.Edges {
+		k := NewEdge(edge.Source, edge.Dest)
+		if seen[k] {
+			return fmt.Errorf("duplicated config of edge %d -> %d", edge.Source, edge.Dest)
+		}
+		seen[k] = true
+		if edge.ExpectedLatencySec < 0 {
+			return fmt.Errorf("expected latency of edge %d -> %d cannot be negative", edge.Source, edge.Dest)
+		}
+		if edge.MaxInflightAmount != nil && edge.MaxInflightAmount.Sign() < 0 {
+			return fmt.Errorf("max inflight amount of edge %d -> %d cannot be negative", edge.Source, edge.Dest)
+		}
+	}
 	return nil
 }
 
@@ -39,6 +157,7 @@ const (
 	RebalancerTypeTargetAndMin = "target-and-min"
 	RebalancerTypeMinLiquidity = "min-liquidity"
 	RebalancerTypePingPong     = "ping-pong"
+	RebalancerTypeMinCostFlow  = "min-cost-flow"
 )
 
 var (
@@ -46,6 +165,7 @@ var (
 		RebalancerTypePingPong,
 		RebalancerTypeMinLiquidity,
 		RebalancerTypeTargetAndMin,
+		RebalancerTypeMinCostFlow,
 	}
 )
 
@@ -276,3 +276,16 @@ func NewEdge(source, dest NetworkSelector) Edge {
 		Dest:   dest,
 	}
 }
+
+// EdgeFee is the native bridge fee quoted for a transfer over a graph edge.
+type EdgeFee struct {
+	Edge
+	Fee *ubig.Big
+}
+
+func NewEdgeFee(edge Edge, fee *big.Int) EdgeFee {
+	return EdgeFee{
+		Edge: edge,
+		Fee:  ubig.New(fee),
+	}
+}
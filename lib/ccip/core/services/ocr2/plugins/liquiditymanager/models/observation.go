@@ -11,9 +11,17 @@ import (
 type NetworkLiquidity struct {
 	Network   NetworkSelector
 	Liquidity *ubig.Big
+	// AccrualRate is the per second growth rate of the liquidity scaled by 1e18, only set for rebasing tokens.
+	AccrualRate *ubig.Big `json:",omitempty"`
+	// ProjectedLiquidity is the liquidity projected to the expected settlement of the transfers using AccrualRate.
+	ProjectedLiquidity *ubig.Big `json:",omitempty"`
 }
 
 func (n NetworkLiquidity) String() string {
+	if n.ProjectedLiquidity != nil {
+		return fmt.Sprintf("NetworkLiquidity{Network: %d, Liquidity: %s, AccrualRate: %s, ProjectedLiquidity: %s}",
+			n.Network, n.Liquidity.String(), n.AccrualRate.String(), n.ProjectedLiquidity.String())
+	}
 	return fmt.Sprintf("NetworkLiquidity{Network: %d, Liquidity: %s}", n.Network, n.Liquidity.String())
 }
 
@@ -24,6 +32,15 @@ func NewNetworkLiquidity(chain NetworkSelector, liq *big.Int) NetworkLiquidity {
 	}
 }
 
+func NewProjectedNetworkLiquidity(chain NetworkSelector, liq, accrualRate, projectedLiq *big.Int) NetworkLiquidity {
+	return NetworkLiquidity{
+		Network:            chain,
+		Liquidity:          ubig.New(liq),
+		AccrualRate:        ubig.New(accrualRate),
+		ProjectedLiquidity: ubig.New(projectedLiq),
+	}
+}
+
 type Observation struct {
 	// LiquidityPerChain is the liquidity per chain that is known in the rebalancer graph.
 	LiquidityPerChain []NetworkLiquidity
@@ -37,6 +54,9 @@ type Observation struct {
 	Edges []Edge
 	// ConfigDigests contains the config digests for each chain and rebalancer.
 	ConfigDigests []ConfigDigestWithMeta
+	// EdgeFees are the bridge fees quoted for the edges of the rebalancer graph.
+	// They are only observed when the rebalancing algorithm takes the bridge fees into account.
+	EdgeFees []EdgeFee `json:",omitempty"`
 }
 
 func NewObservation(
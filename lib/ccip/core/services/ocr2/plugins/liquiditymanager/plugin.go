@@ -37,6 +37,7 @@ type Plugin struct {
 	inflight                inflight.Container
 	lggr                    logger.Logger
 	reportCodec             evmliquiditymanager.OnchainReportCodec
+	balanceAccrual          *models.BalanceAccrualConfig
 }
 
 func NewPlugin(
@@ -49,6 +50,7 @@ func NewPlugin(
 	bridgeFactory bridge.Factory,
 	liquidityRebalancer rebalalgo.RebalancingAlgo,
 	reportCodec evmliquiditymanager.OnchainReportCodec,
+	balanceAccrual *models.BalanceAccrualConfig,
 	lggr logger.Logger,
 ) *Plugin {
 	return &Plugin{
@@ -61,6 +63,7 @@ func NewPlugin(
 		liquidityRebalancer:     liquidityRebalancer,
 		inflight:                inflight.New(),
 		reportCodec:             reportCodec,
+		balanceAccrual:          balanceAccrual,
 		lggr:                    lggr,
 		mu:                      sync.RWMutex{},
 	}
@@ -81,11 +84,11 @@ func (p *Plugin) Observation(ctx context.Context, outcomeCtx ocr3types.OutcomeCo
 
 	networkLiquidities := make([]models.NetworkLiquidity, 0)
 	for _, net := range p.liquidityGraph.GetNetworks() {
-		liq, err := p.liquidityGraph.GetLiquidity(net)
+		data, err := p.liquidityGraph.GetData(net)
 		if err != nil {
 			return ocrtypes.Observation{}, err
 		}
-		networkLiquidities = append(networkLiquidities, models.NewNetworkLiquidity(net, liq))
+		networkLiquidities = append(networkLiquidities, p.networkLiquidity(net, data))
 	}
 
 	pendingTransfers, err := p.loadPendingTransfers(ctx, lggr)
@@ -118,6 +121,14 @@ func (p *Plugin) Observation(ctx context.Context, outcomeCtx ocr3types.OutcomeCo
 		})
 	}
 
+	var edgeFees []models.EdgeFee
+	if _, feeAware := p.liquidityRebalancer.(rebalalgo.FeeAwareRebalancingAlgo); feeAware {
+		edgeFees, err = p.loadEdgeFees(ctx, lggr, edges)
+		if err != nil {
+			return ocrtypes.Observation{}, fmt.Errorf("load edge fees: %w", err)
+		}
+	}
+
 	lggr.Infow("finished observing",
 		"networkLiquidities", networkLiquidities,
 		"pendingTransfers", pendingTransfers,
@@ -125,15 +136,18 @@ func (p *Plugin) Observation(ctx context.Context, outcomeCtx ocr3types.OutcomeCo
 		"resolvedTransfers", resolvedTransfers,
 		"inflightTransfers", inflightTransfers,
 		"numExpired", numExpired,
+		"edgeFees", edgeFees,
 	)
 
-	return models.NewObservation(
+	obs := models.NewObservation(
 		networkLiquidities,
 		resolvedTransfers,
 		pendingTransfers,
 		inflightTransfers,
 		edges,
-		configDigests).Encode()
+		configDigests)
+	obs.EdgeFees = edgeFees
+	return obs.Encode()
 }
 
 func (p *Plugin) ObservationQuorum(outctx ocr3types.OutcomeContext, query ocrtypes.Query) (ocr3types.Quorum, error) {
@@ -198,7 +212,18 @@ func (p *Plugin) Outcome(outctx ocr3types.OutcomeContext, query ocrtypes.Query,
 		"resolvedTransfersQuorum", resolvedTransfersQuorum,
 		"inflightTransfers", inflightTransfers,
 	)
-	proposedTransfers, err := p.liquidityRebalancer.ComputeTransfersToBalance(g, combinedUnexecutedTransfers(pendingTransfers, resolvedTransfersQuorum, inflightTransfers))
+	unexecutedTransfers := combinedUnexecutedTransfers(pendingTransfers, resolvedTransfersQuorum, inflightTransfers)
+	var proposedTransfers []models.ProposedTransfer
+	if feeAwareRebalancer, ok := p.liquidityRebalancer.(rebalalgo.FeeAwareRebalancingAlgo); ok {
+		edgeFees, err2 := rebalcalc.EdgeFeesConsensus(observations, p.f)
+		if err2 != nil {
+			return nil, fmt.Errorf("compute edge fees consensus: %w", err2)
+		}
+		lggr.Infow("computing transfers with bridge fees", "edgeFees", edgeFees)
+		proposedTransfers, err = feeAwareRebalancer.ComputeTransfersWithFees(g, unexecutedTransfers, edgeFees)
+	} else {
+		proposedTransfers, err = p.liquidityRebalancer.ComputeTransfersToBalance(g, unexecutedTransfers)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("compute transfers to reach balance: %w", err)
 	}
@@ -494,9 +519,32 @@ func (p *Plugin) syncGraph(ctx context.Context) error {
 		p.lggr.Infow("finished syncing graph liquidities")
 	}
 
+	if p.balanceAccrual != nil {
+		accrualDiscoverer, ok := p.discoverer.(discoverer.AccrualRateDiscoverer)
+		if !ok {
+			return fmt.Errorf("balance accrual is configured but the discoverer can't read accrual rates")
+		}
+		p.lggr.Infow("syncing graph accrual rates")
+		if err := accrualDiscoverer.DiscoverAccrualRates(ctx, p.liquidityGraph, p.balanceAccrual.RateGetters); err != nil {
+			return fmt.Errorf("discovering accrual rates: %w", err)
+		}
+		p.lggr.Infow("finished syncing graph accrual rates")
+	}
+
 	return nil
 }
 
+// networkLiquidity returns the observed liquidity of a network. The liquidity of networks with an accrual rate is
+// also projected to the expected settlement of the transfers.
+func (p *Plugin) networkLiquidity(net models.NetworkSelector, data graph.Data) models.NetworkLiquidity {
+	if p.balanceAccrual == nil || data.AccrualRate == nil {
+		return models.NewNetworkLiquidity(net, data.Liquidity)
+	}
+	settlementDelay := time.Duration(p.balanceAccrual.SettlementDelaySec) * time.Second
+	projected := graph.ProjectLiquidity(data.Liquidity, data.AccrualRate, settlementDelay)
+	return models.NewProjectedNetworkLiquidity(net, data.Liquidity, data.AccrualRate, projected)
+}
+
 func (p *Plugin) loadPendingTransfers(ctx context.Context, lggr logger.Logger) ([]models.PendingTransfer, error) {
 	lggr.Infow("loading pending transfers")
 
@@ -538,7 +586,74 @@ func (p *Plugin) loadPendingTransfers(ctx context.Context, lggr logger.Logger) (
 	return pendingTransfers, nil
 }
 
+// loadEdgeFees quotes the native bridge fee of a transfer over each of the provided edges, for the liquidity of
+// the source network.
+// Edges without a bridge or whose fee can't be quoted are skipped.
+func (p *Plugin) loadEdgeFees(ctx context.Context, lggr logger.Logger, edges []models.Edge) ([]models.EdgeFee, error) {
+	lggr.Infow("loading edge fees")
+
+	edgeFees := make([]models.EdgeFee, 0, len(edges))
+	for _, edge := range edges {
+		logger := lggr.With("sourceNetwork", edge.Source, "destNetwork", edge.Dest)
+		bridge, err := p.bridgeFactory.NewBridge(ctx, edge.Source, edge.Dest)
+		if err != nil {
+			return nil, fmt.Errorf("init bridge: %w", err)
+		}
+
+		if bridge == nil {
+			logger.Warn("no bridge found for network pair")
+			continue
+		}
+
+		sender, err := p.liquidityGraph.GetLiquidityManagerAddress(edge.Source)
+		if err != nil {
+			return nil, fmt.Errorf("get liquidityManager address for %v: %w", edge.Source, err)
+		}
+		receiver, err := p.liquidityGraph.GetLiquidityManagerAddress(edge.Dest)
+		if err != nil {
+			return nil, fmt.Errorf("get liquidityManager address for %v: %w", edge.Dest, err)
+		}
+		localToken, err := p.liquidityGraph.GetTokenAddress(edge.Source)
+		if err != nil {
+			return nil, fmt.Errorf("get local token address for %v: %w", edge.Source, err)
+		}
+		remoteToken, err := p.liquidityGraph.GetTokenAddress(edge.Dest)
+		if err != nil {
+			return nil, fmt.Errorf("get remote token address for %v: %w", edge.Dest, err)
+		}
+
+		// Some bridge fees grow with the transferred amount, e.g. CCIP token transfers, so the fee is quoted for
+		// the largest transfer the source network can make, its whole liquidity. The rebalancer weighs the edge by
+		// this fee whatever amount it sends over it.
+		amount, err := p.liquidityGraph.GetLiquidity(edge.Source)
+		if err != nil {
+			return nil, fmt.Errorf("get liquidity of %v: %w", edge.Source, err)
+		}
+		if amount.Sign() <= 0 {
+			amount = big.NewInt(1)
+		}
+		_, fee, err := bridge.GetBridgePayloadAndFee(ctx, models.Transfer{
+			From:               edge.Source,
+			To:                 edge.Dest,
+			Amount:             ubig.New(amount),
+			Sender:             sender,
+			Receiver:           receiver,
+			LocalTokenAddress:  localToken,
+			RemoteTokenAddress: remoteToken,
+		})
+		if err != nil {
+			logger.Warnw("failed to quote bridge fee", "err", err)
+			continue
+		}
+		edgeFees = append(edgeFees, models.NewEdgeFee(edge, fee))
+	}
+
+	return edgeFees, nil
+}
+
 // computeMedianGraph computes a graph with the provided median liquidities per chain and edges that quorum agreed on.
+// The projected liquidity is used for the chains where it was agreed on, so that the transfers account for the
+// liquidity that accrues until they settle.
 func (p *Plugin) computeMedianGraph(
 	edges []models.Edge, medianLiquidities []models.NetworkLiquidity) (graph.Graph, error) {
 	g, err := graph.NewGraphFromEdges(edges)
@@ -547,7 +662,12 @@ func (p *Plugin) computeMedianGraph(
 	}
 
 	for _, medianLiq := range medianLiquidities {
-		if !g.SetLiquidity(medianLiq.Network, medianLiq.Liquidity.ToInt()) {
+		liquidity := medianLiq.Liquidity
+		if medianLiq.ProjectedLiquidity != nil {
+			liquidity = medianLiq.ProjectedLiquidity
+			_ = g.SetAccrualRate(medianLiq.Network, medianLiq.AccrualRate.ToInt())
+		}
+		if !g.SetLiquidity(medianLiq.Network, liquidity.ToInt()) {
 			p.lggr.Debugw("median liquidity on network not found on edges quorum", "net", medianLiq.Network)
 		}
 	}
@@ -1092,6 +1092,62 @@ func TestPlugin_Close(t *testing.T) {
 		"some error that indicates something went wrong", err.Error())
 }
 
+func TestPlugin_loadEdgeFees(t *testing.T) {
+	ctx := testutils.Context(t)
+	p := newPluginWithMocksAndDefaults(t)
+
+	g := graph.NewGraph()
+	a := graph.Data{
+		Liquidity:               big.NewInt(1000),
+		TokenAddress:            tokenX,
+		LiquidityManagerAddress: rebalancerA,
+		NetworkSelector:         networkA,
+	}
+	b := graph.Data{
+		Liquidity:               big.NewInt(0),
+		TokenAddress:            tokenY,
+		LiquidityManagerAddress: rebalancerB,
+		NetworkSelector:         networkB,
+	}
+	require.NoError(t, g.Add(a, b))
+	require.NoError(t, g.Add(b, a))
+	p.plugin.liquidityGraph = g
+
+	// the fee is quoted for the liquidity of the source network, at least 1
+	brAB := bridgemocks.NewBridge(t)
+	brAB.On("GetBridgePayloadAndFee", ctx, models.Transfer{
+		From:               networkA,
+		To:                 networkB,
+		Amount:             ubig.New(big.NewInt(1000)),
+		Sender:             rebalancerA,
+		Receiver:           rebalancerB,
+		LocalTokenAddress:  tokenX,
+		RemoteTokenAddress: tokenY,
+	}).Return(nil, big.NewInt(10), nil)
+	brBA := bridgemocks.NewBridge(t)
+	brBA.On("GetBridgePayloadAndFee", ctx, models.Transfer{
+		From:               networkB,
+		To:                 networkA,
+		Amount:             ubig.New(big.NewInt(1)),
+		Sender:             rebalancerB,
+		Receiver:           rebalancerA,
+		LocalTokenAddress:  tokenY,
+		RemoteTokenAddress: tokenX,
+	}).Return(nil, big.NewInt(20), nil)
+	p.bridgeFactory.On("NewBridge", ctx, networkA, networkB).Return(brAB, nil)
+	p.bridgeFactory.On("NewBridge", ctx, networkB, networkA).Return(brBA, nil)
+
+	edgeFees, err := p.plugin.loadEdgeFees(ctx, logger.TestLogger(t), []models.Edge{
+		models.NewEdge(networkA, networkB),
+		models.NewEdge(networkB, networkA),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []models.EdgeFee{
+		models.NewEdgeFee(models.NewEdge(networkA, networkB), big.NewInt(10)),
+		models.NewEdgeFee(models.NewEdge(networkB, networkA), big.NewInt(20)),
+	}, edgeFees)
+}
+
 func TestPlugin_E2EWithMocks(t *testing.T) {
 	ctx := testutils.Context(t)
 	lggr := logger.TestLogger(t)
@@ -1576,6 +1632,7 @@ func newNode(t *testing.T, lggr logger.Logger, f int) node {
 		bridgeFactory,
 		rebalancerAlg,
 		NewJsonReportCodec(),
+		nil,
 		lggr,
 	)
 
@@ -1638,6 +1695,7 @@ func newPluginWithMocks(
 			bridgeFactory,
 			rebalancerAlg,
 			NewJsonReportCodec(),
+			nil,
 			lggr,
 		),
 		lmFactory:         lmFactory,
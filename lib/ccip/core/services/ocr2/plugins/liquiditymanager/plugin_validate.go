@@ -36,6 +36,9 @@ func (p *Plugin) ValidateObservation(outctx ocr3types.OutcomeContext, query ocrt
 	if err := validateItems(dedupKeyConfigDigest, obs.ConfigDigests); err != nil {
 		return fmt.Errorf("invalid ConfigDigests: %w", err)
 	}
+	if err := validateItems(dedupKeyEdgeFee, obs.EdgeFees, validateEdgeFee); err != nil {
+		return fmt.Errorf("invalid EdgeFees: %w", err)
+	}
 
 	return nil
 }
@@ -48,6 +51,12 @@ func validateNetworkLiquidity(liq models.NetworkLiquidity) error {
 	if liq.Liquidity == nil {
 		return fmt.Errorf("nil Liquidity")
 	}
+	if (liq.AccrualRate == nil) != (liq.ProjectedLiquidity == nil) {
+		return fmt.Errorf("AccrualRate and ProjectedLiquidity must be set together")
+	}
+	if liq.ProjectedLiquidity != nil && liq.ProjectedLiquidity.Cmp(liq.Liquidity) < 0 {
+		return fmt.Errorf("ProjectedLiquidity is lower than Liquidity")
+	}
 	return nil
 }
 
@@ -83,6 +92,20 @@ func dedupKeyEdge(e models.Edge) string {
 	return fmt.Sprintf("%d-%d", e.Source, e.Dest)
 }
 
+func dedupKeyEdgeFee(e models.EdgeFee) string {
+	return dedupKeyEdge(e.Edge)
+}
+
+func validateEdgeFee(e models.EdgeFee) error {
+	if e.Fee == nil {
+		return fmt.Errorf("nil Fee")
+	}
+	if e.Fee.ToInt().Sign() < 0 {
+		return fmt.Errorf("negative Fee")
+	}
+	return nil
+}
+
 func dedupKeyConfigDigest(obs models.ConfigDigestWithMeta) string {
 	return fmt.Sprintf("%d", obs.NetworkSel) // we only allow 1 config digest per network
 }
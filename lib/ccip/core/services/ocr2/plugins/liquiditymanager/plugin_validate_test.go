@@ -100,6 +100,34 @@ func TestPlugin_ValidateObservation(t *testing.T) {
 				assert.Error(t, err)
 			},
 		},
+		{
+			name: "invalid network liquidity: projected liquidity without accrual rate",
+			obs: newTestObservation(models.NewObservation(
+				[]models.NetworkLiquidity{{Network: 1, Liquidity: ubig.NewI(2), ProjectedLiquidity: ubig.NewI(3)}},
+				[]models.Transfer{},
+				[]models.PendingTransfer{},
+				[]models.Transfer{},
+				[]models.Edge{},
+				[]models.ConfigDigestWithMeta{},
+			)),
+			expErr: func(t *testing.T, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "invalid network liquidity: projected liquidity lower than liquidity",
+			obs: newTestObservation(models.NewObservation(
+				[]models.NetworkLiquidity{models.NewProjectedNetworkLiquidity(1, big.NewInt(2), big.NewInt(1), big.NewInt(1))},
+				[]models.Transfer{},
+				[]models.PendingTransfer{},
+				[]models.Transfer{},
+				[]models.Edge{},
+				[]models.ConfigDigestWithMeta{},
+			)),
+			expErr: func(t *testing.T, err error) {
+				assert.Error(t, err)
+			},
+		},
 		{
 			name: "deduped resolved transfers",
 			obs: newTestObservation(models.NewObservation(
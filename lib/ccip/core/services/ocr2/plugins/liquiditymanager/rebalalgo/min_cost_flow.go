@@ -0,0 +1,388 @@
+package rebalalgo
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/graph"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/models"
+)
+
+// MinCostFlowBalancer brings every network to its target liquidity with the cheapest set of transfers.
+//
+// The liquidity graph is modeled as a flow network, networks above their target supply liquidity and networks
+// below their target demand it. Each edge costs its weighted bridge fee plus its weighted expected latency, and
+// can carry at most its configured cap minus what is already in flight over it. The flow is computed with
+// successive shortest paths, ties are broken by the number of hops.
+//
+// Only the first hop of a multi-hop path is proposed, the intermediate network forwards the liquidity in a later
+// round once it has arrived.
+type MinCostFlowBalancer struct {
+	lggr        logger.Logger
+	config      models.PluginConfig
+	edgeConfigs map[models.Edge]models.EdgeConfig
+}
+
+var _ FeeAwareRebalancingAlgo = &MinCostFlowBalancer{}
+
+func NewMinCostFlowBalancer(lggr logger.Logger, config models.PluginConfig) *MinCostFlowBalancer {
+	edgeConfigs := make(map[models.Edge]models.EdgeConfig)
+	if config.RebalancerConfig.MinCostFlow != nil {
+		for _, edgeConfig := range config.RebalancerConfig.MinCostFlow.Edges {
+			edgeConfigs[models.NewEdge(edgeConfig.Source, edgeConfig.Dest)] = edgeConfig
+		}
+	}
+	return &MinCostFlowBalancer{
+		lggr:        lggr.With("service", "MinCostFlowBalancer"),
+		config:      config,
+		edgeConfigs: edgeConfigs,
+	}
+}
+
+// ComputeTransfersToBalance computes the transfers without bridge fees, edges are only weighted by their latency.
+func (r *MinCostFlowBalancer) ComputeTransfersToBalance(
+	graphNow graph.Graph, nonExecutedTransfers []UnexecutedTransfer) ([]models.ProposedTransfer, error) {
+	return r.computeTransfers(graphNow, nonExecutedTransfers, nil)
+}
+
+func (r *MinCostFlowBalancer) ComputeTransfersWithFees(
+	graphNow graph.Graph, nonExecutedTransfers []UnexecutedTransfer, fees []models.EdgeFee,
+) ([]models.ProposedTransfer, error) {
+	feePerEdge := make(map[models.Edge]*big.Int, len(fees))
+	for _, fee := range fees {
+		feePerEdge[fee.Edge] = fee.Fee.ToInt()
+	}
+	return r.computeTransfers(graphNow, nonExecutedTransfers, feePerEdge)
+}
+
+// computeTransfers computes the min-cost-flow transfers, feePerEdge is nil if bridge fees are not considered.
+func (r *MinCostFlowBalancer) computeTransfers(
+	graphNow graph.Graph, nonExecutedTransfers []UnexecutedTransfer, feePerEdge map[models.Edge]*big.Int,
+) ([]models.ProposedTransfer, error) {
+	nonExecutedTransfers = filterUnexecutedTransfers(nonExecutedTransfers)
+
+	graphLater, err := getExpectedGraph(graphNow, nonExecutedTransfers)
+	if err != nil {
+		return nil, fmt.Errorf("get expected graph: %w", err)
+	}
+
+	networks := graphNow.GetNetworks()
+	fn := newFlowNetwork(networks)
+
+	totalSupply := big.NewInt(0)
+	for _, net := range networks {
+		supply, demand, err2 := r.supplyAndDemand(graphNow, graphLater, net)
+		if err2 != nil {
+			return nil, fmt.Errorf("supply and demand of net %v: %w", net, err2)
+		}
+		r.lggr.Debugw("network supply and demand", "net", net, "supply", supply, "demand", demand)
+		if supply.Sign() > 0 {
+			fn.addArc(fn.source, fn.nodes[net], supply, big.NewInt(0), 0)
+			totalSupply.Add(totalSupply, supply)
+		}
+		if demand.Sign() > 0 {
+			fn.addArc(fn.nodes[net], fn.sink, demand, big.NewInt(0), 0)
+		}
+	}
+	if totalSupply.Sign() == 0 {
+		r.lggr.Debugw("no network has liquidity to spare, no transfers needed")
+		return []models.ProposedTransfer{}, nil
+	}
+
+	edges, err := graphLater.GetEdges()
+	if err != nil {
+		return nil, fmt.Errorf("get edges: %w", err)
+	}
+	for _, edge := range edges {
+		var fee *big.Int
+		if feePerEdge != nil {
+			var ok bool
+			fee, ok = feePerEdge[edge]
+			if !ok {
+				r.lggr.Debugw("no bridge fee for edge, skipping it", "edge", edge)
+				continue
+			}
+		}
+		capacity := r.edgeCapacity(edge, nonExecutedTransfers, totalSupply)
+		if capacity.Sign() <= 0 {
+			r.lggr.Debugw("edge cap reached, skipping it", "edge", edge)
+			continue
+		}
+		fn.addArc(fn.nodes[edge.Source], fn.nodes[edge.Dest], capacity, r.edgeCost(edge, fee), 1)
+	}
+
+	fn.minCostFlow()
+
+	proposedTransfers, err := fn.firstHopTransfers(networks)
+	if err != nil {
+		return nil, fmt.Errorf("decompose flow: %w", err)
+	}
+	r.lggr.Debugw("computed min cost flow transfers", "proposedTransfers", proposedTransfers)
+
+	proposedTransfers = mergeProposedTransfers(proposedTransfers)
+	sort.Sort(models.ProposedTransfers(proposedTransfers))
+	return proposedTransfers, nil
+}
+
+// supplyAndDemand returns how much liquidity the network can send and how much it needs to reach its target.
+// The supply is bounded by the available liquidity now and after the non-executed transfers, since we don't know
+// when they will complete.
+func (r *MinCostFlowBalancer) supplyAndDemand(
+	graphNow, graphLater graph.Graph, net models.NetworkSelector) (supply, demand *big.Int, err error) {
+	supply, demand = big.NewInt(0), big.NewInt(0)
+
+	target := r.targetLiquidity(net)
+	if target == nil {
+		return nil, nil, fmt.Errorf("target liquidity is nil for network %v", net)
+	}
+	if target.Sign() == 0 {
+		// automated rebalancing is disabled if target is set to 0
+		return supply, demand, nil
+	}
+
+	liquidityLater, err := graphLater.GetLiquidity(net)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if liquidityLater.Cmp(target) < 0 {
+		return supply, demand.Sub(target, liquidityLater), nil
+	}
+
+	transferable, err := availableTransferableAmount(graphNow, graphLater, net)
+	if err != nil {
+		return nil, nil, err
+	}
+	supply = minBigInt(new(big.Int).Sub(liquidityLater, target), transferable)
+	if supply.Sign() < 0 {
+		supply = big.NewInt(0)
+	}
+	return supply, demand, nil
+}
+
+func (r *MinCostFlowBalancer) targetLiquidity(net models.NetworkSelector) *big.Int {
+	if override, ok := r.config.RebalancerConfig.NetworkTargetOverrides[net]; ok {
+		return override
+	}
+	return r.config.RebalancerConfig.DefaultTarget
+}
+
+// edgeCost returns the cost of a transfer over the edge, fee is nil if bridge fees are not considered.
+func (r *MinCostFlowBalancer) edgeCost(edge models.Edge, fee *big.Int) *big.Int {
+	cost := big.NewInt(0)
+	mcfConfig := r.config.RebalancerConfig.MinCostFlow
+
+	if fee != nil {
+		weightedFee := new(big.Int).Set(fee)
+		if mcfConfig != nil {
+			if multiplier, ok := mcfConfig.FeeMultipliers[edge.Source]; ok {
+				weightedFee.Mul(weightedFee, multiplier)
+			}
+		}
+		cost.Add(cost, weightedFee)
+	}
+
+	if mcfConfig != nil && mcfConfig.LatencyCost != nil {
+		latency := big.NewInt(r.edgeConfigs[edge].ExpectedLatencySec)
+		cost.Add(cost, latency.Mul(latency, mcfConfig.LatencyCost))
+	}
+
+	return cost
+}
+
+// edgeCapacity returns how much more liquidity can be transferred over the edge. Uncapped edges can carry the
+// total supply.
+func (r *MinCostFlowBalancer) edgeCapacity(
+	edge models.Edge, nonExecutedTransfers []UnexecutedTransfer, totalSupply *big.Int) *big.Int {
+	edgeConfig, ok := r.edgeConfigs[edge]
+	if !ok || edgeConfig.MaxInflightAmount == nil {
+		return new(big.Int).Set(totalSupply)
+	}
+
+	capacity := new(big.Int).Set(edgeConfig.MaxInflightAmount)
+	for _, tr := range nonExecutedTransfers {
+		if tr.FromNetwork() == edge.Source && tr.ToNetwork() == edge.Dest {
+			capacity.Sub(capacity, tr.TransferAmount())
+		}
+	}
+	return minBigInt(capacity, totalSupply)
+}
+
+// flowArc is an arc of the residual flow network, every arc has a reverse arc with the negated cost.
+type flowArc struct {
+	to       int
+	rev      int
+	capacity *big.Int
+	flow     *big.Int
+	cost     *big.Int
+	hops     int
+}
+
+func (a *flowArc) residual() *big.Int {
+	return new(big.Int).Sub(a.capacity, a.flow)
+}
+
+// flowNetwork is a flow network with a node per liquidity graph network and a super source and sink.
+type flowNetwork struct {
+	arcs   [][]*flowArc
+	nodes  map[models.NetworkSelector]int
+	source int
+	sink   int
+}
+
+func newFlowNetwork(networks []models.NetworkSelector) *flowNetwork {
+	nodes := make(map[models.NetworkSelector]int, len(networks))
+	for i, net := range networks {
+		nodes[net] = i
+	}
+	return &flowNetwork{
+		arcs:   make([][]*flowArc, len(networks)+2),
+		nodes:  nodes,
+		source: len(networks),
+		sink:   len(networks) + 1,
+	}
+}
+
+func (fn *flowNetwork) addArc(from, to int, capacity, cost *big.Int, hops int) {
+	fn.arcs[from] = append(fn.arcs[from], &flowArc{
+		to: to, rev: len(fn.arcs[to]), capacity: capacity, flow: big.NewInt(0), cost: cost, hops: hops,
+	})
+	fn.arcs[to] = append(fn.arcs[to], &flowArc{
+		to: from, rev: len(fn.arcs[from]) - 1, capacity: big.NewInt(0), flow: big.NewInt(0),
+		cost: new(big.Int).Neg(cost), hops: -hops,
+	})
+}
+
+// pathCost is the cost of a path, compared by cost first and number of hops second.
+type pathCost struct {
+	cost *big.Int
+	hops int
+}
+
+func (c pathCost) add(arc *flowArc) pathCost {
+	return pathCost{cost: new(big.Int).Add(c.cost, arc.cost), hops: c.hops + arc.hops}
+}
+
+func (c pathCost) less(other pathCost) bool {
+	if cmp := c.cost.Cmp(other.cost); cmp != 0 {
+		return cmp < 0
+	}
+	return c.hops < other.hops
+}
+
+// minCostFlow pushes the maximum flow from the source to the sink along successive shortest paths.
+func (fn *flowNetwork) minCostFlow() {
+	for {
+		prevArcs, found := fn.shortestPath()
+		if !found {
+			return
+		}
+
+		var amount *big.Int
+		for node := fn.sink; node != fn.source; {
+			arc := prevArcs[node]
+			if residual := arc.residual(); amount == nil || residual.Cmp(amount) < 0 {
+				amount = residual
+			}
+			node = fn.arcs[arc.to][arc.rev].to
+		}
+
+		for node := fn.sink; node != fn.source; {
+			arc := prevArcs[node]
+			arc.flow.Add(arc.flow, amount)
+			reverse := fn.arcs[arc.to][arc.rev]
+			reverse.flow.Sub(reverse.flow, amount)
+			node = reverse.to
+		}
+	}
+}
+
+// shortestPath finds the cheapest path from the source to the sink over arcs with residual capacity using
+// Bellman-Ford, the reverse arcs have negative costs. It returns the arc used to reach each node of the path.
+func (fn *flowNetwork) shortestPath() (map[int]*flowArc, bool) {
+	dist := make(map[int]pathCost, len(fn.arcs))
+	prevArcs := make(map[int]*flowArc, len(fn.arcs))
+	dist[fn.source] = pathCost{cost: big.NewInt(0)}
+
+	for i := 0; i < len(fn.arcs)-1; i++ {
+		updated := false
+		for node := range fn.arcs {
+			nodeDist, reached := dist[node]
+			if !reached {
+				continue
+			}
+			for _, arc := range fn.arcs[node] {
+				if arc.residual().Sign() <= 0 {
+					continue
+				}
+				candidate := nodeDist.add(arc)
+				if toDist, ok := dist[arc.to]; ok && !candidate.less(toDist) {
+					continue
+				}
+				dist[arc.to] = candidate
+				prevArcs[arc.to] = arc
+				updated = true
+			}
+		}
+		if !updated {
+			break
+		}
+	}
+
+	_, found := dist[fn.sink]
+	return prevArcs, found
+}
+
+// firstHopTransfers decomposes the flow into source to sink paths and returns the first transfer of each path.
+func (fn *flowNetwork) firstHopTransfers(networks []models.NetworkSelector) ([]models.ProposedTransfer, error) {
+	transfers := make([]models.ProposedTransfer, 0)
+	for {
+		path := fn.flowPath()
+		if len(path) == 0 {
+			return transfers, nil
+		}
+		if len(path) < 3 {
+			return nil, fmt.Errorf("flow path %v does not contain a transfer", path)
+		}
+
+		var amount *big.Int
+		for _, arc := range path {
+			if amount == nil || arc.flow.Cmp(amount) < 0 {
+				amount = arc.flow
+			}
+		}
+		amount = new(big.Int).Set(amount)
+		for _, arc := range path {
+			arc.flow.Sub(arc.flow, amount)
+		}
+
+		from := networks[fn.arcs[path[1].to][path[1].rev].to]
+		to := networks[path[1].to]
+		transfers = append(transfers, newTransfer(from, to, amount))
+	}
+}
+
+// flowPath returns the arcs of a source to sink path with positive flow, or nil if the flow is exhausted.
+// A min cost flow has no cycles since every transfer adds a hop to the path cost.
+func (fn *flowNetwork) flowPath() []*flowArc {
+	var path []*flowArc
+	visited := map[int]bool{fn.source: true}
+	for node := fn.source; node != fn.sink; {
+		var next *flowArc
+		for _, arc := range fn.arcs[node] {
+			if arc.hops >= 0 && arc.flow.Sign() > 0 && !visited[arc.to] {
+				next = arc
+				break
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		path = append(path, next)
+		visited[next.to] = true
+		node = next.to
+	}
+	return path
+}
@@ -0,0 +1,275 @@
+package rebalalgo
+
+import (
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/graph"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/models"
+)
+
+func TestMinCostFlowBalancer_ComputeTransfersWithFees(t *testing.T) {
+	fullMesh := []models.Edge{
+		models.NewEdge(eth, arb), models.NewEdge(arb, eth),
+		models.NewEdge(eth, opt), models.NewEdge(opt, eth),
+		models.NewEdge(arb, opt), models.NewEdge(opt, arb),
+	}
+	fees := func(fees map[models.Edge]int64) []models.EdgeFee {
+		res := make([]models.EdgeFee, 0, len(fees))
+		for edge, fee := range fees {
+			res = append(res, models.NewEdgeFee(edge, big.NewInt(fee)))
+		}
+		return res
+	}
+	sameFees := fees(map[models.Edge]int64{
+		models.NewEdge(eth, arb): 10, models.NewEdge(arb, eth): 10,
+		models.NewEdge(eth, opt): 10, models.NewEdge(opt, eth): 10,
+		models.NewEdge(arb, opt): 10, models.NewEdge(opt, arb): 10,
+	})
+
+	testCases := []struct {
+		name             string
+		balances         map[models.NetworkSelector]int64
+		minimums         map[models.NetworkSelector]int64
+		targets          map[models.NetworkSelector]int64
+		edges            []models.Edge
+		fees             []models.EdgeFee
+		edgeConfigs      []models.EdgeConfig
+		feeMultipliers   map[models.NetworkSelector]*big.Int
+		latencyCost      int64
+		pendingTransfers []models.ProposedTransfer
+		expTransfers     []models.ProposedTransfer
+	}{
+		{
+			name:         "balanced",
+			balances:     map[models.NetworkSelector]int64{eth: 1000, arb: 1000, opt: 1000},
+			targets:      map[models.NetworkSelector]int64{eth: 1000, arb: 1000, opt: 1000},
+			edges:        fullMesh,
+			fees:         sameFees,
+			expTransfers: []models.ProposedTransfer{},
+		},
+		{
+			name:     "single surplus and deficit",
+			balances: map[models.NetworkSelector]int64{eth: 1200, arb: 800, opt: 1000},
+			targets:  map[models.NetworkSelector]int64{eth: 1000, arb: 1000, opt: 1000},
+			edges:    fullMesh,
+			fees:     sameFees,
+			expTransfers: []models.ProposedTransfer{
+				{From: eth, To: arb, Amount: ubig.New(big.NewInt(200))},
+			},
+		},
+		{
+			name:     "cheapest source is used",
+			balances: map[models.NetworkSelector]int64{eth: 1300, arb: 800, opt: 1300},
+			targets:  map[models.NetworkSelector]int64{eth: 1000, arb: 1000, opt: 1000},
+			edges:    fullMesh,
+			fees: fees(map[models.Edge]int64{
+				models.NewEdge(eth, arb): 10, models.NewEdge(opt, arb): 5,
+			}),
+			expTransfers: []models.ProposedTransfer{
+				{From: opt, To: arb, Amount: ubig.New(big.NewInt(200))},
+			},
+		},
+		{
+			name:     "fees are converted with the multiplier of the source network",
+			balances: map[models.NetworkSelector]int64{eth: 1300, arb: 800, opt: 1300},
+			targets:  map[models.NetworkSelector]int64{eth: 1000, arb: 1000, opt: 1000},
+			edges:    fullMesh,
+			fees: fees(map[models.Edge]int64{
+				models.NewEdge(eth, arb): 10, models.NewEdge(opt, arb): 5,
+			}),
+			feeMultipliers: map[models.NetworkSelector]*big.Int{opt: big.NewInt(3)},
+			expTransfers: []models.ProposedTransfer{
+				{From: eth, To: arb, Amount: ubig.New(big.NewInt(200))},
+			},
+		},
+		{
+			name:     "edge cap splits the transfer",
+			balances: map[models.NetworkSelector]int64{eth: 1300, arb: 800, opt: 1300},
+			targets:  map[models.NetworkSelector]int64{eth: 1000, arb: 1000, opt: 1000},
+			edges:    fullMesh,
+			fees: fees(map[models.Edge]int64{
+				models.NewEdge(eth, arb): 10, models.NewEdge(opt, arb): 5,
+			}),
+			edgeConfigs: []models.EdgeConfig{{Source: opt, Dest: arb, MaxInflightAmount: big.NewInt(150)}},
+			expTransfers: []models.ProposedTransfer{
+				{From: eth, To: arb, Amount: ubig.New(big.NewInt(50))},
+				{From: opt, To: arb, Amount: ubig.New(big.NewInt(150))},
+			},
+		},
+		{
+			name:     "inflight transfers count towards the edge cap",
+			balances: map[models.NetworkSelector]int64{eth: 1300, arb: 800, opt: 1300},
+			targets:  map[models.NetworkSelector]int64{eth: 1000, arb: 1000, opt: 1000},
+			edges:    fullMesh,
+			fees: fees(map[models.Edge]int64{
+				models.NewEdge(eth, arb): 10, models.NewEdge(opt, arb): 5,
+			}),
+			edgeConfigs: []models.EdgeConfig{{Source: opt, Dest: arb, MaxInflightAmount: big.NewInt(150)}},
+			pendingTransfers: []models.ProposedTransfer{
+				{From: opt, To: arb, Amount: ubig.New(big.NewInt(100)), Status: models.TransferStatusInflight},
+			},
+			expTransfers: []models.ProposedTransfer{
+				{From: eth, To: arb, Amount: ubig.New(big.NewInt(50))},
+				{From: opt, To: arb, Amount: ubig.New(big.NewInt(50))},
+			},
+		},
+		{
+			name:     "latency outweighs a lower fee",
+			balances: map[models.NetworkSelector]int64{eth: 1300, arb: 800, opt: 1300},
+			targets:  map[models.NetworkSelector]int64{eth: 1000, arb: 1000, opt: 1000},
+			edges:    fullMesh,
+			fees: fees(map[models.Edge]int64{
+				models.NewEdge(eth, arb): 10, models.NewEdge(opt, arb): 5,
+			}),
+			edgeConfigs: []models.EdgeConfig{
+				{Source: eth, Dest: arb, ExpectedLatencySec: 60},
+				{Source: opt, Dest: arb, ExpectedLatencySec: 7 * 24 * 60 * 60},
+			},
+			latencyCost: 1,
+			expTransfers: []models.ProposedTransfer{
+				{From: eth, To: arb, Amount: ubig.New(big.NewInt(200))},
+			},
+		},
+		{
+			name:     "only the first hop of a multi hop path is proposed",
+			balances: map[models.NetworkSelector]int64{eth: 1000, arb: 800, opt: 1200},
+			targets:  map[models.NetworkSelector]int64{eth: 1000, arb: 1000, opt: 1000},
+			edges: []models.Edge{
+				models.NewEdge(eth, arb), models.NewEdge(arb, eth),
+				models.NewEdge(eth, opt), models.NewEdge(opt, eth),
+			},
+			fees: sameFees,
+			expTransfers: []models.ProposedTransfer{
+				{From: opt, To: eth, Amount: ubig.New(big.NewInt(200))},
+			},
+		},
+		{
+			name:     "direct path is preferred over a multi hop path of the same cost",
+			balances: map[models.NetworkSelector]int64{eth: 1000, arb: 800, opt: 1200},
+			targets:  map[models.NetworkSelector]int64{eth: 1000, arb: 1000, opt: 1000},
+			edges:    fullMesh,
+			fees: fees(map[models.Edge]int64{
+				models.NewEdge(opt, eth): 0, models.NewEdge(eth, arb): 0, models.NewEdge(opt, arb): 0,
+			}),
+			expTransfers: []models.ProposedTransfer{
+				{From: opt, To: arb, Amount: ubig.New(big.NewInt(200))},
+			},
+		},
+		{
+			name:         "edges without a fee are not used",
+			balances:     map[models.NetworkSelector]int64{eth: 1200, arb: 800, opt: 1000},
+			targets:      map[models.NetworkSelector]int64{eth: 1000, arb: 1000, opt: 1000},
+			edges:        fullMesh,
+			fees:         fees(map[models.Edge]int64{models.NewEdge(arb, eth): 10}),
+			expTransfers: []models.ProposedTransfer{},
+		},
+		{
+			name:     "minimum liquidity limits the supply",
+			balances: map[models.NetworkSelector]int64{eth: 1200, arb: 800, opt: 1000},
+			minimums: map[models.NetworkSelector]int64{eth: 1100},
+			targets:  map[models.NetworkSelector]int64{eth: 1000, arb: 1000, opt: 1000},
+			edges:    fullMesh,
+			fees:     sameFees,
+			expTransfers: []models.ProposedTransfer{
+				{From: eth, To: arb, Amount: ubig.New(big.NewInt(100))},
+			},
+		},
+		{
+			name:         "rebalancing is disabled when the target is zero",
+			balances:     map[models.NetworkSelector]int64{eth: 1200, arb: 800, opt: 1000},
+			targets:      map[models.NetworkSelector]int64{eth: 1000, arb: 0, opt: 1000},
+			edges:        fullMesh,
+			fees:         sameFees,
+			expTransfers: []models.ProposedTransfer{},
+		},
+	}
+
+	lggr := logger.TestLogger(t)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			overrides := make(map[models.NetworkSelector]*big.Int)
+			g := graph.NewGraph()
+			for net, b := range tc.balances {
+				g.(graph.GraphTest).AddNetwork(net, graph.Data{
+					Liquidity:        big.NewInt(b),
+					NetworkSelector:  net,
+					MinimumLiquidity: big.NewInt(tc.minimums[net]),
+				})
+				overrides[net] = big.NewInt(tc.targets[net])
+			}
+			for _, edge := range tc.edges {
+				assert.NoError(t, g.(graph.GraphTest).AddConnection(edge.Source, edge.Dest))
+			}
+
+			r := NewMinCostFlowBalancer(lggr, models.PluginConfig{
+				RebalancerConfig: models.RebalancerConfig{
+					Type:                   models.RebalancerTypeMinCostFlow,
+					DefaultTarget:          big.NewInt(1000),
+					NetworkTargetOverrides: overrides,
+					MinCostFlow: &models.MinCostFlowConfig{
+						LatencyCost:    big.NewInt(tc.latencyCost),
+						FeeMultipliers: tc.feeMultipliers,
+						Edges:          tc.edgeConfigs,
+					},
+				},
+			})
+
+			unexecuted := make([]UnexecutedTransfer, 0, len(tc.pendingTransfers))
+			for _, tr := range tc.pendingTransfers {
+				unexecuted = append(unexecuted, tr)
+			}
+			transfersToBalance, err := r.ComputeTransfersWithFees(g, unexecuted, tc.fees)
+			require.NoError(t, err)
+
+			sort.Sort(models.ProposedTransfers(tc.expTransfers))
+			require.Len(t, transfersToBalance, len(tc.expTransfers))
+			for i, tr := range tc.expTransfers {
+				assert.Equal(t, tr.From, transfersToBalance[i].From)
+				assert.Equal(t, tr.To, transfersToBalance[i].To)
+				assert.Equal(t, tr.Amount.Int64(), transfersToBalance[i].Amount.Int64())
+			}
+		})
+	}
+}
+
+func TestMinCostFlowBalancer_ComputeTransfersToBalance(t *testing.T) {
+	g := graph.NewGraph()
+	for net, b := range map[models.NetworkSelector]int64{eth: 1300, arb: 800, opt: 1300} {
+		g.(graph.GraphTest).AddNetwork(net, graph.Data{
+			Liquidity:        big.NewInt(b),
+			NetworkSelector:  net,
+			MinimumLiquidity: big.NewInt(0),
+		})
+	}
+	require.NoError(t, g.(graph.GraphTest).AddConnection(eth, arb))
+	require.NoError(t, g.(graph.GraphTest).AddConnection(opt, arb))
+
+	r := NewMinCostFlowBalancer(logger.TestLogger(t), models.PluginConfig{
+		RebalancerConfig: models.RebalancerConfig{
+			Type:          models.RebalancerTypeMinCostFlow,
+			DefaultTarget: big.NewInt(1000),
+			MinCostFlow: &models.MinCostFlowConfig{
+				LatencyCost: big.NewInt(1),
+				Edges: []models.EdgeConfig{
+					{Source: eth, Dest: arb, ExpectedLatencySec: 20 * 60},
+					{Source: opt, Dest: arb, ExpectedLatencySec: 60},
+				},
+			},
+		},
+	})
+
+	// without fees the edges are only weighted by their latency
+	transfers, err := r.ComputeTransfersToBalance(g, nil)
+	require.NoError(t, err)
+	require.Len(t, transfers, 1)
+	assert.Equal(t, opt, transfers[0].From)
+	assert.Equal(t, arb, transfers[0].To)
+	assert.Equal(t, int64(200), transfers[0].Amount.Int64())
+}
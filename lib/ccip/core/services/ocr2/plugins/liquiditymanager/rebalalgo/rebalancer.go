@@ -27,3 +27,15 @@ type RebalancingAlgo interface {
 		unexecuted []UnexecutedTransfer,
 	) ([]models.ProposedTransfer, error)
 }
+
+// FeeAwareRebalancingAlgo is a RebalancingAlgo that can also weight the graph edges by their bridge fees.
+type FeeAwareRebalancingAlgo interface {
+	RebalancingAlgo
+	// ComputeTransfersWithFees is like ComputeTransfersToBalance, but the cost of transferring over an
+	// edge also accounts for the provided bridge fees. Edges without a fee are not used.
+	ComputeTransfersWithFees(
+		g graph.Graph,
+		unexecuted []UnexecutedTransfer,
+		fees []models.EdgeFee,
+	) ([]models.ProposedTransfer, error)
+}
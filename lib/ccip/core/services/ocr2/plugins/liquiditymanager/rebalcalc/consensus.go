@@ -20,15 +20,24 @@ func bft(f int) int {
 }
 
 // MedianLiquidityPerChain returns the median liquidity per chain from the provided observations.
+// If at least f+1 observers projected the liquidity of a chain, the median accrual rate and projected liquidity
+// are returned as well.
 func MedianLiquidityPerChain(observations []models.Observation, f int) ([]models.NetworkLiquidity, error) {
 	if len(observations) < quorum(f) {
 		return nil, fmt.Errorf("need at least 2f+1 observations (ocr3types.QuorumTwoFPlusOne) to reach consensus, got: %d", len(observations))
 	}
 
 	liqObsPerChain := make(map[models.NetworkSelector][]*big.Int)
+	accrualRateObsPerChain := make(map[models.NetworkSelector][]*big.Int)
+	projectedLiqObsPerChain := make(map[models.NetworkSelector][]*big.Int)
 	for _, ob := range observations {
 		for _, chainLiq := range ob.LiquidityPerChain {
 			liqObsPerChain[chainLiq.Network] = append(liqObsPerChain[chainLiq.Network], chainLiq.Liquidity.ToInt())
+			if chainLiq.AccrualRate != nil && chainLiq.ProjectedLiquidity != nil {
+				net := chainLiq.Network
+				accrualRateObsPerChain[net] = append(accrualRateObsPerChain[net], chainLiq.AccrualRate.ToInt())
+				projectedLiqObsPerChain[net] = append(projectedLiqObsPerChain[net], chainLiq.ProjectedLiquidity.ToInt())
+			}
 		}
 	}
 
@@ -41,6 +50,11 @@ func MedianLiquidityPerChain(observations []models.Observation, f int) ([]models
 
 	medians := make([]models.NetworkLiquidity, 0, len(liqObsPerChain))
 	for chainID, liqs := range liqObsPerChain {
+		if projectedLiqs := projectedLiqObsPerChain[chainID]; len(projectedLiqs) >= bft(f) {
+			medians = append(medians, models.NewProjectedNetworkLiquidity(chainID, BigIntSortedMiddle(liqs),
+				BigIntSortedMiddle(accrualRateObsPerChain[chainID]), BigIntSortedMiddle(projectedLiqs)))
+			continue
+		}
 		medians = append(medians, models.NewNetworkLiquidity(chainID, BigIntSortedMiddle(liqs)))
 	}
 
@@ -197,3 +211,34 @@ func GraphEdgesConsensus(observations []models.Observation, f int) ([]models.Edg
 
 	return quorumEdges, nil
 }
+
+// EdgeFeesConsensus returns the median bridge fee of the edges that have been observed by at least f+1 observers.
+func EdgeFeesConsensus(observations []models.Observation, f int) ([]models.EdgeFee, error) {
+	if len(observations) < quorum(f) {
+		return nil, fmt.Errorf("need at least 2f+1 observations (ocr3types.QuorumTwoFPlusOne) to reach consensus, got: %d", len(observations))
+	}
+
+	feesPerEdge := make(map[models.Edge][]*big.Int)
+	for _, obs := range observations {
+		for _, edgeFee := range obs.EdgeFees {
+			feesPerEdge[edgeFee.Edge] = append(feesPerEdge[edgeFee.Edge], edgeFee.Fee.ToInt())
+		}
+	}
+
+	quorumFees := make([]models.EdgeFee, 0, len(feesPerEdge))
+	for edge, fees := range feesPerEdge {
+		if len(fees) >= bft(f) {
+			quorumFees = append(quorumFees, models.NewEdgeFee(edge, BigIntSortedMiddle(fees)))
+		}
+	}
+
+	// sort for deterministic results
+	sort.Slice(quorumFees, func(i, j int) bool {
+		if quorumFees[i].Source == quorumFees[j].Source {
+			return quorumFees[i].Dest < quorumFees[j].Dest
+		}
+		return quorumFees[i].Source < quorumFees[j].Source
+	})
+
+	return quorumFees, nil
+}
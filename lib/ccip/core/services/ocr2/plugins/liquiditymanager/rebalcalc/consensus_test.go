@@ -90,6 +90,34 @@ func TestMedianLiquidityPerChain(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"projected liquidity",
+			args{[]models.Observation{
+				{
+					LiquidityPerChain: []models.NetworkLiquidity{
+						{Network: 1, Liquidity: ubig.NewI(100), AccrualRate: ubig.NewI(10), ProjectedLiquidity: ubig.NewI(110)},
+						{Network: 2, Liquidity: ubig.NewI(100), AccrualRate: ubig.NewI(10), ProjectedLiquidity: ubig.NewI(110)},
+					},
+				},
+				{
+					LiquidityPerChain: []models.NetworkLiquidity{
+						{Network: 1, Liquidity: ubig.NewI(102), AccrualRate: ubig.NewI(20), ProjectedLiquidity: ubig.NewI(122)},
+						{Network: 2, Liquidity: ubig.NewI(102)},
+					},
+				},
+				{
+					LiquidityPerChain: []models.NetworkLiquidity{
+						{Network: 1, Liquidity: ubig.NewI(101), AccrualRate: ubig.NewI(15), ProjectedLiquidity: ubig.NewI(116)},
+						{Network: 2, Liquidity: ubig.NewI(101)},
+					},
+				},
+			}, 1},
+			[]models.NetworkLiquidity{
+				{Network: 1, Liquidity: ubig.NewI(101), AccrualRate: ubig.NewI(15), ProjectedLiquidity: ubig.NewI(116)},
+				{Network: 2, Liquidity: ubig.NewI(101)}, // not enough projections
+			},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -414,3 +442,50 @@ func TestInflightTransfersConsensus(t *testing.T) {
 		})
 	}
 }
+
+func TestEdgeFeesConsensus(t *testing.T) {
+	edgeFee := func(source, dest models.NetworkSelector, fee int64) models.EdgeFee {
+		return models.EdgeFee{Edge: models.NewEdge(source, dest), Fee: ubig.NewI(fee)}
+	}
+	tests := []struct {
+		name         string
+		observations []models.Observation
+		f            int
+		want         []models.EdgeFee
+		wantErr      bool
+	}{
+		{
+			name:         "not enough observations",
+			observations: []models.Observation{{}, {}},
+			f:            1,
+			wantErr:      true,
+		},
+		{
+			name:         "no fees observed",
+			observations: []models.Observation{{}, {}, {}},
+			f:            1,
+			want:         []models.EdgeFee{},
+		},
+		{
+			name: "median fee of the edges with enough observations",
+			observations: []models.Observation{
+				{EdgeFees: []models.EdgeFee{edgeFee(2, 1, 7), edgeFee(1, 2, 10), edgeFee(3, 1, 1)}},
+				{EdgeFees: []models.EdgeFee{edgeFee(1, 2, 30), edgeFee(2, 1, 5)}},
+				{EdgeFees: []models.EdgeFee{edgeFee(1, 2, 20)}},
+			},
+			f:    1,
+			want: []models.EdgeFee{edgeFee(1, 2, 20), edgeFee(2, 1, 7)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EdgeFeesConsensus(tt.observations, tt.f)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
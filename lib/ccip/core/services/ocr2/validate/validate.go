@@ -314,6 +314,9 @@ func validateLiquidityManagerSpec(jsonConfig job.JSONConfig) error {
 	if err := liquiditymanagermodels.ValidateRebalancerConfig(pluginConfig.RebalancerConfig); err != nil {
 		return fmt.Errorf("rebalancer config invalid: %w", err)
 	}
+	if err := liquiditymanagermodels.ValidateCCIPLanes(pluginConfig.CCIPLanes); err != nil {
+		return fmt.Errorf("ccip lanes config invalid: %w", err)
+	}
 	return nil
 }
 
@@ -0,0 +1,196 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// SimulationFixtures holds the stubbed values used in place of task types that
+// would otherwise reach out to the network, a bridge or a chain.
+type SimulationFixtures struct {
+	// Vars are the initial pipeline variables, e.g. "jobRun" or "jobSpec".
+	Vars map[string]interface{} `json:"vars"`
+	// Tasks maps a task's dot ID to the result it should produce.
+	Tasks map[string]TaskFixture `json:"tasks"`
+}
+
+// TaskFixture is the stubbed result of a single task.
+type TaskFixture struct {
+	Value interface{} `json:"value"`
+	Error string      `json:"error"`
+}
+
+// ParseSimulationFixtures decodes fixtures from JSON.
+func ParseSimulationFixtures(bs []byte) (SimulationFixtures, error) {
+	var fixtures SimulationFixtures
+	if len(strings.TrimSpace(string(bs))) == 0 {
+		return fixtures, nil
+	}
+	if err := json.Unmarshal(bs, &fixtures); err != nil {
+		return fixtures, errors.Wrap(err, "failed to parse simulation fixtures")
+	}
+	return fixtures, nil
+}
+
+// SimulatedTaskRun is the outcome of running one task during a simulation.
+type SimulatedTaskRun struct {
+	DotID   string
+	Type    TaskType
+	Inputs  []Result
+	Result  Result
+	Stubbed bool
+	Elapsed time.Duration
+}
+
+// requiresFixture reports whether the task type cannot run without external
+// dependencies and must therefore be stubbed with a fixture.
+func requiresFixture(taskType TaskType) bool {
+	switch taskType {
+	case TaskTypeHTTP, TaskTypeBridge, TaskTypeETHCall, TaskTypeETHTx, TaskTypeEstimateGasLimit,
+		TaskTypeVRF, TaskTypeVRFV2, TaskTypeVRFV2Plus:
+		return true
+	default:
+		return false
+	}
+}
+
+// Simulate runs the pipeline locally in topological order. Tasks that have a
+// fixture return the stubbed value; network-bound tasks without a fixture fail
+// with an error instead of being executed. Results are returned in the order
+// the tasks were run.
+func Simulate(ctx context.Context, lggr logger.Logger, p *Pipeline, fixtures SimulationFixtures) ([]SimulatedTaskRun, error) {
+	vars := NewVarsFrom(nil)
+	for k, v := range fixtures.Vars {
+		if err := vars.Set(k, v); err != nil {
+			return nil, errors.Wrapf(err, "failed to set var %s", k)
+		}
+	}
+
+	for dotID := range fixtures.Tasks {
+		if p.ByDotID(dotID) == nil {
+			return nil, errors.Errorf("fixture references unknown task %q", dotID)
+		}
+	}
+
+	results := make(map[int]Result, len(p.Tasks))
+	runs := make([]SimulatedTaskRun, 0, len(p.Tasks))
+
+	// p.Tasks is already topologically sorted by Parse.
+	for _, task := range p.Tasks {
+		inputs := simulationInputs(task, results)
+
+		start := time.Now()
+		var result Result
+		fixture, stubbed := fixtures.Tasks[task.DotID()]
+		switch {
+		case stubbed:
+			result = fixture.result(task.Type())
+		case requiresFixture(task.Type()):
+			result = Result{Error: errors.Errorf("no fixture provided for %s task", task.Type())}
+		default:
+			result = runSimulatedTask(ctx, lggr, task, vars.Copy(), inputs)
+		}
+
+		results[task.ID()] = result
+		if result.Error != nil {
+			if err := vars.Set(task.DotID(), result.Error); err != nil {
+				return nil, errors.Wrapf(err, "failed to store result of %s", task.DotID())
+			}
+		} else {
+			if err := vars.Set(task.DotID(), result.Value); err != nil {
+				return nil, errors.Wrapf(err, "failed to store result of %s", task.DotID())
+			}
+		}
+
+		runs = append(runs, SimulatedTaskRun{
+			DotID:   task.DotID(),
+			Type:    task.Type(),
+			Inputs:  inputs,
+			Result:  result,
+			Stubbed: stubbed,
+			Elapsed: time.Since(start),
+		})
+	}
+
+	return runs, nil
+}
+
+// simulationInputs mirrors scheduler.newMemoryTaskRun: only propagated inputs
+// are passed on, sorted by their output index.
+func simulationInputs(task Task, results map[int]Result) []Result {
+	type input struct {
+		index  int32
+		result Result
+	}
+	var inputs []input
+	for _, i := range task.Inputs() {
+		if i.PropagateResult {
+			inputs = append(inputs, input{index: i.InputTask.OutputIndex(), result: results[i.InputTask.ID()]})
+		}
+	}
+	sort.Slice(inputs, func(i, j int) bool {
+		return inputs[i].index < inputs[j].index
+	})
+	out := make([]Result, len(inputs))
+	for i, input := range inputs {
+		out[i] = input.result
+	}
+	return out
+}
+
+func runSimulatedTask(ctx context.Context, lggr logger.Logger, task Task, vars Vars, inputs []Result) (result Result) {
+	defer func() {
+		if err := recover(); err != nil {
+			result = Result{Error: ErrRunPanicked{err}}
+		}
+	}()
+
+	if timeout, isSet := task.TaskTimeout(); isSet && timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	result, _ = task.Run(ctx, lggr, vars, inputs)
+	return result
+}
+
+// result converts the fixture into the value type the real task would produce:
+// HTTP and bridge tasks return the response body as a string and eth_call
+// returns raw bytes.
+func (f TaskFixture) result(taskType TaskType) Result {
+	if f.Error != "" {
+		return Result{Error: errors.New(f.Error)}
+	}
+
+	switch taskType {
+	case TaskTypeHTTP, TaskTypeBridge:
+		if s, ok := f.Value.(string); ok {
+			return Result{Value: s}
+		}
+		bs, err := json.Marshal(f.Value)
+		if err != nil {
+			return Result{Error: errors.Wrap(err, "failed to encode fixture")}
+		}
+		return Result{Value: string(bs)}
+	case TaskTypeETHCall:
+		s, ok := f.Value.(string)
+		if !ok {
+			return Result{Error: errors.Errorf("eth_call fixture must be a hex string, got %T", f.Value)}
+		}
+		bs, err := hexutil.Decode(s)
+		if err != nil {
+			return Result{Error: errors.Wrap(err, "failed to decode eth_call fixture")}
+		}
+		return Result{Value: bs}
+	default:
+		return Result{Value: f.Value}
+	}
+}
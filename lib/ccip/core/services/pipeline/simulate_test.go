@@ -0,0 +1,103 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestSimulate(t *testing.T) {
+	t.Parallel()
+
+	spec := `
+ds1          [type=http method=GET url="https://example.com/price"];
+ds1_parse    [type=jsonparse path="data,price"];
+ds1_multiply [type=multiply times=100];
+call         [type=ethcall contract="0x0000000000000000000000000000000000000001" data="0x"];
+ds1 -> ds1_parse -> ds1_multiply;
+`
+	p, err := pipeline.Parse(spec)
+	require.NoError(t, err)
+
+	byDotID := func(runs []pipeline.SimulatedTaskRun) map[string]pipeline.SimulatedTaskRun {
+		m := make(map[string]pipeline.SimulatedTaskRun, len(runs))
+		for _, run := range runs {
+			m[run.DotID] = run
+		}
+		return m
+	}
+
+	t.Run("runs tasks in topological order with stubbed inputs", func(t *testing.T) {
+		fixtures, err := pipeline.ParseSimulationFixtures([]byte(`{
+			"tasks": {
+				"ds1": {"value": {"data": {"price": 1.5}}},
+				"call": {"value": "0x01ff"}
+			}
+		}`))
+		require.NoError(t, err)
+
+		runs, err := pipeline.Simulate(testutils.Context(t), logger.TestLogger(t), p, fixtures)
+		require.NoError(t, err)
+		require.Len(t, runs, 4)
+
+		order := make(map[string]int)
+		for i, run := range runs {
+			order[run.DotID] = i
+		}
+		assert.Less(t, order["ds1"], order["ds1_parse"])
+		assert.Less(t, order["ds1_parse"], order["ds1_multiply"])
+
+		results := byDotID(runs)
+		assert.True(t, results["ds1"].Stubbed)
+		assert.Equal(t, `{"data":{"price":1.5}}`, results["ds1"].Result.Value)
+		assert.Equal(t, []byte{0x01, 0xff}, results["call"].Result.Value)
+
+		multiply := results["ds1_multiply"]
+		assert.False(t, multiply.Stubbed)
+		require.NoError(t, multiply.Result.Error)
+		assert.Equal(t, "150", multiply.Result.Value.(decimal.Decimal).String())
+		require.Len(t, multiply.Inputs, 1)
+	})
+
+	t.Run("errors network tasks without fixtures", func(t *testing.T) {
+		runs, err := pipeline.Simulate(testutils.Context(t), logger.TestLogger(t), p, pipeline.SimulationFixtures{})
+		require.NoError(t, err)
+		require.Len(t, runs, 4)
+
+		results := byDotID(runs)
+		assert.ErrorContains(t, results["ds1"].Result.Error, "no fixture provided")
+		assert.ErrorContains(t, results["call"].Result.Error, "no fixture provided")
+	})
+
+	t.Run("fixture errors are propagated", func(t *testing.T) {
+		fixtures := pipeline.SimulationFixtures{Tasks: map[string]pipeline.TaskFixture{
+			"ds1": {Error: "connection refused"},
+		}}
+		runs, err := pipeline.Simulate(testutils.Context(t), logger.TestLogger(t), p, fixtures)
+		require.NoError(t, err)
+		require.Len(t, runs, 4)
+
+		results := byDotID(runs)
+		require.Contains(t, results, "ds1")
+		assert.True(t, results["ds1"].Stubbed)
+		assert.EqualError(t, results["ds1"].Result.Error, "connection refused")
+		require.Contains(t, results, "ds1_parse")
+		assert.Error(t, results["ds1_parse"].Result.Error)
+		require.Contains(t, results, "ds1_multiply")
+		assert.Error(t, results["ds1_multiply"].Result.Error)
+	})
+
+	t.Run("rejects fixtures for unknown tasks", func(t *testing.T) {
+		fixtures := pipeline.SimulationFixtures{Tasks: map[string]pipeline.TaskFixture{
+			"nope": {Value: "x"},
+		}}
+		_, err := pipeline.Simulate(testutils.Context(t), logger.TestLogger(t), p, fixtures)
+		assert.ErrorContains(t, err, `unknown task "nope"`)
+	})
+}
@@ -915,6 +915,11 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 	var checker txm.TransmitCheckerSpec
 	if relayConfig.SimulateTransactions {
 		checker.CheckerType = txm.TransmitCheckerTypeSimulate
+		switch commontypes.OCR2PluginType(rargs.ProviderType) {
+		case commontypes.CCIPCommit, commontypes.CCIPExecution:
+			// CCIP reports are only dropped if they would revert for a reason retrying cannot fix
+			checker.CheckerType = txm.TransmitCheckerTypeCCIP
+		}
 	}
 
 	gasLimit := configWatcher.chain.Config().EVM().GasEstimator().LimitDefault()
@@ -24,6 +24,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/liquiditymanager/generated/no_op_ocr3"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/estimatorconfig"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/bridge"
 	evmliquiditymanager "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/chain/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/liquiditymanager/discoverer"
@@ -75,7 +77,7 @@ func NewRebalancerRelayer(
 
 // NewRebalancerProvider implements RebalancerRelayer.
 func (r *rebalancerRelayer) NewRebalancerProvider(ctx context.Context, rargs commontypes.RelayArgs, pargs commontypes.PluginArgs) (RebalancerProvider, error) {
-	configWatcher, lmContracts, lmFactory, discovererFactory, bridgeFactory, err := newRebalancerConfigProvider(r.lggr, r.chains, rargs)
+	configWatcher, lmContracts, lmFactory, discovererFactory, bridgeFactory, err := newRebalancerConfigProvider(r.lggr, r.chains, rargs, pargs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create config watcher: %w", err)
 	}
@@ -181,6 +183,7 @@ func newRebalancerConfigProvider(
 	lggr logger.Logger,
 	chains legacyevm.LegacyChainContainer,
 	rargs commontypes.RelayArgs,
+	pargs commontypes.PluginArgs,
 ) (
 	*configWatcher,
 	map[commontypes.RelayID]common.Address,
@@ -197,6 +200,11 @@ func newRebalancerConfigProvider(
 	if !common.IsHexAddress(rargs.ContractID) {
 		return nil, nil, nil, nil, nil, fmt.Errorf("invalid contract address %s", rargs.ContractID)
 	}
+	var pluginConfig models.PluginConfig
+	err = json.Unmarshal(pargs.PluginConfig, &pluginConfig)
+	if err != nil {
+		return nil, nil, nil, nil, nil, fmt.Errorf("failed to unmarshal plugin config (%s): %w", string(pargs.PluginConfig), err)
+	}
 
 	var lmFactoryOpts []evmliquiditymanager.Opt
 	var discovererOpts []discoverer.Opt
@@ -289,6 +297,11 @@ func newRebalancerConfigProvider(
 			bridgeAdapters,
 		))
 	}
+	ccipLaneOpts, err := newCCIPLaneBridgeOpts(lggr, chains, pluginConfig.CCIPLanes)
+	if err != nil {
+		return nil, nil, nil, nil, nil, fmt.Errorf("failed to create ccip lane bridges: %w", err)
+	}
+	bridgeOpts = append(bridgeOpts, ccipLaneOpts...)
 	bridgeFactory := bridge.NewFactory(lggr, bridgeOpts...)
 
 	mcct, err := ocr3impls.NewMultichainConfigTracker(
@@ -322,3 +335,60 @@ func newRebalancerConfigProvider(
 		rargs.New,
 	), mcct.GetContractAddresses(), lmFactory, discovererFactory, bridgeFactory, nil
 }
+
+// newCCIPLaneBridgeOpts returns the bridge options of the CCIP lanes of the plugin config, the onRamp reader is
+// created on the source chain and the offRamp reader on the dest chain of each lane.
+func newCCIPLaneBridgeOpts(
+	lggr logger.Logger,
+	chains legacyevm.LegacyChainContainer,
+	lanes []models.CCIPLaneConfig,
+) ([]bridge.Opt, error) {
+	legacyChain := func(selector models.NetworkSelector) (legacyevm.Chain, error) {
+		chain, ok := chainsel.ChainBySelector(uint64(selector))
+		if !ok {
+			return nil, fmt.Errorf("chain selector for network %d not found", selector)
+		}
+		return chains.Get(strconv.FormatUint(chain.EvmChainID, 10))
+	}
+
+	versionFinder := ccip.NewEvmVersionFinder()
+	var opts []bridge.Opt
+	for _, lane := range lanes {
+		sourceChain, err := legacyChain(lane.Source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get source chain of lane %d -> %d: %w", lane.Source, lane.Dest, err)
+		}
+		destChain, err := legacyChain(lane.Dest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get dest chain of lane %d -> %d: %w", lane.Source, lane.Dest, err)
+		}
+		onRampReader, err := ccip.NewOnRampReader(
+			lggr,
+			versionFinder,
+			uint64(lane.Source),
+			uint64(lane.Dest),
+			ccip.EvmAddrToGeneric(common.Address(lane.OnRamp)),
+			sourceChain.LogPoller(),
+			sourceChain.Client(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create onRamp reader of lane %d -> %d: %w", lane.Source, lane.Dest, err)
+		}
+		offRampReader, err := ccip.NewOffRampReader(
+			lggr,
+			versionFinder,
+			ccip.EvmAddrToGeneric(common.Address(lane.OffRamp)),
+			destChain.Client(),
+			destChain.LogPoller(),
+			destChain.GasEstimator(),
+			destChain.Config().EVM().GasEstimator().PriceMax().ToInt(),
+			true,
+			estimatorconfig.NewFeeEstimatorConfigService(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create offRamp reader of lane %d -> %d: %w", lane.Source, lane.Dest, err)
+		}
+		opts = append(opts, bridge.WithCCIPLane(lane.Source, lane.Dest, lane.Router, onRampReader, offRampReader))
+	}
+	return opts, nil
+}
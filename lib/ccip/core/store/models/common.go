@@ -258,6 +258,20 @@ type SendEtherRequest struct {
 	WaitAttemptTimeout *time.Duration `json:"waitAttemptTimeout"`
 }
 
+// SpeedUpEVMTxRequest represents a request to rebroadcast an unconfirmed EVM
+// transaction at the same nonce with a higher fee. TipCap is only used by
+// EIP-1559 transactions, where it is required.
+type SpeedUpEVMTxRequest struct {
+	FeeCap *assets.Wei `json:"feeCap"`
+	TipCap *assets.Wei `json:"tipCap"`
+}
+
+// ReplaceEVMTxRequest represents a request to rebroadcast an unconfirmed EVM
+// transaction at the same nonce with a different gas limit.
+type ReplaceEVMTxRequest struct {
+	GasLimit uint64 `json:"gasLimit"`
+}
+
 // AddressCollection is an array of common.Address
 // serializable to and from a database.
 type AddressCollection []common.Address
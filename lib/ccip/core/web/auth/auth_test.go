@@ -245,6 +245,9 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/tx_attempts/evm", true, true, true},
 	{"GET", "/v2/transactions/evm", true, true, true},
 	{"GET", "/v2/transactions/evm/MOCK", true, true, true},
+	{"POST", "/v2/transactions/evm/MOCK/cancel", false, false, false},
+	{"POST", "/v2/transactions/evm/MOCK/speedup", false, false, false},
+	{"POST", "/v2/transactions/evm/MOCK/replace", false, false, false},
 	{"GET", "/v2/transactions", true, true, true},
 	{"GET", "/v2/transactions/MOCK", true, true, true},
 	{"POST", "/v2/replay_from_block/MOCK", false, true, true},
@@ -1,6 +1,9 @@
 package web
 
 import (
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
@@ -8,7 +11,32 @@ import (
 
 func NewEVMNodesController(app chainlink.Application) NodesController {
 	scopedNodeStatuser := NewNetworkScopedNodeStatuser(app.GetRelayers(), relay.NetworkEVM)
+	newResource := func(status types.NodeStatus) presenters.EVMNodeResource {
+		r := presenters.NewEVMNodeResource(status)
+		r.Score = evmNodeScore(app.GetRelayers().LegacyEVMChains(), status)
+		return r
+	}
 
 	return newNodesController[presenters.EVMNodeResource](
-		scopedNodeStatuser, ErrEVMNotEnabled, presenters.NewEVMNodeResource, app.GetAuditLogger())
+		scopedNodeStatuser, ErrEVMNotEnabled, newResource, app.GetAuditLogger())
+}
+
+// evmNodeScore returns the latency-weighted score of the node, or nil if the node is not running or not scored yet.
+func evmNodeScore(chains legacyevm.LegacyChainContainer, status types.NodeStatus) *float64 {
+	if chains == nil {
+		return nil
+	}
+	chain, err := chains.Get(status.ChainID)
+	if err != nil {
+		return nil
+	}
+	stats, ok := chain.Client().NodeStats()[status.Name]
+	if !ok {
+		return nil
+	}
+	score, ok := stats.Score()
+	if !ok {
+		return nil
+	}
+	return &score
 }
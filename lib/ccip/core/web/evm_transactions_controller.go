@@ -4,7 +4,13 @@ import (
 	"database/sql"
 	"net/http"
 
+	commontxmgr "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -47,3 +53,166 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// Cancel replaces an unconfirmed Ethereum Transaction with a zero-value
+// transfer to its own sender at the same nonce.
+// Example:
+//
+//	"<application>/transactions/evm/:TxHash/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	attempt, chain, ok := tc.findLatestAttemptAndChain(c)
+	if !ok {
+		return
+	}
+
+	_, err := chain.TxManager().CancelTransaction(c, attempt.TxID)
+	tc.renderReplacement(c, attempt.TxID, "cancel", err)
+}
+
+// SpeedUp rebroadcasts an unconfirmed Ethereum Transaction at the same nonce
+// with the fee cap (and tip cap for EIP-1559 transactions) given by the caller.
+// Example:
+//
+//	"<application>/transactions/evm/:TxHash/speedup"
+func (tc *TransactionsController) SpeedUp(c *gin.Context) {
+	var req models.SpeedUpEVMTxRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	attempt, chain, ok := tc.findLatestAttemptAndChain(c)
+	if !ok {
+		return
+	}
+
+	fee, err := speedUpFee(attempt, req)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	_, err = chain.TxManager().SpeedUpTransaction(c, attempt.TxID, fee)
+	tc.renderReplacement(c, attempt.TxID, "speedup", err)
+}
+
+// Replace rebroadcasts an unconfirmed Ethereum Transaction at the same nonce
+// with the gas limit given by the caller and a bumped fee.
+// Example:
+//
+//	"<application>/transactions/evm/:TxHash/replace"
+func (tc *TransactionsController) Replace(c *gin.Context) {
+	var req models.ReplaceEVMTxRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if req.GasLimit == 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("gasLimit must be greater than zero"))
+		return
+	}
+
+	attempt, chain, ok := tc.findLatestAttemptAndChain(c)
+	if !ok {
+		return
+	}
+
+	_, err := chain.TxManager().ReplaceTransaction(c, attempt.TxID, req.GasLimit)
+	tc.renderReplacement(c, attempt.TxID, "replace", err)
+}
+
+// findLatestAttemptAndChain looks up the transaction that the attempt hash in
+// the path belongs to, and returns its latest attempt along with its chain.
+// It renders an error response and returns false if either can't be found.
+func (tc *TransactionsController) findLatestAttemptAndChain(c *gin.Context) (attempt txmgr.TxAttempt, chain legacyevm.Chain, ok bool) {
+	hash := common.HexToHash(c.Param("TxHash"))
+
+	ethTxAttempt, err := tc.App.TxmStorageService().FindTxAttempt(c, hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return attempt, nil, false
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return attempt, nil, false
+	}
+
+	etx, err := tc.App.TxmStorageService().FindTxWithAttempts(c, ethTxAttempt.TxID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return attempt, nil, false
+	}
+
+	chain, err = getChain(tc.App.GetRelayers().LegacyEVMChains(), etx.ChainID.String())
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return attempt, nil, false
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return attempt, nil, false
+	}
+
+	attempt = etx.TxAttempts[0]
+	attempt.Tx = etx
+	return attempt, chain, true
+}
+
+// renderReplacement renders the latest attempt of the transaction after a
+// cancel, speed-up or replace, or the error that prevented it.
+func (tc *TransactionsController) renderReplacement(c *gin.Context, txID int64, action string, err error) {
+	if errors.Is(err, commontxmgr.ErrTxNotReplaceable) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, errors.Errorf("%s failed: %v", action, err))
+		return
+	}
+
+	etx, err := tc.App.TxmStorageService().FindTxWithAttempts(c, txID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionReplaced, map[string]interface{}{
+		"ethTX":  etx,
+		"action": action,
+	})
+
+	attempt := etx.TxAttempts[0]
+	attempt.Tx = etx
+	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(attempt), "transaction")
+}
+
+// speedUpFee builds the fee requested for speeding up the transaction of the
+// given attempt. Nodes only accept a replacement paying more than the
+// transaction it replaces, so the fee must be higher than the attempt's.
+func speedUpFee(attempt txmgr.TxAttempt, req models.SpeedUpEVMTxRequest) (fee gas.EvmFee, err error) {
+	if req.FeeCap == nil {
+		return fee, errors.New("feeCap is required")
+	}
+	if attempt.TxType != 0x2 {
+		if req.TipCap != nil {
+			return fee, errors.New("tipCap is only supported for EIP-1559 transactions")
+		}
+		if attempt.TxFee.Legacy != nil && req.FeeCap.Cmp(attempt.TxFee.Legacy) <= 0 {
+			return fee, errors.Errorf("feeCap must be higher than the current gas price of %s", attempt.TxFee.Legacy)
+		}
+		return gas.EvmFee{Legacy: req.FeeCap}, nil
+	}
+
+	if req.TipCap == nil {
+		return fee, errors.New("tipCap is required for EIP-1559 transactions")
+	}
+	if req.TipCap.Cmp(req.FeeCap) > 0 {
+		return fee, errors.New("tipCap must not be higher than feeCap")
+	}
+	if attempt.TxFee.ValidDynamic() &&
+		(req.FeeCap.Cmp(attempt.TxFee.DynamicFeeCap) <= 0 || req.TipCap.Cmp(attempt.TxFee.DynamicTipCap) <= 0) {
+		return fee, errors.Errorf("feeCap and tipCap must be higher than the current fee cap of %s and tip cap of %s",
+			attempt.TxFee.DynamicFeeCap, attempt.TxFee.DynamicTipCap)
+	}
+	return gas.EvmFee{DynamicFeeCap: req.FeeCap, DynamicTipCap: req.TipCap}, nil
+}
@@ -1,6 +1,8 @@
 package web_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -10,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
@@ -129,3 +132,77 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_Cancel_NotFound(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Post("/v2/transactions/evm/"+testutils.NewHash().String()+"/cancel", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestTransactionsController_SpeedUp(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	txStore := cltest.NewTestTxStore(t, app.GetDB())
+	client := app.NewHTTPClient(nil)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	speedUp := func(t *testing.T, hash string, req models.SpeedUpEVMTxRequest) *http.Response {
+		body, err := json.Marshal(req)
+		require.NoError(t, err)
+		resp, cleanup := client.Post("/v2/transactions/evm/"+hash+"/speedup", bytes.NewBuffer(body))
+		t.Cleanup(cleanup)
+		return resp
+	}
+
+	t.Run("rejects a fee that does not exceed the current one", func(t *testing.T) {
+		tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, from)
+
+		resp := speedUp(t, tx.TxAttempts[0].Hash.String(), models.SpeedUpEVMTxRequest{FeeCap: tx.TxAttempts[0].TxFee.Legacy})
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("rejects a tip cap for legacy transactions", func(t *testing.T) {
+		tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 2, from)
+
+		req := models.SpeedUpEVMTxRequest{FeeCap: assets.GWei(50), TipCap: assets.GWei(1)}
+		resp := speedUp(t, tx.TxAttempts[0].Hash.String(), req)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("rejects confirmed transactions", func(t *testing.T) {
+		tx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 3, 1, from)
+
+		resp := speedUp(t, tx.TxAttempts[0].Hash.String(), models.SpeedUpEVMTxRequest{FeeCap: assets.GWei(50)})
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+}
+
+func TestTransactionsController_Replace_MissingGasLimit(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	txStore := cltest.NewTestTxStore(t, app.GetDB())
+	client := app.NewHTTPClient(nil)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+	tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, from)
+
+	path := "/v2/transactions/evm/" + tx.TxAttempts[0].Hash.String() + "/replace"
+	resp, cleanup := client.Post(path, bytes.NewBufferString(`{}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
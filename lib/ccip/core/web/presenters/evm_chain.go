@@ -24,6 +24,9 @@ func NewEVMChainResource(chain types.ChainStatus) EVMChainResource {
 // EVMNodeResource is an EVM node JSONAPI resource.
 type EVMNodeResource struct {
 	NodeResource
+	// Score is the latency-weighted score of the node in milliseconds, lower is better.
+	// It is omitted until the node has recorded any poll results.
+	Score *float64 `json:"score,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -33,7 +36,7 @@ func (r EVMNodeResource) GetName() string {
 
 // NewEVMNodeResource returns a new EVMNodeResource for node.
 func NewEVMNodeResource(node types.NodeStatus) EVMNodeResource {
-	return EVMNodeResource{NodeResource{
+	return EVMNodeResource{NodeResource: NodeResource{
 		JAID:    NewPrefixedJAID(node.Name, node.ChainID),
 		ChainID: node.ChainID,
 		Name:    node.Name,
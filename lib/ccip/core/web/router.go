@@ -286,6 +286,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		txs := TransactionsController{app}
 		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
+		authv2.POST("/transactions/evm/:TxHash/cancel", auth.RequiresAdminRole(txs.Cancel))
+		authv2.POST("/transactions/evm/:TxHash/speedup", auth.RequiresAdminRole(txs.SpeedUp))
+		authv2.POST("/transactions/evm/:TxHash/replace", auth.RequiresAdminRole(txs.Replace))
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
@@ -0,0 +1,112 @@
+package contracts
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/contracts/tests/config"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/contracts/tests/testutils"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/common"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/tokens"
+)
+
+func TestToken2022Extensions(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	url := testutils.SetupLocalSolNode(t)
+	client := rpc.New(url)
+
+	admin, err := solana.NewRandomPrivateKey()
+	require.NoError(t, err)
+	testutils.FundAccounts(ctx, []solana.PrivateKey{admin}, client, t)
+
+	decimals := uint8(9)
+	amount := uint64(1_000_000_000_000)
+	rateBps := int16(500) // 5% APR
+
+	mintPriv, err := solana.NewRandomPrivateKey()
+	require.NoError(t, err)
+	mint := mintPriv.PublicKey()
+
+	t.Run("create interest bearing mint with transfer fee and metadata", func(t *testing.T) {
+		ixs, err := tokens.CreateToken2022WithExtensions(ctx, mint, admin.PublicKey(), decimals, tokens.Token2022Extensions{
+			InterestBearing: &tokens.InterestBearingParams{RateAuthority: admin.PublicKey(), RateBps: rateBps},
+			TransferFee:     &tokens.TransferFeeParams{ConfigAuthority: admin.PublicKey(), WithdrawAuthority: admin.PublicKey(), BasisPoints: 10, MaximumFee: 1_000},
+			Metadata:        &tokens.TokenMetadataParams{UpdateAuthority: admin.PublicKey(), Name: "Rebase Token", Symbol: "RBT", URI: "https://example.com/rbt.json"},
+		}, client, config.DefaultCommitment)
+		require.NoError(t, err)
+
+		createI, userATA, err := tokens.CreateAssociatedTokenAccount(solana.Token2022ProgramID, mint, admin.PublicKey(), admin.PublicKey())
+		require.NoError(t, err)
+		mintToI, err := tokens.MintTo(amount, solana.Token2022ProgramID, mint, userATA, admin.PublicKey())
+		require.NoError(t, err)
+
+		ixs = append(ixs, createI, mintToI)
+		testutils.SendAndConfirm(ctx, t, client, ixs, admin, config.DefaultCommitment, common.AddSigners(mintPriv))
+
+		cfg, err := tokens.GetInterestBearingConfig(ctx, client, mint, config.DefaultCommitment)
+		require.NoError(t, err)
+		require.Equal(t, admin.PublicKey(), cfg.RateAuthority)
+		require.Equal(t, rateBps, cfg.CurrentRate)
+		require.Equal(t, cfg.InitializationTimestamp, cfg.LastUpdateTimestamp)
+
+		data, err := client.GetAccountInfoWithOpts(ctx, mint, &rpc.GetAccountInfoOpts{Commitment: config.DefaultCommitment})
+		require.NoError(t, err)
+		_, err = tokens.GetMintExtension(data.Value.Data.GetBinary(), tokens.ExtensionTransferFeeConfig)
+		require.NoError(t, err)
+		_, err = tokens.GetMintExtension(data.Value.Data.GetBinary(), tokens.ExtensionTokenMetadata)
+		require.NoError(t, err)
+	})
+
+	t.Run("ui amount accrues interest", func(t *testing.T) {
+		cfg, err := tokens.GetInterestBearingConfig(ctx, client, mint, config.DefaultCommitment)
+		require.NoError(t, err)
+
+		raw := float64(amount) / 1e9
+		require.InDelta(t, raw, cfg.AmountToUIAmount(amount, decimals, cfg.InitializationTimestamp), 1e-9)
+
+		oneYear := cfg.InitializationTimestamp + int64(60*60*24*365.24)
+		uiAmount := cfg.AmountToUIAmount(amount, decimals, oneYear)
+		require.InDelta(t, raw*1.0512710963760241, uiAmount, 1e-6) // e^0.05
+		require.InDelta(t, float64(amount), float64(cfg.UIAmountToAmount(uiAmount, decimals, oneYear)), 1)
+
+		// compare against the token program's own UI amount
+		userATA, _, err := tokens.FindAssociatedTokenAddress(solana.Token2022ProgramID, mint, admin.PublicKey())
+		require.NoError(t, err)
+		balance, err := client.GetTokenAccountBalance(ctx, userATA, config.DefaultCommitment)
+		require.NoError(t, err)
+		rpcUIAmount, err := strconv.ParseFloat(balance.Value.UiAmountString, 64)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, rpcUIAmount, raw)
+
+		slot, err := client.GetSlot(ctx, config.DefaultCommitment)
+		require.NoError(t, err)
+		blockTime, err := client.GetBlockTime(ctx, slot)
+		require.NoError(t, err)
+		require.InDelta(t, rpcUIAmount, cfg.AmountToUIAmount(amount, decimals, int64(*blockTime)), 1e-3)
+	})
+
+	t.Run("update rate", func(t *testing.T) {
+		ix := tokens.UpdateInterestRate(solana.Token2022ProgramID, mint, admin.PublicKey(), 2*rateBps)
+		testutils.SendAndConfirm(ctx, t, client, []solana.Instruction{ix}, admin, config.DefaultCommitment)
+
+		cfg, err := tokens.GetInterestBearingConfig(ctx, client, mint, config.DefaultCommitment)
+		require.NoError(t, err)
+		require.Equal(t, 2*rateBps, cfg.CurrentRate)
+	})
+
+	t.Run("token pool", func(t *testing.T) {
+		p, err := tokens.NewToken2022Pool(config.CcipTokenPoolProgram, mint)
+		require.NoError(t, err)
+		entries := p.ToTokenPoolEntries()
+		require.Equal(t, solana.Token2022ProgramID, entries[6])
+		require.Equal(t, mint, entries[7])
+	})
+}
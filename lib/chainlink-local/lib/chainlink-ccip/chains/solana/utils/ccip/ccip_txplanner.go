@@ -0,0 +1,310 @@
+package ccip
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_offramp"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/common"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/fees"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/tokens"
+)
+
+const (
+	// MaxTxSize is the maximum size of a serialized solana transaction (IPv6 MTU - headers)
+	MaxTxSize = 1232
+	// MaxComputeUnits is the maximum compute unit limit of a solana transaction
+	MaxComputeUnits = 1_400_000
+
+	// DefaultExecuteBaseComputeUnits is the estimated cost of an execute without tokens or receiver execution, including RMN checks
+	DefaultExecuteBaseComputeUnits = 60_000
+	// DefaultExecutePerTokenComputeUnits is the estimated cost of a single token pool release/mint CPI
+	DefaultExecutePerTokenComputeUnits = 90_000
+
+	lookupTableOverhead = solana.PublicKeyLength + 2 // table address + two compact-u16 index array lengths
+	lookupTableSavings  = solana.PublicKeyLength - 1 // a static key is replaced by a single byte index
+)
+
+// ExecuteAccounts are the accounts required by the offramp execute instruction, independent of the message
+type ExecuteAccounts struct {
+	Config             solana.PublicKey
+	ReferenceAddresses solana.PublicKey
+	SourceChain        solana.PublicKey
+	CommitReport       solana.PublicKey
+	Offramp            solana.PublicKey
+	AllowedOfframp     solana.PublicKey
+	Authority          solana.PublicKey // transmitter, the only signer
+	SystemProgram      solana.PublicKey
+	SysVarInstructions solana.PublicKey
+	RMNRemote          solana.PublicKey
+	RMNRemoteCurses    solana.PublicKey
+	RMNRemoteConfig    solana.PublicKey
+}
+
+// ExecuteTokenEntry contains the accounts for a single token transfer in the message
+type ExecuteTokenEntry struct {
+	Pool             tokens.TokenPool
+	UserTokenAccount solana.PublicKey
+	ChainSelector    uint64
+}
+
+func (e ExecuteTokenEntry) accounts() (solana.AccountMetaSlice, error) {
+	billing, ok := e.Pool.Billing[e.ChainSelector]
+	if !ok {
+		return nil, fmt.Errorf("token pool for mint %s has no billing config for chain %d", e.Pool.Mint, e.ChainSelector)
+	}
+	chainConfig, ok := e.Pool.Chain[e.ChainSelector]
+	if !ok {
+		return nil, fmt.Errorf("token pool for mint %s has no chain config for chain %d", e.Pool.Mint, e.ChainSelector)
+	}
+
+	list := solana.AccountMetaSlice{
+		solana.Meta(e.Pool.OfframpSigner),
+		solana.Meta(e.UserTokenAccount).WRITE(),
+		solana.Meta(billing),
+		solana.Meta(chainConfig).WRITE(),
+	}
+	for i, k := range e.Pool.ToTokenPoolEntries() {
+		meta := solana.Meta(k)
+		if slices.Contains(e.Pool.WritableIndexes, uint8(i)) { //nolint:gosec // pool entries are a small fixed list
+			meta = meta.WRITE()
+		}
+		list = append(list, meta)
+	}
+	return list, nil
+}
+
+// ExecutePlanInput describes a single message execution to plan for
+type ExecutePlanInput struct {
+	Report          ccip_offramp.ExecutionReportSingleChain
+	ReportContext   [2][32]byte
+	MessageAccounts solana.AccountMetaSlice // logic receiver and its accounts, passed before any token accounts
+	Tokens          []ExecuteTokenEntry     // must match the order of Report.Message.TokenAmounts
+}
+
+// ExecutePlan is a transaction layout that fits in a single solana transaction
+type ExecutePlan struct {
+	Instruction   solana.Instruction
+	LookupTables  map[solana.PublicKey]solana.PublicKeySlice
+	Size          int
+	ComputeUnits  fees.ComputeUnitLimit
+	TokenIndexes  []byte
+	RemainingSize int
+}
+
+// TxTooLargeError is returned when no combination of the known lookup tables makes the transaction fit
+type TxTooLargeError struct {
+	Size int
+	// Uncovered are the static accounts that are not part of any lookup table and could be moved into one
+	Uncovered solana.PublicKeySlice
+	// MustMove is the minimum number of uncovered accounts that need to be moved into a lookup table
+	MustMove int
+}
+
+func (e *TxTooLargeError) Error() string {
+	names := common.Map(e.Uncovered, func(k solana.PublicKey) string { return k.String() })
+	if e.MustMove > len(e.Uncovered) {
+		return fmt.Sprintf("transaction size %d exceeds limit %d by %d bytes: moving all %d uncovered accounts into a lookup table is not sufficient [%s]",
+			e.Size, MaxTxSize, e.Size-MaxTxSize, len(e.Uncovered), strings.Join(names, ", "))
+	}
+	return fmt.Sprintf("transaction size %d exceeds limit %d by %d bytes: at least %d of these accounts must move into a lookup table [%s]",
+		e.Size, MaxTxSize, e.Size-MaxTxSize, e.MustMove, strings.Join(names, ", "))
+}
+
+// ExecuteTxPlanner plans offramp execute transactions around the solana transaction size and compute limits
+type ExecuteTxPlanner struct {
+	Accounts ExecuteAccounts
+	// Tables are the candidate lookup tables, e.g. the offramp table and the token pool tables
+	Tables map[solana.PublicKey]solana.PublicKeySlice
+
+	BaseComputeUnits     uint32
+	PerTokenComputeUnits uint32
+}
+
+func NewExecuteTxPlanner(accounts ExecuteAccounts, tables map[solana.PublicKey]solana.PublicKeySlice) *ExecuteTxPlanner {
+	if tables == nil {
+		tables = map[solana.PublicKey]solana.PublicKeySlice{}
+	}
+	return &ExecuteTxPlanner{
+		Accounts:             accounts,
+		Tables:               tables,
+		BaseComputeUnits:     DefaultExecuteBaseComputeUnits,
+		PerTokenComputeUnits: DefaultExecutePerTokenComputeUnits,
+	}
+}
+
+// LoadLookupTables fetches the entries of the given lookup tables and adds them as candidates
+func (p *ExecuteTxPlanner) LoadLookupTables(ctx context.Context, client *rpc.Client, tables ...solana.PublicKey) error {
+	for _, table := range tables {
+		entries, err := common.GetAddressLookupTable(ctx, client, table)
+		if err != nil {
+			return fmt.Errorf("failed to load lookup table %s: %w", table, err)
+		}
+		p.Tables[table] = entries
+	}
+	return nil
+}
+
+// Plan builds the execute instruction and selects the lookup tables needed to fit the transaction size limit.
+// Lookup tables are only added when needed, preferring the table that saves the most bytes.
+func (p *ExecuteTxPlanner) Plan(input ExecutePlanInput) (ExecutePlan, error) {
+	if len(input.Tokens) != len(input.Report.Message.TokenAmounts) {
+		return ExecutePlan{}, fmt.Errorf("expected %d token entries, got %d", len(input.Report.Message.TokenAmounts), len(input.Tokens))
+	}
+
+	cu := uint64(p.BaseComputeUnits) + uint64(p.PerTokenComputeUnits)*uint64(len(input.Tokens)) + uint64(input.Report.Message.ExtraArgs.ComputeUnits)
+	if cu > MaxComputeUnits {
+		return ExecutePlan{}, fmt.Errorf("estimated compute units %d exceed the transaction limit %d", cu, MaxComputeUnits)
+	}
+
+	ix, tokenIndexes, err := p.buildInstruction(input)
+	if err != nil {
+		return ExecutePlan{}, err
+	}
+
+	selected := map[solana.PublicKey]solana.PublicKeySlice{}
+	size, err := TxSize(ix, p.Accounts.Authority, selected, fees.ComputeUnitLimit(cu))
+	if err != nil {
+		return ExecutePlan{}, err
+	}
+
+	// greedily add the table with the largest size reduction until the transaction fits
+	for size > MaxTxSize {
+		var best solana.PublicKey
+		bestSize := size
+		for _, table := range sortedTables(p.Tables) {
+			if _, ok := selected[table]; ok {
+				continue
+			}
+			selected[table] = p.Tables[table]
+			s, serr := TxSize(ix, p.Accounts.Authority, selected, fees.ComputeUnitLimit(cu))
+			delete(selected, table)
+			if serr != nil {
+				return ExecutePlan{}, serr
+			}
+			if s < bestSize {
+				best, bestSize = table, s
+			}
+		}
+		if bestSize == size {
+			return ExecutePlan{}, p.tooLargeError(ix, selected, size)
+		}
+		selected[best] = p.Tables[best]
+		size = bestSize
+	}
+
+	return ExecutePlan{
+		Instruction:   ix,
+		LookupTables:  selected,
+		Size:          size,
+		ComputeUnits:  fees.ComputeUnitLimit(cu),
+		TokenIndexes:  tokenIndexes,
+		RemainingSize: MaxTxSize - size,
+	}, nil
+}
+
+func (p *ExecuteTxPlanner) buildInstruction(input ExecutePlanInput) (solana.Instruction, []byte, error) {
+	remaining := slices.Clone(input.MessageAccounts)
+	tokenIndexes := make([]byte, 0, len(input.Tokens))
+	for _, t := range input.Tokens {
+		if len(remaining) > 255 {
+			return nil, nil, fmt.Errorf("too many remaining accounts for token index: %d", len(remaining))
+		}
+		tokenIndexes = append(tokenIndexes, byte(len(remaining)))
+		metas, err := t.accounts()
+		if err != nil {
+			return nil, nil, err
+		}
+		remaining = append(remaining, metas...)
+	}
+
+	rawReport, err := bin.MarshalBorsh(input.Report)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := p.Accounts
+	raw := ccip_offramp.NewExecuteInstruction(
+		rawReport,
+		input.ReportContext,
+		tokenIndexes,
+		a.Config,
+		a.ReferenceAddresses,
+		a.SourceChain,
+		a.CommitReport,
+		a.Offramp,
+		a.AllowedOfframp,
+		a.Authority,
+		a.SystemProgram,
+		a.SysVarInstructions,
+		a.RMNRemote,
+		a.RMNRemoteCurses,
+		a.RMNRemoteConfig,
+	)
+	raw.AccountMetaSlice = append(raw.AccountMetaSlice, remaining...)
+
+	ix, err := raw.ValidateAndBuild()
+	return ix, tokenIndexes, err
+}
+
+// tooLargeError lists the static accounts which are not covered by any lookup table, and how many of them need to
+// move into a (new) lookup table to fit the size limit.
+func (p *ExecuteTxPlanner) tooLargeError(ix solana.Instruction, selected map[solana.PublicKey]solana.PublicKeySlice, size int) error {
+	covered := map[solana.PublicKey]bool{}
+	for _, entries := range p.Tables {
+		for _, k := range entries {
+			covered[k] = true
+		}
+	}
+
+	uncovered := solana.PublicKeySlice{}
+	for _, meta := range ix.Accounts() {
+		// signers and invoked programs can not be loaded from lookup tables
+		if meta.IsSigner || meta.PublicKey.Equals(ix.ProgramID()) || covered[meta.PublicKey] || slices.Contains(uncovered, meta.PublicKey) {
+			continue
+		}
+		uncovered = append(uncovered, meta.PublicKey)
+	}
+
+	// a new table costs its address + index lengths, every moved account saves a key but costs an index
+	excess := size - MaxTxSize + lookupTableOverhead
+	mustMove := (excess + lookupTableSavings - 1) / lookupTableSavings
+	return &TxTooLargeError{Size: size, Uncovered: uncovered, MustMove: mustMove}
+}
+
+// TxSize returns the serialized size of a signed transaction for the given instruction, lookup tables and compute unit limit
+func TxSize(ix solana.Instruction, payer solana.PublicKey, tables map[solana.PublicKey]solana.PublicKeySlice, cu fees.ComputeUnitLimit) (int, error) {
+	tx, err := solana.NewTransaction([]solana.Instruction{ix}, solana.Hash{}, solana.TransactionPayer(payer), solana.TransactionAddressTables(tables))
+	if err != nil {
+		return 0, err
+	}
+	if cu > 0 {
+		if err = fees.SetComputeUnitLimit(tx, cu); err != nil {
+			return 0, err
+		}
+	}
+
+	// signatures have a fixed size, so placeholders are sufficient
+	tx.Signatures = make([]solana.Signature, tx.Message.Header.NumRequiredSignatures)
+	bz, err := tx.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	return len(bz), nil
+}
+
+func sortedTables(tables map[solana.PublicKey]solana.PublicKeySlice) []solana.PublicKey {
+	keys := slices.Collect(maps.Keys(tables))
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	return keys
+}
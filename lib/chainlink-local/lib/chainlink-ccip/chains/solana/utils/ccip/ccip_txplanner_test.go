@@ -0,0 +1,149 @@
+package ccip
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/contracts/tests/config"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_offramp"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/tokens"
+)
+
+func TestExecuteTxPlanner(t *testing.T) {
+	t.Parallel()
+
+	randomKey := func() solana.PublicKey {
+		k, err := solana.NewRandomPrivateKey()
+		require.NoError(t, err)
+		return k.PublicKey()
+	}
+
+	accounts := ExecuteAccounts{
+		Config:             config.OfframpConfigPDA,
+		ReferenceAddresses: config.OfframpReferenceAddressesPDA,
+		SourceChain:        config.OfframpEvmSourceChainPDA,
+		CommitReport:       randomKey(),
+		Offramp:            config.CcipOfframpProgram,
+		AllowedOfframp:     config.AllowedOfframpEvmPDA,
+		Authority:          randomKey(),
+		SystemProgram:      solana.SystemProgramID,
+		SysVarInstructions: solana.SysVarInstructionsPubkey,
+		RMNRemote:          config.RMNRemoteProgram,
+		RMNRemoteCurses:    config.RMNRemoteCursesPDA,
+		RMNRemoteConfig:    config.RMNRemoteConfigPDA,
+	}
+	offrampTable := randomKey()
+	offrampEntries := solana.PublicKeySlice{
+		accounts.Config,
+		accounts.ReferenceAddresses,
+		accounts.Offramp,
+		accounts.SystemProgram,
+		accounts.SysVarInstructions,
+		accounts.RMNRemote,
+		accounts.RMNRemoteCurses,
+		accounts.RMNRemoteConfig,
+		config.CcipLogicReceiver,
+	}
+
+	pool, err := tokens.NewTokenPool(config.Token2022Program, config.CcipTokenPoolProgram, randomKey())
+	require.NoError(t, err)
+	pool.PoolLookupTable = randomKey()
+	pool.PoolTokenAccount = randomKey()
+
+	message := CreateDefaultMessageWith(config.EvmChainSelector, 1)
+	message.TokenAmounts = []ccip_offramp.Any2SVMTokenTransfer{{
+		SourcePoolAddress: make([]byte, 20),
+		DestTokenAddress:  pool.Mint,
+		ExtraData:         []byte{},
+		Amount:            ccip_offramp.CrossChainAmount{LeBytes: [32]uint8{1}},
+	}}
+	// receiver accounts are not part of any lookup table, they push the transaction over the limit without tables
+	messageAccounts := solana.AccountMetaSlice{solana.Meta(config.CcipLogicReceiver)}
+	for i := 0; i < 4; i++ {
+		messageAccounts = append(messageAccounts, solana.Meta(randomKey()).WRITE())
+	}
+	input := ExecutePlanInput{
+		Report: ccip_offramp.ExecutionReportSingleChain{
+			SourceChainSelector: config.EvmChainSelector,
+			Message:             message,
+			OffchainTokenData:   [][]byte{{}},
+			Proofs:              [][32]uint8{},
+		},
+		MessageAccounts: messageAccounts,
+		Tokens:          []ExecuteTokenEntry{{Pool: pool, UserTokenAccount: randomKey(), ChainSelector: config.EvmChainSelector}},
+	}
+
+	t.Run("selects lookup tables to fit", func(t *testing.T) {
+		t.Parallel()
+		planner := NewExecuteTxPlanner(accounts, map[solana.PublicKey]solana.PublicKeySlice{
+			offrampTable:         offrampEntries,
+			pool.PoolLookupTable: pool.ToTokenPoolEntries(),
+			randomKey():          {randomKey(), randomKey()}, // unrelated table should never be selected
+		})
+
+		plan, err := planner.Plan(input)
+		require.NoError(t, err)
+		require.LessOrEqual(t, plan.Size, MaxTxSize)
+		require.Equal(t, MaxTxSize-plan.Size, plan.RemainingSize)
+		require.Contains(t, plan.LookupTables, pool.PoolLookupTable)
+		require.LessOrEqual(t, len(plan.LookupTables), 2)
+		require.Equal(t, []byte{5}, plan.TokenIndexes) // after the logic receiver and its accounts
+		require.Equal(t, uint32(DefaultExecuteBaseComputeUnits+DefaultExecutePerTokenComputeUnits)+message.ExtraArgs.ComputeUnits, uint32(plan.ComputeUnits))
+
+		size, err := TxSize(plan.Instruction, accounts.Authority, plan.LookupTables, plan.ComputeUnits)
+		require.NoError(t, err)
+		require.Equal(t, plan.Size, size)
+	})
+
+	t.Run("no tables needed without tokens", func(t *testing.T) {
+		t.Parallel()
+		planner := NewExecuteTxPlanner(accounts, map[solana.PublicKey]solana.PublicKeySlice{offrampTable: offrampEntries})
+
+		noTokens := input
+		noTokens.Report.Message.TokenAmounts = nil
+		noTokens.Tokens = nil
+		plan, err := planner.Plan(noTokens)
+		require.NoError(t, err)
+		require.Empty(t, plan.LookupTables)
+		require.Empty(t, plan.TokenIndexes)
+	})
+
+	t.Run("reports accounts to move when it can not fit", func(t *testing.T) {
+		t.Parallel()
+		planner := NewExecuteTxPlanner(accounts, nil)
+
+		_, err := planner.Plan(input)
+		var tooLarge *TxTooLargeError
+		require.True(t, errors.As(err, &tooLarge))
+		require.Greater(t, tooLarge.Size, MaxTxSize)
+		require.Positive(t, tooLarge.MustMove)
+		require.LessOrEqual(t, tooLarge.MustMove, len(tooLarge.Uncovered))
+		require.Contains(t, tooLarge.Uncovered, pool.PoolConfig)
+		require.NotContains(t, tooLarge.Uncovered, accounts.Authority) // signer
+		require.NotContains(t, tooLarge.Uncovered, accounts.Offramp)   // invoked program
+		require.ErrorContains(t, err, pool.PoolConfig.String())
+	})
+
+	t.Run("rejects compute units over the limit", func(t *testing.T) {
+		t.Parallel()
+		planner := NewExecuteTxPlanner(accounts, nil)
+
+		heavy := input
+		heavy.Report.Message.ExtraArgs.ComputeUnits = MaxComputeUnits
+		_, err := planner.Plan(heavy)
+		require.ErrorContains(t, err, "exceed the transaction limit")
+	})
+
+	t.Run("token entries must match message", func(t *testing.T) {
+		t.Parallel()
+		planner := NewExecuteTxPlanner(accounts, nil)
+
+		mismatch := input
+		mismatch.Tokens = nil
+		_, err := planner.Plan(mismatch)
+		require.ErrorContains(t, err, "expected 1 token entries")
+	})
+}
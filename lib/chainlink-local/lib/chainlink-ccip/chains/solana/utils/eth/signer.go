@@ -47,6 +47,26 @@ func (s *Signer) Sign(msg []byte) (mcm.Signature, error) {
 	return sig, nil
 }
 
+// recovers the evm address that produced the signature over a 32-byte hash
+func RecoverAddress(msg []byte, sig mcm.Signature) ([20]byte, error) {
+	if len(msg) != 32 {
+		return [20]byte{}, errors.New("message must be a 32-byte hash")
+	}
+	compact := make([]byte, 0, 65)
+	compact = append(compact, sig.V)
+	compact = append(compact, sig.R[:]...)
+	compact = append(compact, sig.S[:]...)
+
+	publicKey, _, err := ecdsa.RecoverCompact(compact, msg)
+	if err != nil {
+		return [20]byte{}, fmt.Errorf("failed to recover public key: %w", err)
+	}
+	hash := Keccak256(publicKey.SerializeUncompressed()[1:]) // skip the leading 0x04 byte
+	var address [20]byte
+	copy(address[:], hash[12:])
+	return address, nil
+}
+
 func (s Signer) String() string {
 	return "0x" + hex.EncodeToString(s.Address[:])
 }
@@ -0,0 +1,93 @@
+// Decodes the CCIP events emitted in a transaction and prints them as JSON.
+//
+// Usage (from chains/solana):
+//
+//	go run ./utils/events/cmd -rpc <url> -tx <signature> -router <id> -offramp <id> -fee-quoter <id> -pools <id>,<id>
+//	go run ./utils/events/cmd -logs tx_logs.txt -router <id> ...
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/events"
+)
+
+func main() {
+	rpcURL := flag.String("rpc", rpc.DevNet_RPC, "solana RPC endpoint")
+	sig := flag.String("tx", "", "transaction signature to decode")
+	logsFile := flag.String("logs", "", "decode log messages from a file (one line per message) instead of fetching a transaction")
+	router := flag.String("router", "", "ccip_router program ID")
+	offramp := flag.String("offramp", "", "ccip_offramp program ID")
+	feeQuoter := flag.String("fee-quoter", "", "fee_quoter program ID")
+	pools := flag.String("pools", "", "comma separated token pool program IDs")
+	timeout := flag.Duration("timeout", 30*time.Second, "RPC timeout")
+	flag.Parse()
+
+	var poolIDs []solana.PublicKey
+	for _, p := range strings.Split(*pools, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			poolIDs = append(poolIDs, mustPublicKey("pools", p))
+		}
+	}
+	decoder := events.NewCCIPDecoder(
+		mustPublicKey("router", *router),
+		mustPublicKey("offramp", *offramp),
+		mustPublicKey("fee-quoter", *feeQuoter),
+		poolIDs...,
+	)
+
+	var res events.Result
+	var err error
+	switch {
+	case *logsFile != "":
+		raw, readErr := os.ReadFile(*logsFile)
+		if readErr != nil {
+			log.Fatal(readErr)
+		}
+		res, err = decoder.DecodeLogs(strings.Split(strings.TrimSpace(string(raw)), "\n"))
+	case *sig != "":
+		signature, sigErr := solana.SignatureFromBase58(*sig)
+		if sigErr != nil {
+			log.Fatalf("invalid transaction signature: %v", sigErr)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+		defer cancel()
+		v := uint64(0)
+		tx, txErr := rpc.New(*rpcURL).GetTransaction(ctx, signature, &rpc.GetTransactionOpts{
+			Commitment:                     rpc.CommitmentConfirmed,
+			MaxSupportedTransactionVersion: &v,
+		})
+		if txErr != nil {
+			log.Fatalf("failed to fetch transaction: %v", txErr)
+		}
+		res, err = decoder.DecodeTransaction(tx)
+	default:
+		log.Fatal("either -tx or -logs must be set")
+	}
+	if err != nil {
+		log.Fatalf("failed to decode events: %v", err)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err = enc.Encode(res); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func mustPublicKey(flagName, value string) solana.PublicKey {
+	key, err := solana.PublicKeyFromBase58(value)
+	if err != nil {
+		log.Fatalf("invalid -%s program ID %q: %v", flagName, value, err)
+	}
+	return key
+}
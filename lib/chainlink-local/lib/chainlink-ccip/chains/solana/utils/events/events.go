@@ -0,0 +1,193 @@
+// Package events decodes the anchor events emitted by the CCIP programs into typed structs.
+// The event types are generated from the IDLs, as anchor-go (v0.29 IDLs) does not support events.
+package events
+
+//go:generate go run ./gen -idl ../../contracts/target/idl -out events_gen.go
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/common"
+)
+
+const (
+	programDataPrefix = "Program data:"
+	logTruncated      = "Log truncated"
+)
+
+// Event is implemented by all generated event types
+type Event interface {
+	UnmarshalWithDecoder(decoder *bin.Decoder) error
+}
+
+// Definition links an anchor event name to its go type
+type Definition struct {
+	Name string
+	New  func() Event
+}
+
+// DecodedEvent is an event emitted by one of the registered programs
+type DecodedEvent struct {
+	Program   string           `json:"program"`
+	ProgramID solana.PublicKey `json:"programId"`
+	Name      string           `json:"name"`
+	Depth     int              `json:"depth"` // 1 for top level instructions, incremented for every CPI
+	Data      Event            `json:"data"`
+}
+
+// Result holds the events decoded from the logs of a single transaction
+type Result struct {
+	Events []DecodedEvent `json:"events"`
+	// Truncated is set when the runtime dropped log lines, events emitted after that point are missing
+	Truncated bool `json:"truncated"`
+}
+
+type program struct {
+	name          string
+	definitions   []Definition
+	discriminator map[[8]byte][]Definition
+}
+
+// Decoder decodes the events of registered programs from transaction logs
+type Decoder struct {
+	programs map[solana.PublicKey]program
+}
+
+// NewDecoder returns a decoder without registered programs, see Register and NewCCIPDecoder
+func NewDecoder() *Decoder {
+	return &Decoder{programs: map[solana.PublicKey]program{}}
+}
+
+// NewCCIPDecoder returns a decoder for the router, offramp, fee quoter and any number of token pool programs
+func NewCCIPDecoder(router, offramp, feeQuoter solana.PublicKey, pools ...solana.PublicKey) *Decoder {
+	d := NewDecoder()
+	d.Register(router, "ccip_router", RouterEvents)
+	d.Register(offramp, "ccip_offramp", OfframpEvents)
+	d.Register(feeQuoter, "fee_quoter", FeeQuoterEvents)
+	for _, pool := range pools {
+		d.Register(pool, "token_pool", TokenPoolEvents)
+	}
+	return d
+}
+
+// Register adds the events emitted by the program deployed at programID
+func (d *Decoder) Register(programID solana.PublicKey, name string, definitions []Definition) {
+	p := program{name: name, definitions: definitions, discriminator: map[[8]byte][]Definition{}}
+	for _, def := range definitions {
+		disc := [8]byte(common.Discriminator("event", def.Name))
+		p.discriminator[disc] = append(p.discriminator[disc], def)
+	}
+	d.programs[programID] = p
+}
+
+// DecodeTransaction decodes the events from the log messages of a getTransaction result
+func (d *Decoder) DecodeTransaction(tx *rpc.GetTransactionResult) (Result, error) {
+	if tx == nil || tx.Meta == nil {
+		return Result{}, fmt.Errorf("transaction has no metadata")
+	}
+	return d.DecodeLogs(tx.Meta.LogMessages)
+}
+
+// DecodeLogs decodes the events of registered programs from raw log messages.
+// Program invocations are tracked so events emitted during CPIs are attributed to the emitting program,
+// data lines of unregistered programs are ignored.
+func (d *Decoder) DecodeLogs(logs []string) (Result, error) {
+	var res Result
+	var stack []solana.PublicKey
+
+	for i, line := range logs {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == logTruncated:
+			res.Truncated = true
+		case strings.HasPrefix(line, programDataPrefix):
+			if len(stack) == 0 {
+				continue
+			}
+			programID := stack[len(stack)-1]
+			p, ok := d.programs[programID]
+			if !ok {
+				continue
+			}
+			data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(strings.TrimPrefix(line, programDataPrefix)))
+			if err != nil {
+				return res, fmt.Errorf("line %d: invalid program data: %w", i, err)
+			}
+			name, event, err := p.decode(data)
+			if err != nil {
+				return res, fmt.Errorf("line %d: %s: %w", i, p.name, err)
+			}
+			if event == nil {
+				continue // not an event, e.g. a return value
+			}
+			res.Events = append(res.Events, DecodedEvent{
+				Program:   p.name,
+				ProgramID: programID,
+				Name:      name,
+				Depth:     len(stack),
+				Data:      event,
+			})
+		default:
+			id, action, ok := parseProgramLine(line)
+			if !ok {
+				continue
+			}
+			switch action {
+			case "invoke":
+				stack = append(stack, id)
+			case "success", "failed":
+				if len(stack) > 0 {
+					stack = stack[:len(stack)-1]
+				}
+			}
+		}
+	}
+	return res, nil
+}
+
+// decode returns a nil event if the data does not match any registered event.
+// Events sharing a name (and discriminator) are disambiguated by the one consuming all the data.
+func (p program) decode(data []byte) (string, Event, error) {
+	if len(data) < 8 {
+		return "", nil, nil
+	}
+	candidates := p.discriminator[[8]byte(data[:8])]
+	if len(candidates) == 0 {
+		return "", nil, nil
+	}
+
+	var lastErr error
+	for _, def := range candidates {
+		event := def.New()
+		decoder := bin.NewBorshDecoder(data[8:])
+		if err := event.UnmarshalWithDecoder(decoder); err != nil {
+			lastErr = err
+			continue
+		}
+		if decoder.Remaining() != 0 {
+			lastErr = fmt.Errorf("%d trailing bytes", decoder.Remaining())
+			continue
+		}
+		return def.Name, event, nil
+	}
+	return "", nil, fmt.Errorf("failed to decode %s event: %w", candidates[0].Name, lastErr)
+}
+
+// parseProgramLine parses "Program <id> invoke [n]", "Program <id> success" and "Program <id> failed: <err>"
+func parseProgramLine(line string) (solana.PublicKey, string, bool) {
+	fields := strings.Fields(line)
+	if len(fields) < 3 || fields[0] != "Program" {
+		return solana.PublicKey{}, "", false
+	}
+	id, err := solana.PublicKeyFromBase58(fields[1])
+	if err != nil {
+		return solana.PublicKey{}, "", false
+	}
+	return id, strings.TrimSuffix(fields[2], ":"), true
+}
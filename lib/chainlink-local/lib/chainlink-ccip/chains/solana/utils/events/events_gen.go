@@ -0,0 +1,1290 @@
+// Code generated by utils/events/gen. DO NOT EDIT.
+
+package events
+
+import (
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/base_token_pool"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_offramp"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_router"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/fee_quoter"
+)
+
+var (
+	_ = solana.PublicKey{}
+	_ bin.Uint128
+)
+
+// RouterConfigSet is the `ConfigSet` event of the ccip_router program.
+type RouterConfigSet struct {
+	SvmChainSelector uint64
+	FeeQuoter        solana.PublicKey
+	RmnRemote        solana.PublicKey
+	LinkTokenMint    solana.PublicKey
+	FeeAggregator    solana.PublicKey
+}
+
+func (obj *RouterConfigSet) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `SvmChainSelector`:
+	if err := decoder.Decode(&obj.SvmChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `FeeQuoter`:
+	if err := decoder.Decode(&obj.FeeQuoter); err != nil {
+		return err
+	}
+	// Deserialize `RmnRemote`:
+	if err := decoder.Decode(&obj.RmnRemote); err != nil {
+		return err
+	}
+	// Deserialize `LinkTokenMint`:
+	if err := decoder.Decode(&obj.LinkTokenMint); err != nil {
+		return err
+	}
+	// Deserialize `FeeAggregator`:
+	if err := decoder.Decode(&obj.FeeAggregator); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RouterFeeTokenAdded is the `FeeTokenAdded` event of the ccip_router program.
+type RouterFeeTokenAdded struct {
+	FeeToken solana.PublicKey
+	Enabled  bool
+}
+
+func (obj *RouterFeeTokenAdded) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `FeeToken`:
+	if err := decoder.Decode(&obj.FeeToken); err != nil {
+		return err
+	}
+	// Deserialize `Enabled`:
+	if err := decoder.Decode(&obj.Enabled); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RouterFeeTokenEnabled is the `FeeTokenEnabled` event of the ccip_router program.
+type RouterFeeTokenEnabled struct {
+	FeeToken solana.PublicKey
+}
+
+func (obj *RouterFeeTokenEnabled) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `FeeToken`:
+	if err := decoder.Decode(&obj.FeeToken); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RouterFeeTokenDisabled is the `FeeTokenDisabled` event of the ccip_router program.
+type RouterFeeTokenDisabled struct {
+	FeeToken solana.PublicKey
+}
+
+func (obj *RouterFeeTokenDisabled) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `FeeToken`:
+	if err := decoder.Decode(&obj.FeeToken); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RouterFeeTokenRemoved is the `FeeTokenRemoved` event of the ccip_router program.
+type RouterFeeTokenRemoved struct {
+	FeeToken solana.PublicKey
+}
+
+func (obj *RouterFeeTokenRemoved) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `FeeToken`:
+	if err := decoder.Decode(&obj.FeeToken); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RouterDestChainConfigUpdated is the `DestChainConfigUpdated` event of the ccip_router program.
+type RouterDestChainConfigUpdated struct {
+	DestChainSelector uint64
+	DestChainConfig   ccip_router.DestChainConfig
+}
+
+func (obj *RouterDestChainConfigUpdated) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `DestChainSelector`:
+	if err := decoder.Decode(&obj.DestChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `DestChainConfig`:
+	if err := decoder.Decode(&obj.DestChainConfig); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RouterDestChainAdded is the `DestChainAdded` event of the ccip_router program.
+type RouterDestChainAdded struct {
+	DestChainSelector uint64
+	DestChainConfig   ccip_router.DestChainConfig
+}
+
+func (obj *RouterDestChainAdded) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `DestChainSelector`:
+	if err := decoder.Decode(&obj.DestChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `DestChainConfig`:
+	if err := decoder.Decode(&obj.DestChainConfig); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RouterOwnershipTransferRequested is the `OwnershipTransferRequested` event of the ccip_router program.
+type RouterOwnershipTransferRequested struct {
+	From solana.PublicKey
+	To   solana.PublicKey
+}
+
+func (obj *RouterOwnershipTransferRequested) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `From`:
+	if err := decoder.Decode(&obj.From); err != nil {
+		return err
+	}
+	// Deserialize `To`:
+	if err := decoder.Decode(&obj.To); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RouterOwnershipTransferred is the `OwnershipTransferred` event of the ccip_router program.
+type RouterOwnershipTransferred struct {
+	From solana.PublicKey
+	To   solana.PublicKey
+}
+
+func (obj *RouterOwnershipTransferred) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `From`:
+	if err := decoder.Decode(&obj.From); err != nil {
+		return err
+	}
+	// Deserialize `To`:
+	if err := decoder.Decode(&obj.To); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RouterOfframpAdded is the `OfframpAdded` event of the ccip_router program.
+type RouterOfframpAdded struct {
+	SourceChainSelector uint64
+	Offramp             solana.PublicKey
+}
+
+func (obj *RouterOfframpAdded) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `SourceChainSelector`:
+	if err := decoder.Decode(&obj.SourceChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `Offramp`:
+	if err := decoder.Decode(&obj.Offramp); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RouterOfframpRemoved is the `OfframpRemoved` event of the ccip_router program.
+type RouterOfframpRemoved struct {
+	SourceChainSelector uint64
+	Offramp             solana.PublicKey
+}
+
+func (obj *RouterOfframpRemoved) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `SourceChainSelector`:
+	if err := decoder.Decode(&obj.SourceChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `Offramp`:
+	if err := decoder.Decode(&obj.Offramp); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RouterCcipVersionForDestChainVersionBumped is the `CcipVersionForDestChainVersionBumped` event of the ccip_router program.
+type RouterCcipVersionForDestChainVersionBumped struct {
+	DestChainSelector      uint64
+	PreviousSequenceNumber uint64
+	NewSequenceNumber      uint64
+}
+
+func (obj *RouterCcipVersionForDestChainVersionBumped) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `DestChainSelector`:
+	if err := decoder.Decode(&obj.DestChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `PreviousSequenceNumber`:
+	if err := decoder.Decode(&obj.PreviousSequenceNumber); err != nil {
+		return err
+	}
+	// Deserialize `NewSequenceNumber`:
+	if err := decoder.Decode(&obj.NewSequenceNumber); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RouterCcipVersionForDestChainVersionRolledBack is the `CcipVersionForDestChainVersionRolledBack` event of the ccip_router program.
+type RouterCcipVersionForDestChainVersionRolledBack struct {
+	DestChainSelector      uint64
+	PreviousSequenceNumber uint64
+	NewSequenceNumber      uint64
+}
+
+func (obj *RouterCcipVersionForDestChainVersionRolledBack) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `DestChainSelector`:
+	if err := decoder.Decode(&obj.DestChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `PreviousSequenceNumber`:
+	if err := decoder.Decode(&obj.PreviousSequenceNumber); err != nil {
+		return err
+	}
+	// Deserialize `NewSequenceNumber`:
+	if err := decoder.Decode(&obj.NewSequenceNumber); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RouterCCIPMessageSent is the `CCIPMessageSent` event of the ccip_router program.
+type RouterCCIPMessageSent struct {
+	DestChainSelector uint64
+	SequenceNumber    uint64
+	Message           ccip_router.SVM2AnyRampMessage
+}
+
+func (obj *RouterCCIPMessageSent) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `DestChainSelector`:
+	if err := decoder.Decode(&obj.DestChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `SequenceNumber`:
+	if err := decoder.Decode(&obj.SequenceNumber); err != nil {
+		return err
+	}
+	// Deserialize `Message`:
+	if err := decoder.Decode(&obj.Message); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RouterPoolSet is the `PoolSet` event of the ccip_router program.
+type RouterPoolSet struct {
+	Token                   solana.PublicKey
+	PreviousPoolLookupTable solana.PublicKey
+	NewPoolLookupTable      solana.PublicKey
+}
+
+func (obj *RouterPoolSet) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `Token`:
+	if err := decoder.Decode(&obj.Token); err != nil {
+		return err
+	}
+	// Deserialize `PreviousPoolLookupTable`:
+	if err := decoder.Decode(&obj.PreviousPoolLookupTable); err != nil {
+		return err
+	}
+	// Deserialize `NewPoolLookupTable`:
+	if err := decoder.Decode(&obj.NewPoolLookupTable); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RouterAdministratorTransferRequested is the `AdministratorTransferRequested` event of the ccip_router program.
+type RouterAdministratorTransferRequested struct {
+	Token        solana.PublicKey
+	CurrentAdmin solana.PublicKey
+	NewAdmin     solana.PublicKey
+}
+
+func (obj *RouterAdministratorTransferRequested) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `Token`:
+	if err := decoder.Decode(&obj.Token); err != nil {
+		return err
+	}
+	// Deserialize `CurrentAdmin`:
+	if err := decoder.Decode(&obj.CurrentAdmin); err != nil {
+		return err
+	}
+	// Deserialize `NewAdmin`:
+	if err := decoder.Decode(&obj.NewAdmin); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RouterAdministratorTransferred is the `AdministratorTransferred` event of the ccip_router program.
+type RouterAdministratorTransferred struct {
+	Token    solana.PublicKey
+	NewAdmin solana.PublicKey
+}
+
+func (obj *RouterAdministratorTransferred) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `Token`:
+	if err := decoder.Decode(&obj.Token); err != nil {
+		return err
+	}
+	// Deserialize `NewAdmin`:
+	if err := decoder.Decode(&obj.NewAdmin); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RouterEvents lists the events emitted by the ccip_router program.
+var RouterEvents = []Definition{
+	{Name: "ConfigSet", New: func() Event { return new(RouterConfigSet) }},
+	{Name: "FeeTokenAdded", New: func() Event { return new(RouterFeeTokenAdded) }},
+	{Name: "FeeTokenEnabled", New: func() Event { return new(RouterFeeTokenEnabled) }},
+	{Name: "FeeTokenDisabled", New: func() Event { return new(RouterFeeTokenDisabled) }},
+	{Name: "FeeTokenRemoved", New: func() Event { return new(RouterFeeTokenRemoved) }},
+	{Name: "DestChainConfigUpdated", New: func() Event { return new(RouterDestChainConfigUpdated) }},
+	{Name: "DestChainAdded", New: func() Event { return new(RouterDestChainAdded) }},
+	{Name: "OwnershipTransferRequested", New: func() Event { return new(RouterOwnershipTransferRequested) }},
+	{Name: "OwnershipTransferred", New: func() Event { return new(RouterOwnershipTransferred) }},
+	{Name: "OfframpAdded", New: func() Event { return new(RouterOfframpAdded) }},
+	{Name: "OfframpRemoved", New: func() Event { return new(RouterOfframpRemoved) }},
+	{Name: "CcipVersionForDestChainVersionBumped", New: func() Event { return new(RouterCcipVersionForDestChainVersionBumped) }},
+	{Name: "CcipVersionForDestChainVersionRolledBack", New: func() Event { return new(RouterCcipVersionForDestChainVersionRolledBack) }},
+	{Name: "CCIPMessageSent", New: func() Event { return new(RouterCCIPMessageSent) }},
+	{Name: "PoolSet", New: func() Event { return new(RouterPoolSet) }},
+	{Name: "AdministratorTransferRequested", New: func() Event { return new(RouterAdministratorTransferRequested) }},
+	{Name: "AdministratorTransferred", New: func() Event { return new(RouterAdministratorTransferred) }},
+}
+
+// OfframpSourceChainConfigUpdated is the `SourceChainConfigUpdated` event of the ccip_offramp program.
+type OfframpSourceChainConfigUpdated struct {
+	SourceChainSelector uint64
+	SourceChainConfig   ccip_offramp.SourceChainConfig
+}
+
+func (obj *OfframpSourceChainConfigUpdated) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `SourceChainSelector`:
+	if err := decoder.Decode(&obj.SourceChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `SourceChainConfig`:
+	if err := decoder.Decode(&obj.SourceChainConfig); err != nil {
+		return err
+	}
+	return nil
+}
+
+// OfframpSourceChainAdded is the `SourceChainAdded` event of the ccip_offramp program.
+type OfframpSourceChainAdded struct {
+	SourceChainSelector uint64
+	SourceChainConfig   ccip_offramp.SourceChainConfig
+}
+
+func (obj *OfframpSourceChainAdded) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `SourceChainSelector`:
+	if err := decoder.Decode(&obj.SourceChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `SourceChainConfig`:
+	if err := decoder.Decode(&obj.SourceChainConfig); err != nil {
+		return err
+	}
+	return nil
+}
+
+// OfframpOwnershipTransferRequested is the `OwnershipTransferRequested` event of the ccip_offramp program.
+type OfframpOwnershipTransferRequested struct {
+	From solana.PublicKey
+	To   solana.PublicKey
+}
+
+func (obj *OfframpOwnershipTransferRequested) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `From`:
+	if err := decoder.Decode(&obj.From); err != nil {
+		return err
+	}
+	// Deserialize `To`:
+	if err := decoder.Decode(&obj.To); err != nil {
+		return err
+	}
+	return nil
+}
+
+// OfframpOwnershipTransferred is the `OwnershipTransferred` event of the ccip_offramp program.
+type OfframpOwnershipTransferred struct {
+	From solana.PublicKey
+	To   solana.PublicKey
+}
+
+func (obj *OfframpOwnershipTransferred) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `From`:
+	if err := decoder.Decode(&obj.From); err != nil {
+		return err
+	}
+	// Deserialize `To`:
+	if err := decoder.Decode(&obj.To); err != nil {
+		return err
+	}
+	return nil
+}
+
+// OfframpConfigSet is the `ConfigSet` event of the ccip_offramp program.
+type OfframpConfigSet struct {
+	SvmChainSelector           uint64
+	EnableManualExecutionAfter int64
+}
+
+func (obj *OfframpConfigSet) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `SvmChainSelector`:
+	if err := decoder.Decode(&obj.SvmChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `EnableManualExecutionAfter`:
+	if err := decoder.Decode(&obj.EnableManualExecutionAfter); err != nil {
+		return err
+	}
+	return nil
+}
+
+// OfframpReferenceAddressesSet is the `ReferenceAddressesSet` event of the ccip_offramp program.
+type OfframpReferenceAddressesSet struct {
+	Router             solana.PublicKey
+	FeeQuoter          solana.PublicKey
+	OfframpLookupTable solana.PublicKey
+	RmnRemote          solana.PublicKey
+}
+
+func (obj *OfframpReferenceAddressesSet) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `Router`:
+	if err := decoder.Decode(&obj.Router); err != nil {
+		return err
+	}
+	// Deserialize `FeeQuoter`:
+	if err := decoder.Decode(&obj.FeeQuoter); err != nil {
+		return err
+	}
+	// Deserialize `OfframpLookupTable`:
+	if err := decoder.Decode(&obj.OfframpLookupTable); err != nil {
+		return err
+	}
+	// Deserialize `RmnRemote`:
+	if err := decoder.Decode(&obj.RmnRemote); err != nil {
+		return err
+	}
+	return nil
+}
+
+// OfframpCommitReportAccepted is the `CommitReportAccepted` event of the ccip_offramp program.
+type OfframpCommitReportAccepted struct {
+	MerkleRoot   *ccip_offramp.MerkleRoot `bin:"optional"`
+	PriceUpdates ccip_offramp.PriceUpdates
+}
+
+func (obj *OfframpCommitReportAccepted) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `MerkleRoot`:
+	if ok, err := decoder.ReadBool(); err != nil {
+		return err
+	} else if ok {
+		if err = decoder.Decode(&obj.MerkleRoot); err != nil {
+			return err
+		}
+	}
+	// Deserialize `PriceUpdates`:
+	if err := decoder.Decode(&obj.PriceUpdates); err != nil {
+		return err
+	}
+	return nil
+}
+
+// OfframpCommitReportPDAClosed is the `CommitReportPDAClosed` event of the ccip_offramp program.
+type OfframpCommitReportPDAClosed struct {
+	SourceChainSelector uint64
+	MerkleRoot          [32]uint8
+}
+
+func (obj *OfframpCommitReportPDAClosed) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `SourceChainSelector`:
+	if err := decoder.Decode(&obj.SourceChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `MerkleRoot`:
+	if err := decoder.Decode(&obj.MerkleRoot); err != nil {
+		return err
+	}
+	return nil
+}
+
+// OfframpSkippedAlreadyExecutedMessage is the `SkippedAlreadyExecutedMessage` event of the ccip_offramp program.
+type OfframpSkippedAlreadyExecutedMessage struct {
+	SourceChainSelector uint64
+	SequenceNumber      uint64
+}
+
+func (obj *OfframpSkippedAlreadyExecutedMessage) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `SourceChainSelector`:
+	if err := decoder.Decode(&obj.SourceChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `SequenceNumber`:
+	if err := decoder.Decode(&obj.SequenceNumber); err != nil {
+		return err
+	}
+	return nil
+}
+
+// OfframpExecutionStateChanged is the `ExecutionStateChanged` event of the ccip_offramp program.
+type OfframpExecutionStateChanged struct {
+	SourceChainSelector uint64
+	SequenceNumber      uint64
+	MessageId           [32]uint8
+	MessageHash         [32]uint8
+	State               ccip_offramp.MessageExecutionState
+}
+
+func (obj *OfframpExecutionStateChanged) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `SourceChainSelector`:
+	if err := decoder.Decode(&obj.SourceChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `SequenceNumber`:
+	if err := decoder.Decode(&obj.SequenceNumber); err != nil {
+		return err
+	}
+	// Deserialize `MessageId`:
+	if err := decoder.Decode(&obj.MessageId); err != nil {
+		return err
+	}
+	// Deserialize `MessageHash`:
+	if err := decoder.Decode(&obj.MessageHash); err != nil {
+		return err
+	}
+	// Deserialize `State`:
+	if err := decoder.Decode(&obj.State); err != nil {
+		return err
+	}
+	return nil
+}
+
+// OfframpConfigSet2 is the `ConfigSet` event of the ccip_offramp program.
+type OfframpConfigSet2 struct {
+	OcrPluginType ccip_offramp.OcrPluginType
+	ConfigDigest  [32]uint8
+	Signers       [][20]uint8
+	Transmitters  []solana.PublicKey
+	F             uint8
+}
+
+func (obj *OfframpConfigSet2) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `OcrPluginType`:
+	if err := decoder.Decode(&obj.OcrPluginType); err != nil {
+		return err
+	}
+	// Deserialize `ConfigDigest`:
+	if err := decoder.Decode(&obj.ConfigDigest); err != nil {
+		return err
+	}
+	// Deserialize `Signers`:
+	if err := decoder.Decode(&obj.Signers); err != nil {
+		return err
+	}
+	// Deserialize `Transmitters`:
+	if err := decoder.Decode(&obj.Transmitters); err != nil {
+		return err
+	}
+	// Deserialize `F`:
+	if err := decoder.Decode(&obj.F); err != nil {
+		return err
+	}
+	return nil
+}
+
+// OfframpTransmitted is the `Transmitted` event of the ccip_offramp program.
+type OfframpTransmitted struct {
+	OcrPluginType  ccip_offramp.OcrPluginType
+	ConfigDigest   [32]uint8
+	SequenceNumber uint64
+}
+
+func (obj *OfframpTransmitted) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `OcrPluginType`:
+	if err := decoder.Decode(&obj.OcrPluginType); err != nil {
+		return err
+	}
+	// Deserialize `ConfigDigest`:
+	if err := decoder.Decode(&obj.ConfigDigest); err != nil {
+		return err
+	}
+	// Deserialize `SequenceNumber`:
+	if err := decoder.Decode(&obj.SequenceNumber); err != nil {
+		return err
+	}
+	return nil
+}
+
+// OfframpEvents lists the events emitted by the ccip_offramp program.
+var OfframpEvents = []Definition{
+	{Name: "SourceChainConfigUpdated", New: func() Event { return new(OfframpSourceChainConfigUpdated) }},
+	{Name: "SourceChainAdded", New: func() Event { return new(OfframpSourceChainAdded) }},
+	{Name: "OwnershipTransferRequested", New: func() Event { return new(OfframpOwnershipTransferRequested) }},
+	{Name: "OwnershipTransferred", New: func() Event { return new(OfframpOwnershipTransferred) }},
+	{Name: "ConfigSet", New: func() Event { return new(OfframpConfigSet) }},
+	{Name: "ReferenceAddressesSet", New: func() Event { return new(OfframpReferenceAddressesSet) }},
+	{Name: "CommitReportAccepted", New: func() Event { return new(OfframpCommitReportAccepted) }},
+	{Name: "CommitReportPDAClosed", New: func() Event { return new(OfframpCommitReportPDAClosed) }},
+	{Name: "SkippedAlreadyExecutedMessage", New: func() Event { return new(OfframpSkippedAlreadyExecutedMessage) }},
+	{Name: "ExecutionStateChanged", New: func() Event { return new(OfframpExecutionStateChanged) }},
+	{Name: "ConfigSet", New: func() Event { return new(OfframpConfigSet2) }},
+	{Name: "Transmitted", New: func() Event { return new(OfframpTransmitted) }},
+}
+
+// FeeQuoterConfigSet is the `ConfigSet` event of the fee_quoter program.
+type FeeQuoterConfigSet struct {
+	MaxFeeJuelsPerMsg      bin.Uint128
+	LinkTokenMint          solana.PublicKey
+	LinkTokenLocalDecimals uint8
+	Onramp                 solana.PublicKey
+	DefaultCodeVersion     fee_quoter.CodeVersion
+}
+
+func (obj *FeeQuoterConfigSet) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `MaxFeeJuelsPerMsg`:
+	if err := decoder.Decode(&obj.MaxFeeJuelsPerMsg); err != nil {
+		return err
+	}
+	// Deserialize `LinkTokenMint`:
+	if err := decoder.Decode(&obj.LinkTokenMint); err != nil {
+		return err
+	}
+	// Deserialize `LinkTokenLocalDecimals`:
+	if err := decoder.Decode(&obj.LinkTokenLocalDecimals); err != nil {
+		return err
+	}
+	// Deserialize `Onramp`:
+	if err := decoder.Decode(&obj.Onramp); err != nil {
+		return err
+	}
+	// Deserialize `DefaultCodeVersion`:
+	if err := decoder.Decode(&obj.DefaultCodeVersion); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FeeQuoterFeeTokenAdded is the `FeeTokenAdded` event of the fee_quoter program.
+type FeeQuoterFeeTokenAdded struct {
+	FeeToken solana.PublicKey
+	Enabled  bool
+}
+
+func (obj *FeeQuoterFeeTokenAdded) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `FeeToken`:
+	if err := decoder.Decode(&obj.FeeToken); err != nil {
+		return err
+	}
+	// Deserialize `Enabled`:
+	if err := decoder.Decode(&obj.Enabled); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FeeQuoterFeeTokenEnabled is the `FeeTokenEnabled` event of the fee_quoter program.
+type FeeQuoterFeeTokenEnabled struct {
+	FeeToken solana.PublicKey
+}
+
+func (obj *FeeQuoterFeeTokenEnabled) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `FeeToken`:
+	if err := decoder.Decode(&obj.FeeToken); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FeeQuoterFeeTokenDisabled is the `FeeTokenDisabled` event of the fee_quoter program.
+type FeeQuoterFeeTokenDisabled struct {
+	FeeToken solana.PublicKey
+}
+
+func (obj *FeeQuoterFeeTokenDisabled) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `FeeToken`:
+	if err := decoder.Decode(&obj.FeeToken); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FeeQuoterFeeTokenRemoved is the `FeeTokenRemoved` event of the fee_quoter program.
+type FeeQuoterFeeTokenRemoved struct {
+	FeeToken solana.PublicKey
+}
+
+func (obj *FeeQuoterFeeTokenRemoved) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `FeeToken`:
+	if err := decoder.Decode(&obj.FeeToken); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FeeQuoterDestChainAdded is the `DestChainAdded` event of the fee_quoter program.
+type FeeQuoterDestChainAdded struct {
+	DestChainSelector uint64
+	DestChainConfig   fee_quoter.DestChainConfig
+}
+
+func (obj *FeeQuoterDestChainAdded) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `DestChainSelector`:
+	if err := decoder.Decode(&obj.DestChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `DestChainConfig`:
+	if err := decoder.Decode(&obj.DestChainConfig); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FeeQuoterDestChainConfigUpdated is the `DestChainConfigUpdated` event of the fee_quoter program.
+type FeeQuoterDestChainConfigUpdated struct {
+	DestChainSelector uint64
+	DestChainConfig   fee_quoter.DestChainConfig
+}
+
+func (obj *FeeQuoterDestChainConfigUpdated) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `DestChainSelector`:
+	if err := decoder.Decode(&obj.DestChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `DestChainConfig`:
+	if err := decoder.Decode(&obj.DestChainConfig); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FeeQuoterOwnershipTransferRequested is the `OwnershipTransferRequested` event of the fee_quoter program.
+type FeeQuoterOwnershipTransferRequested struct {
+	From solana.PublicKey
+	To   solana.PublicKey
+}
+
+func (obj *FeeQuoterOwnershipTransferRequested) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `From`:
+	if err := decoder.Decode(&obj.From); err != nil {
+		return err
+	}
+	// Deserialize `To`:
+	if err := decoder.Decode(&obj.To); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FeeQuoterOwnershipTransferred is the `OwnershipTransferred` event of the fee_quoter program.
+type FeeQuoterOwnershipTransferred struct {
+	From solana.PublicKey
+	To   solana.PublicKey
+}
+
+func (obj *FeeQuoterOwnershipTransferred) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `From`:
+	if err := decoder.Decode(&obj.From); err != nil {
+		return err
+	}
+	// Deserialize `To`:
+	if err := decoder.Decode(&obj.To); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FeeQuoterUsdPerUnitGasUpdated is the `UsdPerUnitGasUpdated` event of the fee_quoter program.
+type FeeQuoterUsdPerUnitGasUpdated struct {
+	DestChain uint64
+	Value     [28]uint8
+	Timestamp int64
+}
+
+func (obj *FeeQuoterUsdPerUnitGasUpdated) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `DestChain`:
+	if err := decoder.Decode(&obj.DestChain); err != nil {
+		return err
+	}
+	// Deserialize `Value`:
+	if err := decoder.Decode(&obj.Value); err != nil {
+		return err
+	}
+	// Deserialize `Timestamp`:
+	if err := decoder.Decode(&obj.Timestamp); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FeeQuoterUsdPerTokenUpdated is the `UsdPerTokenUpdated` event of the fee_quoter program.
+type FeeQuoterUsdPerTokenUpdated struct {
+	Token     solana.PublicKey
+	Value     [28]uint8
+	Timestamp int64
+}
+
+func (obj *FeeQuoterUsdPerTokenUpdated) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `Token`:
+	if err := decoder.Decode(&obj.Token); err != nil {
+		return err
+	}
+	// Deserialize `Value`:
+	if err := decoder.Decode(&obj.Value); err != nil {
+		return err
+	}
+	// Deserialize `Timestamp`:
+	if err := decoder.Decode(&obj.Timestamp); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FeeQuoterTokenPriceUpdateIgnored is the `TokenPriceUpdateIgnored` event of the fee_quoter program.
+type FeeQuoterTokenPriceUpdateIgnored struct {
+	Token solana.PublicKey
+	Value [28]uint8
+}
+
+func (obj *FeeQuoterTokenPriceUpdateIgnored) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `Token`:
+	if err := decoder.Decode(&obj.Token); err != nil {
+		return err
+	}
+	// Deserialize `Value`:
+	if err := decoder.Decode(&obj.Value); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FeeQuoterTokenTransferFeeConfigUpdated is the `TokenTransferFeeConfigUpdated` event of the fee_quoter program.
+type FeeQuoterTokenTransferFeeConfigUpdated struct {
+	DestChainSelector      uint64
+	Token                  solana.PublicKey
+	TokenTransferFeeConfig fee_quoter.TokenTransferFeeConfig
+}
+
+func (obj *FeeQuoterTokenTransferFeeConfigUpdated) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `DestChainSelector`:
+	if err := decoder.Decode(&obj.DestChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `Token`:
+	if err := decoder.Decode(&obj.Token); err != nil {
+		return err
+	}
+	// Deserialize `TokenTransferFeeConfig`:
+	if err := decoder.Decode(&obj.TokenTransferFeeConfig); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FeeQuoterPremiumMultiplierWeiPerEthUpdated is the `PremiumMultiplierWeiPerEthUpdated` event of the fee_quoter program.
+type FeeQuoterPremiumMultiplierWeiPerEthUpdated struct {
+	Token                      solana.PublicKey
+	PremiumMultiplierWeiPerEth uint64
+}
+
+func (obj *FeeQuoterPremiumMultiplierWeiPerEthUpdated) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `Token`:
+	if err := decoder.Decode(&obj.Token); err != nil {
+		return err
+	}
+	// Deserialize `PremiumMultiplierWeiPerEth`:
+	if err := decoder.Decode(&obj.PremiumMultiplierWeiPerEth); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FeeQuoterPriceUpdaterAdded is the `PriceUpdaterAdded` event of the fee_quoter program.
+type FeeQuoterPriceUpdaterAdded struct {
+	PriceUpdater solana.PublicKey
+}
+
+func (obj *FeeQuoterPriceUpdaterAdded) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `PriceUpdater`:
+	if err := decoder.Decode(&obj.PriceUpdater); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FeeQuoterPriceUpdaterRemoved is the `PriceUpdaterRemoved` event of the fee_quoter program.
+type FeeQuoterPriceUpdaterRemoved struct {
+	PriceUpdater solana.PublicKey
+}
+
+func (obj *FeeQuoterPriceUpdaterRemoved) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `PriceUpdater`:
+	if err := decoder.Decode(&obj.PriceUpdater); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FeeQuoterEvents lists the events emitted by the fee_quoter program.
+var FeeQuoterEvents = []Definition{
+	{Name: "ConfigSet", New: func() Event { return new(FeeQuoterConfigSet) }},
+	{Name: "FeeTokenAdded", New: func() Event { return new(FeeQuoterFeeTokenAdded) }},
+	{Name: "FeeTokenEnabled", New: func() Event { return new(FeeQuoterFeeTokenEnabled) }},
+	{Name: "FeeTokenDisabled", New: func() Event { return new(FeeQuoterFeeTokenDisabled) }},
+	{Name: "FeeTokenRemoved", New: func() Event { return new(FeeQuoterFeeTokenRemoved) }},
+	{Name: "DestChainAdded", New: func() Event { return new(FeeQuoterDestChainAdded) }},
+	{Name: "DestChainConfigUpdated", New: func() Event { return new(FeeQuoterDestChainConfigUpdated) }},
+	{Name: "OwnershipTransferRequested", New: func() Event { return new(FeeQuoterOwnershipTransferRequested) }},
+	{Name: "OwnershipTransferred", New: func() Event { return new(FeeQuoterOwnershipTransferred) }},
+	{Name: "UsdPerUnitGasUpdated", New: func() Event { return new(FeeQuoterUsdPerUnitGasUpdated) }},
+	{Name: "UsdPerTokenUpdated", New: func() Event { return new(FeeQuoterUsdPerTokenUpdated) }},
+	{Name: "TokenPriceUpdateIgnored", New: func() Event { return new(FeeQuoterTokenPriceUpdateIgnored) }},
+	{Name: "TokenTransferFeeConfigUpdated", New: func() Event { return new(FeeQuoterTokenTransferFeeConfigUpdated) }},
+	{Name: "PremiumMultiplierWeiPerEthUpdated", New: func() Event { return new(FeeQuoterPremiumMultiplierWeiPerEthUpdated) }},
+	{Name: "PriceUpdaterAdded", New: func() Event { return new(FeeQuoterPriceUpdaterAdded) }},
+	{Name: "PriceUpdaterRemoved", New: func() Event { return new(FeeQuoterPriceUpdaterRemoved) }},
+}
+
+// TokenPoolBurned is the `Burned` event of the base_token_pool program.
+type TokenPoolBurned struct {
+	Sender solana.PublicKey
+	Amount uint64
+	Mint   solana.PublicKey
+}
+
+func (obj *TokenPoolBurned) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `Sender`:
+	if err := decoder.Decode(&obj.Sender); err != nil {
+		return err
+	}
+	// Deserialize `Amount`:
+	if err := decoder.Decode(&obj.Amount); err != nil {
+		return err
+	}
+	// Deserialize `Mint`:
+	if err := decoder.Decode(&obj.Mint); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TokenPoolMinted is the `Minted` event of the base_token_pool program.
+type TokenPoolMinted struct {
+	Sender    solana.PublicKey
+	Recipient solana.PublicKey
+	Amount    uint64
+	Mint      solana.PublicKey
+}
+
+func (obj *TokenPoolMinted) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `Sender`:
+	if err := decoder.Decode(&obj.Sender); err != nil {
+		return err
+	}
+	// Deserialize `Recipient`:
+	if err := decoder.Decode(&obj.Recipient); err != nil {
+		return err
+	}
+	// Deserialize `Amount`:
+	if err := decoder.Decode(&obj.Amount); err != nil {
+		return err
+	}
+	// Deserialize `Mint`:
+	if err := decoder.Decode(&obj.Mint); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TokenPoolLocked is the `Locked` event of the base_token_pool program.
+type TokenPoolLocked struct {
+	Sender solana.PublicKey
+	Amount uint64
+	Mint   solana.PublicKey
+}
+
+func (obj *TokenPoolLocked) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `Sender`:
+	if err := decoder.Decode(&obj.Sender); err != nil {
+		return err
+	}
+	// Deserialize `Amount`:
+	if err := decoder.Decode(&obj.Amount); err != nil {
+		return err
+	}
+	// Deserialize `Mint`:
+	if err := decoder.Decode(&obj.Mint); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TokenPoolReleased is the `Released` event of the base_token_pool program.
+type TokenPoolReleased struct {
+	Sender    solana.PublicKey
+	Recipient solana.PublicKey
+	Amount    uint64
+	Mint      solana.PublicKey
+}
+
+func (obj *TokenPoolReleased) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `Sender`:
+	if err := decoder.Decode(&obj.Sender); err != nil {
+		return err
+	}
+	// Deserialize `Recipient`:
+	if err := decoder.Decode(&obj.Recipient); err != nil {
+		return err
+	}
+	// Deserialize `Amount`:
+	if err := decoder.Decode(&obj.Amount); err != nil {
+		return err
+	}
+	// Deserialize `Mint`:
+	if err := decoder.Decode(&obj.Mint); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TokenPoolRemoteChainConfigured is the `RemoteChainConfigured` event of the base_token_pool program.
+type TokenPoolRemoteChainConfigured struct {
+	ChainSelector         uint64
+	Token                 base_token_pool.RemoteAddress
+	PreviousToken         base_token_pool.RemoteAddress
+	PoolAddresses         []base_token_pool.RemoteAddress
+	PreviousPoolAddresses []base_token_pool.RemoteAddress
+	Mint                  solana.PublicKey
+}
+
+func (obj *TokenPoolRemoteChainConfigured) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `ChainSelector`:
+	if err := decoder.Decode(&obj.ChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `Token`:
+	if err := decoder.Decode(&obj.Token); err != nil {
+		return err
+	}
+	// Deserialize `PreviousToken`:
+	if err := decoder.Decode(&obj.PreviousToken); err != nil {
+		return err
+	}
+	// Deserialize `PoolAddresses`:
+	if err := decoder.Decode(&obj.PoolAddresses); err != nil {
+		return err
+	}
+	// Deserialize `PreviousPoolAddresses`:
+	if err := decoder.Decode(&obj.PreviousPoolAddresses); err != nil {
+		return err
+	}
+	// Deserialize `Mint`:
+	if err := decoder.Decode(&obj.Mint); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TokenPoolRateLimitConfigured is the `RateLimitConfigured` event of the base_token_pool program.
+type TokenPoolRateLimitConfigured struct {
+	ChainSelector     uint64
+	OutboundRateLimit base_token_pool.RateLimitConfig
+	InboundRateLimit  base_token_pool.RateLimitConfig
+	Mint              solana.PublicKey
+}
+
+func (obj *TokenPoolRateLimitConfigured) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `ChainSelector`:
+	if err := decoder.Decode(&obj.ChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `OutboundRateLimit`:
+	if err := decoder.Decode(&obj.OutboundRateLimit); err != nil {
+		return err
+	}
+	// Deserialize `InboundRateLimit`:
+	if err := decoder.Decode(&obj.InboundRateLimit); err != nil {
+		return err
+	}
+	// Deserialize `Mint`:
+	if err := decoder.Decode(&obj.Mint); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TokenPoolRemotePoolsAppended is the `RemotePoolsAppended` event of the base_token_pool program.
+type TokenPoolRemotePoolsAppended struct {
+	ChainSelector         uint64
+	PoolAddresses         []base_token_pool.RemoteAddress
+	PreviousPoolAddresses []base_token_pool.RemoteAddress
+	Mint                  solana.PublicKey
+}
+
+func (obj *TokenPoolRemotePoolsAppended) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `ChainSelector`:
+	if err := decoder.Decode(&obj.ChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `PoolAddresses`:
+	if err := decoder.Decode(&obj.PoolAddresses); err != nil {
+		return err
+	}
+	// Deserialize `PreviousPoolAddresses`:
+	if err := decoder.Decode(&obj.PreviousPoolAddresses); err != nil {
+		return err
+	}
+	// Deserialize `Mint`:
+	if err := decoder.Decode(&obj.Mint); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TokenPoolRemoteChainRemoved is the `RemoteChainRemoved` event of the base_token_pool program.
+type TokenPoolRemoteChainRemoved struct {
+	ChainSelector uint64
+	Mint          solana.PublicKey
+}
+
+func (obj *TokenPoolRemoteChainRemoved) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `ChainSelector`:
+	if err := decoder.Decode(&obj.ChainSelector); err != nil {
+		return err
+	}
+	// Deserialize `Mint`:
+	if err := decoder.Decode(&obj.Mint); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TokenPoolRouterUpdated is the `RouterUpdated` event of the base_token_pool program.
+type TokenPoolRouterUpdated struct {
+	OldRouter solana.PublicKey
+	NewRouter solana.PublicKey
+	Mint      solana.PublicKey
+}
+
+func (obj *TokenPoolRouterUpdated) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `OldRouter`:
+	if err := decoder.Decode(&obj.OldRouter); err != nil {
+		return err
+	}
+	// Deserialize `NewRouter`:
+	if err := decoder.Decode(&obj.NewRouter); err != nil {
+		return err
+	}
+	// Deserialize `Mint`:
+	if err := decoder.Decode(&obj.Mint); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TokenPoolOwnershipTransferRequested is the `OwnershipTransferRequested` event of the base_token_pool program.
+type TokenPoolOwnershipTransferRequested struct {
+	From solana.PublicKey
+	To   solana.PublicKey
+	Mint solana.PublicKey
+}
+
+func (obj *TokenPoolOwnershipTransferRequested) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `From`:
+	if err := decoder.Decode(&obj.From); err != nil {
+		return err
+	}
+	// Deserialize `To`:
+	if err := decoder.Decode(&obj.To); err != nil {
+		return err
+	}
+	// Deserialize `Mint`:
+	if err := decoder.Decode(&obj.Mint); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TokenPoolOwnershipTransferred is the `OwnershipTransferred` event of the base_token_pool program.
+type TokenPoolOwnershipTransferred struct {
+	From solana.PublicKey
+	To   solana.PublicKey
+	Mint solana.PublicKey
+}
+
+func (obj *TokenPoolOwnershipTransferred) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `From`:
+	if err := decoder.Decode(&obj.From); err != nil {
+		return err
+	}
+	// Deserialize `To`:
+	if err := decoder.Decode(&obj.To); err != nil {
+		return err
+	}
+	// Deserialize `Mint`:
+	if err := decoder.Decode(&obj.Mint); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TokenPoolTokensConsumed is the `TokensConsumed` event of the base_token_pool program.
+type TokenPoolTokensConsumed struct {
+	Tokens uint64
+}
+
+func (obj *TokenPoolTokensConsumed) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `Tokens`:
+	if err := decoder.Decode(&obj.Tokens); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TokenPoolConfigChanged is the `ConfigChanged` event of the base_token_pool program.
+type TokenPoolConfigChanged struct {
+	Config base_token_pool.RateLimitConfig
+}
+
+func (obj *TokenPoolConfigChanged) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	// Deserialize `Config`:
+	if err := decoder.Decode(&obj.Config); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TokenPoolEvents lists the events emitted by the base_token_pool program.
+var TokenPoolEvents = []Definition{
+	{Name: "Burned", New: func() Event { return new(TokenPoolBurned) }},
+	{Name: "Minted", New: func() Event { return new(TokenPoolMinted) }},
+	{Name: "Locked", New: func() Event { return new(TokenPoolLocked) }},
+	{Name: "Released", New: func() Event { return new(TokenPoolReleased) }},
+	{Name: "RemoteChainConfigured", New: func() Event { return new(TokenPoolRemoteChainConfigured) }},
+	{Name: "RateLimitConfigured", New: func() Event { return new(TokenPoolRateLimitConfigured) }},
+	{Name: "RemotePoolsAppended", New: func() Event { return new(TokenPoolRemotePoolsAppended) }},
+	{Name: "RemoteChainRemoved", New: func() Event { return new(TokenPoolRemoteChainRemoved) }},
+	{Name: "RouterUpdated", New: func() Event { return new(TokenPoolRouterUpdated) }},
+	{Name: "OwnershipTransferRequested", New: func() Event { return new(TokenPoolOwnershipTransferRequested) }},
+	{Name: "OwnershipTransferred", New: func() Event { return new(TokenPoolOwnershipTransferred) }},
+	{Name: "TokensConsumed", New: func() Event { return new(TokenPoolTokensConsumed) }},
+	{Name: "ConfigChanged", New: func() Event { return new(TokenPoolConfigChanged) }},
+}
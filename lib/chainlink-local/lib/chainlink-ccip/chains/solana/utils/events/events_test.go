@@ -0,0 +1,173 @@
+package events
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_offramp"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/common"
+)
+
+func TestDecoder(t *testing.T) {
+	t.Parallel()
+
+	router := solana.NewWallet().PublicKey()
+	offramp := solana.NewWallet().PublicKey()
+	feeQuoter := solana.NewWallet().PublicKey()
+	pool := solana.NewWallet().PublicKey()
+	unknown := solana.NewWallet().PublicKey()
+	decoder := NewCCIPDecoder(router, offramp, feeQuoter, pool)
+
+	mint := solana.NewWallet().PublicKey()
+	recipient := solana.NewWallet().PublicKey()
+	messageID := common.MakeRandom32ByteArray()
+
+	programData := func(name string, fields ...interface{}) string {
+		var buf bytes.Buffer
+		buf.Write(common.Discriminator("event", name))
+		enc := bin.NewBorshEncoder(&buf)
+		for _, f := range fields {
+			require.NoError(t, enc.Encode(f))
+		}
+		return "Program data: " + base64.StdEncoding.EncodeToString(buf.Bytes())
+	}
+	invoke := func(id solana.PublicKey, depth int) string {
+		return fmt.Sprintf("Program %s invoke [%d]", id, depth)
+	}
+	success := func(id solana.PublicKey) string {
+		return fmt.Sprintf("Program %s success", id)
+	}
+
+	t.Run("attributes events to the emitting program through CPIs", func(t *testing.T) {
+		t.Parallel()
+		logs := []string{
+			"Program ComputeBudget111111111111111111111111111111 invoke [1]",
+			"Program ComputeBudget111111111111111111111111111111 success",
+			invoke(offramp, 1),
+			"Program log: Instruction: Execute",
+			invoke(pool, 2),
+			"Program log: Instruction: ReleaseOrMintTokens",
+			programData("Minted", offramp, recipient, uint64(100), mint),
+			success(pool),
+			invoke(unknown, 2),
+			programData("Minted", offramp, recipient, uint64(1), mint), // same shape, but not a registered program
+			success(unknown),
+			programData("ExecutionStateChanged", uint64(1), uint64(2), messageID, [32]uint8{}, ccip_offramp.Success_MessageExecutionState),
+			success(offramp),
+		}
+
+		res, err := decoder.DecodeLogs(logs)
+		require.NoError(t, err)
+		require.False(t, res.Truncated)
+		require.Len(t, res.Events, 2)
+
+		require.Equal(t, "token_pool", res.Events[0].Program)
+		require.Equal(t, pool, res.Events[0].ProgramID)
+		require.Equal(t, 2, res.Events[0].Depth)
+		minted, ok := res.Events[0].Data.(*TokenPoolMinted)
+		require.True(t, ok)
+		require.Equal(t, TokenPoolMinted{Sender: offramp, Recipient: recipient, Amount: 100, Mint: mint}, *minted)
+
+		require.Equal(t, "ExecutionStateChanged", res.Events[1].Name)
+		require.Equal(t, 1, res.Events[1].Depth)
+		changed, ok := res.Events[1].Data.(*OfframpExecutionStateChanged)
+		require.True(t, ok)
+		require.Equal(t, messageID, changed.MessageId)
+		require.Equal(t, ccip_offramp.Success_MessageExecutionState, changed.State)
+	})
+
+	t.Run("disambiguates events sharing a name", func(t *testing.T) {
+		t.Parallel()
+		logs := []string{
+			invoke(offramp, 1),
+			programData("ConfigSet", uint64(7), int64(3600)),
+			programData("ConfigSet", ccip_offramp.Commit_OcrPluginType, [32]uint8{1}, [][20]uint8{{2}}, []solana.PublicKey{recipient}, uint8(1)),
+			success(offramp),
+		}
+
+		res, err := decoder.DecodeLogs(logs)
+		require.NoError(t, err)
+		require.Len(t, res.Events, 2)
+		require.Equal(t, &OfframpConfigSet{SvmChainSelector: 7, EnableManualExecutionAfter: 3600}, res.Events[0].Data)
+		ocr, ok := res.Events[1].Data.(*OfframpConfigSet2)
+		require.True(t, ok)
+		require.Equal(t, []solana.PublicKey{recipient}, ocr.Transmitters)
+		require.Equal(t, uint8(1), ocr.F)
+	})
+
+	t.Run("decodes optional fields", func(t *testing.T) {
+		t.Parallel()
+		logs := []string{
+			invoke(offramp, 1),
+			programData("CommitReportAccepted", false, ccip_offramp.PriceUpdates{
+				TokenPriceUpdates: []ccip_offramp.TokenPriceUpdate{},
+				GasPriceUpdates:   []ccip_offramp.GasPriceUpdate{},
+			}),
+			success(offramp),
+		}
+
+		res, err := decoder.DecodeLogs(logs)
+		require.NoError(t, err)
+		require.Len(t, res.Events, 1)
+		accepted, ok := res.Events[0].Data.(*OfframpCommitReportAccepted)
+		require.True(t, ok)
+		require.Nil(t, accepted.MerkleRoot)
+	})
+
+	t.Run("keeps events before truncation", func(t *testing.T) {
+		t.Parallel()
+		logs := []string{
+			invoke(router, 1),
+			programData("FeeTokenEnabled", mint),
+			"Log truncated",
+		}
+
+		res, err := decoder.DecodeLogs(logs)
+		require.NoError(t, err)
+		require.True(t, res.Truncated)
+		require.Len(t, res.Events, 1)
+		require.Equal(t, &RouterFeeTokenEnabled{FeeToken: mint}, res.Events[0].Data)
+	})
+
+	t.Run("failed CPI pops the stack", func(t *testing.T) {
+		t.Parallel()
+		logs := []string{
+			invoke(feeQuoter, 1),
+			invoke(unknown, 2),
+			fmt.Sprintf("Program %s failed: custom program error: 0x1", unknown),
+			programData("FeeTokenEnabled", mint),
+			success(feeQuoter),
+		}
+
+		res, err := decoder.DecodeLogs(logs)
+		require.NoError(t, err)
+		require.Len(t, res.Events, 1)
+		require.Equal(t, feeQuoter, res.Events[0].ProgramID)
+		require.Equal(t, &FeeQuoterFeeTokenEnabled{FeeToken: mint}, res.Events[0].Data)
+	})
+
+	t.Run("malformed event data", func(t *testing.T) {
+		t.Parallel()
+		logs := []string{
+			invoke(router, 1),
+			"Program data: " + base64.StdEncoding.EncodeToString(append(common.Discriminator("event", "FeeTokenEnabled"), 1, 2, 3)),
+			success(router),
+		}
+
+		_, err := decoder.DecodeLogs(logs)
+		require.ErrorContains(t, err, "failed to decode FeeTokenEnabled event")
+	})
+
+	t.Run("transaction without metadata", func(t *testing.T) {
+		t.Parallel()
+		_, err := decoder.DecodeTransaction(&rpc.GetTransactionResult{})
+		require.ErrorContains(t, err, "no metadata")
+	})
+}
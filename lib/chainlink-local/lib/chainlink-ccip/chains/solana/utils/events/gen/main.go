@@ -0,0 +1,220 @@
+// gen generates typed event structs and decoders from the anchor IDLs of the CCIP programs.
+// anchor-go (v0.29 IDLs) does not generate events, see utils/ccip/ccip_events.go.
+//
+// Usage (from chains/solana): go run ./utils/events/gen -idl contracts/target/idl -out utils/events/events_gen.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// programs maps the IDL name to the go type prefix of its events
+var programs = []struct {
+	IDL    string
+	Prefix string
+}{
+	{"ccip_router", "Router"},
+	{"ccip_offramp", "Offramp"},
+	{"fee_quoter", "FeeQuoter"},
+	{"base_token_pool", "TokenPool"},
+}
+
+type idl struct {
+	Events []struct {
+		Name   string `json:"name"`
+		Fields []struct {
+			Name string          `json:"name"`
+			Type json.RawMessage `json:"type"`
+		} `json:"fields"`
+	} `json:"events"`
+}
+
+type field struct {
+	Name     string
+	GoType   string
+	Optional bool // anchor `option`, decoded manually as bin.UnmarshalBorsh does not support it
+}
+
+type event struct {
+	TypeName string
+	IDLName  string
+	Fields   []field
+}
+
+func main() {
+	idlDir := flag.String("idl", "contracts/target/idl", "directory containing the anchor IDLs")
+	out := flag.String("out", "utils/events/events_gen.go", "output file")
+	flag.Parse()
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by utils/events/gen. DO NOT EDIT.\n\npackage events\n\n")
+	buf.WriteString("import (\n")
+	buf.WriteString("\tbin \"github.com/gagliardetto/binary\"\n\t\"github.com/gagliardetto/solana-go\"\n\n")
+	for _, p := range programs {
+		fmt.Fprintf(&buf, "\t\"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/%s\"\n", p.IDL)
+	}
+	buf.WriteString(")\n\n")
+	buf.WriteString("var (\n\t_ = solana.PublicKey{}\n\t_ bin.Uint128\n)\n\n")
+
+	for _, p := range programs {
+		raw, err := os.ReadFile(filepath.Join(*idlDir, p.IDL+".json"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		var parsed idl
+		if err = json.Unmarshal(raw, &parsed); err != nil {
+			log.Fatalf("%s: %v", p.IDL, err)
+		}
+
+		events := []event{}
+		seen := map[string]int{}
+		for _, e := range parsed.Events {
+			// some programs declare multiple events with the same name (and therefore discriminator)
+			typeName := p.Prefix + e.Name
+			if n := seen[e.Name]; n > 0 {
+				typeName = fmt.Sprintf("%s%d", typeName, n+1)
+			}
+			seen[e.Name]++
+
+			ev := event{TypeName: typeName, IDLName: e.Name}
+			for _, f := range e.Fields {
+				goType, optional, err := toGoType(f.Type, p.IDL)
+				if err != nil {
+					log.Fatalf("%s.%s.%s: %v", p.IDL, e.Name, f.Name, err)
+				}
+				ev.Fields = append(ev.Fields, field{Name: exported(f.Name), GoType: goType, Optional: optional})
+			}
+			events = append(events, ev)
+		}
+
+		writeProgram(&buf, p.IDL, p.Prefix, events)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("failed to format generated code: %v\n%s", err, buf.String())
+	}
+	if err = os.WriteFile(*out, formatted, 0o600); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeProgram(buf *bytes.Buffer, idlName, prefix string, events []event) {
+	for _, e := range events {
+		fmt.Fprintf(buf, "// %s is the `%s` event of the %s program.\n", e.TypeName, e.IDLName, idlName)
+		fmt.Fprintf(buf, "type %s struct {\n", e.TypeName)
+		for _, f := range e.Fields {
+			if f.Optional {
+				fmt.Fprintf(buf, "\t%s *%s `bin:\"optional\"`\n", f.Name, f.GoType)
+				continue
+			}
+			fmt.Fprintf(buf, "\t%s %s\n", f.Name, f.GoType)
+		}
+		buf.WriteString("}\n\n")
+
+		fmt.Fprintf(buf, "func (obj *%s) UnmarshalWithDecoder(decoder *bin.Decoder) error {\n", e.TypeName)
+		for _, f := range e.Fields {
+			fmt.Fprintf(buf, "\t// Deserialize `%s`:\n", f.Name)
+			if f.Optional {
+				fmt.Fprintf(buf, "\tif ok, err := decoder.ReadBool(); err != nil {\n\t\treturn err\n\t} else if ok {\n")
+				fmt.Fprintf(buf, "\t\tif err = decoder.Decode(&obj.%s); err != nil {\n\t\t\treturn err\n\t\t}\n\t}\n", f.Name)
+				continue
+			}
+			fmt.Fprintf(buf, "\tif err := decoder.Decode(&obj.%s); err != nil {\n\t\treturn err\n\t}\n", f.Name)
+		}
+		buf.WriteString("\treturn nil\n}\n\n")
+	}
+
+	fmt.Fprintf(buf, "// %sEvents lists the events emitted by the %s program.\n", prefix, idlName)
+	fmt.Fprintf(buf, "var %sEvents = []Definition{\n", prefix)
+	for _, e := range events {
+		fmt.Fprintf(buf, "\t{Name: %q, New: func() Event { return new(%s) }},\n", e.IDLName, e.TypeName)
+	}
+	buf.WriteString("}\n\n")
+}
+
+func toGoType(raw json.RawMessage, pkg string) (string, bool, error) {
+	var primitive string
+	if err := json.Unmarshal(raw, &primitive); err == nil {
+		t, err := primitiveType(primitive)
+		return t, false, err
+	}
+
+	var complex map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &complex); err != nil {
+		return "", false, err
+	}
+	for kind, inner := range complex {
+		switch kind {
+		case "defined":
+			var name string
+			if err := json.Unmarshal(inner, &name); err != nil {
+				return "", false, err
+			}
+			return pkg + "." + name, false, nil
+		case "option":
+			t, _, err := toGoType(inner, pkg)
+			return t, true, err
+		case "vec":
+			t, optional, err := toGoType(inner, pkg)
+			if optional {
+				return "", false, fmt.Errorf("vec of options is not supported")
+			}
+			return "[]" + t, false, err
+		case "array":
+			var arr []json.RawMessage
+			if err := json.Unmarshal(inner, &arr); err != nil || len(arr) != 2 {
+				return "", false, fmt.Errorf("invalid array type: %s", inner)
+			}
+			t, optional, err := toGoType(arr[0], pkg)
+			if err != nil || optional {
+				return "", false, fmt.Errorf("invalid array element type: %s", arr[0])
+			}
+			var size int
+			if err = json.Unmarshal(arr[1], &size); err != nil {
+				return "", false, err
+			}
+			return fmt.Sprintf("[%d]%s", size, t), false, nil
+		}
+		return "", false, fmt.Errorf("unsupported type kind: %s", kind)
+	}
+	return "", false, fmt.Errorf("empty type")
+}
+
+var primitives = map[string]string{
+	"bool":      "bool",
+	"u8":        "uint8",
+	"u16":       "uint16",
+	"u32":       "uint32",
+	"u64":       "uint64",
+	"u128":      "bin.Uint128",
+	"i8":        "int8",
+	"i16":       "int16",
+	"i32":       "int32",
+	"i64":       "int64",
+	"i128":      "bin.Int128",
+	"string":    "string",
+	"bytes":     "[]byte",
+	"publicKey": "solana.PublicKey",
+	"pubkey":    "solana.PublicKey",
+}
+
+func primitiveType(t string) (string, error) {
+	goType, ok := primitives[t]
+	if !ok {
+		return "", fmt.Errorf("unsupported primitive type: %s", t)
+	}
+	return goType, nil
+}
+
+func exported(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
@@ -14,47 +14,71 @@ import (
 
 // mcm signer dataless pda
 func GetSignerPDA(msigID [32]byte) solana.PublicKey {
+	return getSignerPDA(config.McmProgram, msigID)
+}
+
+func getSignerPDA(program solana.PublicKey, msigID [32]byte) solana.PublicKey {
 	pda, _, _ := solana.FindProgramAddress([][]byte{
 		[]byte("multisig_signer"),
 		msigID[:],
-	}, config.McmProgram)
+	}, program)
 	return pda
 }
 
 func GetConfigPDA(msigID [32]byte) solana.PublicKey {
+	return getConfigPDA(config.McmProgram, msigID)
+}
+
+func getConfigPDA(program solana.PublicKey, msigID [32]byte) solana.PublicKey {
 	pda, _, _ := solana.FindProgramAddress([][]byte{
 		[]byte("multisig_config"),
 		msigID[:],
-	}, config.McmProgram)
+	}, program)
 	return pda
 }
 
 func GetConfigSignersPDA(msigID [32]byte) solana.PublicKey {
+	return getConfigSignersPDA(config.McmProgram, msigID)
+}
+
+func getConfigSignersPDA(program solana.PublicKey, msigID [32]byte) solana.PublicKey {
 	pda, _, _ := solana.FindProgramAddress([][]byte{
 		[]byte("multisig_config_signers"),
 		msigID[:],
-	}, config.McmProgram)
+	}, program)
 	return pda
 }
 
 func GetRootMetadataPDA(msigID [32]byte) solana.PublicKey {
+	return getRootMetadataPDA(config.McmProgram, msigID)
+}
+
+func getRootMetadataPDA(program solana.PublicKey, msigID [32]byte) solana.PublicKey {
 	pda, _, _ := solana.FindProgramAddress([][]byte{
 		[]byte("root_metadata"),
 		msigID[:],
-	}, config.McmProgram)
+	}, program)
 	return pda
 }
 
 func GetExpiringRootAndOpCountPDA(msigID [32]byte) solana.PublicKey {
+	return getExpiringRootAndOpCountPDA(config.McmProgram, msigID)
+}
+
+func getExpiringRootAndOpCountPDA(program solana.PublicKey, msigID [32]byte) solana.PublicKey {
 	pda, _, _ := solana.FindProgramAddress([][]byte{
 		[]byte("expiring_root_and_op_count"),
 		msigID[:],
-	}, config.McmProgram)
+	}, program)
 	return pda
 }
 
 // get address of the root_signatures pda
 func GetRootSignaturesPDA(msigID [32]byte, root [32]byte, validUntil uint32, authority solana.PublicKey) solana.PublicKey {
+	return getRootSignaturesPDA(config.McmProgram, msigID, root, validUntil, authority)
+}
+
+func getRootSignaturesPDA(program solana.PublicKey, msigID [32]byte, root [32]byte, validUntil uint32, authority solana.PublicKey) solana.PublicKey {
 	validUntilBytes := make([]byte, 4)
 	binary.LittleEndian.PutUint32(validUntilBytes, validUntil)
 
@@ -64,12 +88,16 @@ func GetRootSignaturesPDA(msigID [32]byte, root [32]byte, validUntil uint32, aut
 		root[:],
 		validUntilBytes,
 		authority[:],
-	}, config.McmProgram)
+	}, program)
 	return pda
 }
 
 // get address of the seen_signed_hashes pda
 func GetSeenSignedHashesPDA(msigID [32]byte, root [32]byte, validUntil uint32) solana.PublicKey {
+	return getSeenSignedHashesPDA(config.McmProgram, msigID, root, validUntil)
+}
+
+func getSeenSignedHashesPDA(program solana.PublicKey, msigID [32]byte, root [32]byte, validUntil uint32) solana.PublicKey {
 	validUntilBytes := make([]byte, 4)
 	binary.LittleEndian.PutUint32(validUntilBytes, validUntil)
 	pda, _, _ := solana.FindProgramAddress([][]byte{
@@ -77,7 +105,7 @@ func GetSeenSignedHashesPDA(msigID [32]byte, root [32]byte, validUntil uint32) s
 		msigID[:],
 		root[:],
 		validUntilBytes,
-	}, config.McmProgram)
+	}, program)
 	return pda
 }
 
@@ -162,18 +190,23 @@ func NewValidMcmConfig(msigID [32]byte, signerPrivateKeys []string, signerGroups
 }
 
 func GetNewMcmMultisig(id [32]byte) Multisig {
+	return NewMcmMultisig(config.McmProgram, id)
+}
+
+// NewMcmMultisig returns the accounts of multisig id of the mcm program deployed at program
+func NewMcmMultisig(program solana.PublicKey, id [32]byte) Multisig {
 	return Multisig{
 		PaddedID:                  id,
-		SignerPDA:                 GetSignerPDA(id),
-		ConfigPDA:                 GetConfigPDA(id),
-		RootMetadataPDA:           GetRootMetadataPDA(id),
-		ExpiringRootAndOpCountPDA: GetExpiringRootAndOpCountPDA(id),
-		ConfigSignersPDA:          GetConfigSignersPDA(id),
+		SignerPDA:                 getSignerPDA(program, id),
+		ConfigPDA:                 getConfigPDA(program, id),
+		RootMetadataPDA:           getRootMetadataPDA(program, id),
+		ExpiringRootAndOpCountPDA: getExpiringRootAndOpCountPDA(program, id),
+		ConfigSignersPDA:          getConfigSignersPDA(program, id),
 		RootSignaturesPDA: func(root [32]byte, validUntil uint32, authority solana.PublicKey) solana.PublicKey {
-			return GetRootSignaturesPDA(id, root, validUntil, authority)
+			return getRootSignaturesPDA(program, id, root, validUntil, authority)
 		},
 		SeenSignedHashesPDA: func(root [32]byte, validUntil uint32) solana.PublicKey {
-			return GetSeenSignedHashesPDA(id, root, validUntil)
+			return getSeenSignedHashesPDA(program, id, root, validUntil)
 		},
 	}
 }
@@ -244,9 +277,15 @@ func GetAppendSignersIxs(signerAddresses [][20]uint8, msigID [32]byte, multisigC
 
 // instructions builder for preloading signatures
 func GetMcmPreloadSignaturesIxs(signatures []mcm.Signature, msigID [32]byte, root [32]uint8, validUntil uint32, authority solana.PublicKey, appendChunkSize int) ([]solana.Instruction, error) {
+	return GetMultisigPreloadSignaturesIxs(signatures, GetNewMcmMultisig(msigID), root, validUntil, authority, appendChunkSize)
+}
+
+// instructions builder for preloading signatures to the root_signatures pda of msig
+func GetMultisigPreloadSignaturesIxs(signatures []mcm.Signature, msig Multisig, root [32]uint8, validUntil uint32, authority solana.PublicKey, appendChunkSize int) ([]solana.Instruction, error) {
 	ixs := make([]solana.Instruction, 0)
 
-	signaturesPDA := GetRootSignaturesPDA(msigID, root, validUntil, authority)
+	msigID := msig.PaddedID
+	signaturesPDA := msig.RootSignaturesPDA(root, validUntil, authority)
 
 	initSigsIx, isErr := mcm.NewInitSignaturesInstruction(
 		msigID,
@@ -263,7 +302,7 @@ func GetMcmPreloadSignaturesIxs(signatures []mcm.Signature, msigID [32]byte, roo
 	}
 	ixs = append(ixs, initSigsIx)
 
-	appendSigsIxs, asErr := GetAppendSignaturesIxs(signatures, msigID, root, validUntil, authority, appendChunkSize)
+	appendSigsIxs, asErr := getAppendSignaturesIxs(signatures, msigID, signaturesPDA, root, validUntil, authority, appendChunkSize)
 	if asErr != nil {
 		return nil, asErr
 	}
@@ -288,7 +327,10 @@ func GetMcmPreloadSignaturesIxs(signatures []mcm.Signature, msigID [32]byte, roo
 // get chunked append instructions to preload signatures to pda, required before set_root
 func GetAppendSignaturesIxs(signatures []mcm.Signature, msigID [32]byte, root [32]uint8, validUntil uint32, authority solana.PublicKey, chunkSize int) ([]solana.Instruction, error) {
 	signaturesPDA := GetRootSignaturesPDA(msigID, root, validUntil, authority)
+	return getAppendSignaturesIxs(signatures, msigID, signaturesPDA, root, validUntil, authority, chunkSize)
+}
 
+func getAppendSignaturesIxs(signatures []mcm.Signature, msigID [32]byte, signaturesPDA solana.PublicKey, root [32]uint8, validUntil uint32, authority solana.PublicKey, chunkSize int) ([]solana.Instruction, error) {
 	if chunkSize > config.MaxAppendSignatureBatchSize {
 		return nil, errors.New("chunkSize exceeds max signatures chunk size")
 	}
@@ -315,6 +357,7 @@ func GetAppendSignaturesIxs(signatures []mcm.Signature, msigID [32]byte, root [3
 }
 
 type McmRootInput struct {
+	ChainID              uint64 // chain ID the multisig was initialized with, config.TestChainID if zero
 	Multisig             solana.PublicKey
 	Operations           []McmOpNode
 	PreOpCount           uint64
@@ -340,6 +383,7 @@ func CreateMcmRootData(input McmRootInput) (McmRootData, error) {
 	}
 
 	rootMetadata := RootMetadataNode{
+		ChainID:              input.ChainID,
 		Multisig:             input.Multisig,
 		PreOpCount:           input.PreOpCount,
 		PostOpCount:          input.PostOpCount,
@@ -353,8 +397,12 @@ func CreateMcmRootData(input McmRootInput) (McmRootData, error) {
 		return McmRootData{}, fmt.Errorf("failed to create tree: %w", err)
 	}
 
+	chainID := input.ChainID
+	if chainID == 0 {
+		chainID = config.TestChainID
+	}
 	metadata := mcm.RootMetadataInput{
-		ChainId:              config.TestChainID,
+		ChainId:              chainID,
 		Multisig:             rootMetadata.Multisig,
 		PreOpCount:           rootMetadata.PreOpCount,
 		PostOpCount:          rootMetadata.PostOpCount,
@@ -396,6 +444,11 @@ func BulkSignOnMsgHash(signers []eth.Signer, ethMsgHash []byte) ([]mcm.Signature
 }
 
 func IxToMcmTestOpNode(multisig solana.PublicKey, msigSigner solana.PublicKey, ix solana.Instruction, nonce uint64) (McmOpNode, error) {
+	return IxToMcmOpNode(config.TestChainID, multisig, msigSigner, ix, nonce)
+}
+
+// IxToMcmOpNode converts ix into an operation of the multisig initialized with chainID, executed by the msigSigner pda
+func IxToMcmOpNode(chainID uint64, multisig solana.PublicKey, msigSigner solana.PublicKey, ix solana.Instruction, nonce uint64) (McmOpNode, error) {
 	ixData, err := ix.Data()
 	if err != nil {
 		return McmOpNode{}, err
@@ -417,6 +470,7 @@ func IxToMcmTestOpNode(multisig solana.PublicKey, msigSigner solana.PublicKey, i
 	}
 
 	node := McmOpNode{
+		ChainID:           chainID,
 		Multisig:          multisig,
 		Nonce:             nonce,
 		To:                ix.ProgramID(),
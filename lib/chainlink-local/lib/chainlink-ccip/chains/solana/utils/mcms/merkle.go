@@ -43,6 +43,7 @@ func (b *BaseNode) Size() int {
 
 type McmOpNode struct {
 	BaseNode
+	ChainID           uint64 // chain ID the multisig was initialized with, config.TestChainID if zero
 	Nonce             uint64
 	Data              []byte
 	Multisig          solana.PublicKey // this is config PDA
@@ -54,7 +55,7 @@ func (t *McmOpNode) Buffers() [][]byte {
 	domainSeparatorHashBytes := eth.Keccak256([]byte("MANY_CHAIN_MULTI_SIG_DOMAIN_SEPARATOR_OP_SOLANA"))
 	buffers := [][]byte{
 		domainSeparatorHashBytes[:],
-		config.TestChainIDPaddedBuffer[:],
+		chainIDBuffer(t.ChainID),
 		t.Multisig.Bytes(),
 		numToU64LePaddedEncoding(t.Nonce),
 		t.To.Bytes(),
@@ -99,6 +100,7 @@ func (t *McmOpNode) Print(padding int) {
 
 type RootMetadataNode struct {
 	BaseNode
+	ChainID              uint64 // chain ID the multisig was initialized with, config.TestChainID if zero
 	PreOpCount           uint64
 	PostOpCount          uint64
 	Multisig             solana.PublicKey
@@ -109,7 +111,7 @@ func (rm *RootMetadataNode) Buffers() [][]byte {
 	domainSeparatorHashBytes := eth.Keccak256([]byte("MANY_CHAIN_MULTI_SIG_DOMAIN_SEPARATOR_METADATA_SOLANA"))
 	return [][]byte{
 		domainSeparatorHashBytes[:],
-		config.TestChainIDPaddedBuffer[:],
+		chainIDBuffer(rm.ChainID),
 		rm.Multisig.Bytes(),
 		numToU64LePaddedEncoding(rm.PreOpCount),
 		numToU64LePaddedEncoding(rm.PostOpCount),
@@ -274,6 +276,13 @@ func NewOpMerkleTree(nodes []MerkleNode) (MerkleNode, error) {
 	}
 }
 
+func chainIDBuffer(chainID uint64) []byte {
+	if chainID == 0 {
+		return config.TestChainIDPaddedBuffer[:]
+	}
+	return numToU64LePaddedEncoding(chainID)
+}
+
 func numToU64LePaddedEncoding(n uint64) []byte {
 	b := make([]byte, 32)
 	binary.LittleEndian.PutUint64(b[24:], n)
@@ -0,0 +1,205 @@
+// Builds, signs and verifies mcm proposals and emits the instructions to submit them.
+//
+// Usage (from chains/solana):
+//
+//	go run ./utils/mcms/proposal/cmd build -proposal proposal.json -out built.json -payload payload.json
+//	go run ./utils/mcms/proposal/cmd sign -built built.json -key <hex private key> -out sig.json
+//	go run ./utils/mcms/proposal/cmd verify -built built.json -sigs sig1.json,sig2.json [-config config.json] -out signatures.json
+//	go run ./utils/mcms/proposal/cmd instructions -built built.json -sigs signatures.json -authority <pubkey> -out ixs.json
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/eth"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/mcms/proposal"
+)
+
+var commands = map[string]func(args []string) error{
+	"build":        build,
+	"sign":         sign,
+	"verify":       verify,
+	"instructions": instructions,
+}
+
+func main() {
+	if len(os.Args) < 2 || commands[os.Args[1]] == nil {
+		log.Fatal("usage: <build|sign|verify|instructions> [flags]")
+	}
+	if err := commands[os.Args[1]](os.Args[2:]); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func build(args []string) error {
+	fs := flag.NewFlagSet("build", flag.ExitOnError)
+	proposalFile := fs.String("proposal", "proposal.json", "declarative proposal file")
+	out := fs.String("out", "", "output file for the built proposal, stdout if empty")
+	payload := fs.String("payload", "", "optional output file for the signing payload")
+	_ = fs.Parse(args)
+
+	raw, err := os.ReadFile(*proposalFile)
+	if err != nil {
+		return err
+	}
+	p, err := proposal.ParseProposal(raw)
+	if err != nil {
+		return err
+	}
+	built, err := proposal.Build(p)
+	if err != nil {
+		return err
+	}
+	if *payload != "" {
+		if err = writeJSON(*payload, built.SigningPayload); err != nil {
+			return err
+		}
+	}
+	return writeJSON(*out, built)
+}
+
+func sign(args []string) error {
+	fs := flag.NewFlagSet("sign", flag.ExitOnError)
+	builtFile := fs.String("built", "built.json", "built proposal")
+	key := fs.String("key", "", "hex encoded evm private key")
+	out := fs.String("out", "", "output file, stdout if empty")
+	_ = fs.Parse(args)
+
+	var built proposal.Built
+	if err := readJSON(*builtFile, &built); err != nil {
+		return err
+	}
+	signer, err := eth.GetSignerFromPk(strings.TrimPrefix(*key, "0x"))
+	if err != nil {
+		return err
+	}
+	sig, err := proposal.Sign(signer, built.SigningPayload)
+	if err != nil {
+		return err
+	}
+	return writeJSON(*out, []proposal.SignerSignature{sig})
+}
+
+func verify(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	builtFile := fs.String("built", "built.json", "built proposal")
+	sigFiles := fs.String("sigs", "", "comma separated signature files")
+	configFile := fs.String("config", "", "optional multisig config overriding the one of the proposal, e.g. fetched from chain")
+	out := fs.String("out", "", "optional output file for the merged signatures")
+	_ = fs.Parse(args)
+
+	built, sigs, err := loadSignatures(*builtFile, *sigFiles, *configFile)
+	if err != nil {
+		return err
+	}
+	ordered, err := proposal.Verify(built, sigs)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "quorum reached with %d signatures for root %x\n", len(ordered), built.Root)
+	if *out == "" {
+		return nil
+	}
+
+	// keep the signers so that the merged file can be passed to instructions
+	merged := make([]proposal.SignerSignature, len(ordered))
+	for i, sig := range ordered {
+		signer, err := eth.RecoverAddress(built.MsgHash[:], sig)
+		if err != nil {
+			return err
+		}
+		merged[i] = proposal.SignerSignature{Signer: signer, Signature: proposal.Signature(sig)}
+	}
+	return writeJSON(*out, merged)
+}
+
+func instructions(args []string) error {
+	fs := flag.NewFlagSet("instructions", flag.ExitOnError)
+	builtFile := fs.String("built", "built.json", "built proposal")
+	sigFiles := fs.String("sigs", "", "comma separated signature files")
+	configFile := fs.String("config", "", "optional multisig config overriding the one of the proposal")
+	authority := fs.String("authority", "", "account submitting the proposal")
+	out := fs.String("out", "", "output file, stdout if empty")
+	_ = fs.Parse(args)
+
+	authorityKey, err := solana.PublicKeyFromBase58(*authority)
+	if err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	built, sigs, err := loadSignatures(*builtFile, *sigFiles, *configFile)
+	if err != nil {
+		return err
+	}
+	ordered, err := proposal.Verify(built, sigs)
+	if err != nil {
+		return err
+	}
+	ixs, err := proposal.Instructions(built, ordered, authorityKey)
+	if err != nil {
+		return err
+	}
+
+	serialized := make([]proposal.Instruction, len(ixs))
+	for i, ix := range ixs {
+		if serialized[i], err = proposal.FromSolana(ix); err != nil {
+			return err
+		}
+	}
+	return writeJSON(*out, serialized)
+}
+
+func loadSignatures(builtFile, sigFiles, configFile string) (proposal.Built, []proposal.SignerSignature, error) {
+	var built proposal.Built
+	if err := readJSON(builtFile, &built); err != nil {
+		return built, nil, err
+	}
+	if configFile != "" {
+		if err := readJSON(configFile, &built.Config); err != nil {
+			return built, nil, err
+		}
+	}
+	if sigFiles == "" {
+		return built, nil, errors.New("no signature files provided")
+	}
+
+	var sigs []proposal.SignerSignature
+	for _, f := range strings.Split(sigFiles, ",") {
+		var fileSigs []proposal.SignerSignature
+		if err := readJSON(strings.TrimSpace(f), &fileSigs); err != nil {
+			return built, nil, err
+		}
+		sigs = append(sigs, fileSigs...)
+	}
+	return built, sigs, nil
+}
+
+func readJSON(file string, v interface{}) error {
+	raw, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	if err = json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", file, err)
+	}
+	return nil
+}
+
+func writeJSON(file string, v interface{}) error {
+	raw, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if file == "" {
+		_, err = fmt.Println(string(raw))
+		return err
+	}
+	return os.WriteFile(file, append(raw, '\n'), 0o600)
+}
@@ -0,0 +1,398 @@
+// Package proposal builds mcm proposals from a declarative file, verifies collected signatures
+// against the multisig config offline and produces the instructions to submit the proposal.
+//
+// Roots and PDAs are derived with the chain ID and the program IDs of the proposal file.
+package proposal
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/timelock"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/mcms"
+	timelockutil "github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/timelock"
+)
+
+const (
+	numGroups = 32
+	// NOTE: mirrors MAX_NUM_SIGNERS of the mcm program
+	maxNumSigners = 180
+	// max number of signatures appended per transaction
+	maxAppendSignatureBatchSize = 13
+)
+
+// Hash is a 32 byte value encoded as hex in proposal files
+type Hash [32]byte
+
+func (h Hash) MarshalText() ([]byte, error) {
+	return []byte("0x" + hex.EncodeToString(h[:])), nil
+}
+
+func (h *Hash) UnmarshalText(text []byte) error {
+	return decodeHex(string(text), h[:])
+}
+
+// Address is an evm signer address encoded as hex in proposal files
+type Address [20]byte
+
+func (a Address) MarshalText() ([]byte, error) {
+	return []byte("0x" + hex.EncodeToString(a[:])), nil
+}
+
+func (a *Address) UnmarshalText(text []byte) error {
+	return decodeHex(string(text), a[:])
+}
+
+func decodeHex(s string, out []byte) error {
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return err
+	}
+	if len(b) != len(out) {
+		return fmt.Errorf("expected %d bytes, got %d", len(out), len(b))
+	}
+	copy(out, b)
+	return nil
+}
+
+type AccountMeta struct {
+	PublicKey  solana.PublicKey `json:"publicKey"`
+	IsSigner   bool             `json:"isSigner"`
+	IsWritable bool             `json:"isWritable"`
+}
+
+// Instruction is a target program instruction, data is base64 encoded
+type Instruction struct {
+	ProgramID solana.PublicKey `json:"programId"`
+	Accounts  []AccountMeta    `json:"accounts"`
+	Data      []byte           `json:"data"`
+}
+
+func (ix Instruction) toSolana() solana.Instruction {
+	accounts := make(solana.AccountMetaSlice, len(ix.Accounts))
+	for i, acc := range ix.Accounts {
+		accounts[i] = &solana.AccountMeta{PublicKey: acc.PublicKey, IsSigner: acc.IsSigner, IsWritable: acc.IsWritable}
+	}
+	return solana.NewInstruction(ix.ProgramID, accounts, ix.Data)
+}
+
+// FromSolana converts an instruction so it can be serialized
+func FromSolana(ix solana.Instruction) (Instruction, error) {
+	data, err := ix.Data()
+	if err != nil {
+		return Instruction{}, err
+	}
+	accounts := make([]AccountMeta, len(ix.Accounts()))
+	for i, acc := range ix.Accounts() {
+		accounts[i] = AccountMeta{PublicKey: acc.PublicKey, IsSigner: acc.IsSigner, IsWritable: acc.IsWritable}
+	}
+	return Instruction{ProgramID: ix.ProgramID(), Accounts: accounts, Data: data}, nil
+}
+
+// Timelock schedules the proposal instructions as a single timelock batch instead of executing them directly
+type Timelock struct {
+	Program                  solana.PublicKey `json:"program"`
+	ID                       string           `json:"id"`
+	Delay                    uint64           `json:"delay"` // seconds
+	Predecessor              Hash             `json:"predecessor"`
+	Salt                     Hash             `json:"salt"`
+	ProposerAccessController solana.PublicKey `json:"proposerAccessController"`
+}
+
+// Uint8s is encoded as a list of numbers, encoding/json would encode a []uint8 as base64
+type Uint8s []uint8
+
+func (u Uint8s) MarshalJSON() ([]byte, error) {
+	ints := make([]int, len(u))
+	for i, v := range u {
+		ints[i] = int(v)
+	}
+	return json.Marshal(ints)
+}
+
+// Config mirrors the on-chain multisig config used to verify signatures offline
+type Config struct {
+	Signers      []Address `json:"signers"`
+	SignerGroups Uint8s    `json:"signerGroups"`
+	GroupQuorums Uint8s    `json:"groupQuorums"`
+	GroupParents Uint8s    `json:"groupParents"`
+}
+
+// Validate applies the same checks as mcm::set_config
+func (c Config) Validate() error {
+	if len(c.Signers) == 0 || len(c.Signers) > maxNumSigners {
+		return fmt.Errorf("invalid number of signers: %d", len(c.Signers))
+	}
+	if len(c.Signers) != len(c.SignerGroups) {
+		return fmt.Errorf("number of signers (%d) does not match length of signerGroups (%d)", len(c.Signers), len(c.SignerGroups))
+	}
+	if len(c.GroupQuorums) > numGroups || len(c.GroupParents) > numGroups {
+		return fmt.Errorf("at most %d groups are supported", numGroups)
+	}
+	if len(c.GroupQuorums) == 0 || c.GroupQuorums[0] == 0 {
+		return errors.New("root group must have a quorum")
+	}
+
+	quorums, parents := c.groups()
+	if parents[0] != 0 {
+		return errors.New("root group must be its own parent")
+	}
+	members := [numGroups]int{}
+	for _, g := range c.SignerGroups {
+		if int(g) >= numGroups {
+			return fmt.Errorf("signer group %d out of range", g)
+		}
+		members[g]++
+	}
+	for i := numGroups - 1; i >= 0; i-- {
+		if i > 0 && parents[i] >= uint8(i) { //nolint:gosec // i < numGroups
+			return fmt.Errorf("group %d must have a parent with a lower index, got %d", i, parents[i])
+		}
+		if quorums[i] == 0 {
+			if members[i] > 0 {
+				return fmt.Errorf("group %d has signers but no quorum", i)
+			}
+			continue
+		}
+		if members[i] < int(quorums[i]) {
+			return fmt.Errorf("group %d can not reach its quorum of %d with %d members", i, quorums[i], members[i])
+		}
+		if i > 0 {
+			members[parents[i]]++ // a group that reached quorum counts as one vote of its parent
+		}
+	}
+	return nil
+}
+
+func (c Config) groups() (quorums, parents [numGroups]uint8) {
+	copy(quorums[:], c.GroupQuorums)
+	copy(parents[:], c.GroupParents)
+	return quorums, parents
+}
+
+// Proposal is the declarative proposal file
+type Proposal struct {
+	ChainID              uint64           `json:"chainId"` // chain ID the multisig was initialized with
+	McmProgram           solana.PublicKey `json:"mcmProgram"`
+	MultisigID           string           `json:"multisigId"`
+	PreOpCount           uint64           `json:"preOpCount"`
+	ValidUntil           uint32           `json:"validUntil"`
+	OverridePreviousRoot bool             `json:"overridePreviousRoot"`
+	Timelock             *Timelock        `json:"timelock,omitempty"`
+	Instructions         []Instruction    `json:"instructions"`
+	Config               Config           `json:"config"`
+}
+
+func ParseProposal(data []byte) (Proposal, error) {
+	var p Proposal
+	if err := json.Unmarshal(data, &p); err != nil {
+		return Proposal{}, fmt.Errorf("failed to parse proposal: %w", err)
+	}
+	return p, nil
+}
+
+// Op is a single mcm operation with its proof against the root
+type Op struct {
+	Nonce    uint64           `json:"nonce"`
+	To       solana.PublicKey `json:"to"`
+	Data     []byte           `json:"data"`
+	Accounts []AccountMeta    `json:"accounts"`
+	Proof    []Hash           `json:"proof"`
+}
+
+// SigningPayload is what signers need to sign a proposal
+type SigningPayload struct {
+	MultisigID string `json:"multisigId"`
+	Root       Hash   `json:"root"`
+	ValidUntil uint32 `json:"validUntil"`
+	// MsgHash is the eth signed message hash of (root, validUntil) that has to be signed
+	MsgHash Hash `json:"msgHash"`
+}
+
+// Built is the output of Build, it holds everything needed to collect signatures and submit the proposal
+type Built struct {
+	SigningPayload
+	ChainID              uint64           `json:"chainId"`
+	McmProgram           solana.PublicKey `json:"mcmProgram"`
+	Multisig             solana.PublicKey `json:"multisig"` // config PDA
+	PreOpCount           uint64           `json:"preOpCount"`
+	PostOpCount          uint64           `json:"postOpCount"`
+	OverridePreviousRoot bool             `json:"overridePreviousRoot"`
+	MetadataProof        []Hash           `json:"metadataProof"`
+	Ops                  []Op             `json:"ops"`
+	Config               Config           `json:"config"`
+	TimelockOperationID  *Hash            `json:"timelockOperationId,omitempty"`
+}
+
+func (b Built) multisig() (mcms.Multisig, error) {
+	id, err := mcms.PadString32(b.MultisigID)
+	if err != nil {
+		return mcms.Multisig{}, err
+	}
+	return mcms.NewMcmMultisig(b.McmProgram, id), nil
+}
+
+// Build computes the mcm operations, merkle root and proofs of the proposal
+func Build(p Proposal) (Built, error) {
+	if len(p.Instructions) == 0 {
+		return Built{}, errors.New("proposal has no instructions")
+	}
+	if p.ChainID == 0 {
+		return Built{}, errors.New("proposal has no chain id")
+	}
+	if p.McmProgram.IsZero() {
+		return Built{}, errors.New("proposal has no mcm program")
+	}
+	if p.Timelock != nil && p.Timelock.Program.IsZero() {
+		return Built{}, errors.New("proposal timelock has no program")
+	}
+	if err := p.Config.Validate(); err != nil {
+		return Built{}, fmt.Errorf("invalid config: %w", err)
+	}
+	id, err := mcms.PadString32(p.MultisigID)
+	if err != nil {
+		return Built{}, fmt.Errorf("invalid multisig id: %w", err)
+	}
+	msig := mcms.NewMcmMultisig(p.McmProgram, id)
+
+	ixs := make([]solana.Instruction, len(p.Instructions))
+	for i, ix := range p.Instructions {
+		ixs[i] = ix.toSolana()
+	}
+
+	var opID *Hash
+	if p.Timelock != nil {
+		var scheduled Hash
+		ixs, scheduled, err = scheduleBatchIxs(p.Timelock, msig.SignerPDA, ixs)
+		if err != nil {
+			return Built{}, err
+		}
+		opID = &scheduled
+	}
+
+	nodes := make([]mcms.McmOpNode, len(ixs))
+	for i, ix := range ixs {
+		nodes[i], err = mcms.IxToMcmOpNode(p.ChainID, msig.ConfigPDA, msig.SignerPDA, ix, p.PreOpCount+uint64(i))
+		if err != nil {
+			return Built{}, fmt.Errorf("failed to build op %d: %w", i, err)
+		}
+	}
+
+	rootData, err := mcms.CreateMcmRootData(mcms.McmRootInput{
+		ChainID:              p.ChainID,
+		Multisig:             msig.ConfigPDA,
+		Operations:           nodes,
+		PreOpCount:           p.PreOpCount,
+		PostOpCount:          p.PreOpCount + uint64(len(nodes)),
+		ValidUntil:           p.ValidUntil,
+		OverridePreviousRoot: p.OverridePreviousRoot,
+	})
+	if err != nil {
+		return Built{}, fmt.Errorf("failed to create root: %w", err)
+	}
+
+	built := Built{
+		SigningPayload: SigningPayload{
+			MultisigID: p.MultisigID,
+			Root:       rootData.Root,
+			ValidUntil: p.ValidUntil,
+		},
+		ChainID:              p.ChainID,
+		McmProgram:           p.McmProgram,
+		Multisig:             msig.ConfigPDA,
+		PreOpCount:           rootData.Metadata.PreOpCount,
+		PostOpCount:          rootData.Metadata.PostOpCount,
+		OverridePreviousRoot: rootData.Metadata.OverridePreviousRoot,
+		MetadataProof:        toHashes(rootData.MetadataProof),
+		Config:               p.Config,
+		TimelockOperationID:  opID,
+	}
+	copy(built.MsgHash[:], rootData.EthMsgHash)
+
+	for i := range nodes {
+		node := &nodes[i]
+		proof, err := node.Proofs()
+		if err != nil {
+			return Built{}, fmt.Errorf("failed to get proof of op %d: %w", i, err)
+		}
+		op := Op{Nonce: node.Nonce, To: node.To, Data: node.Data, Proof: toHashes(proof)}
+		for _, acc := range node.RemainingAccounts {
+			op.Accounts = append(op.Accounts, AccountMeta{PublicKey: acc.PublicKey, IsSigner: acc.IsSigner, IsWritable: acc.IsWritable})
+		}
+		built.Ops = append(built.Ops, op)
+	}
+	return built, nil
+}
+
+// wraps the instructions in a timelock batch: operation preload instructions followed by schedule_batch
+func scheduleBatchIxs(tl *Timelock, msigSigner solana.PublicKey, ixs []solana.Instruction) ([]solana.Instruction, Hash, error) {
+	timelockID, err := mcms.PadString32(tl.ID)
+	if err != nil {
+		return nil, Hash{}, fmt.Errorf("invalid timelock id: %w", err)
+	}
+	op := timelockutil.Operation{
+		TimelockID:  timelockID,
+		Predecessor: tl.Predecessor,
+		Salt:        tl.Salt,
+		Delay:       tl.Delay,
+	}
+	for _, ix := range ixs {
+		op.AddInstruction(ix, []solana.PublicKey{ix.ProgramID()})
+	}
+
+	out, err := timelockutil.GetProgramPreloadOperationIxs(tl.Program, timelockID, op, msigSigner, tl.ProposerAccessController)
+	if err != nil {
+		return nil, Hash{}, err
+	}
+	scheduleIx, err := timelock.NewScheduleBatchInstruction(
+		timelockID,
+		op.OperationID(),
+		op.Delay,
+		timelockutil.GetProgramOperationPDA(tl.Program, timelockID, op.OperationID()),
+		timelockutil.GetProgramConfigPDA(tl.Program, timelockID),
+		tl.ProposerAccessController,
+		msigSigner,
+	).ValidateAndBuild()
+	if err != nil {
+		return nil, Hash{}, fmt.Errorf("failed to build schedule batch instruction: %w", err)
+	}
+	out, err = onProgram(tl.Program, append(out, scheduleIx))
+	if err != nil {
+		return nil, Hash{}, err
+	}
+	return out, op.OperationID(), nil
+}
+
+// onProgram points instructions built with the program ID of the bindings to program
+func onProgram(program solana.PublicKey, ixs []solana.Instruction) ([]solana.Instruction, error) {
+	out := make([]solana.Instruction, len(ixs))
+	for i, ix := range ixs {
+		data, err := ix.Data()
+		if err != nil {
+			return nil, err
+		}
+		out[i] = solana.NewInstruction(program, ix.Accounts(), data)
+	}
+	return out, nil
+}
+
+func toHashes(proof [][32]byte) []Hash {
+	hashes := make([]Hash, len(proof))
+	for i, p := range proof {
+		hashes[i] = p
+	}
+	return hashes
+}
+
+func fromHashes(hashes []Hash) [][32]uint8 {
+	proof := make([][32]uint8, len(hashes))
+	for i, h := range hashes {
+		proof[i] = h
+	}
+	return proof
+}
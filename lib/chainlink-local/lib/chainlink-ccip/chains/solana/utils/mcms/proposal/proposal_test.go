@@ -0,0 +1,239 @@
+package proposal
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/contracts/tests/config"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/mcm"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/eth"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/mcms"
+)
+
+func TestProposal(t *testing.T) {
+	t.Parallel()
+
+	keys, err := eth.GenerateEthPrivateKeys(5)
+	require.NoError(t, err)
+	signers, err := eth.GetEvmSigners(keys)
+	require.NoError(t, err)
+
+	// root group 0 needs 2 votes: signer 0 directly and group 1 (2 of 4 signers)
+	cfg := Config{SignerGroups: []uint8{0, 1, 1, 1, 1}, GroupQuorums: []uint8{2, 2}, GroupParents: []uint8{0, 0}}
+	for _, s := range signers {
+		cfg.Signers = append(cfg.Signers, s.Address)
+	}
+
+	target := solana.NewWallet().PublicKey()
+	timelockProgram := solana.NewWallet().PublicKey()
+	p := Proposal{
+		ChainID:    config.TestChainID,
+		McmProgram: solana.NewWallet().PublicKey(),
+		MultisigID: "test-mcm",
+		PreOpCount: 3,
+		ValidUntil: 0xffffffff,
+		Instructions: []Instruction{
+			{ProgramID: target, Accounts: []AccountMeta{{PublicKey: solana.NewWallet().PublicKey(), IsWritable: true}}, Data: []byte{1, 2, 3}},
+			{ProgramID: target, Accounts: []AccountMeta{}, Data: []byte{4}},
+		},
+		Config: cfg,
+	}
+
+	sign := func(t *testing.T, built Built, idx ...int) []SignerSignature {
+		sigs := make([]SignerSignature, 0, len(idx))
+		for _, i := range idx {
+			sig, err := Sign(signers[i], built.SigningPayload)
+			require.NoError(t, err)
+			sigs = append(sigs, sig)
+		}
+		return sigs
+	}
+
+	t.Run("build matches mcms utils", func(t *testing.T) {
+		t.Parallel()
+		built, err := Build(p)
+		require.NoError(t, err)
+		require.Len(t, built.Ops, 2)
+		require.Equal(t, uint64(3), built.Ops[0].Nonce)
+		require.Equal(t, uint64(5), built.PostOpCount)
+		require.Nil(t, built.TimelockOperationID)
+
+		id, err := mcms.PadString32(p.MultisigID)
+		require.NoError(t, err)
+		msig := mcms.NewMcmMultisig(p.McmProgram, id)
+		nodes := make([]mcms.McmOpNode, len(p.Instructions))
+		for i, ix := range p.Instructions {
+			nodes[i], err = mcms.IxToMcmOpNode(p.ChainID, msig.ConfigPDA, msig.SignerPDA, ix.toSolana(), p.PreOpCount+uint64(i))
+			require.NoError(t, err)
+		}
+		rootData, err := mcms.CreateMcmRootData(mcms.McmRootInput{
+			ChainID:     p.ChainID,
+			Multisig:    msig.ConfigPDA,
+			Operations:  nodes,
+			PreOpCount:  p.PreOpCount,
+			PostOpCount: p.PreOpCount + uint64(len(nodes)),
+			ValidUntil:  p.ValidUntil,
+		})
+		require.NoError(t, err)
+		require.Equal(t, Hash(rootData.Root), built.Root)
+		require.Equal(t, rootData.EthMsgHash, built.MsgHash[:])
+	})
+
+	t.Run("schedules through timelock", func(t *testing.T) {
+		t.Parallel()
+		withTimelock := p
+		withTimelock.Timelock = &Timelock{Program: timelockProgram, ID: "test-timelock", Delay: 3600, ProposerAccessController: solana.NewWallet().PublicKey()}
+		built, err := Build(withTimelock)
+		require.NoError(t, err)
+		require.NotNil(t, built.TimelockOperationID)
+		// initialize_operation, initialize_instruction + append data per instruction, finalize_operation, schedule_batch
+		require.Len(t, built.Ops, 1+2*2+1+1)
+		for _, op := range built.Ops {
+			require.Equal(t, timelockProgram, op.To)
+		}
+	})
+
+	t.Run("uses the chain and programs of the proposal", func(t *testing.T) {
+		t.Parallel()
+		testBuilt, err := Build(p)
+		require.NoError(t, err)
+
+		other := p
+		other.ChainID = 1
+		other.McmProgram = solana.NewWallet().PublicKey()
+		built, err := Build(other)
+		require.NoError(t, err)
+		require.NotEqual(t, testBuilt.Root, built.Root)
+		require.NotEqual(t, testBuilt.Multisig, built.Multisig)
+
+		id, err := mcms.PadString32(p.MultisigID)
+		require.NoError(t, err)
+		require.Equal(t, mcms.NewMcmMultisig(other.McmProgram, id).ConfigPDA, built.Multisig)
+
+		ordered, err := Verify(built, sign(t, built, 0, 1, 2))
+		require.NoError(t, err)
+		ixs, err := Instructions(built, ordered, solana.NewWallet().PublicKey())
+		require.NoError(t, err)
+		for _, ix := range ixs {
+			require.Equal(t, other.McmProgram, ix.ProgramID())
+		}
+		setRoot, err := mcm.DecodeInstruction(ixs[3].Accounts(), mustData(t, ixs[3]))
+		require.NoError(t, err)
+		require.Equal(t, other.ChainID, setRoot.Impl.(*mcm.SetRoot).Metadata.ChainId)
+
+		missing := p
+		missing.ChainID = 0
+		_, err = Build(missing)
+		require.ErrorContains(t, err, "no chain id")
+
+		missing = p
+		missing.Timelock = &Timelock{ID: "test-timelock"}
+		_, err = Build(missing)
+		require.ErrorContains(t, err, "timelock has no program")
+	})
+
+	t.Run("round trips through json", func(t *testing.T) {
+		t.Parallel()
+		built, err := Build(p)
+		require.NoError(t, err)
+		sigs := sign(t, built, 0, 1)
+
+		raw, err := json.Marshal(built)
+		require.NoError(t, err)
+		var decoded Built
+		require.NoError(t, json.Unmarshal(raw, &decoded))
+		require.Equal(t, built, decoded)
+
+		raw, err = json.Marshal(sigs)
+		require.NoError(t, err)
+		var decodedSigs []SignerSignature
+		require.NoError(t, json.Unmarshal(raw, &decodedSigs))
+		require.Equal(t, sigs, decodedSigs)
+	})
+
+	t.Run("verify quorum", func(t *testing.T) {
+		t.Parallel()
+		built, err := Build(p)
+		require.NoError(t, err)
+
+		ordered, err := Verify(built, sign(t, built, 4, 2, 0, 2))
+		require.NoError(t, err)
+		require.Len(t, ordered, 3) // duplicate dropped
+		for i := 1; i < len(ordered); i++ {
+			prev, err := eth.RecoverAddress(built.MsgHash[:], ordered[i-1])
+			require.NoError(t, err)
+			cur, err := eth.RecoverAddress(built.MsgHash[:], ordered[i])
+			require.NoError(t, err)
+			require.Less(t, string(prev[:]), string(cur[:]))
+		}
+
+		// group 1 alone only counts as a single vote of the root group
+		_, err = Verify(built, sign(t, built, 1, 2, 3, 4))
+		var quorumErr QuorumError
+		require.True(t, errors.As(err, &quorumErr))
+		require.Equal(t, uint8(1), quorumErr.Votes[0])
+		require.Equal(t, uint8(4), quorumErr.Votes[1])
+	})
+
+	t.Run("rejects foreign and mismatched signatures", func(t *testing.T) {
+		t.Parallel()
+		built, err := Build(p)
+		require.NoError(t, err)
+
+		otherKeys, err := eth.GenerateEthPrivateKeys(1)
+		require.NoError(t, err)
+		other, err := eth.GetSignerFromPk(otherKeys[0])
+		require.NoError(t, err)
+		foreign, err := Sign(other, built.SigningPayload)
+		require.NoError(t, err)
+		_, err = Verify(built, append(sign(t, built, 0, 1), foreign))
+		require.ErrorContains(t, err, "is not a signer")
+
+		mismatched := sign(t, built, 0)
+		mismatched[0].Signer = signers[1].Address
+		_, err = Verify(built, mismatched)
+		require.ErrorContains(t, err, "signed by")
+	})
+
+	t.Run("instructions", func(t *testing.T) {
+		t.Parallel()
+		built, err := Build(p)
+		require.NoError(t, err)
+		ordered, err := Verify(built, sign(t, built, 0, 1, 2))
+		require.NoError(t, err)
+
+		authority := solana.NewWallet().PublicKey()
+		ixs, err := Instructions(built, ordered, authority)
+		require.NoError(t, err)
+		// init, append, finalize signatures, set_root, one execute per op
+		require.Len(t, ixs, 3+1+len(built.Ops))
+		for _, ix := range ixs {
+			require.Equal(t, p.McmProgram, ix.ProgramID())
+		}
+		data, err := ixs[3].Data()
+		require.NoError(t, err)
+		require.Equal(t, mcm.Instruction_SetRoot[:], data[:8])
+
+		execute := ixs[len(ixs)-2]
+		require.Equal(t, target, execute.Accounts()[3].PublicKey)
+		require.Equal(t, built.Ops[0].Accounts[0].PublicKey, execute.Accounts()[len(execute.Accounts())-1].PublicKey)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		t.Parallel()
+		invalid := p
+		invalid.Config.GroupQuorums = []uint8{2, 5}
+		_, err := Build(invalid)
+		require.ErrorContains(t, err, "can not reach its quorum")
+	})
+}
+
+func mustData(t *testing.T, ix solana.Instruction) []byte {
+	data, err := ix.Data()
+	require.NoError(t, err)
+	return data
+}
@@ -0,0 +1,189 @@
+package proposal
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/mcm"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/eth"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/mcms"
+)
+
+// Signature is encoded as 0x-prefixed hex of r || s || v, the usual evm encoding
+type Signature mcm.Signature
+
+func (s Signature) MarshalText() ([]byte, error) {
+	b := make([]byte, 0, 65)
+	b = append(b, s.R[:]...)
+	b = append(b, s.S[:]...)
+	b = append(b, s.V)
+	return []byte("0x" + hex.EncodeToString(b)), nil
+}
+
+func (s *Signature) UnmarshalText(text []byte) error {
+	var b [65]byte
+	if err := decodeHex(string(text), b[:]); err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	copy(s.R[:], b[:32])
+	copy(s.S[:], b[32:64])
+	s.V = b[64]
+	if s.V < 27 {
+		s.V += 27 // some signers return the raw recovery id
+	}
+	return nil
+}
+
+// SignerSignature is a signature collected from a single signer
+type SignerSignature struct {
+	Signer    Address   `json:"signer"`
+	Signature Signature `json:"signature"`
+}
+
+// Sign signs the payload with a local key, mostly useful for testing and dev environments
+func Sign(signer eth.Signer, payload SigningPayload) (SignerSignature, error) {
+	sig, err := signer.Sign(payload.MsgHash[:])
+	if err != nil {
+		return SignerSignature{}, err
+	}
+	return SignerSignature{Signer: signer.Address, Signature: Signature(sig)}, nil
+}
+
+// QuorumError is returned when the collected signatures do not reach the root group quorum
+type QuorumError struct {
+	Votes  [numGroups]uint8
+	Quorum uint8
+}
+
+func (e QuorumError) Error() string {
+	return fmt.Sprintf("root group quorum not reached: %d of %d (group votes: %v)", e.Votes[0], e.Quorum, e.Votes)
+}
+
+// Verify checks the signatures against the proposal config the same way mcm::set_root does,
+// and returns them in the order expected on-chain (strictly increasing signer address).
+// Duplicate signatures of the same signer are dropped.
+func Verify(built Built, sigs []SignerSignature) ([]mcm.Signature, error) {
+	if err := built.Config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	groups := make(map[Address]uint8, len(built.Config.Signers))
+	for i, signer := range built.Config.Signers {
+		groups[signer] = built.Config.SignerGroups[i]
+	}
+
+	bySigner := map[Address]mcm.Signature{}
+	for i, sig := range sigs {
+		recovered, err := eth.RecoverAddress(built.MsgHash[:], mcm.Signature(sig.Signature))
+		if err != nil {
+			return nil, fmt.Errorf("signature %d: %w", i, err)
+		}
+		if Address(recovered) != sig.Signer {
+			return nil, fmt.Errorf("signature %d: signed by %s, expected %s", i, fmtAddress(recovered), fmtAddress(sig.Signer))
+		}
+		if _, ok := groups[sig.Signer]; !ok {
+			return nil, fmt.Errorf("signature %d: %s is not a signer of the multisig", i, fmtAddress(sig.Signer))
+		}
+		bySigner[sig.Signer] = mcm.Signature(sig.Signature)
+	}
+
+	signers := make([]Address, 0, len(bySigner))
+	for signer := range bySigner {
+		signers = append(signers, signer)
+	}
+	sort.Slice(signers, func(i, j int) bool {
+		return bytes.Compare(signers[i][:], signers[j][:]) < 0
+	})
+
+	quorums, parents := built.Config.groups()
+	var votes [numGroups]uint8
+	ordered := make([]mcm.Signature, len(signers))
+	for i, signer := range signers {
+		ordered[i] = bySigner[signer]
+		group := groups[signer]
+		for {
+			votes[group]++
+			if votes[group] != quorums[group] || group == 0 {
+				break
+			}
+			group = parents[group]
+		}
+	}
+	if votes[0] < quorums[0] {
+		return nil, QuorumError{Votes: votes, Quorum: quorums[0]}
+	}
+	return ordered, nil
+}
+
+// Instructions returns the instructions to submit the proposal in order:
+// preloading the signatures, mcm::set_root and one mcm::execute per operation.
+// authority pays for the signatures account and must sign the preload and set_root transactions.
+func Instructions(built Built, sigs []mcm.Signature, authority solana.PublicKey) ([]solana.Instruction, error) {
+	msig, err := built.multisig()
+	if err != nil {
+		return nil, err
+	}
+
+	ixs, err := mcms.GetMultisigPreloadSignaturesIxs(sigs, msig, built.Root, built.ValidUntil, authority, maxAppendSignatureBatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build signature preload instructions: %w", err)
+	}
+
+	setRootIx, err := mcm.NewSetRootInstruction(
+		msig.PaddedID,
+		built.Root,
+		built.ValidUntil,
+		mcm.RootMetadataInput{
+			ChainId:              built.ChainID,
+			Multisig:             built.Multisig,
+			PreOpCount:           built.PreOpCount,
+			PostOpCount:          built.PostOpCount,
+			OverridePreviousRoot: built.OverridePreviousRoot,
+		},
+		fromHashes(built.MetadataProof),
+		msig.RootSignaturesPDA(built.Root, built.ValidUntil, authority),
+		msig.RootMetadataPDA,
+		msig.SeenSignedHashesPDA(built.Root, built.ValidUntil),
+		msig.ExpiringRootAndOpCountPDA,
+		msig.ConfigPDA,
+		authority,
+		solana.SystemProgramID,
+	).ValidateAndBuild()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build set root instruction: %w", err)
+	}
+	ixs = append(ixs, setRootIx)
+
+	for _, op := range built.Ops {
+		ix := mcm.NewExecuteInstruction(
+			msig.PaddedID,
+			built.ChainID,
+			op.Nonce,
+			op.Data,
+			fromHashes(op.Proof),
+			msig.ConfigPDA,
+			msig.RootMetadataPDA,
+			msig.ExpiringRootAndOpCountPDA,
+			op.To,
+			msig.SignerPDA,
+			authority,
+		)
+		for _, acc := range op.Accounts {
+			ix.AccountMetaSlice = append(ix.AccountMetaSlice, &solana.AccountMeta{PublicKey: acc.PublicKey, IsSigner: acc.IsSigner, IsWritable: acc.IsWritable})
+		}
+		executeIx, err := ix.ValidateAndBuild()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build execute instruction for op %d: %w", op.Nonce, err)
+		}
+		ixs = append(ixs, executeIx)
+	}
+	return onProgram(built.McmProgram, ixs)
+}
+
+func fmtAddress(a [20]byte) string {
+	return "0x" + hex.EncodeToString(a[:])
+}
@@ -26,16 +26,26 @@ func GetSignerPDA(timelockID [32]byte) solana.PublicKey {
 }
 
 func GetConfigPDA(timelockID [32]byte) solana.PublicKey {
-	pda, _, _ := solana.FindProgramAddress([][]byte{[]byte("timelock_config"), timelockID[:]}, config.TimelockProgram)
+	return GetProgramConfigPDA(config.TimelockProgram, timelockID)
+}
+
+// GetProgramConfigPDA returns the config pda of timelockID of the timelock program deployed at program
+func GetProgramConfigPDA(program solana.PublicKey, timelockID [32]byte) solana.PublicKey {
+	pda, _, _ := solana.FindProgramAddress([][]byte{[]byte("timelock_config"), timelockID[:]}, program)
 	return pda
 }
 
 func GetOperationPDA(timelockID [32]byte, opID [32]byte) solana.PublicKey {
+	return GetProgramOperationPDA(config.TimelockProgram, timelockID, opID)
+}
+
+// GetProgramOperationPDA returns the operation pda of opID of the timelock program deployed at program
+func GetProgramOperationPDA(program solana.PublicKey, timelockID [32]byte, opID [32]byte) solana.PublicKey {
 	pda, _, _ := solana.FindProgramAddress([][]byte{
 		[]byte("timelock_operation"),
 		timelockID[:],
 		opID[:],
-	}, config.TimelockProgram)
+	}, program)
 	return pda
 }
 
@@ -121,6 +131,17 @@ func GetBatchAddAccessIxs(ctx context.Context, timelockID [32]byte, roleAcAccoun
 
 // instructions builder for preloading instructions to timelock operation
 func GetPreloadOperationIxs(timelockID [32]byte, op Operation, authority solana.PublicKey, proposerAc solana.PublicKey) ([]solana.Instruction, error) {
+	return getPreloadOperationIxs(timelockID, op, op.OperationPDA(), GetConfigPDA(timelockID), authority, proposerAc)
+}
+
+// GetProgramPreloadOperationIxs builds the instructions preloading op to the timelock program deployed at program.
+// The instructions are built with the timelock bindings, their program ID is timelock.ProgramID.
+func GetProgramPreloadOperationIxs(program solana.PublicKey, timelockID [32]byte, op Operation, authority solana.PublicKey, proposerAc solana.PublicKey) ([]solana.Instruction, error) {
+	opPDA := GetProgramOperationPDA(program, timelockID, op.OperationID())
+	return getPreloadOperationIxs(timelockID, op, opPDA, GetProgramConfigPDA(program, timelockID), authority, proposerAc)
+}
+
+func getPreloadOperationIxs(timelockID [32]byte, op Operation, opPDA solana.PublicKey, configPDA solana.PublicKey, authority solana.PublicKey, proposerAc solana.PublicKey) ([]solana.Instruction, error) {
 	ixs := []solana.Instruction{}
 	initOpIx, ioErr := timelock.NewInitializeOperationInstruction(
 		timelockID,
@@ -128,8 +149,8 @@ func GetPreloadOperationIxs(timelockID [32]byte, op Operation, authority solana.
 		op.Predecessor,
 		op.Salt,
 		op.IxsCountU32(),
-		op.OperationPDA(),
-		GetConfigPDA(timelockID),
+		opPDA,
+		configPDA,
 		proposerAc,
 		authority,
 		solana.SystemProgramID,
@@ -146,8 +167,8 @@ func GetPreloadOperationIxs(timelockID [32]byte, op Operation, authority solana.
 			ixData.ProgramId, // ProgramId
 			ixData.Accounts,  // The list of accounts for this instruction
 			// Accounts:
-			op.OperationPDA(),
-			GetConfigPDA(timelockID),
+			opPDA,
+			configPDA,
 			proposerAc,
 			authority,
 			solana.SystemProgramID,
@@ -173,8 +194,8 @@ func GetPreloadOperationIxs(timelockID [32]byte, op Operation, authority solana.
 				//nolint:gosec
 				uint32(ixIndex), // which instruction index we are chunking
 				chunk,           // partial data
-				op.OperationPDA(),
-				GetConfigPDA(timelockID),
+				opPDA,
+				configPDA,
 				proposerAc,
 				authority,
 				solana.SystemProgramID,
@@ -191,8 +212,8 @@ func GetPreloadOperationIxs(timelockID [32]byte, op Operation, authority solana.
 	finOpIx, foErr := timelock.NewFinalizeOperationInstruction(
 		timelockID,
 		op.OperationID(),
-		op.OperationPDA(),
-		GetConfigPDA(timelockID),
+		opPDA,
+		configPDA,
 		proposerAc,
 		authority,
 	).ValidateAndBuild()
@@ -0,0 +1,355 @@
+package tokens
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// Token-2022 instruction and extension layouts are not covered by `solana-go`, so they are encoded manually here.
+// https://github.com/solana-program/token-2022/blob/main/program/src/instruction.rs
+// https://github.com/solana-program/token-2022/blob/main/program/src/extension/mod.rs
+const (
+	token2022InstructionTransferFeeExtension     uint8 = 26
+	token2022InstructionInterestBearingMint      uint8 = 33
+	token2022InstructionMetadataPointerExtension uint8 = 39
+
+	// sub-instructions of the extension instructions above
+	transferFeeInitializeConfig   uint8 = 0
+	interestBearingMintInitialize uint8 = 0
+	interestBearingMintUpdateRate uint8 = 1
+	metadataPointerInitialize     uint8 = 0
+
+	token2022AccountTypeMint uint8 = 1
+)
+
+const (
+	token2022BaseAccountLen             = 165 // extensions are appended after the base account length, even for mints
+	token2022MultisigLen                = 355
+	token2022ExtensionHeaderLen         = 4 // u16 type + u16 length
+	token2022AccountTypeLen             = 1
+	tokenMetadataEmptyAdditionalDataLen = 4 // borsh encoded empty vec
+
+	token2022SecondsPerYear   float64 = 60 * 60 * 24 * 365.24
+	token2022OneInBasisPoints float64 = 10_000
+)
+
+// Token2022ExtensionType identifies a Token-2022 TLV extension
+type Token2022ExtensionType uint16
+
+const (
+	ExtensionTransferFeeConfig     Token2022ExtensionType = 1
+	ExtensionInterestBearingConfig Token2022ExtensionType = 10
+	ExtensionMetadataPointer       Token2022ExtensionType = 18
+	ExtensionTokenMetadata         Token2022ExtensionType = 19
+)
+
+// fixed data lengths for the supported mint extensions
+var token2022ExtensionLen = map[Token2022ExtensionType]int{
+	ExtensionTransferFeeConfig:     108,
+	ExtensionInterestBearingConfig: 52,
+	ExtensionMetadataPointer:       64,
+}
+
+var tokenMetadataInitializeDiscriminator = func() [8]byte {
+	h := sha256.Sum256([]byte("spl_token_metadata_interface:initialize_account"))
+	var d [8]byte
+	copy(d[:], h[:8])
+	return d
+}()
+
+// InterestBearingParams configures the interest-bearing mint extension
+type InterestBearingParams struct {
+	RateAuthority solana.PublicKey // zero value means the rate can not be updated
+	RateBps       int16
+}
+
+// TransferFeeParams configures the transfer-fee mint extension
+type TransferFeeParams struct {
+	ConfigAuthority   solana.PublicKey // zero value means no authority
+	WithdrawAuthority solana.PublicKey // zero value means no authority
+	BasisPoints       uint16
+	MaximumFee        uint64
+}
+
+// TokenMetadataParams configures the metadata stored directly on the mint (metadata pointer + token metadata extensions)
+type TokenMetadataParams struct {
+	UpdateAuthority solana.PublicKey
+	Name            string
+	Symbol          string
+	URI             string
+}
+
+// Token2022Extensions lists the mint extensions to enable, nil entries are skipped
+type Token2022Extensions struct {
+	InterestBearing *InterestBearingParams
+	TransferFee     *TransferFeeParams
+	Metadata        *TokenMetadataParams
+}
+
+func (e Token2022Extensions) types() []Token2022ExtensionType {
+	list := []Token2022ExtensionType{}
+	if e.TransferFee != nil {
+		list = append(list, ExtensionTransferFeeConfig)
+	}
+	if e.InterestBearing != nil {
+		list = append(list, ExtensionInterestBearingConfig)
+	}
+	if e.Metadata != nil {
+		list = append(list, ExtensionMetadataPointer)
+	}
+	return list
+}
+
+// Token2022MintSize returns the account size required for a mint with the given fixed-length extensions.
+// Variable length extensions (token metadata) are reallocated by the token program and are not included.
+func Token2022MintSize(extensions ...Token2022ExtensionType) (int, error) {
+	if len(extensions) == 0 {
+		return token.MINT_SIZE, nil
+	}
+
+	size := token2022BaseAccountLen + token2022AccountTypeLen
+	for _, e := range extensions {
+		l, ok := token2022ExtensionLen[e]
+		if !ok {
+			return 0, fmt.Errorf("unsupported fixed length extension: %d", e)
+		}
+		size += token2022ExtensionHeaderLen + l
+	}
+
+	// avoid collision with the multisig account length, see `ExtensionType::try_calculate_account_len`
+	if size == token2022MultisigLen {
+		size += token2022ExtensionHeaderLen
+	}
+	return size, nil
+}
+
+// TokenMetadataSize returns the TLV size of the token metadata extension
+func TokenMetadataSize(m TokenMetadataParams) int {
+	return token2022ExtensionHeaderLen +
+		solana.PublicKeyLength + // update authority
+		solana.PublicKeyLength + // mint
+		4 + len(m.Name) +
+		4 + len(m.Symbol) +
+		4 + len(m.URI) +
+		tokenMetadataEmptyAdditionalDataLen
+}
+
+// CreateToken2022WithExtensions builds the instructions to create and initialize a Token-2022 mint with the requested extensions.
+// The mint account must sign the transaction alongside the admin.
+func CreateToken2022WithExtensions(ctx context.Context, mint, admin solana.PublicKey, decimals uint8, extensions Token2022Extensions, client *rpc.Client, commitment rpc.CommitmentType) ([]solana.Instruction, error) {
+	program := solana.Token2022ProgramID
+
+	space, err := Token2022MintSize(extensions.types()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// metadata is reallocated into the mint by the token program, but the lamports for it need to be there upfront
+	rentSize := space
+	if extensions.Metadata != nil {
+		rentSize += TokenMetadataSize(*extensions.Metadata)
+	}
+	lamports, err := client.GetMinimumBalanceForRentExemption(ctx, uint64(rentSize), commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	initI, err := system.NewCreateAccountInstruction(lamports, uint64(space), program, admin, mint).ValidateAndBuild()
+	if err != nil {
+		return nil, err
+	}
+	ixs := []solana.Instruction{initI}
+
+	// extensions must be initialized before the mint itself
+	if extensions.TransferFee != nil {
+		ixs = append(ixs, InitializeTransferFeeConfig(program, mint, *extensions.TransferFee))
+	}
+	if extensions.InterestBearing != nil {
+		ixs = append(ixs, InitializeInterestBearingMint(program, mint, *extensions.InterestBearing))
+	}
+	if extensions.Metadata != nil {
+		ixs = append(ixs, InitializeMetadataPointer(program, mint, extensions.Metadata.UpdateAuthority, mint))
+	}
+
+	mintI, err := token.NewInitializeMintInstruction(decimals, admin, admin, mint, solana.SysVarRentPubkey).ValidateAndBuild()
+	if err != nil {
+		return nil, err
+	}
+	ixs = append(ixs, &TokenInstruction{mintI, program})
+
+	if extensions.Metadata != nil {
+		ixs = append(ixs, InitializeTokenMetadata(program, mint, admin, *extensions.Metadata))
+	}
+	return ixs, nil
+}
+
+func InitializeTransferFeeConfig(program, mint solana.PublicKey, params TransferFeeParams) solana.Instruction {
+	data := []byte{token2022InstructionTransferFeeExtension, transferFeeInitializeConfig}
+	data = appendCOptionPubkey(data, params.ConfigAuthority)
+	data = appendCOptionPubkey(data, params.WithdrawAuthority)
+	data = binary.LittleEndian.AppendUint16(data, params.BasisPoints)
+	data = binary.LittleEndian.AppendUint64(data, params.MaximumFee)
+	return solana.NewInstruction(program, solana.AccountMetaSlice{solana.Meta(mint).WRITE()}, data)
+}
+
+func InitializeInterestBearingMint(program, mint solana.PublicKey, params InterestBearingParams) solana.Instruction {
+	data := []byte{token2022InstructionInterestBearingMint, interestBearingMintInitialize}
+	data = append(data, params.RateAuthority.Bytes()...) // OptionalNonZeroPubkey, zero key = None
+	data = binary.LittleEndian.AppendUint16(data, uint16(params.RateBps))
+	return solana.NewInstruction(program, solana.AccountMetaSlice{solana.Meta(mint).WRITE()}, data)
+}
+
+func UpdateInterestRate(program, mint, rateAuthority solana.PublicKey, rateBps int16) solana.Instruction {
+	data := []byte{token2022InstructionInterestBearingMint, interestBearingMintUpdateRate}
+	data = binary.LittleEndian.AppendUint16(data, uint16(rateBps))
+	return solana.NewInstruction(program, solana.AccountMetaSlice{
+		solana.Meta(mint).WRITE(),
+		solana.Meta(rateAuthority).SIGNER(),
+	}, data)
+}
+
+func InitializeMetadataPointer(program, mint, authority, metadata solana.PublicKey) solana.Instruction {
+	data := []byte{token2022InstructionMetadataPointerExtension, metadataPointerInitialize}
+	data = append(data, authority.Bytes()...) // OptionalNonZeroPubkey
+	data = append(data, metadata.Bytes()...)  // OptionalNonZeroPubkey
+	return solana.NewInstruction(program, solana.AccountMetaSlice{solana.Meta(mint).WRITE()}, data)
+}
+
+// InitializeTokenMetadata stores the metadata on the mint itself, requires the mint to be initialized with a metadata pointer to itself
+func InitializeTokenMetadata(program, mint, mintAuthority solana.PublicKey, params TokenMetadataParams) solana.Instruction {
+	data := append([]byte{}, tokenMetadataInitializeDiscriminator[:]...)
+	data = appendBorshString(data, params.Name)
+	data = appendBorshString(data, params.Symbol)
+	data = appendBorshString(data, params.URI)
+	return solana.NewInstruction(program, solana.AccountMetaSlice{
+		solana.Meta(mint).WRITE(),
+		solana.Meta(params.UpdateAuthority),
+		solana.Meta(mint),
+		solana.Meta(mintAuthority).SIGNER(),
+	}, data)
+}
+
+// InterestBearingConfig mirrors the on-chain `InterestBearingConfig` extension
+type InterestBearingConfig struct {
+	RateAuthority           solana.PublicKey
+	InitializationTimestamp int64
+	PreUpdateAverageRate    int16
+	LastUpdateTimestamp     int64
+	CurrentRate             int16
+}
+
+// AmountToUIAmount converts a raw amount to the UI amount including accrued interest at the given unix timestamp.
+// This matches `InterestBearingConfig::amount_to_ui_amount` in the token program.
+func (c InterestBearingConfig) AmountToUIAmount(amount uint64, decimals uint8, unixTimestamp int64) float64 {
+	return float64(amount) * c.totalScale(unixTimestamp) / math.Pow10(int(decimals))
+}
+
+// UIAmountToAmount is the inverse of AmountToUIAmount, rounded down to the nearest raw amount
+func (c InterestBearingConfig) UIAmountToAmount(uiAmount float64, decimals uint8, unixTimestamp int64) uint64 {
+	return uint64(math.Floor(uiAmount * math.Pow10(int(decimals)) / c.totalScale(unixTimestamp)))
+}
+
+func (c InterestBearingConfig) totalScale(unixTimestamp int64) float64 {
+	preUpdateTimespan := float64(c.LastUpdateTimestamp - c.InitializationTimestamp)
+	preUpdateExp := math.Exp(float64(c.PreUpdateAverageRate) * preUpdateTimespan / token2022SecondsPerYear / token2022OneInBasisPoints)
+
+	postUpdateTimespan := float64(unixTimestamp - c.LastUpdateTimestamp)
+	postUpdateExp := math.Exp(float64(c.CurrentRate) * postUpdateTimespan / token2022SecondsPerYear / token2022OneInBasisPoints)
+	return preUpdateExp * postUpdateExp
+}
+
+// GetMintExtension returns the raw data of an extension from Token-2022 mint account data
+func GetMintExtension(data []byte, extension Token2022ExtensionType) ([]byte, error) {
+	if len(data) <= token2022BaseAccountLen {
+		return nil, fmt.Errorf("mint has no extensions")
+	}
+	if data[token2022BaseAccountLen] != token2022AccountTypeMint {
+		return nil, fmt.Errorf("account is not a mint: account type %d", data[token2022BaseAccountLen])
+	}
+
+	tlv := data[token2022BaseAccountLen+token2022AccountTypeLen:]
+	for len(tlv) >= token2022ExtensionHeaderLen {
+		t := Token2022ExtensionType(binary.LittleEndian.Uint16(tlv[0:2]))
+		l := int(binary.LittleEndian.Uint16(tlv[2:4]))
+		if t == 0 { // uninitialized space
+			break
+		}
+		if len(tlv) < token2022ExtensionHeaderLen+l {
+			return nil, fmt.Errorf("extension %d is truncated", t)
+		}
+		if t == extension {
+			return tlv[token2022ExtensionHeaderLen : token2022ExtensionHeaderLen+l], nil
+		}
+		tlv = tlv[token2022ExtensionHeaderLen+l:]
+	}
+	return nil, fmt.Errorf("extension %d not found", extension)
+}
+
+func ParseInterestBearingConfig(data []byte) (InterestBearingConfig, error) {
+	raw, err := GetMintExtension(data, ExtensionInterestBearingConfig)
+	if err != nil {
+		return InterestBearingConfig{}, err
+	}
+	if len(raw) != token2022ExtensionLen[ExtensionInterestBearingConfig] {
+		return InterestBearingConfig{}, fmt.Errorf("unexpected interest bearing config length: %d", len(raw))
+	}
+
+	return InterestBearingConfig{
+		RateAuthority:           solana.PublicKeyFromBytes(raw[0:32]),
+		InitializationTimestamp: int64(binary.LittleEndian.Uint64(raw[32:40])), //nolint:gosec // i64 stored as u64 bytes
+		PreUpdateAverageRate:    int16(binary.LittleEndian.Uint16(raw[40:42])), //nolint:gosec // i16 stored as u16 bytes
+		LastUpdateTimestamp:     int64(binary.LittleEndian.Uint64(raw[42:50])), //nolint:gosec // i64 stored as u64 bytes
+		CurrentRate:             int16(binary.LittleEndian.Uint16(raw[50:52])), //nolint:gosec // i16 stored as u16 bytes
+	}, nil
+}
+
+func GetInterestBearingConfig(ctx context.Context, client *rpc.Client, mint solana.PublicKey, commitment rpc.CommitmentType) (InterestBearingConfig, error) {
+	res, err := client.GetAccountInfoWithOpts(ctx, mint, &rpc.GetAccountInfoOpts{Commitment: commitment})
+	if err != nil {
+		return InterestBearingConfig{}, err
+	}
+	if res == nil || res.Value == nil {
+		return InterestBearingConfig{}, fmt.Errorf("rpc returned nil")
+	}
+	return ParseInterestBearingConfig(res.Value.Data.GetBinary())
+}
+
+// TokenUIBalance returns the UI balance of a token account including accrued interest, computed locally from the mint config
+func TokenUIBalance(ctx context.Context, client *rpc.Client, mint, acc solana.PublicKey, unixTimestamp int64, commitment rpc.CommitmentType) (float64, error) {
+	decimals, amount, err := TokenBalance(ctx, client, acc, commitment)
+	if err != nil {
+		return 0, err
+	}
+	cfg, err := GetInterestBearingConfig(ctx, client, mint, commitment)
+	if err != nil {
+		return 0, err
+	}
+	return cfg.AmountToUIAmount(uint64(amount), decimals, unixTimestamp), nil //nolint:gosec // balances are never negative
+}
+
+// NewToken2022Pool returns token + pool addresses for a Token-2022 mint, regardless of the mint extensions used.
+// The pool treats interest-bearing, transfer-fee and metadata mints like any other mint, as the extensions don't
+// require additional accounts. The token still needs to be deployed (see CreateToken2022WithExtensions).
+func NewToken2022Pool(poolProgram solana.PublicKey, mint solana.PublicKey) (TokenPool, error) {
+	return NewTokenPool(solana.Token2022ProgramID, poolProgram, mint)
+}
+
+func appendCOptionPubkey(data []byte, key solana.PublicKey) []byte {
+	if key.IsZero() {
+		return append(data, 0)
+	}
+	return append(append(data, 1), key.Bytes()...)
+}
+
+func appendBorshString(data []byte, s string) []byte {
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(s))) //nolint:gosec // metadata strings are short
+	return append(data, []byte(s)...)
+}
@@ -0,0 +1,43 @@
+// Package homechain prints the home chain config changes found in the logs.
+package homechain
+
+import (
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/format"
+	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/parse"
+	"github.com/smartcontractkit/chainlink-ccip/internal/reader"
+)
+
+func init() {
+	format.Register("homechain", homeChainFormatterFactory, "Print the home chain config changes.")
+}
+
+func homeChainFormatterFactory(options format.Options) format.Formatter {
+	return format.NewWrappedFormat(homeChainFormatter)
+}
+
+func homeChainFormatter(data *parse.Data) {
+	if data.GetMessage() != reader.HomeChainConfigChangedMsg {
+		return
+	}
+
+	fields := data.RawLoggerFields
+	fmt.Printf("%s chain=%v %v: %q -> %q (digest %v, logger %s)\n",
+		data.GetTimestamp().Format("2006-01-02T15:04:05.000Z07:00"),
+		fields["chain"],
+		fields["changeType"],
+		fieldString(fields, "old"),
+		fieldString(fields, "new"),
+		fields["digest"],
+		data.GetLoggerName(),
+	)
+}
+
+func fieldString(fields map[string]any, key string) string {
+	v, ok := fields[key]
+	if !ok || v == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", v)
+}
@@ -8,6 +8,7 @@ import (
 	// Register the formatters
 	_ "github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/format/basic"
 	_ "github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/format/fancy"
+	_ "github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/format/homechain"
 	_ "github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/format/summary"
 )
 
@@ -23,6 +23,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/ocrrecorder"
 	readerpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
@@ -77,6 +78,7 @@ type PluginFactory struct {
 	chainWriters      map[cciptypes.ChainSelector]types.ContractWriter
 	rmnPeerClient     rmn.PeerClient
 	rmnCrypto         cciptypes.RMNCrypto
+	roundRecorder     ocrrecorder.Store
 }
 
 type CommitPluginFactoryParams struct {
@@ -92,6 +94,8 @@ type CommitPluginFactoryParams struct {
 	ContractWriters   map[cciptypes.ChainSelector]types.ContractWriter
 	RmnPeerClient     rmn.PeerClient
 	RmnCrypto         cciptypes.RMNCrypto
+	// RoundRecorder is optional, when set every round is recorded for offline replay.
+	RoundRecorder ocrrecorder.Store
 }
 
 // NewCommitPluginFactory creates a new PluginFactory instance. For commit plugin, oracle instances are not managed by
@@ -110,6 +114,7 @@ func NewCommitPluginFactory(params CommitPluginFactoryParams) *PluginFactory {
 		chainWriters:      params.ContractWriters,
 		rmnPeerClient:     params.RmnPeerClient,
 		rmnCrypto:         params.RmnCrypto,
+		roundRecorder:     params.RoundRecorder,
 	}
 }
 
@@ -190,13 +195,21 @@ func (p *PluginFactory) NewReportingPlugin(ctx context.Context, config ocr3types
 	// The node supports the chain that the token prices are on.
 	_, ok := readers[offchainConfig.PriceFeedChainSelector]
 	if ok {
-		// Bind all token aggregate contracts
+		// Bind all token aggregate and rate getter contracts, fixed and token ratio prices are not read on-chain.
 		var bcs []types.BoundContract
 		for _, info := range offchainConfig.TokenInfo {
-			bcs = append(bcs, types.BoundContract{
-				Address: string(info.AggregatorAddress),
-				Name:    consts.ContractNamePriceAggregator,
-			})
+			if info.AggregatorAddress != "" {
+				bcs = append(bcs, types.BoundContract{
+					Address: string(info.AggregatorAddress),
+					Name:    consts.ContractNamePriceAggregator,
+				})
+			}
+			if info.SourceType() == pluginconfig.TokenPriceSourceExchangeRate {
+				bcs = append(bcs, types.BoundContract{
+					Address: string(info.RateGetterAddress),
+					Name:    consts.ContractNameTokenRateGetter,
+				})
+			}
 		}
 		if err1 := readers[offchainConfig.PriceFeedChainSelector].Bind(ctx, bcs); err1 != nil {
 			return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to bind token price contracts: %w", err1)
@@ -226,34 +239,40 @@ func (p *PluginFactory) NewReportingPlugin(ctx context.Context, config ocr3types
 		return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to create report builder: %w", err)
 	}
 
-	return NewPlugin(
-			p.donID,
-			oracleIDToP2PID,
-			offchainConfig,
-			p.ocrConfig.Config.ChainSelector,
-			ccipReader,
-			onChainTokenPricesReader,
-			p.commitCodec,
-			p.msgHasher,
-			lggr,
-			p.homeChainReader,
-			rmnHomeReader,
-			p.rmnCrypto,
-			p.rmnPeerClient,
-			config,
-			metricsReporter,
-			p.addrCodec,
-			reportBuilder,
-		), ocr3types.ReportingPluginInfo{
-			Name: "CCIPRoleCommit",
-			Limits: ocr3types.ReportingPluginLimits{
-				MaxQueryLength:       maxQueryLength,
-				MaxObservationLength: maxObservationLength,
-				MaxOutcomeLength:     maxOutcomeLength,
-				MaxReportLength:      maxReportLength,
-				MaxReportCount:       maxReportCount,
-			},
-		}, nil
+	var plugin ocr3types.ReportingPlugin[[]byte] = NewPlugin(
+		p.donID,
+		oracleIDToP2PID,
+		offchainConfig,
+		p.ocrConfig.Config.ChainSelector,
+		ccipReader,
+		onChainTokenPricesReader,
+		p.commitCodec,
+		p.msgHasher,
+		lggr,
+		p.homeChainReader,
+		rmnHomeReader,
+		p.rmnCrypto,
+		p.rmnPeerClient,
+		config,
+		metricsReporter,
+		p.addrCodec,
+		reportBuilder,
+	)
+	if p.roundRecorder != nil {
+		plugin = ocrrecorder.NewRecordingPlugin(
+			plugin, "commit", p.donID, config.ConfigDigest, lggr, p.roundRecorder)
+	}
+
+	return plugin, ocr3types.ReportingPluginInfo{
+		Name: "CCIPRoleCommit",
+		Limits: ocr3types.ReportingPluginLimits{
+			MaxQueryLength:       maxQueryLength,
+			MaxObservationLength: maxObservationLength,
+			MaxOutcomeLength:     maxOutcomeLength,
+			MaxReportLength:      maxReportLength,
+			MaxReportCount:       maxReportCount,
+		},
+	}, nil
 }
 
 func validateOcrConfig(cfg readerpkg.OCR3Config) error {
@@ -454,6 +454,7 @@ type observerImpl struct {
 	chainSupport plugincommon.ChainSupport
 	ccipReader   readerpkg.CCIPReader
 	msgHasher    cciptypes.MessageHasher
+	metrics      MetricsReporter
 }
 
 func newObserverImpl(
@@ -463,6 +464,7 @@ func newObserverImpl(
 	chainSupport plugincommon.ChainSupport,
 	ccipReader readerpkg.CCIPReader,
 	msgHasher cciptypes.MessageHasher,
+	metrics MetricsReporter,
 ) observerImpl {
 	return observerImpl{
 		lggr:         lggr,
@@ -471,6 +473,7 @@ func newObserverImpl(
 		chainSupport: chainSupport,
 		ccipReader:   ccipReader,
 		msgHasher:    msgHasher,
+		metrics:      metrics,
 	}
 }
 
@@ -606,6 +609,10 @@ func (o observerImpl) ObserveMerkleRoots(
 			wg.Add(1)
 			go func() {
 				defer wg.Done()
+				start := time.Now()
+				defer func() {
+					o.metrics.TrackProcessorChainObservationLatency(processorLabel, chainRange.ChainSel, time.Since(start))
+				}()
 				msgs, err := o.ccipReader.MsgsBetweenSeqNums(ctx, chainRange.ChainSel, chainRange.SeqNumRange)
 				if err != nil {
 					lggr.Warnw("call to MsgsBetweenSeqNums failed", "err", err)
@@ -374,6 +374,7 @@ func Test_ObserveOffRampNextSeqNums(t *testing.T) {
 				chainSupport,
 				ccipReader,
 				mocks.NewMessageHasher(),
+				NoopMetrics{},
 			)
 
 			assert.Equal(t, tc.expResult, o.ObserveOffRampNextSeqNums(ctx))
@@ -635,6 +636,7 @@ func Test_ObserveMerkleRoots(t *testing.T) {
 				chainSupport,
 				mockCCIPReader,
 				mocks.NewMessageHasher(),
+				NoopMetrics{},
 			)
 
 			roots := o.ObserveMerkleRoots(ctx, tc.ranges)
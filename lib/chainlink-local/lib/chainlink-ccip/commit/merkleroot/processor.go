@@ -65,6 +65,7 @@ func NewProcessor(
 		chainSupport,
 		ccipReader,
 		msgHasher,
+		metricsReporter,
 	)
 	if !offchainCfg.MerkleRootAsyncObserverDisabled {
 		observer = newAsyncObserver(
@@ -61,3 +61,21 @@ func verifyObservationSignature(
 
 	return nil
 }
+
+// signObservation signs the RMN observation the way verifyObservationSignature expects it.
+func signObservation(
+	key ed25519.PrivateKey,
+	signedObservationPrefix string,
+	observation *rmnpb.Observation,
+) ([]byte, error) {
+	observationBytes, err := proto.Marshal(observation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal observation: %w", err)
+	}
+
+	observationBytesSha256 := sha256.Sum256(observationBytes)
+	msg := append([]byte(signedObservationPrefix), observationBytesSha256[:]...)
+	msgSha256 := sha256.Sum256(msg)
+
+	return ed25519.Sign(key, msgSha256[:]), nil
+}
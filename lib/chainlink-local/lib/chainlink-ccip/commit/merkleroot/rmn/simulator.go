@@ -0,0 +1,442 @@
+// simulator.go contains an in-process RMN network implementing PeerClient. It allows running the RMN controller,
+// and the commit plugin with RMN enabled, without any RMN infrastructure, e.g. in tests and local devnets.
+
+package rmn
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	ragep2ptypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
+	rmnpb "github.com/smartcontractkit/chainlink-protos/rmn/v1.6/go/serialization"
+
+	rmntypes "github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn/types"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// simulatorResponseBufferSize is the number of responses buffered by the simulator before responses block.
+const simulatorResponseBufferSize = 1000
+
+// ReportSigner signs RMN reports with the onchain key of a simulated RMN node. The signatures must be verifiable
+// by the RMNCrypto of the plugin against the node onchain public key configured in RMNRemote.
+type ReportSigner interface {
+	SignReport(ctx context.Context, report cciptypes.RMNReport) (cciptypes.RMNECDSASignature, error)
+}
+
+// RootFunc returns the merkle root of the messages of a source chain in the [minSeqNr, maxSeqNr] interval.
+type RootFunc func(
+	sourceChain cciptypes.ChainSelector, minSeqNr, maxSeqNr cciptypes.SeqNum) (cciptypes.Bytes32, error)
+
+// SimulatedNodeFaults are the faults injected by a simulated RMN node.
+type SimulatedNodeFaults struct {
+	// Delay is waited before responding to any request.
+	Delay time.Duration
+	// DropObservations makes the node ignore observation requests.
+	DropObservations bool
+	// DropReportSignatures makes the node ignore report signature requests.
+	DropReportSignatures bool
+	// BadObservationSignature makes the node respond with invalid observation signatures.
+	BadObservationSignature bool
+	// BadReportSignature makes the node respond with invalid report signatures.
+	BadReportSignature bool
+	// WrongRoots makes the node observe roots which differ from the roots observed by the other nodes.
+	WrongRoots bool
+}
+
+// SimulatedNode is an RMN node of the simulator.
+type SimulatedNode struct {
+	ID rmntypes.NodeID
+	// OffchainKey signs the observations, its public key must be the node offchain public key in RMNHome.
+	OffchainKey ed25519.PrivateKey
+	// ReportSigner signs the reports, nodes without a ReportSigner ignore report signature requests.
+	ReportSigner ReportSigner
+	Faults       SimulatedNodeFaults
+}
+
+// SimulatorConfig configures the simulated RMN network.
+type SimulatorConfig struct {
+	// SignObservationPrefix must match the prefix used by the plugin to verify the observations.
+	SignObservationPrefix string
+	// RMNReportVersion must match the report version of RMNRemote.
+	RMNReportVersion cciptypes.Bytes32
+	Nodes            []SimulatedNode
+	// Roots is optional, by default the roots are derived from the source chain and the interval, which makes
+	// all the nodes without the WrongRoots fault agree on them.
+	Roots RootFunc
+	// EncodeOnRampAddress is optional, it converts the on-ramp addresses of the observations, which the plugin
+	// truncates to 20 bytes, to the addresses of the signed report, e.g. abi encodes them for EVM source chains.
+	EncodeOnRampAddress func(observed []byte) cciptypes.UnknownAddress
+}
+
+// Simulator is an in-process RMN network. The plugin sends requests to the simulated nodes through the PeerClient
+// interface and the nodes respond asynchronously, after applying their faults.
+type Simulator struct {
+	lggr                  logger.Logger
+	signObservationPrefix string
+	rmnReportVersion      cciptypes.Bytes32
+	roots                 RootFunc
+	encodeOnRampAddress   func(observed []byte) cciptypes.UnknownAddress
+	resChan               chan PeerResponse
+
+	mu                  sync.RWMutex
+	nodes               map[rmntypes.NodeID]SimulatedNode
+	cursed              map[cciptypes.ChainSelector]struct{}
+	rmnHomeConfigDigest cciptypes.Bytes32
+	connected           bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ PeerClient = &Simulator{}
+
+// NewSimulator creates a simulated RMN network with the configured nodes.
+func NewSimulator(lggr logger.Logger, cfg SimulatorConfig) *Simulator {
+	roots := cfg.Roots
+	if roots == nil {
+		roots = defaultSimulatorRoot
+	}
+
+	nodes := make(map[rmntypes.NodeID]SimulatedNode, len(cfg.Nodes))
+	for _, node := range cfg.Nodes {
+		nodes[node.ID] = node
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Simulator{
+		lggr:                  lggr,
+		signObservationPrefix: cfg.SignObservationPrefix,
+		rmnReportVersion:      cfg.RMNReportVersion,
+		roots:                 roots,
+		encodeOnRampAddress:   cfg.EncodeOnRampAddress,
+		resChan:               make(chan PeerResponse, simulatorResponseBufferSize),
+		nodes:                 nodes,
+		cursed:                make(map[cciptypes.ChainSelector]struct{}),
+		ctx:                   ctx,
+		cancel:                cancel,
+	}
+}
+
+// SetFaults replaces the faults injected by a node, it can be called while the simulator is running.
+func (s *Simulator) SetFaults(nodeID rmntypes.NodeID, faults SimulatedNodeFaults) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	node, ok := s.nodes[nodeID]
+	if !ok {
+		return fmt.Errorf("rmn node %d not found", nodeID)
+	}
+	node.Faults = faults
+	s.nodes[nodeID] = node
+	return nil
+}
+
+// Curse makes the nodes refuse to observe and sign lane updates involving the chains. Cursing the destination
+// chain makes the nodes ignore all the requests.
+func (s *Simulator) Curse(chains ...cciptypes.ChainSelector) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, chain := range chains {
+		s.cursed[chain] = struct{}{}
+	}
+}
+
+// Uncurse lifts the curses of the chains.
+func (s *Simulator) Uncurse(chains ...cciptypes.ChainSelector) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, chain := range chains {
+		delete(s.cursed, chain)
+	}
+}
+
+func (s *Simulator) InitConnection(
+	_ context.Context,
+	_ cciptypes.Bytes32,
+	rmnHomeConfigDigest cciptypes.Bytes32,
+	_ []ragep2ptypes.PeerID,
+	_ []rmntypes.HomeNodeInfo,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rmnHomeConfigDigest = rmnHomeConfigDigest
+	s.connected = true
+	return nil
+}
+
+// Close stops the simulated nodes, pending responses are dropped.
+func (s *Simulator) Close() error {
+	// Mark the simulator as disconnected first so that no new request goroutines are started
+	// while we wait for the in-flight ones to finish.
+	s.mu.Lock()
+	s.connected = false
+	s.mu.Unlock()
+
+	s.cancel()
+	s.wg.Wait()
+	return nil
+}
+
+func (s *Simulator) Send(rmnNode rmntypes.HomeNodeInfo, request []byte) error {
+	req := &rmnpb.Request{}
+	if err := proto.Unmarshal(request, req); err != nil {
+		return fmt.Errorf("proto unmarshal: %w", err)
+	}
+
+	// The connection check and wg.Add happen under the same lock that Close uses to
+	// disconnect, so a request is either rejected or tracked before Close waits.
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if !s.connected {
+		return ErrNoConn
+	}
+	if _, ok := s.nodes[rmnNode.ID]; !ok {
+		return fmt.Errorf("rmn node %d not found", rmnNode.ID)
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.handleRequest(rmnNode.ID, req)
+	}()
+	return nil
+}
+
+func (s *Simulator) Recv() <-chan PeerResponse {
+	return s.resChan
+}
+
+func (s *Simulator) handleRequest(nodeID rmntypes.NodeID, req *rmnpb.Request) {
+	s.mu.RLock()
+	node := s.nodes[nodeID]
+	s.mu.RUnlock()
+	lggr := logger.With(s.lggr, "node", nodeID, "requestID", req.RequestId)
+
+	if node.Faults.Delay > 0 {
+		select {
+		case <-time.After(node.Faults.Delay):
+		case <-s.ctx.Done():
+			return
+		}
+	}
+
+	var resp *rmnpb.Response
+	var err error
+	switch r := req.Request.(type) {
+	case *rmnpb.Request_ObservationRequest:
+		if node.Faults.DropObservations {
+			lggr.Debugw("simulated rmn node dropped observation request")
+			return
+		}
+		resp, err = s.observe(node, req.RequestId, r.ObservationRequest)
+	case *rmnpb.Request_ReportSignatureRequest:
+		if node.Faults.DropReportSignatures || node.ReportSigner == nil {
+			lggr.Debugw("simulated rmn node dropped report signature request")
+			return
+		}
+		resp, err = s.signReport(node, req.RequestId, r.ReportSignatureRequest)
+	default:
+		err = fmt.Errorf("unexpected request type %T", req.Request)
+	}
+	if err != nil {
+		lggr.Warnw("simulated rmn node did not respond", "err", err)
+		return
+	}
+
+	body, err := proto.Marshal(resp)
+	if err != nil {
+		lggr.Errorw("failed to marshal simulated rmn response", "err", err)
+		return
+	}
+
+	select {
+	case s.resChan <- PeerResponse{RMNNodeID: nodeID, Body: body}:
+	case <-s.ctx.Done():
+	}
+}
+
+func (s *Simulator) observe(
+	node SimulatedNode, requestID uint64, req *rmnpb.ObservationRequest,
+) (*rmnpb.Response, error) {
+	if s.isCursed(req.LaneDest.DestChainSelector) {
+		return nil, fmt.Errorf("dest chain %d is cursed", req.LaneDest.DestChainSelector)
+	}
+
+	laneUpdates := make([]*rmnpb.FixedDestLaneUpdate, 0, len(req.FixedDestLaneUpdateRequests))
+	for _, lur := range req.FixedDestLaneUpdateRequests {
+		if s.isCursed(lur.LaneSource.SourceChainSelector) {
+			continue
+		}
+
+		root, err := s.roots(
+			cciptypes.ChainSelector(lur.LaneSource.SourceChainSelector),
+			cciptypes.SeqNum(lur.ClosedInterval.MinMsgNr),
+			cciptypes.SeqNum(lur.ClosedInterval.MaxMsgNr),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("get root of chain %d: %w", lur.LaneSource.SourceChainSelector, err)
+		}
+		if node.Faults.WrongRoots {
+			root = sha256.Sum256(append(root[:], byte(node.ID)))
+		}
+
+		laneUpdates = append(laneUpdates, &rmnpb.FixedDestLaneUpdate{
+			LaneSource:     lur.LaneSource,
+			ClosedInterval: lur.ClosedInterval,
+			Root:           root[:],
+		})
+	}
+	if len(laneUpdates) == 0 {
+		return nil, fmt.Errorf("all the requested source chains are cursed")
+	}
+
+	s.mu.RLock()
+	configDigest := s.rmnHomeConfigDigest
+	s.mu.RUnlock()
+
+	observation := &rmnpb.Observation{
+		RmnHomeContractConfigDigest: configDigest[:],
+		LaneDest:                    req.LaneDest,
+		FixedDestLaneUpdates:        laneUpdates,
+		Timestamp:                   uint64(time.Now().UnixMilli()),
+	}
+	sig, err := signObservation(node.OffchainKey, s.signObservationPrefix, observation)
+	if err != nil {
+		return nil, err
+	}
+	if node.Faults.BadObservationSignature {
+		sig[0] ^= 0xff
+	}
+
+	return &rmnpb.Response{
+		RequestId: requestID,
+		Response: &rmnpb.Response_SignedObservation{
+			SignedObservation: &rmnpb.SignedObservation{Observation: observation, Signature: sig},
+		},
+	}, nil
+}
+
+func (s *Simulator) signReport(
+	node SimulatedNode, requestID uint64, req *rmnpb.ReportSignatureRequest,
+) (*rmnpb.Response, error) {
+	reportCtx := req.Context
+	if s.isCursed(reportCtx.LaneDest.DestChainSelector) {
+		return nil, fmt.Errorf("dest chain %d is cursed", reportCtx.LaneDest.DestChainSelector)
+	}
+
+	laneUpdates, err := mostVotedLaneUpdates(req.AttributedSignedObservations)
+	if err != nil {
+		return nil, err
+	}
+	for _, lu := range laneUpdates {
+		if s.isCursed(lu.LaneSource.SourceChainSelector) {
+			return nil, fmt.Errorf("source chain %d is cursed", lu.LaneSource.SourceChainSelector)
+		}
+	}
+
+	if len(reportCtx.RmnHomeContractConfigDigest) != len(cciptypes.Bytes32{}) {
+		return nil, fmt.Errorf("invalid rmn home config digest %x", reportCtx.RmnHomeContractConfigDigest)
+	}
+
+	rmnLaneUpdates, err := NewLaneUpdatesFromPB(laneUpdates)
+	if err != nil {
+		return nil, err
+	}
+	if s.encodeOnRampAddress != nil {
+		for i := range rmnLaneUpdates {
+			rmnLaneUpdates[i].OnRampAddress = s.encodeOnRampAddress(rmnLaneUpdates[i].OnRampAddress)
+		}
+	}
+
+	report := cciptypes.NewRMNReport(
+		s.rmnReportVersion,
+		cciptypes.NewBigIntFromInt64(int64(reportCtx.EvmDestChainId)),
+		cciptypes.ChainSelector(reportCtx.LaneDest.DestChainSelector),
+		reportCtx.RmnRemoteContractAddress,
+		reportCtx.LaneDest.OfframpAddress,
+		cciptypes.Bytes32(reportCtx.RmnHomeContractConfigDigest),
+		rmnLaneUpdates,
+	)
+	sig, err := node.ReportSigner.SignReport(s.ctx, report)
+	if err != nil {
+		return nil, fmt.Errorf("sign report: %w", err)
+	}
+	if node.Faults.BadReportSignature {
+		sig.R[0] ^= 0xff
+	}
+
+	return &rmnpb.Response{
+		RequestId: requestID,
+		Response: &rmnpb.Response_ReportSignature{
+			ReportSignature: &rmnpb.ReportSignature{
+				Signature: &rmnpb.EcdsaSignature{R: sig.R[:], S: sig.S[:]},
+			},
+		},
+	}, nil
+}
+
+func (s *Simulator) isCursed(chain uint64) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.cursed[cciptypes.ChainSelector(chain)]
+	return ok
+}
+
+// mostVotedLaneUpdates returns the lane update with the most voted root of each source chain, sorted by source
+// chain selector like the lane updates of the report built by the controller.
+func mostVotedLaneUpdates(aos []*rmnpb.AttributedSignedObservation) ([]*rmnpb.FixedDestLaneUpdate, error) {
+	votes := make(map[uint64]map[cciptypes.Bytes32]int)
+	updates := make(map[uint64]map[cciptypes.Bytes32]*rmnpb.FixedDestLaneUpdate)
+	for _, ao := range aos {
+		for _, lu := range ao.GetSignedObservation().GetObservation().GetFixedDestLaneUpdates() {
+			if len(lu.Root) != len(cciptypes.Bytes32{}) {
+				return nil, fmt.Errorf("invalid merkle root, must be 32 bytes: %v", lu.Root)
+			}
+			chain := lu.LaneSource.SourceChainSelector
+			if _, ok := votes[chain]; !ok {
+				votes[chain] = make(map[cciptypes.Bytes32]int)
+				updates[chain] = make(map[cciptypes.Bytes32]*rmnpb.FixedDestLaneUpdate)
+			}
+			root := cciptypes.Bytes32(lu.Root)
+			votes[chain][root]++
+			updates[chain][root] = lu
+		}
+	}
+	if len(votes) == 0 {
+		return nil, fmt.Errorf("no lane updates in the attributed observations")
+	}
+
+	laneUpdates := make([]*rmnpb.FixedDestLaneUpdate, 0, len(votes))
+	for chain, rootVotes := range votes {
+		var selected cciptypes.Bytes32
+		for root, vote := range rootVotes {
+			// ties are broken by the root bytes to keep the selection deterministic.
+			if vote > rootVotes[selected] || (vote == rootVotes[selected] && bytes.Compare(root[:], selected[:]) < 0) {
+				selected = root
+			}
+		}
+		laneUpdates = append(laneUpdates, updates[chain][selected])
+	}
+	sort.Slice(laneUpdates, func(i, j int) bool {
+		return laneUpdates[i].LaneSource.SourceChainSelector < laneUpdates[j].LaneSource.SourceChainSelector
+	})
+	return laneUpdates, nil
+}
+
+// defaultSimulatorRoot derives a root from the source chain and the interval.
+func defaultSimulatorRoot(
+	sourceChain cciptypes.ChainSelector, minSeqNr, maxSeqNr cciptypes.SeqNum,
+) (cciptypes.Bytes32, error) {
+	return sha256.Sum256([]byte(fmt.Sprintf("%d[%d,%d]", sourceChain, minSeqNr, maxSeqNr))), nil
+}
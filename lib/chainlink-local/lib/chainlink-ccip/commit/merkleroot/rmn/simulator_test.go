@@ -0,0 +1,235 @@
+package rmn
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rmnpb "github.com/smartcontractkit/chainlink-protos/rmn/v1.6/go/serialization"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	rmntypes "github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn/types"
+	readerpkg_mock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+const simulatorSignPrefix = "chainlink ccip 1.6 rmn observation"
+
+// testReportCrypto signs reports with a secret derived from the signer address, it is not secure and only
+// allows verifying that the signatures were produced over the expected report by the expected signer.
+type testReportCrypto struct {
+	signer cciptypes.UnknownAddress
+}
+
+func (c testReportCrypto) sign(
+	report cciptypes.RMNReport, signer cciptypes.UnknownAddress,
+) (cciptypes.RMNECDSASignature, error) {
+	b, err := json.Marshal(report)
+	if err != nil {
+		return cciptypes.RMNECDSASignature{}, err
+	}
+	return cciptypes.RMNECDSASignature{
+		R: sha256.Sum256(append(append([]byte{}, signer...), b...)),
+		S: sha256.Sum256(b),
+	}, nil
+}
+
+func (c testReportCrypto) SignReport(
+	_ context.Context, report cciptypes.RMNReport,
+) (cciptypes.RMNECDSASignature, error) {
+	return c.sign(report, c.signer)
+}
+
+func (c testReportCrypto) VerifyReportSignatures(
+	_ context.Context, sigs []cciptypes.RMNECDSASignature, report cciptypes.RMNReport, signers []cciptypes.UnknownAddress,
+) error {
+	for _, sig := range sigs {
+		valid := false
+		for _, signer := range signers {
+			exp, err := c.sign(report, signer)
+			if err != nil {
+				return err
+			}
+			if bytes.Equal(exp.R[:], sig.R[:]) && bytes.Equal(exp.S[:], sig.S[:]) {
+				valid = true
+			}
+		}
+		if !valid {
+			return fmt.Errorf("invalid signature %v", sig)
+		}
+	}
+	return nil
+}
+
+type simulatorSetup struct {
+	simulator      *Simulator
+	controller     Controller
+	remoteCfg      cciptypes.RemoteConfig
+	destChain      *rmnpb.LaneDest
+	updateRequests []*rmnpb.FixedDestLaneUpdateRequest
+}
+
+func newSimulatorSetup(t *testing.T, numNodes int) simulatorSetup {
+	lggr := logger.Test(t)
+	configDigest := cciptypes.Bytes32{0x1, 0x2, 0x3}
+
+	nodes := make([]SimulatedNode, numNodes)
+	homeNodes := make([]rmntypes.HomeNodeInfo, numNodes)
+	signers := make([]cciptypes.RemoteSignerInfo, numNodes)
+	for i := 0; i < numNodes; i++ {
+		id := rmntypes.NodeID(i + 1)
+		pub, priv, err := ed25519.GenerateKey(nil)
+		require.NoError(t, err)
+		signer := cciptypes.UnknownAddress{byte(id), 0xa}
+
+		nodes[i] = SimulatedNode{ID: id, OffchainKey: priv, ReportSigner: testReportCrypto{signer: signer}}
+		homeNodes[i] = rmntypes.HomeNodeInfo{
+			ID:                    id,
+			SupportedSourceChains: mapset.NewSet(chainS1, chainS2),
+			OffchainPublicKey:     &pub,
+		}
+		signers[i] = cciptypes.RemoteSignerInfo{OnchainPublicKey: signer, NodeIndex: uint64(id)}
+	}
+
+	rmnHome := readerpkg_mock.NewMockRMNHome(t)
+	rmnHome.On("GetRMNNodesInfo", configDigest).Return(homeNodes, nil).Maybe()
+	rmnHome.On("GetFObserve", configDigest).Return(
+		map[cciptypes.ChainSelector]int{chainS1: 1, chainS2: 1}, nil).Maybe()
+
+	simulator := NewSimulator(lggr, SimulatorConfig{
+		SignObservationPrefix: simulatorSignPrefix,
+		RMNReportVersion:      cciptypes.Bytes32{0x9},
+		Nodes:                 nodes,
+	})
+	t.Cleanup(func() { require.NoError(t, simulator.Close()) })
+
+	controller := NewController(
+		lggr,
+		testReportCrypto{},
+		simulatorSignPrefix,
+		simulator,
+		rmnHome,
+		10*time.Millisecond,
+		10*time.Millisecond,
+		NoopMetrics{},
+	)
+	require.NoError(t, controller.InitConnection(tests.Context(t), cciptypes.Bytes32{}, configDigest, nil, homeNodes))
+
+	return simulatorSetup{
+		simulator:  simulator,
+		controller: controller,
+		remoteCfg: cciptypes.RemoteConfig{
+			ContractAddress:  []byte{1, 2, 3},
+			ConfigDigest:     configDigest,
+			FSign:            1,
+			Signers:          signers,
+			RmnReportVersion: cciptypes.Bytes32{0x9},
+		},
+		destChain: &rmnpb.LaneDest{DestChainSelector: uint64(chainD1), OfframpAddress: chainD1OffRamp},
+		updateRequests: []*rmnpb.FixedDestLaneUpdateRequest{
+			{
+				LaneSource:     &rmnpb.LaneSource{SourceChainSelector: uint64(chainS1), OnrampAddress: chainS1OnRamp},
+				ClosedInterval: &rmnpb.ClosedInterval{MinMsgNr: 10, MaxMsgNr: 20},
+			},
+			{
+				LaneSource:     &rmnpb.LaneSource{SourceChainSelector: uint64(chainS2), OnrampAddress: chainS2OnRamp},
+				ClosedInterval: &rmnpb.ClosedInterval{MinMsgNr: 100, MaxMsgNr: 110},
+			},
+		},
+	}
+}
+
+func (s simulatorSetup) computeReportSignatures(t *testing.T, timeout time.Duration) (*ReportSignatures, error) {
+	ctx, cancel := context.WithTimeout(tests.Context(t), timeout)
+	defer cancel()
+	return s.controller.ComputeReportSignatures(ctx, s.destChain, s.updateRequests, s.remoteCfg)
+}
+
+func TestSimulator(t *testing.T) {
+	t.Run("honest nodes", func(t *testing.T) {
+		s := newSimulatorSetup(t, 4)
+
+		sigs, err := s.computeReportSignatures(t, 10*time.Second)
+		require.NoError(t, err)
+		assert.Len(t, sigs.Signatures, int(s.remoteCfg.FSign)+1)
+		require.Len(t, sigs.LaneUpdates, 2)
+		for _, lu := range sigs.LaneUpdates {
+			expRoot, err := defaultSimulatorRoot(cciptypes.ChainSelector(lu.LaneSource.SourceChainSelector),
+				cciptypes.SeqNum(lu.ClosedInterval.MinMsgNr), cciptypes.SeqNum(lu.ClosedInterval.MaxMsgNr))
+			require.NoError(t, err)
+			assert.Equal(t, expRoot[:], lu.Root)
+		}
+	})
+
+	t.Run("faulty nodes are tolerated", func(t *testing.T) {
+		s := newSimulatorSetup(t, 5)
+		require.NoError(t, s.simulator.SetFaults(1, SimulatedNodeFaults{BadObservationSignature: true}))
+		require.NoError(t, s.simulator.SetFaults(2, SimulatedNodeFaults{BadReportSignature: true}))
+		require.NoError(t, s.simulator.SetFaults(3, SimulatedNodeFaults{Delay: time.Hour}))
+
+		sigs, err := s.computeReportSignatures(t, 10*time.Second)
+		require.NoError(t, err)
+		assert.Len(t, sigs.Signatures, int(s.remoteCfg.FSign)+1)
+	})
+
+	t.Run("too many faulty nodes", func(t *testing.T) {
+		s := newSimulatorSetup(t, 4)
+		for _, id := range []rmntypes.NodeID{1, 2, 3} {
+			require.NoError(t, s.simulator.SetFaults(id, SimulatedNodeFaults{DropObservations: true}))
+		}
+
+		_, err := s.computeReportSignatures(t, 200*time.Millisecond)
+		require.Error(t, err)
+	})
+
+	t.Run("cursed dest chain", func(t *testing.T) {
+		s := newSimulatorSetup(t, 4)
+		s.simulator.Curse(chainD1)
+
+		_, err := s.computeReportSignatures(t, 200*time.Millisecond)
+		require.Error(t, err)
+
+		s.simulator.Uncurse(chainD1)
+		_, err = s.computeReportSignatures(t, 10*time.Second)
+		require.NoError(t, err)
+	})
+}
+
+func Test_mostVotedLaneUpdates(t *testing.T) {
+	lu := func(chain uint64, root byte) *rmnpb.FixedDestLaneUpdate {
+		r := cciptypes.Bytes32{root}
+		return &rmnpb.FixedDestLaneUpdate{LaneSource: &rmnpb.LaneSource{SourceChainSelector: chain}, Root: r[:]}
+	}
+	ao := func(lus ...*rmnpb.FixedDestLaneUpdate) *rmnpb.AttributedSignedObservation {
+		return &rmnpb.AttributedSignedObservation{
+			SignedObservation: &rmnpb.SignedObservation{
+				Observation: &rmnpb.Observation{FixedDestLaneUpdates: lus},
+			},
+		}
+	}
+
+	updates, err := mostVotedLaneUpdates([]*rmnpb.AttributedSignedObservation{
+		ao(lu(2, 1), lu(1, 5)),
+		ao(lu(2, 1), lu(1, 4)),
+		ao(lu(2, 2)),
+	})
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+	assert.Equal(t, uint64(1), updates[0].LaneSource.SourceChainSelector)
+	assert.Equal(t, byte(4), updates[0].Root[0]) // tie broken by the root bytes
+	assert.Equal(t, byte(1), updates[1].Root[0])
+
+	_, err = mostVotedLaneUpdates(nil)
+	require.Error(t, err)
+}
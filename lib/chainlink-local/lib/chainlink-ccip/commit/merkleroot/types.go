@@ -248,6 +248,9 @@ type MetricsReporter interface {
 	TrackRmnReport(latency float64, success bool)
 	TrackProcessorLatency(processor string, method string, latency time.Duration, err error)
 	TrackProcessorOutput(processor string, method plugincommon.MethodType, obs plugintypes.Trackable)
+	TrackProcessorObservationSize(processor string, sizeBytes int)
+	TrackProcessorChainObservationSize(processor string, chain cciptypes.ChainSelector, sizeBytes int)
+	TrackProcessorChainObservationLatency(processor string, chain cciptypes.ChainSelector, latency time.Duration)
 }
 
 type NoopMetrics struct{}
@@ -257,3 +260,10 @@ func (n NoopMetrics) TrackRmnReport(float64, bool) {}
 func (n NoopMetrics) TrackProcessorLatency(string, string, time.Duration, error) {}
 
 func (n NoopMetrics) TrackProcessorOutput(string, plugincommon.MethodType, plugintypes.Trackable) {}
+
+func (n NoopMetrics) TrackProcessorObservationSize(string, int) {}
+
+func (n NoopMetrics) TrackProcessorChainObservationSize(string, cciptypes.ChainSelector, int) {}
+
+func (n NoopMetrics) TrackProcessorChainObservationLatency(string, cciptypes.ChainSelector, time.Duration) {
+}
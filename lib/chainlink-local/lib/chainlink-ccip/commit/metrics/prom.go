@@ -77,6 +77,56 @@ var (
 		},
 		[]string{"method", "nodeID", "error"},
 	)
+	promProcessorObservationSizeHistogram = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "ccip_commit_processor_observation_size_bytes",
+			Help:    "This metric tracks the encoded size of the observation of a processor",
+			Buckets: prometheus.ExponentialBuckets(256, 4, 8),
+		},
+		[]string{"chainID", "processor"},
+	)
+	promProcessorChainObservationSizeHistogram = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "ccip_commit_processor_chain_observation_size_bytes",
+			Help:    "This metric tracks the encoded size of the data of a source chain in the observation of a processor",
+			Buckets: prometheus.ExponentialBuckets(256, 4, 8),
+		},
+		[]string{"chainID", "processor", "sourceChain"},
+	)
+	promProcessorChainObservationLatencyHistogram = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "ccip_commit_processor_chain_observation_latency",
+			Help: "This metric tracks the time spent observing the data of a source chain in a processor",
+			Buckets: []float64{
+				float64(50 * time.Millisecond),
+				float64(100 * time.Millisecond),
+				float64(200 * time.Millisecond),
+				float64(500 * time.Millisecond),
+				float64(700 * time.Millisecond),
+				float64(time.Second),
+				float64(2 * time.Second),
+				float64(5 * time.Second),
+				float64(7 * time.Second),
+				float64(10 * time.Second),
+				float64(20 * time.Second),
+			},
+		},
+		[]string{"chainID", "processor", "sourceChain"},
+	)
+	promObservationSize = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ccip_commit_observation_size_bytes",
+			Help: "This metric tracks the encoded size of the last observation of the plugin",
+		},
+		[]string{"chainID"},
+	)
+	promObservationNearMaxSize = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ccip_commit_observation_near_max_size",
+			Help: "This metric tracks the number of observations close to the max observation length",
+		},
+		[]string{"chainID"},
+	)
 )
 
 type PromReporter struct {
@@ -87,6 +137,11 @@ type PromReporter struct {
 	rmnControllerRmnRequestHistogram  *prometheus.HistogramVec
 	processorLatencyHistogram         *prometheus.HistogramVec
 	processorOutputCounter            *prometheus.CounterVec
+	processorObservationSizes         *prometheus.HistogramVec
+	processorChainObservationSizes    *prometheus.HistogramVec
+	processorChainObservationLatency  *prometheus.HistogramVec
+	observationSize                   *prometheus.GaugeVec
+	observationNearMaxSize            *prometheus.CounterVec
 	processorErrors                   *prometheus.CounterVec
 	sequenceNumbers                   *prometheus.GaugeVec
 }
@@ -106,9 +161,14 @@ func NewPromReporter(lggr logger.Logger, selector cciptypes.ChainSelector) (*Pro
 
 		sequenceNumbers: promSequenceNumbers,
 
-		processorLatencyHistogram: promProcessorLatencyHistogram,
-		processorOutputCounter:    promProcessorOutputCounter,
-		processorErrors:           promProcessorErrors,
+		processorLatencyHistogram:        promProcessorLatencyHistogram,
+		processorOutputCounter:           promProcessorOutputCounter,
+		processorErrors:                  promProcessorErrors,
+		processorObservationSizes:        promProcessorObservationSizeHistogram,
+		processorChainObservationSizes:   promProcessorChainObservationSizeHistogram,
+		processorChainObservationLatency: promProcessorChainObservationLatencyHistogram,
+		observationSize:                  promObservationSize,
+		observationNearMaxSize:           promObservationNearMaxSize,
 	}, nil
 }
 
@@ -186,6 +246,51 @@ func (p *PromReporter) TrackProcessorLatency(
 		Observe(float64(latency))
 }
 
+func (p *PromReporter) TrackProcessorObservationSize(processor string, sizeBytes int) {
+	p.processorObservationSizes.
+		WithLabelValues(p.chainID, processor).
+		Observe(float64(sizeBytes))
+}
+
+func (p *PromReporter) TrackProcessorChainObservationSize(
+	processor string,
+	chain cciptypes.ChainSelector,
+	sizeBytes int,
+) {
+	sourceChain, err := sel.GetChainIDFromSelector(uint64(chain))
+	if err != nil {
+		p.lggr.Errorw("failed to get chain ID from selector", "err", err)
+		return
+	}
+
+	p.processorChainObservationSizes.
+		WithLabelValues(p.chainID, processor, sourceChain).
+		Observe(float64(sizeBytes))
+}
+
+func (p *PromReporter) TrackProcessorChainObservationLatency(
+	processor string,
+	chain cciptypes.ChainSelector,
+	latency time.Duration,
+) {
+	sourceChain, err := sel.GetChainIDFromSelector(uint64(chain))
+	if err != nil {
+		p.lggr.Errorw("failed to get chain ID from selector", "err", err)
+		return
+	}
+
+	p.processorChainObservationLatency.
+		WithLabelValues(p.chainID, processor, sourceChain).
+		Observe(float64(latency))
+}
+
+func (p *PromReporter) TrackObservationSize(sizeBytes, maxSizeBytes int) {
+	p.observationSize.WithLabelValues(p.chainID).Set(float64(sizeBytes))
+	if plugincommon.IsNearMaxObservationSize(sizeBytes, maxSizeBytes) {
+		p.observationNearMaxSize.WithLabelValues(p.chainID).Inc()
+	}
+}
+
 func (p *PromReporter) TrackProcessorOutput(
 	processor string,
 	method plugincommon.MethodType,
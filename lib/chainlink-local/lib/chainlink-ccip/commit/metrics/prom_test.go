@@ -542,10 +542,61 @@ func Test_SequenceNumbers(t *testing.T) {
 	}
 }
 
+func Test_ObservationSizes(t *testing.T) {
+	reporter, err := NewPromReporter(logger.Test(t), selector)
+	require.NoError(t, err)
+	t.Cleanup(cleanupMetrics(reporter))
+
+	t.Run("processor sizes are tracked per chain", func(t *testing.T) {
+		processor := "merkle"
+
+		reporter.TrackProcessorObservationSize(processor, 1000)
+		reporter.TrackProcessorChainObservationSize(processor, selector, 600)
+		reporter.TrackProcessorChainObservationSize(processor, selector, 700)
+
+		total := internal.CounterFromHistogramByLabels(
+			t, reporter.processorObservationSizes, chainID, processor)
+		require.Equal(t, 1, total)
+		perChain := internal.CounterFromHistogramByLabels(
+			t, reporter.processorChainObservationSizes, chainID, processor, chainID)
+		require.Equal(t, 2, perChain)
+	})
+
+	t.Run("processor latencies are tracked per chain", func(t *testing.T) {
+		processor := "merkle"
+
+		reporter.TrackProcessorChainObservationLatency(processor, selector, time.Second)
+		latencies := internal.CounterFromHistogramByLabels(
+			t, reporter.processorChainObservationLatency, chainID, processor, chainID)
+		require.Equal(t, 1, latencies)
+
+		// unknown chains are not tracked.
+		reporter.TrackProcessorChainObservationLatency(processor, 1, time.Second)
+		latencies = internal.CounterFromHistogramByLabels(
+			t, reporter.processorChainObservationLatency, chainID, processor, chainID)
+		require.Equal(t, 1, latencies)
+	})
+
+	t.Run("observations close to the max size are counted", func(t *testing.T) {
+		reporter.TrackObservationSize(100, 1000)
+		require.Equal(t, float64(100), testutil.ToFloat64(reporter.observationSize.WithLabelValues(chainID)))
+		require.Equal(t, float64(0), testutil.ToFloat64(reporter.observationNearMaxSize.WithLabelValues(chainID)))
+
+		reporter.TrackObservationSize(900, 1000)
+		require.Equal(t, float64(900), testutil.ToFloat64(reporter.observationSize.WithLabelValues(chainID)))
+		require.Equal(t, float64(1), testutil.ToFloat64(reporter.observationNearMaxSize.WithLabelValues(chainID)))
+	})
+}
+
 func cleanupMetrics(reporter *PromReporter) func() {
 	return func() {
 		reporter.processorErrors.Reset()
 		reporter.processorOutputCounter.Reset()
 		reporter.processorLatencyHistogram.Reset()
+		reporter.processorObservationSizes.Reset()
+		reporter.processorChainObservationSizes.Reset()
+		reporter.processorChainObservationLatency.Reset()
+		reporter.observationSize.Reset()
+		reporter.observationNearMaxSize.Reset()
 	}
 }
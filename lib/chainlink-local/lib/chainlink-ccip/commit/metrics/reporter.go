@@ -7,6 +7,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
 // Reporter is a simple interface used for tracking observations and outcomes of the commit plugin.
@@ -21,17 +22,22 @@ import (
 type Reporter interface {
 	TrackObservation(obs committypes.Observation)
 	TrackOutcome(outcome committypes.Outcome)
+	TrackObservationSize(sizeBytes, maxSizeBytes int)
 
 	TrackRmnReport(latency float64, success bool)
 	TrackRmnRequest(method string, latency float64, nodeID uint64, err string)
 
 	TrackProcessorLatency(processor string, method plugincommon.MethodType, latency time.Duration, err error)
 	TrackProcessorOutput(processor string, method plugincommon.MethodType, obs plugintypes.Trackable)
+	TrackProcessorObservationSize(processor string, sizeBytes int)
+	TrackProcessorChainObservationSize(processor string, chain cciptypes.ChainSelector, sizeBytes int)
+	TrackProcessorChainObservationLatency(processor string, chain cciptypes.ChainSelector, latency time.Duration)
 }
 
 type CommitPluginReporter interface {
 	TrackObservation(obs committypes.Observation)
 	TrackOutcome(outcome committypes.Outcome)
+	TrackObservationSize(sizeBytes, maxSizeBytes int)
 }
 
 type Noop struct{}
@@ -40,6 +46,8 @@ func (n *Noop) TrackObservation(committypes.Observation) {}
 
 func (n *Noop) TrackOutcome(committypes.Outcome) {}
 
+func (n *Noop) TrackObservationSize(int, int) {}
+
 func (n *Noop) TrackRmnReport(float64, bool) {}
 
 func (n *Noop) TrackRmnRequest(string, float64, uint64, string) {}
@@ -48,6 +56,13 @@ func (n *Noop) TrackProcessorLatency(string, plugincommon.MethodType, time.Durat
 
 func (n *Noop) TrackProcessorOutput(string, plugincommon.MethodType, plugintypes.Trackable) {}
 
+func (n *Noop) TrackProcessorObservationSize(string, int) {}
+
+func (n *Noop) TrackProcessorChainObservationSize(string, cciptypes.ChainSelector, int) {}
+
+func (n *Noop) TrackProcessorChainObservationLatency(string, cciptypes.ChainSelector, time.Duration) {
+}
+
 var _ Reporter = &PromReporter{}
 var _ CommitPluginReporter = &PromReporter{}
 var _ merkleroot.MetricsReporter = &PromReporter{}
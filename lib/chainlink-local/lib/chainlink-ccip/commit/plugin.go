@@ -165,6 +165,14 @@ func NewPlugin(
 		reporter,
 	)
 
+	// The processors can't depend on the codec, the sizers are injected here to track observation sizes.
+	merkleRootProcessor = plugincommon.WithObservationSizer(
+		merkleRootProcessor, ocrtypecodec.MerkleRootObservationSizes)
+	tokenPriceProcessor = plugincommon.WithObservationSizer(
+		tokenPriceProcessor, ocrtypecodec.TokenPriceObservationSizes)
+	chainFeeProcessr = plugincommon.WithObservationSizer(
+		chainFeeProcessr, ocrtypecodec.ChainFeeObservationSizes)
+
 	return &Plugin{
 		donID:               donID,
 		oracleID:            reportingCfg.OracleID,
@@ -304,6 +312,7 @@ func (p *Plugin) Observation(
 	if err != nil {
 		return nil, fmt.Errorf("encode observation: %w, observation: %+v, seq nr: %d", err, obs, outCtx.SeqNr)
 	}
+	plugincommon.TrackObservationSize(lggr, p.metricsReporter, len(encoded), maxObservationLength)
 
 	lggr.Infow("Commit plugin making observation", "encodedObservation", encoded, "observation", obs)
 	return encoded, nil
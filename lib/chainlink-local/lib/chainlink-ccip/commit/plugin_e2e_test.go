@@ -2,7 +2,9 @@ package commit
 
 import (
 	"context"
+	"crypto/ed25519"
 	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"sort"
@@ -32,6 +34,8 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/commit/committypes"
 	"github.com/smartcontractkit/chainlink-ccip/commit/internal/builder"
 	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot"
+	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn"
+	rmntypes "github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn/types"
 	"github.com/smartcontractkit/chainlink-ccip/commit/metrics"
 	"github.com/smartcontractkit/chainlink-ccip/commit/tokenprice"
 	"github.com/smartcontractkit/chainlink-ccip/internal"
@@ -270,6 +274,126 @@ func TestPlugin_E2E_AllNodesAgree_MerkleRoots(t *testing.T) {
 	}
 }
 
+func TestPlugin_E2E_AllNodesAgree_RMNEnabled(t *testing.T) {
+	const numRMNNodes = 4
+	rmnDestChain := ccipocr3.ChainSelector(sel.ETHEREUM_TESTNET_SEPOLIA_ARBITRUM_1.Selector)
+
+	params := defaultNodeParams(t)
+	params.destChain = rmnDestChain
+	params.reportingCfg.MaxDurationQuery = time.Second
+	params.offchainCfg.RMNEnabled = true
+	params.offchainCfg.RMNSignaturesTimeout = 10 * time.Second
+	params.offchainCfg.SignObservationPrefix = rmnSignObservationPrefix
+	params.rmnEnabledChains = map[ccipocr3.ChainSelector]bool{sourceEvmChain1: true}
+	params.rmnCrypto = simulatedRMNCrypto{}
+
+	chainCfg := make(map[ccipocr3.ChainSelector]reader.ChainConfig, len(params.chainCfg))
+	for chainSel, cfg := range params.chainCfg {
+		if chainSel == destChain {
+			chainSel = rmnDestChain
+		}
+		chainCfg[chainSel] = cfg
+	}
+	params.chainCfg = chainCfg
+
+	rmnSourceChainConfigs := map[ccipocr3.ChainSelector]reader2.StaticSourceChainConfig{
+		sourceEvmChain1: {IsEnabled: true, IsRMNVerificationDisabled: false},
+		sourceSolChain:  {IsEnabled: true, IsRMNVerificationDisabled: true},
+	}
+
+	simulatedNodes := make([]rmn.SimulatedNode, numRMNNodes)
+	params.rmnNodes = make([]rmntypes.HomeNodeInfo, numRMNNodes)
+	params.rmnReportCfg.Signers = make([]ccipocr3.RemoteSignerInfo, numRMNNodes)
+	for i := 0; i < numRMNNodes; i++ {
+		id := rmntypes.NodeID(i + 1)
+		pub, priv, err := ed25519.GenerateKey(nil)
+		require.NoError(t, err)
+		signer := ccipocr3.UnknownAddress{byte(id), 0xa}
+
+		simulatedNodes[i] = rmn.SimulatedNode{
+			ID:           id,
+			OffchainKey:  priv,
+			ReportSigner: simulatedRMNCrypto{signer: signer},
+		}
+		params.rmnNodes[i] = rmntypes.HomeNodeInfo{
+			ID:                    id,
+			SupportedSourceChains: mapset.NewSet(sourceEvmChain1),
+			OffchainPublicKey:     &pub,
+		}
+		params.rmnReportCfg.Signers[i] = ccipocr3.RemoteSignerInfo{OnchainPublicKey: signer, NodeIndex: uint64(id)}
+	}
+
+	prevOutcome := committypes.Outcome{
+		MerkleRootOutcome: merkleroot.Outcome{
+			OutcomeType: merkleroot.ReportIntervalsSelected,
+			RangesSelectedForReport: []plugintypes.ChainRange{
+				{ChainSel: sourceEvmChain1, SeqNumRange: ccipocr3.SeqNumRange{10, 10}},
+				{ChainSel: sourceSolChain, SeqNumRange: ccipocr3.SeqNumRange{20, 20}},
+			},
+			OffRampNextSeqNums: []plugintypes.SeqNumChain{
+				{ChainSel: sourceEvmChain1, SeqNum: 10},
+				{ChainSel: sourceSolChain, SeqNum: 20},
+			},
+			RMNRemoteCfg: params.rmnReportCfg,
+		},
+	}
+
+	nodes := make([]ocr3types.ReportingPlugin[[]byte], len(oracleIDs))
+	var reportCodec ccipocr3.CommitPluginCodec
+	for i := range oracleIDs {
+		// Every oracle talks to its own simulated RMN network since the peer client responses are consumed by
+		// the controller of a single oracle.
+		simulator := rmn.NewSimulator(params.lggr, rmn.SimulatorConfig{
+			SignObservationPrefix: rmnSignObservationPrefix,
+			RMNReportVersion:      params.rmnReportCfg.RmnReportVersion,
+			Nodes:                 simulatedNodes,
+			Roots: func(ccipocr3.ChainSelector, ccipocr3.SeqNum, ccipocr3.SeqNum) (ccipocr3.Bytes32, error) {
+				return merkleRoot1, nil
+			},
+		})
+		t.Cleanup(func() { require.NoError(t, simulator.Close()) })
+
+		paramsCp := params
+		paramsCp.reportingCfg.OracleID = oracleIDs[i]
+		paramsCp.rmnPeerClient = simulator
+		n := setupNode(paramsCp)
+		nodes[i] = n.node
+		if i == 0 {
+			reportCodec = n.reportCodec
+		}
+		prepareCcipReaderMock(n.ccipReader, false, false, true)
+		n.ccipReader.EXPECT().GetOffRampSourceChainsConfig(mock.Anything, mock.Anything).Unset()
+		n.ccipReader.EXPECT().GetOffRampSourceChainsConfig(mock.Anything, mock.Anything).
+			Return(rmnSourceChainConfigs, nil).Maybe()
+		preparePriceReaderMock(n.priceReader)
+	}
+
+	encodedPrevOutcome, err := ocrTypCodec.EncodeOutcome(prevOutcome)
+	require.NoError(t, err)
+	runner := testhelpers.NewOCR3Runner(nodes, oracleIDs, encodedPrevOutcome)
+	res, err := runner.RunRound(params.ctx)
+	require.NoError(t, err)
+
+	decodedOutcome, err := ocrTypCodec.DecodeOutcome(res.Outcome)
+	require.NoError(t, err)
+	require.Equal(t, merkleroot.ReportGenerated, decodedOutcome.MerkleRootOutcome.OutcomeType)
+	assert.Len(t, decodedOutcome.MerkleRootOutcome.RMNReportSignatures, int(params.rmnReportCfg.FSign)+1)
+
+	require.Len(t, res.Transmitted, 1)
+	report, err := reportCodec.Decode(params.ctx, res.Transmitted[0].Report)
+	require.NoError(t, err)
+	assert.Empty(t, report.UnblessedMerkleRoots)
+	assert.Equal(t, []ccipocr3.MerkleRootChain{
+		{
+			ChainSel:      sourceEvmChain1,
+			SeqNumsRange:  ccipocr3.NewSeqNumRange(0xa, 0xa),
+			OnRampAddress: ccipocr3.UnknownAddress{1},
+			MerkleRoot:    merkleRoot1,
+		},
+	}, report.BlessedMerkleRoots)
+	assert.Len(t, report.RMNSignatures, int(params.rmnReportCfg.FSign)+1)
+}
+
 func TestPlugin_E2E_AllNodesAgree_TokenPrices(t *testing.T) {
 	params := defaultNodeParams(t)
 
@@ -795,6 +919,12 @@ type SetupNodeParams struct {
 	onRampLastSeqNum  map[ccipocr3.ChainSelector]ccipocr3.SeqNum
 	rmnReportCfg      ccipocr3.RemoteConfig
 	enableDiscovery   bool
+	destChain         ccipocr3.ChainSelector
+	// rmnEnabledChains, rmnNodes, rmnCrypto and rmnPeerClient are only required by RMN-enabled nodes.
+	rmnEnabledChains map[ccipocr3.ChainSelector]bool
+	rmnNodes         []rmntypes.HomeNodeInfo
+	rmnCrypto        ccipocr3.RMNCrypto
+	rmnPeerClient    rmn.PeerClient
 }
 
 //nolint:gocyclo // todo
@@ -806,10 +936,25 @@ func setupNode(params SetupNodeParams) nodeSetup {
 	homeChainReader := reader_mock.NewMockHomeChain(params.t)
 	rmnHomeReader := readerpkg_mock.NewMockRMNHome(params.t)
 
-	rmnHomeReader.EXPECT().GetRMNEnabledSourceChains(mock.Anything).Return(map[ccipocr3.ChainSelector]bool{
+	rmnEnabledChains := map[ccipocr3.ChainSelector]bool{
 		sourceEvmChain1: false,
 		sourceSolChain:  false,
-	}, nil).Maybe()
+	}
+	for chainSel, enabled := range params.rmnEnabledChains {
+		rmnEnabledChains[chainSel] = enabled
+	}
+	rmnHomeReader.EXPECT().GetRMNEnabledSourceChains(mock.Anything).Return(rmnEnabledChains, nil).Maybe()
+
+	if len(params.rmnNodes) > 0 {
+		fObserve := make(map[ccipocr3.ChainSelector]int)
+		for chainSel, enabled := range rmnEnabledChains {
+			if enabled {
+				fObserve[chainSel] = int(params.rmnReportCfg.FSign)
+			}
+		}
+		rmnHomeReader.EXPECT().GetRMNNodesInfo(params.rmnReportCfg.ConfigDigest).Return(params.rmnNodes, nil).Maybe()
+		rmnHomeReader.EXPECT().GetFObserve(params.rmnReportCfg.ConfigDigest).Return(fObserve, nil).Maybe()
+	}
 
 	fChain := map[ccipocr3.ChainSelector]int{}
 	supportedChainsForPeer := make(map[libocrtypes.PeerID]mapset.Set[ccipocr3.ChainSelector])
@@ -907,7 +1052,8 @@ func setupNode(params SetupNodeParams) nodeSetup {
 	cfg := pluginconfig.CommitOffchainConfig{}
 	err := cfg.ApplyDefaultsAndValidate()
 	require.NoError(params.t, err)
-	reportBuilder, err := builder.NewReportBuilder(cfg.RMNEnabled, cfg.MaxMerkleRootsPerReport, cfg.MaxPricesPerReport)
+	reportBuilder, err := builder.NewReportBuilder(
+		params.offchainCfg.RMNEnabled, cfg.MaxMerkleRootsPerReport, cfg.MaxPricesPerReport)
 	require.NoError(params.t, err)
 
 	mockAddrCodec := internal.NewMockAddressCodecHex(params.t)
@@ -915,7 +1061,7 @@ func setupNode(params SetupNodeParams) nodeSetup {
 		params.donID,
 		params.oracleIDToP2pID,
 		params.offchainCfg,
-		destChain,
+		params.destChain,
 		ccipReader,
 		tokenPricesReader,
 		reportCodec,
@@ -923,8 +1069,8 @@ func setupNode(params SetupNodeParams) nodeSetup {
 		params.lggr,
 		homeChainReader,
 		rmnHomeReader,
-		nil,
-		nil,
+		params.rmnCrypto,
+		params.rmnPeerClient,
 		params.reportingCfg,
 		&metrics.Noop{},
 		mockAddrCodec,
@@ -1011,11 +1157,60 @@ func defaultNodeParams(t *testing.T) SetupNodeParams {
 		onRampLastSeqNum:  onRampLastSeqNum,
 		rmnReportCfg:      rmnRemoteCfg,
 		enableDiscovery:   false,
+		destChain:         destChain,
 	}
 
 	return params
 }
 
+const rmnSignObservationPrefix = "chainlink ccip 1.6 rmn observation"
+
+// simulatedRMNCrypto signs RMN reports with a secret derived from the signer address, it is not secure and only
+// allows the plugin to verify that the signatures of the simulated RMN nodes were produced over the expected report.
+type simulatedRMNCrypto struct {
+	signer ccipocr3.UnknownAddress
+}
+
+func (c simulatedRMNCrypto) sign(
+	report ccipocr3.RMNReport, signer ccipocr3.UnknownAddress,
+) (ccipocr3.RMNECDSASignature, error) {
+	b, err := json.Marshal(report)
+	if err != nil {
+		return ccipocr3.RMNECDSASignature{}, err
+	}
+	return ccipocr3.RMNECDSASignature{
+		R: sha256.Sum256(append(append([]byte{}, signer...), b...)),
+		S: sha256.Sum256(b),
+	}, nil
+}
+
+func (c simulatedRMNCrypto) SignReport(
+	_ context.Context, report ccipocr3.RMNReport,
+) (ccipocr3.RMNECDSASignature, error) {
+	return c.sign(report, c.signer)
+}
+
+func (c simulatedRMNCrypto) VerifyReportSignatures(
+	_ context.Context, sigs []ccipocr3.RMNECDSASignature, report ccipocr3.RMNReport, signers []ccipocr3.UnknownAddress,
+) error {
+	for _, sig := range sigs {
+		valid := false
+		for _, signer := range signers {
+			exp, err := c.sign(report, signer)
+			if err != nil {
+				return err
+			}
+			if exp == sig {
+				valid = true
+			}
+		}
+		if !valid {
+			return fmt.Errorf("invalid signature %v", sig)
+		}
+	}
+	return nil
+}
+
 // merkleRoot1 is the markle root that the test generates, the merkle root generation logic is not supposed to be
 // tested in this context, so we just assume it's correct.
 var merkleRoot1 = ccipocr3.Bytes32{0x4a, 0x44, 0xdc, 0x15, 0x36, 0x42, 0x4, 0xa8, 0xf, 0xe8, 0xe,
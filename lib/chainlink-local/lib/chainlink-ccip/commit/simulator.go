@@ -0,0 +1,82 @@
+package commit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	libocrtypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
+	"github.com/smartcontractkit/chainlink-ccip/commit/metrics"
+	"github.com/smartcontractkit/chainlink-ccip/internal/mocks/inmem"
+	"github.com/smartcontractkit/chainlink-ccip/internal/reader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/ocrrecorder"
+	readerpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+// ReplayConfig configures the plugin used to replay recorded rounds. It should match the configuration of the
+// plugin which recorded them.
+type ReplayConfig struct {
+	DestChain       cciptypes.ChainSelector
+	ReportingCfg    ocr3types.ReportingPluginConfig
+	OffchainCfg     pluginconfig.CommitOffchainConfig
+	OracleIDToP2PID map[commontypes.OracleID]libocrtypes.PeerID
+	MsgHasher       cciptypes.MessageHasher
+	AddrCodec       cciptypes.AddressCodec
+	// HomeChain provides the chain configs, e.g. the fChain of the chains the gas prices are computed for.
+	HomeChain reader.HomeChain
+	// CCIPReader is optional, an in-memory reader is used by default.
+	CCIPReader readerpkg.CCIPReader
+}
+
+// SimulateRound replays a recorded round through the outcome functions of the merkle root, token price and
+// chain fee processors and returns the diff between the recorded and the recomputed outcome, an empty diff
+// means the outcome was reproduced. RMN is never contacted, RMN signatures are taken from the recorded query.
+func SimulateRound(
+	ctx context.Context, lggr logger.Logger, round ocrrecorder.Round, cfg ReplayConfig,
+) (string, error) {
+	ccipReader := cfg.CCIPReader
+	if ccipReader == nil {
+		ccipReader = inmem.InMemoryCCIPReader{Dest: cfg.DestChain}
+	}
+
+	p := NewPlugin(
+		0,
+		cfg.OracleIDToP2PID,
+		cfg.OffchainCfg,
+		cfg.DestChain,
+		ccipReader,
+		nil,
+		nil,
+		cfg.MsgHasher,
+		logutil.WithComponent(lggr, "CommitSimulator"),
+		cfg.HomeChain,
+		nil,
+		nil,
+		nil,
+		cfg.ReportingCfg,
+		&metrics.Noop{},
+		cfg.AddrCodec,
+		nil,
+	)
+
+	recomputed, err := ocrrecorder.Replay(ctx, p, round)
+	if err != nil {
+		return "", fmt.Errorf("replay round %d: %w", round.SeqNr, err)
+	}
+
+	recordedOutcome, err := p.ocrTypeCodec.DecodeOutcome(round.Outcome)
+	if err != nil {
+		return "", fmt.Errorf("decode recorded outcome: %w", err)
+	}
+	recomputedOutcome, err := p.ocrTypeCodec.DecodeOutcome(recomputed)
+	if err != nil {
+		return "", fmt.Errorf("decode recomputed outcome: %w", err)
+	}
+	return ocrrecorder.Diff(recordedOutcome, recomputedOutcome)
+}
@@ -2,12 +2,14 @@ package exectypes
 
 import (
 	"context"
+	"time"
 
 	"golang.org/x/exp/maps"
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/internal"
 	dt "github.com/smartcontractkit/chainlink-ccip/internal/plugincommon/discovery/discoverytypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
@@ -96,6 +98,27 @@ func GetEncodedMsgAndTokenDataSizes(mo MessageObservations, tds TokenDataObserva
 // must be encoding according to the destination chain requirements with typeconv.AddressBytesToString.
 type NonceObservations map[cciptypes.ChainSelector]map[string]uint64
 
+// TokenPoolRateLimitObservations contain the inbound rate limits of the destination token pools used by the
+// previously observed messages. Rate limits are organized by source chain selector and the hex encoded
+// destination token address (cciptypes.UnknownAddress.String()).
+type TokenPoolRateLimitObservations map[cciptypes.ChainSelector]map[string]reader.TokenPoolRateLimit
+
+// CostPriceObservation contains the destination chain prices used to check that messages pay for their
+// execution. A zero value means the prices were not observed.
+type CostPriceObservation struct {
+	// GasPrice is the destination execution gas price in the smallest denomination of the native token.
+	GasPrice cciptypes.BigInt `json:"gasPrice"`
+	// NativePriceUSD is the price of the destination wrapped native token.
+	NativePriceUSD cciptypes.BigInt `json:"nativePriceUSD"`
+	// LinkPriceUSD is the price of LINK.
+	LinkPriceUSD cciptypes.BigInt `json:"linkPriceUSD"`
+}
+
+// IsEmpty returns true if any of the prices is missing.
+func (c CostPriceObservation) IsEmpty() bool {
+	return c.GasPrice.IsEmpty() || c.NativePriceUSD.IsEmpty() || c.LinkPriceUSD.IsEmpty()
+}
+
 // TokenDataObservations contain token data for messages organized by source chain selector and sequence number.
 // There could be multiple tokens per a single message, so MessageTokenData is a slice of TokenData.
 // TokenDataObservations are populated during the Observation phase and depend on previously fetched
@@ -130,6 +153,16 @@ type Observation struct {
 	// It contains the nonces of senders who are being considered for the final report.
 	Nonces NonceObservations `json:"nonces"`
 
+	// TokenPoolRateLimits are determined during the third phase of execute.
+	// It contains the inbound rate limits of the token pools used by the messages being considered.
+	TokenPoolRateLimits TokenPoolRateLimitObservations `json:"tokenPoolRateLimits"`
+
+	// CostPrices are determined during the third phase of execute, only if the fee check is enabled.
+	CostPrices CostPriceObservation `json:"costPrices"`
+
+	// Timestamp is the time of the third phase observation, the consensus value is used as the time of the round.
+	Timestamp time.Time `json:"timestamp"`
+
 	// Contracts are part of the initial discovery phase which runs to initialize the CCIP Reader.
 	Contracts dt.Observation `json:"contracts"`
 
@@ -146,13 +179,16 @@ func (o Observation) ToLogFormat() Observation {
 		}
 	}
 	cleanedObs := Observation{
-		CommitReports: o.CommitReports,
-		Hashes:        o.Hashes,
-		TokenData:     o.TokenData,
-		Nonces:        o.Nonces,
-		FChain:        o.FChain,
-		Messages:      msgsWithEmptyData,
-		Contracts:     dt.Observation{},
+		CommitReports:       o.CommitReports,
+		Hashes:              o.Hashes,
+		TokenData:           o.TokenData,
+		Nonces:              o.Nonces,
+		TokenPoolRateLimits: o.TokenPoolRateLimits,
+		CostPrices:          o.CostPrices,
+		Timestamp:           o.Timestamp,
+		FChain:              o.FChain,
+		Messages:            msgsWithEmptyData,
+		Contracts:           dt.Observation{},
 	}
 
 	return cleanedObs
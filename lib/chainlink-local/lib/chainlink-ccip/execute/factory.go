@@ -15,11 +15,13 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/metrics"
+	"github.com/smartcontractkit/chainlink-ccip/execute/report"
 	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/observer"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/reader"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/ocrrecorder"
 	readerpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
@@ -80,6 +82,7 @@ type PluginFactory struct {
 	tokenDataEncoder cciptypes.TokenDataEncoder
 	contractReaders  map[cciptypes.ChainSelector]types.ContractReader
 	chainWriters     map[cciptypes.ChainSelector]types.ContractWriter
+	roundRecorder    ocrrecorder.Store
 }
 
 type PluginFactoryParams struct {
@@ -94,6 +97,8 @@ type PluginFactoryParams struct {
 	EstimateProvider cciptypes.EstimateProvider
 	ContractReaders  map[cciptypes.ChainSelector]types.ContractReader
 	ContractWriters  map[cciptypes.ChainSelector]types.ContractWriter
+	// RoundRecorder is optional, when set every round is recorded for offline replay.
+	RoundRecorder ocrrecorder.Store
 }
 
 // NewExecutePluginFactory creates a new PluginFactory instance. For execute plugin, oracle instances are not managed by
@@ -111,6 +116,7 @@ func NewExecutePluginFactory(params PluginFactoryParams) *PluginFactory {
 		tokenDataEncoder: params.TokenDataEncoder,
 		contractReaders:  params.ContractReaders,
 		chainWriters:     params.ContractWriters,
+		roundRecorder:    params.RoundRecorder,
 	}
 }
 
@@ -169,37 +175,49 @@ func (p PluginFactory) NewReportingPlugin(
 		return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to create token data observer: %w", err)
 	}
 
+	batchingStrategy, err := report.NewBatchingStrategy(offchainConfig.BatchingStrategyID)
+	if err != nil {
+		return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to create batching strategy: %w", err)
+	}
+
 	metricsReporter, err := metrics.NewPromReporter(lggr, p.ocrConfig.Config.ChainSelector)
 	if err != nil {
 		return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to create metrics reporter: %w", err)
 	}
 
-	return NewPlugin(
-			p.donID,
-			config,
-			offchainConfig,
-			p.ocrConfig.Config.ChainSelector,
-			oracleIDToP2PID,
-			ccipReader,
-			p.execCodec,
-			p.msgHasher,
-			p.homeChainReader,
-			tokenDataObserver,
-			p.estimateProvider,
-			lggr,
-			metricsReporter,
-			p.addrCodec,
-		), ocr3types.ReportingPluginInfo{
-			Name: "CCIPRoleExecute",
-			Limits: ocr3types.ReportingPluginLimits{
-				// No query for this execute implementation.
-				MaxQueryLength:       maxQueryLength,
-				MaxObservationLength: maxObservationLength,
-				MaxOutcomeLength:     maxOutcomeLength,
-				MaxReportLength:      maxReportLength,
-				MaxReportCount:       maxReportCount,
-			},
-		}, nil
+	plugin := NewPlugin(
+		p.donID,
+		config,
+		offchainConfig,
+		p.ocrConfig.Config.ChainSelector,
+		oracleIDToP2PID,
+		ccipReader,
+		p.execCodec,
+		p.msgHasher,
+		p.homeChainReader,
+		tokenDataObserver,
+		p.estimateProvider,
+		lggr,
+		metricsReporter,
+		p.addrCodec,
+		batchingStrategy,
+	)
+	if p.roundRecorder != nil {
+		plugin = ocrrecorder.NewRecordingPlugin(
+			plugin, "execute", p.donID, config.ConfigDigest, lggr, p.roundRecorder)
+	}
+
+	return plugin, ocr3types.ReportingPluginInfo{
+		Name: "CCIPRoleExecute",
+		Limits: ocr3types.ReportingPluginLimits{
+			// No query for this execute implementation.
+			MaxQueryLength:       maxQueryLength,
+			MaxObservationLength: maxObservationLength,
+			MaxOutcomeLength:     maxOutcomeLength,
+			MaxReportLength:      maxReportLength,
+			MaxReportCount:       maxReportCount,
+		},
+	}, nil
 }
 
 func (p PluginFactory) Name() string {
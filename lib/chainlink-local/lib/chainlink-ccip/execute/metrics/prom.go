@@ -85,6 +85,86 @@ var (
 		},
 		[]string{"chainID", "sourceChain", "method"},
 	)
+	PromExecProcessorObservationSizeHistogram = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "ccip_exec_processor_observation_size_bytes",
+			Help:    "This metric tracks the encoded size of the observation of a processor",
+			Buckets: prometheus.ExponentialBuckets(256, 4, 8),
+		},
+		[]string{"chainID", "processor"},
+	)
+	PromExecProcessorChainObservationSizeHistogram = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "ccip_exec_processor_chain_observation_size_bytes",
+			Help:    "This metric tracks the encoded size of the data of a source chain in the observation of a processor",
+			Buckets: prometheus.ExponentialBuckets(256, 4, 8),
+		},
+		[]string{"chainID", "processor", "sourceChain"},
+	)
+	PromExecProcessorChainObservationLatencyHistogram = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "ccip_exec_processor_chain_observation_latency",
+			Help: "This metric tracks the time spent observing the data of a source chain in a processor",
+			Buckets: []float64{
+				float64(50 * time.Millisecond),
+				float64(100 * time.Millisecond),
+				float64(200 * time.Millisecond),
+				float64(500 * time.Millisecond),
+				float64(700 * time.Millisecond),
+				float64(time.Second),
+				float64(2 * time.Second),
+				float64(5 * time.Second),
+				float64(7 * time.Second),
+				float64(10 * time.Second),
+				float64(20 * time.Second),
+			},
+		},
+		[]string{"chainID", "processor", "sourceChain"},
+	)
+	PromExecObservationSize = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ccip_exec_observation_size_bytes",
+			Help: "This metric tracks the encoded size of the last observation of the plugin",
+		},
+		[]string{"chainID"},
+	)
+	PromExecObservationNearMaxSize = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ccip_exec_observation_near_max_size",
+			Help: "This metric tracks the number of observations close to the max observation length",
+		},
+		[]string{"chainID"},
+	)
+	PromExecCurseEvents = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ccip_exec_curse_events",
+			Help: "This metric tracks the number of RMN curse and uncurse events seen by the exec plugin",
+		},
+		[]string{"chainID", "subject", "subjectChain", "event"},
+	)
+	PromExecCursed = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ccip_exec_cursed",
+			Help: "This metric tracks whether an RMN curse subject is currently cursed (1) or not (0)",
+		},
+		[]string{"chainID", "subject", "subjectChain"},
+	)
+	PromExecCurseDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "ccip_exec_curse_duration_seconds",
+			Help:    "This metric tracks how long RMN curses lasted",
+			Buckets: prometheus.ExponentialBuckets(60, 4, 8),
+		},
+		[]string{"chainID", "subject", "subjectChain"},
+	)
+	PromExecCurseBacklogMessages = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "ccip_exec_curse_backlog_messages",
+			Help:    "This metric tracks the number of messages observed while draining the backlog of a lifted curse",
+			Buckets: prometheus.ExponentialBuckets(1, 4, 8),
+		},
+		[]string{"chainID", "subject", "subjectChain"},
+	)
 )
 
 type PromReporter struct {
@@ -92,12 +172,21 @@ type PromReporter struct {
 	chainID string
 
 	// Prometheus reporters
-	latencyHistogram          *prometheus.HistogramVec
-	execErrors                *prometheus.CounterVec
-	outputDetailsCounter      *prometheus.CounterVec
-	sequenceNumbers           *prometheus.GaugeVec
-	processorLatencyHistogram *prometheus.HistogramVec
-	processorErrors           *prometheus.CounterVec
+	latencyHistogram                 *prometheus.HistogramVec
+	execErrors                       *prometheus.CounterVec
+	outputDetailsCounter             *prometheus.CounterVec
+	sequenceNumbers                  *prometheus.GaugeVec
+	processorLatencyHistogram        *prometheus.HistogramVec
+	processorObservationSizes        *prometheus.HistogramVec
+	processorChainObservationSizes   *prometheus.HistogramVec
+	processorChainObservationLatency *prometheus.HistogramVec
+	observationSize                  *prometheus.GaugeVec
+	observationNearMaxSize           *prometheus.CounterVec
+	processorErrors                  *prometheus.CounterVec
+	curseEvents                      *prometheus.CounterVec
+	cursed                           *prometheus.GaugeVec
+	curseDuration                    *prometheus.HistogramVec
+	curseBacklogMessages             *prometheus.HistogramVec
 }
 
 func NewPromReporter(lggr logger.Logger, selector cciptypes.ChainSelector) (*PromReporter, error) {
@@ -110,12 +199,21 @@ func NewPromReporter(lggr logger.Logger, selector cciptypes.ChainSelector) (*Pro
 		lggr:    lggr,
 		chainID: chainID,
 
-		latencyHistogram:          PromExecLatencyHistogram,
-		execErrors:                PromExecErrors,
-		outputDetailsCounter:      PromExecOutputCounter,
-		sequenceNumbers:           PromSequenceNumbers,
-		processorLatencyHistogram: PromExecProcessorLatencyHistogram,
-		processorErrors:           PromExecProcessorErrors,
+		latencyHistogram:                 PromExecLatencyHistogram,
+		execErrors:                       PromExecErrors,
+		outputDetailsCounter:             PromExecOutputCounter,
+		sequenceNumbers:                  PromSequenceNumbers,
+		processorLatencyHistogram:        PromExecProcessorLatencyHistogram,
+		processorErrors:                  PromExecProcessorErrors,
+		processorObservationSizes:        PromExecProcessorObservationSizeHistogram,
+		processorChainObservationSizes:   PromExecProcessorChainObservationSizeHistogram,
+		processorChainObservationLatency: PromExecProcessorChainObservationLatencyHistogram,
+		observationSize:                  PromExecObservationSize,
+		observationNearMaxSize:           PromExecObservationNearMaxSize,
+		curseEvents:                      PromExecCurseEvents,
+		cursed:                           PromExecCursed,
+		curseDuration:                    PromExecCurseDuration,
+		curseBacklogMessages:             PromExecCurseBacklogMessages,
 	}, nil
 }
 
@@ -180,6 +278,92 @@ func (p *PromReporter) TrackProcessorOutput(
 	// noop
 }
 
+func (p *PromReporter) TrackProcessorObservationSize(processor string, sizeBytes int) {
+	p.processorObservationSizes.
+		WithLabelValues(p.chainID, processor).
+		Observe(float64(sizeBytes))
+}
+
+func (p *PromReporter) TrackProcessorChainObservationSize(
+	processor string,
+	chain cciptypes.ChainSelector,
+	sizeBytes int,
+) {
+	sourceChain, err := sel.GetChainIDFromSelector(uint64(chain))
+	if err != nil {
+		p.lggr.Errorw("failed to get chain ID from selector", "err", err)
+		return
+	}
+
+	p.processorChainObservationSizes.
+		WithLabelValues(p.chainID, processor, sourceChain).
+		Observe(float64(sizeBytes))
+}
+
+func (p *PromReporter) TrackProcessorChainObservationLatency(
+	processor string,
+	chain cciptypes.ChainSelector,
+	latency time.Duration,
+) {
+	sourceChain, err := sel.GetChainIDFromSelector(uint64(chain))
+	if err != nil {
+		p.lggr.Errorw("failed to get chain ID from selector", "err", err)
+		return
+	}
+
+	p.processorChainObservationLatency.
+		WithLabelValues(p.chainID, processor, sourceChain).
+		Observe(float64(latency))
+}
+
+func (p *PromReporter) TrackObservationSize(sizeBytes, maxSizeBytes int) {
+	p.observationSize.WithLabelValues(p.chainID).Set(float64(sizeBytes))
+	if plugincommon.IsNearMaxObservationSize(sizeBytes, maxSizeBytes) {
+		p.observationNearMaxSize.WithLabelValues(p.chainID).Inc()
+	}
+}
+
+func (p *PromReporter) TrackCurse(subject plugincommon.CurseSubject, cursed bool, duration time.Duration) {
+	subjectChain, ok := p.curseSubjectChain(subject)
+	if !ok {
+		return
+	}
+
+	if cursed {
+		p.curseEvents.WithLabelValues(p.chainID, string(subject.Kind), subjectChain, "cursed").Inc()
+		p.cursed.WithLabelValues(p.chainID, string(subject.Kind), subjectChain).Set(1)
+		return
+	}
+
+	p.curseEvents.WithLabelValues(p.chainID, string(subject.Kind), subjectChain, "uncursed").Inc()
+	p.cursed.WithLabelValues(p.chainID, string(subject.Kind), subjectChain).Set(0)
+	p.curseDuration.WithLabelValues(p.chainID, string(subject.Kind), subjectChain).Observe(duration.Seconds())
+}
+
+func (p *PromReporter) TrackCurseBacklog(subject plugincommon.CurseSubject, messages int) {
+	subjectChain, ok := p.curseSubjectChain(subject)
+	if !ok {
+		return
+	}
+
+	p.curseBacklogMessages.
+		WithLabelValues(p.chainID, string(subject.Kind), subjectChain).
+		Observe(float64(messages))
+}
+
+// curseSubjectChain returns the chain ID label of the curse subject, "all" for global curses.
+func (p *PromReporter) curseSubjectChain(subject plugincommon.CurseSubject) (string, bool) {
+	if subject.Kind == plugincommon.CurseSubjectGlobal {
+		return "all", true
+	}
+	chainID, err := sel.GetChainIDFromSelector(uint64(subject.Chain))
+	if err != nil {
+		p.lggr.Errorw("failed to get chain ID from selector", "err", err)
+		return "", false
+	}
+	return chainID, true
+}
+
 func (p *PromReporter) trackMaxSequenceNumber(
 	sourceChainSelector cciptypes.ChainSelector,
 	maxSeqNr int,
@@ -469,6 +469,76 @@ func Test_LatencyAndErrors(t *testing.T) {
 	})
 }
 
+func Test_ObservationSizes(t *testing.T) {
+	reporter, err := NewPromReporter(logger.Test(t), selector)
+	require.NoError(t, err)
+	t.Cleanup(cleanupMetrics(reporter))
+
+	t.Run("processor sizes are tracked per chain", func(t *testing.T) {
+		processor := "tokenData"
+
+		reporter.TrackProcessorObservationSize(processor, 1000)
+		reporter.TrackProcessorChainObservationSize(processor, selector, 600)
+		reporter.TrackProcessorChainObservationSize(processor, selector, 700)
+
+		total := internal.CounterFromHistogramByLabels(
+			t, reporter.processorObservationSizes, chainID, processor)
+		require.Equal(t, 1, total)
+		perChain := internal.CounterFromHistogramByLabels(
+			t, reporter.processorChainObservationSizes, chainID, processor, chainID)
+		require.Equal(t, 2, perChain)
+	})
+
+	t.Run("processor latencies are tracked per chain", func(t *testing.T) {
+		processor := "merkle"
+
+		reporter.TrackProcessorChainObservationLatency(processor, selector, time.Second)
+		latencies := internal.CounterFromHistogramByLabels(
+			t, reporter.processorChainObservationLatency, chainID, processor, chainID)
+		require.Equal(t, 1, latencies)
+
+		// unknown chains are not tracked.
+		reporter.TrackProcessorChainObservationLatency(processor, 1, time.Second)
+		latencies = internal.CounterFromHistogramByLabels(
+			t, reporter.processorChainObservationLatency, chainID, processor, chainID)
+		require.Equal(t, 1, latencies)
+	})
+
+	t.Run("observations close to the max size are counted", func(t *testing.T) {
+		reporter.TrackObservationSize(100, 1000)
+		require.Equal(t, float64(100), testutil.ToFloat64(reporter.observationSize.WithLabelValues(chainID)))
+		require.Equal(t, float64(0), testutil.ToFloat64(reporter.observationNearMaxSize.WithLabelValues(chainID)))
+
+		reporter.TrackObservationSize(900, 1000)
+		require.Equal(t, float64(900), testutil.ToFloat64(reporter.observationSize.WithLabelValues(chainID)))
+		require.Equal(t, float64(1), testutil.ToFloat64(reporter.observationNearMaxSize.WithLabelValues(chainID)))
+	})
+}
+
+func Test_Curses(t *testing.T) {
+	reporter, err := NewPromReporter(logger.Test(t), selector)
+	require.NoError(t, err)
+	t.Cleanup(cleanupMetrics(reporter))
+
+	global := plugincommon.CurseSubject{Kind: plugincommon.CurseSubjectGlobal}
+	source := plugincommon.CurseSubject{Kind: plugincommon.CurseSubjectSourceChain, Chain: selector}
+
+	reporter.TrackCurse(global, true, 0)
+	reporter.TrackCurse(source, true, 0)
+	require.Equal(t, float64(1), testutil.ToFloat64(reporter.cursed.WithLabelValues(chainID, "global", "all")))
+	require.Equal(t, float64(1), testutil.ToFloat64(reporter.cursed.WithLabelValues(chainID, "source", chainID)))
+
+	reporter.TrackCurse(source, false, time.Hour)
+	require.Equal(t, float64(0), testutil.ToFloat64(reporter.cursed.WithLabelValues(chainID, "source", chainID)))
+	require.Equal(t, float64(1),
+		testutil.ToFloat64(reporter.curseEvents.WithLabelValues(chainID, "source", chainID, "uncursed")))
+	require.Equal(t, 1, internal.CounterFromHistogramByLabels(t, reporter.curseDuration, chainID, "source", chainID))
+
+	reporter.TrackCurseBacklog(source, 10)
+	require.Equal(t, 1,
+		internal.CounterFromHistogramByLabels(t, reporter.curseBacklogMessages, chainID, "source", chainID))
+}
+
 func cleanupMetrics(p *PromReporter) func() {
 	return func() {
 		p.sequenceNumbers.Reset()
@@ -477,5 +547,14 @@ func cleanupMetrics(p *PromReporter) func() {
 		p.execErrors.Reset()
 		p.processorLatencyHistogram.Reset()
 		p.processorErrors.Reset()
+		p.processorObservationSizes.Reset()
+		p.processorChainObservationSizes.Reset()
+		p.processorChainObservationLatency.Reset()
+		p.observationSize.Reset()
+		p.observationNearMaxSize.Reset()
+		p.curseEvents.Reset()
+		p.cursed.Reset()
+		p.curseDuration.Reset()
+		p.curseBacklogMessages.Reset()
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
 // Reporter is a simple interface used for tracking observations and outcomes of the execution plugin.
@@ -19,6 +20,12 @@ type Reporter interface {
 	TrackLatency(state exectypes.PluginState, method plugincommon.MethodType, latency time.Duration, err error)
 	TrackProcessorOutput(string, plugincommon.MethodType, plugintypes.Trackable)
 	TrackProcessorLatency(processor string, method plugincommon.MethodType, latency time.Duration, err error)
+	TrackProcessorObservationSize(processor string, sizeBytes int)
+	TrackProcessorChainObservationSize(processor string, chain cciptypes.ChainSelector, sizeBytes int)
+	TrackProcessorChainObservationLatency(processor string, chain cciptypes.ChainSelector, latency time.Duration)
+	TrackObservationSize(sizeBytes, maxSizeBytes int)
+	TrackCurse(subject plugincommon.CurseSubject, cursed bool, duration time.Duration)
+	TrackCurseBacklog(subject plugincommon.CurseSubject, messages int)
 }
 
 type Noop struct{}
@@ -33,5 +40,18 @@ func (n *Noop) TrackProcessorOutput(string, plugincommon.MethodType, plugintypes
 
 func (n *Noop) TrackProcessorLatency(string, plugincommon.MethodType, time.Duration, error) {}
 
+func (n *Noop) TrackProcessorObservationSize(string, int) {}
+
+func (n *Noop) TrackProcessorChainObservationSize(string, cciptypes.ChainSelector, int) {}
+
+func (n *Noop) TrackProcessorChainObservationLatency(string, cciptypes.ChainSelector, time.Duration) {
+}
+
+func (n *Noop) TrackObservationSize(int, int) {}
+
+func (n *Noop) TrackCurse(plugincommon.CurseSubject, bool, time.Duration) {}
+
+func (n *Noop) TrackCurseBacklog(plugincommon.CurseSubject, int) {}
+
 var _ Reporter = &Noop{}
 var _ Reporter = &PromReporter{}
@@ -13,8 +13,10 @@ import (
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	dt "github.com/smartcontractkit/chainlink-ccip/internal/plugincommon/discovery/discoverytypes"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
+	ocrtypecodec "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
@@ -120,7 +122,30 @@ func (p *Plugin) Observation(
 		"numCommitReports", len(observation.CommitReports),
 		"numMessages", observation.Messages.Count())
 
-	return p.ocrTypeCodec.EncodeObservation(observation)
+	encoded, err := p.ocrTypeCodec.EncodeObservation(observation)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode observation: %w", err)
+	}
+	p.trackObservationSize(lggr, observation, len(encoded))
+
+	return encoded, nil
+}
+
+// trackObservationSize reports the encoded size of each part of the observation per source chain and warns
+// when the observation is close to the max observation length.
+func (p *Plugin) trackObservationSize(lggr logger.Logger, observation exectypes.Observation, sizeBytes int) {
+	partSizes := ocrtypecodec.ExecObservationSizes(observation)
+	for part, chainSizes := range partSizes {
+		partSize := 0
+		for chain, size := range chainSizes {
+			p.observer.TrackProcessorChainObservationSize(part, chain, size)
+			partSize += size
+		}
+		p.observer.TrackProcessorObservationSize(part, partSize)
+	}
+	lggr.Debugw("execute plugin observation size", "sizeBytes", sizeBytes, "partSizesBytes", partSizes)
+
+	plugincommon.TrackObservationSize(lggr, p.observer, sizeBytes, maxObservationLength)
 }
 
 func (p *Plugin) getCurseInfo(ctx context.Context, lggr logger.Logger) (reader.CurseInfo, error) {
@@ -192,6 +217,7 @@ func (p *Plugin) getCommitReportsObservation(
 		// The error is logged by getCurseInfo.
 		return observation, nil
 	}
+	p.curseTracker.Update(ci, time.Now().UTC())
 	if ci.GlobalCurse || ci.CursedDestination {
 		lggr.Warnw("nothing to observe: rmn curse", "curseInfo", ci)
 		return observation, nil
@@ -368,16 +394,26 @@ func (p *Plugin) getMessagesObservation(
 	sort.Slice(commitData, func(i, j int) bool {
 		return exectypes.LessThan(commitData[i], commitData[j])
 	})
+	commitData = p.prioritizeCurseBacklog(lggr, commitData)
 
 	stop := false
 
 	totalMsgs := 0
 	encodedObsSize := 0
+	readLatencies := make(map[cciptypes.ChainSelector]time.Duration)
+	defer func() {
+		for chain, latency := range readLatencies {
+			p.observer.TrackProcessorChainObservationLatency(
+				ocrtypecodec.ExecMessagesPart, chain, latency)
+		}
+	}()
 	for _, report := range commitData {
 		srcChain := report.SourceChain
 
 		// Read messages for this report's sequence number range
+		readStart := time.Now()
 		msgs, err := p.readMessagesForReport(ctx, lggr, srcChain, report)
+		readLatencies[srcChain] += time.Since(readStart)
 		if err != nil {
 			lggr.Errorw("unable to read all messages for report",
 				"srcChain", srcChain,
@@ -439,6 +475,7 @@ func (p *Plugin) getMessagesObservation(
 			observation.Messages = messageObs
 			observation.TokenData = tkData
 			totalMsgs++
+			p.curseTracker.RecordBacklogMessage(msg, time.Now().UTC())
 
 			encodedObs, err := p.ocrTypeCodec.EncodeObservation(observation)
 			if err != nil {
@@ -468,12 +505,43 @@ func (p *Plugin) getMessagesObservation(
 	return observation, nil
 }
 
+// prioritizeCurseBacklog moves the commit reports of lanes whose curse lifted recently to the front, so that
+// their backlog is observed first when the observation is too large to include every message. The relative
+// order of the reports is kept otherwise.
+func (p *Plugin) prioritizeCurseBacklog(
+	lggr logger.Logger,
+	commitData []exectypes.CommitData,
+) []exectypes.CommitData {
+	sourceChains := make([]cciptypes.ChainSelector, 0, len(commitData))
+	for _, report := range commitData {
+		sourceChains = append(sourceChains, report.SourceChain)
+	}
+	draining := p.curseTracker.DrainingLanes(sourceChains, time.Now().UTC())
+	if len(draining) == 0 {
+		return commitData
+	}
+
+	drainingChains := make([]cciptypes.ChainSelector, 0, len(draining))
+	for chain := range draining {
+		drainingChains = append(drainingChains, chain)
+	}
+	slices.Sort(drainingChains)
+	lggr.Infow("prioritizing curse backlog", "sourceChains", drainingChains)
+	sort.SliceStable(commitData, func(i, j int) bool {
+		return draining[commitData[i].SourceChain] && !draining[commitData[j].SourceChain]
+	})
+	return commitData
+}
+
 func (p *Plugin) getFilterObservation(
 	ctx context.Context,
 	lggr logger.Logger,
 	previousOutcome exectypes.Outcome,
 	observation exectypes.Observation,
 ) (exectypes.Observation, error) {
+	// The time of the round is observed by every oracle, the consensus value is used in place of the local time.
+	observation.Timestamp = time.Now().UTC()
+
 	supportsDest, err := p.supportsDestChain()
 	if err != nil {
 		return exectypes.Observation{}, fmt.Errorf("unable to determine if the destination chain is supported: %w", err)
@@ -483,6 +551,9 @@ func (p *Plugin) getFilterObservation(
 		return observation, nil
 	}
 
+	observation.TokenPoolRateLimits = p.observeTokenPoolRateLimits(ctx, lggr, previousOutcome.CommitReports)
+	observation.CostPrices = p.observeCostPrices(ctx, lggr)
+
 	commitReportSenders := make(map[cciptypes.ChainSelector][]string)
 	uniqueSenders := make(map[cciptypes.ChainSelector]map[string]struct{})
 	for _, report := range previousOutcome.CommitReports {
@@ -536,3 +607,71 @@ func (p *Plugin) getFilterObservation(
 	}
 	return observation, nil
 }
+
+// observeTokenPoolRateLimits reads the inbound rate limits of the destination token pools used by the messages of
+// the commit reports. Reading the rate limits is best-effort, if they can't be read nothing is observed and the
+// rate limit check is skipped for the tokens without consensus.
+func (p *Plugin) observeTokenPoolRateLimits(
+	ctx context.Context,
+	lggr logger.Logger,
+	commitReports []exectypes.CommitData,
+) exectypes.TokenPoolRateLimitObservations {
+	destTokens := make(map[cciptypes.ChainSelector][]cciptypes.UnknownAddress)
+	seen := make(map[cciptypes.ChainSelector]map[string]struct{})
+	for _, commitReport := range commitReports {
+		srcChain := commitReport.SourceChain
+		if _, ok := seen[srcChain]; !ok {
+			seen[srcChain] = make(map[string]struct{})
+		}
+		for _, msg := range commitReport.Messages {
+			for _, tokenAmount := range msg.TokenAmounts {
+				if _, ok := seen[srcChain][tokenAmount.DestTokenAddress.String()]; ok {
+					continue
+				}
+				seen[srcChain][tokenAmount.DestTokenAddress.String()] = struct{}{}
+				destTokens[srcChain] = append(destTokens[srcChain], tokenAmount.DestTokenAddress)
+			}
+		}
+	}
+	if len(destTokens) == 0 {
+		return nil
+	}
+
+	rateLimits, err := p.ccipReader.GetTokenPoolInboundRateLimits(ctx, destTokens)
+	if err != nil {
+		lggr.Errorw("unable to read token pool rate limits", "err", err)
+		return nil
+	}
+	return rateLimits
+}
+
+// observeCostPrices reads the destination gas price, wrapped native and LINK prices used by the fee check.
+// Nothing is observed if the fee check is disabled or any of the prices can't be read.
+func (p *Plugin) observeCostPrices(ctx context.Context, lggr logger.Logger) exectypes.CostPriceObservation {
+	if !p.offchainCfg.EnableFeeCheck {
+		return exectypes.CostPriceObservation{}
+	}
+
+	feeComponents, err := p.ccipReader.GetDestChainFeeComponents(ctx)
+	if err != nil || feeComponents.ExecutionFee == nil {
+		lggr.Errorw("unable to read dest chain fee components", "err", err)
+		return exectypes.CostPriceObservation{}
+	}
+	nativePrice, ok := p.ccipReader.GetWrappedNativeTokenPriceUSD(
+		ctx, []cciptypes.ChainSelector{p.destChain})[p.destChain]
+	if !ok || !nativePrice.IsPositive() {
+		lggr.Errorw("unable to read dest chain native token price")
+		return exectypes.CostPriceObservation{}
+	}
+	linkPrice, err := p.ccipReader.LinkPriceUSD(ctx)
+	if err != nil || !linkPrice.IsPositive() {
+		lggr.Errorw("unable to read LINK price", "err", err, "linkPrice", linkPrice)
+		return exectypes.CostPriceObservation{}
+	}
+
+	return exectypes.CostPriceObservation{
+		GasPrice:       cciptypes.NewBigInt(feeComponents.ExecutionFee),
+		NativePriceUSD: nativePrice,
+		LinkPriceUSD:   linkPrice,
+	}
+}
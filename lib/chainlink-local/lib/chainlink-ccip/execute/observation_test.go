@@ -3,6 +3,7 @@ package execute
 import (
 	"context"
 	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -15,13 +16,18 @@ import (
 
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
 	"github.com/smartcontractkit/chainlink-ccip/execute/internal/cache"
+	"github.com/smartcontractkit/chainlink-ccip/execute/metrics"
 	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/observer"
 	"github.com/smartcontractkit/chainlink-ccip/internal/mocks"
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/mocks/internal_/reader"
 	codec_mock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/ocrtypecodec/v1"
 	readerpkg_mock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/reader"
+	readerpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
@@ -647,6 +653,7 @@ func Test_getMessagesObservation(t *testing.T) {
 				estimateProvider:     estimateProvider,
 				inflightMessageCache: inflightCache,
 				tokenDataObserver:    &tokenDataObserver,
+				observer:             &metrics.Noop{},
 				offchainCfg: pluginconfig.ExecuteOffchainConfig{
 					BatchGasLimit: uint64(batchGasLimit),
 				},
@@ -671,3 +678,27 @@ func Test_getMessagesObservation(t *testing.T) {
 		})
 	}
 }
+
+func Test_prioritizeCurseBacklog(t *testing.T) {
+	lggr := logger.Test(t)
+	commitData := []exectypes.CommitData{
+		{SourceChain: 1, MerkleRoot: cciptypes.Bytes32{1}},
+		{SourceChain: 2, MerkleRoot: cciptypes.Bytes32{2}},
+		{SourceChain: 1, MerkleRoot: cciptypes.Bytes32{3}},
+		{SourceChain: 2, MerkleRoot: cciptypes.Bytes32{4}},
+	}
+
+	// without a tracker nothing is reordered.
+	plugin := &Plugin{}
+	require.Equal(t, commitData, plugin.prioritizeCurseBacklog(lggr, slices.Clone(commitData)))
+
+	plugin.curseTracker = plugincommon.NewCurseTracker(lggr, &metrics.Noop{}, 10, time.Hour)
+	now := time.Now().UTC()
+	plugin.curseTracker.Update(readerpkg.CurseInfo{
+		CursedSourceChains: map[cciptypes.ChainSelector]bool{2: true},
+	}, now.Add(-time.Minute))
+	plugin.curseTracker.Update(readerpkg.CurseInfo{}, now)
+
+	prioritized := plugin.prioritizeCurseBacklog(lggr, slices.Clone(commitData))
+	require.Equal(t, []exectypes.CommitData{commitData[1], commitData[3], commitData[0], commitData[2]}, prioritized)
+}
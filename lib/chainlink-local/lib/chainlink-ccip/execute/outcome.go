@@ -3,7 +3,9 @@ package execute
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
+	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
@@ -173,7 +175,9 @@ func (p *Plugin) getFilterOutcome(
 	observation exectypes.Observation,
 	previousOutcome exectypes.Outcome,
 ) (exectypes.Outcome, error) {
-	commitReports := previousOutcome.CommitReports
+	// Reports with prioritized messages are added first, so that they are kept when the limits are reached.
+	prioritizer := report.NewMessagePrioritizer(p.offchainCfg.MessagePriority)
+	commitReports := report.SortCommitReportsByPriority(prioritizer, previousOutcome.CommitReports)
 
 	builder := report.NewBuilder(
 		lggr,
@@ -187,8 +191,12 @@ func (p *Plugin) getFilterOutcome(
 		report.WithExtraMessageCheck(report.CheckNonces(observation.Nonces, p.addrCodec)),
 		//TODO: remove as we already check it in GetMessages phase
 		report.WithExtraMessageCheck(report.CheckIfInflight(p.inflightMessageCache.IsInflight)),
+		p.tokenPoolRateLimitsCheck(commitReports, observation.TokenPoolRateLimits),
+		p.feeCheck(lggr, observation.CostPrices, observation.Timestamp),
 		report.WithMaxMessages(p.offchainCfg.MaxReportMessages),
 		report.WithMaxSingleChainReports(p.offchainCfg.MaxSingleChainReports),
+		report.WithBatchingStrategy(p.batchingStrategy),
+		report.WithMessagePrioritizer(prioritizer),
 	)
 
 	outcomeReports, selectedCommitReports, err := selectReport(
@@ -208,3 +216,60 @@ func (p *Plugin) getFilterOutcome(
 	// TODO: sort in the encoder.
 	return exectypes.NewOutcome(exectypes.Filter, selectedCommitReports, execReport), nil
 }
+
+// tokenPoolRateLimitsCheck returns a check skipping messages which would exceed the consensus inbound rate limits
+// of their destination token pools. Messages which are inflight but not yet executed are accounted for. The check
+// is not added if there are no rate limits, tokens without a rate limit are left to the on-chain check.
+func (p *Plugin) tokenPoolRateLimitsCheck(
+	commitReports []exectypes.CommitData,
+	rateLimits exectypes.TokenPoolRateLimitObservations,
+) report.Option {
+	if len(rateLimits) == 0 {
+		return nil
+	}
+
+	var inflight []cciptypes.Message
+	for _, commitReport := range commitReports {
+		for _, msg := range commitReport.Messages {
+			if len(msg.TokenAmounts) == 0 {
+				continue
+			}
+			if p.inflightMessageCache.IsInflight(commitReport.SourceChain, msg.Header.MessageID) &&
+				!slices.Contains(commitReport.ExecutedMessages, msg.Header.SequenceNumber) {
+				inflight = append(inflight, msg)
+			}
+		}
+	}
+	return report.WithExtraMessageCheck(report.CheckTokenPoolRateLimits(rateLimits, inflight))
+}
+
+// feeCheck returns a check skipping messages whose fee doesn't cover their execution cost, priced with the
+// consensus destination gas price, wrapped native and LINK prices at the consensus time of the round. The check
+// is not added if it is disabled, there is no consensus on the prices or the time, or the LINK price is not
+// positive.
+func (p *Plugin) feeCheck(
+	lggr logger.Logger,
+	costPrices exectypes.CostPriceObservation,
+	timestamp time.Time,
+) report.Option {
+	if !p.offchainCfg.EnableFeeCheck {
+		return nil
+	}
+	if costPrices.IsEmpty() || timestamp.IsZero() {
+		lggr.Warnw("no consensus on cost prices or time, skipping fee check",
+			"costPrices", costPrices, "timestamp", timestamp)
+		return nil
+	}
+	if !costPrices.LinkPriceUSD.IsPositive() {
+		lggr.Warnw("non-positive LINK price, skipping fee check", "linkPriceUSD", costPrices.LinkPriceUSD)
+		return nil
+	}
+
+	prices := report.ExecCostPrices{
+		GasPrice:       costPrices.GasPrice.Int,
+		NativePriceUSD: costPrices.NativePriceUSD.Int,
+		LinkPriceUSD:   costPrices.LinkPriceUSD.Int,
+	}
+	return report.WithExtraMessageCheck(
+		report.CheckFee(p.estimateProvider, prices, p.offchainCfg.RelativeBoostPerWaitHour, timestamp))
+}
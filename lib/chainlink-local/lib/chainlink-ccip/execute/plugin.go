@@ -80,6 +80,7 @@ type Plugin struct {
 	lggr              logger.Logger
 	ocrTypeCodec      ocrtypecodec.ExecCodec
 	addrCodec         cciptypes.AddressCodec
+	batchingStrategy  report.BatchingStrategy
 
 	// state
 
@@ -88,6 +89,8 @@ type Plugin struct {
 	commitRootsCache cache.CommitsRootsCache
 	// inflightMessageCache prevents duplicate reports from being sent for the same message.
 	inflightMessageCache inflightMessageCache
+	// curseTracker records the RMN curses and the lanes whose backlog should be prioritized.
+	curseTracker *plugincommon.CurseTracker
 }
 
 func NewPlugin(
@@ -105,6 +108,7 @@ func NewPlugin(
 	lggr logger.Logger,
 	metricsReporter metrics.Reporter,
 	addrCodec cciptypes.AddressCodec,
+	batchingStrategy report.BatchingStrategy,
 ) ocr3types.ReportingPlugin[[]byte] {
 	lggr.Infow("creating new plugin instance", "p2pID", oracleIDToP2pID[reportingCfg.OracleID])
 
@@ -121,6 +125,7 @@ func NewPlugin(
 		homeChain:         homeChain,
 		tokenDataObserver: tokenDataObserver,
 		estimateProvider:  estimateProvider,
+		batchingStrategy:  batchingStrategy,
 		lggr:              logutil.WithComponent(lggr, "ExecutePlugin"),
 		discovery: discovery.NewContractDiscoveryProcessor(
 			logutil.WithComponent(lggr, "Discovery"),
@@ -148,6 +153,12 @@ func NewPlugin(
 		ocrTypeCodec:         ocrTypCodec,
 		addrCodec:            addrCodec,
 	}
+	p.curseTracker = plugincommon.NewCurseTracker(
+		logutil.WithComponent(lggr, "CurseTracker"),
+		metricsReporter,
+		destChain,
+		offchainCfg.CurseBacklogDrainPeriod.Duration(),
+	)
 	return NewTrackedPlugin(p, lggr, metricsReporter, ocrTypCodec)
 }
 
@@ -4,6 +4,8 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"math/big"
+	"slices"
 	"sort"
 	"time"
 
@@ -756,6 +758,180 @@ func computeNoncesConsensus(
 	return consensusNonces
 }
 
+// computeTokenPoolRateLimitsConsensus computes the consensus on the token pool rate limits. A rate limit needs to
+// be observed by at least fChainDest+1 oracles, and the pool, decimals and whether the rate limit is enabled need
+// to be agreed on by fChainDest+1 of them. The bucket values change over time, similarly to nonces each of them is
+// sorted independently and the fChainDest-th lowest one is used.
+func computeTokenPoolRateLimitsConsensus(
+	lggr logger.Logger,
+	observations []plugincommon.AttributedObservation[exectypes.Observation],
+	fChainDest int,
+) exectypes.TokenPoolRateLimitObservations {
+	type chainTokenPair struct {
+		chain cciptypes.ChainSelector
+		token string
+	}
+	// poolSettings are the values which must be agreed on, they are not expected to change between observations.
+	type poolSettings struct {
+		pool      string
+		decimals  uint8
+		isEnabled bool
+	}
+
+	observedRateLimits := make(map[chainTokenPair][]reader.TokenPoolRateLimit)
+	for _, obs := range observations {
+		for chain, rateLimits := range obs.Observation.TokenPoolRateLimits {
+			for token, rateLimit := range rateLimits {
+				if rateLimit.Inbound.Tokens == nil || rateLimit.Inbound.Capacity == nil {
+					continue
+				}
+				pair := chainTokenPair{chain: chain, token: token}
+				observedRateLimits[pair] = append(observedRateLimits[pair], rateLimit)
+			}
+		}
+	}
+
+	var consensusRateLimits exectypes.TokenPoolRateLimitObservations
+	for pair, rateLimits := range observedRateLimits {
+		if consensus.LtFPlusOne(fChainDest, len(rateLimits)) {
+			lggr.Debugw("no consensus on chain/token rate limit",
+				"chain", pair.chain, "token", pair.token, "observations", len(rateLimits))
+			continue
+		}
+
+		settingsCounts := make(map[poolSettings]int)
+		for _, rateLimit := range rateLimits {
+			settingsCounts[poolSettings{
+				pool:      string(rateLimit.Pool),
+				decimals:  rateLimit.Decimals,
+				isEnabled: rateLimit.Inbound.IsEnabled,
+			}]++
+		}
+		var agreed []poolSettings
+		for candidate, count := range settingsCounts {
+			if consensus.GteFPlusOne(fChainDest, count) {
+				agreed = append(agreed, candidate)
+			}
+		}
+		if len(agreed) == 0 {
+			lggr.Debugw("no consensus on chain/token rate limit pool settings",
+				"chain", pair.chain, "token", pair.token, "observations", len(rateLimits))
+			continue
+		}
+		// several settings only reach the threshold with faulty oracles, the most observed one is used and ties
+		// are broken deterministically, preferring an enabled rate limit.
+		sort.Slice(agreed, func(i, j int) bool {
+			a, b := agreed[i], agreed[j]
+			if settingsCounts[a] != settingsCounts[b] {
+				return settingsCounts[a] > settingsCounts[b]
+			}
+			if a.isEnabled != b.isEnabled {
+				return a.isEnabled
+			}
+			if a.pool != b.pool {
+				return a.pool < b.pool
+			}
+			return a.decimals < b.decimals
+		})
+		settings := agreed[0]
+
+		fthLowestBig := func(value func(reader.RateLimiterState) *big.Int) *big.Int {
+			values := make([]*big.Int, 0, len(rateLimits))
+			for _, rateLimit := range rateLimits {
+				if v := value(rateLimit.Inbound); v != nil {
+					values = append(values, v)
+				}
+			}
+			if consensus.LtFPlusOne(fChainDest, len(values)) {
+				return nil
+			}
+			sort.Slice(values, func(i, j int) bool { return values[i].Cmp(values[j]) < 0 })
+			return values[fChainDest]
+		}
+		lastUpdated := make([]uint32, 0, len(rateLimits))
+		for _, rateLimit := range rateLimits {
+			lastUpdated = append(lastUpdated, rateLimit.Inbound.LastUpdated)
+		}
+		slices.Sort(lastUpdated)
+
+		if consensusRateLimits == nil {
+			consensusRateLimits = make(exectypes.TokenPoolRateLimitObservations)
+		}
+		if _, ok := consensusRateLimits[pair.chain]; !ok {
+			consensusRateLimits[pair.chain] = make(map[string]reader.TokenPoolRateLimit)
+		}
+		consensusRateLimits[pair.chain][pair.token] = reader.TokenPoolRateLimit{
+			Pool:     []byte(settings.pool),
+			Decimals: settings.decimals,
+			Inbound: reader.RateLimiterState{
+				Tokens:      fthLowestBig(func(s reader.RateLimiterState) *big.Int { return s.Tokens }),
+				LastUpdated: lastUpdated[fChainDest],
+				IsEnabled:   settings.isEnabled,
+				Capacity:    fthLowestBig(func(s reader.RateLimiterState) *big.Int { return s.Capacity }),
+				Rate:        fthLowestBig(func(s reader.RateLimiterState) *big.Int { return s.Rate }),
+			},
+		}
+	}
+
+	return consensusRateLimits
+}
+
+// computeCostPricesConsensus computes the median of each of the observed cost prices. Prices need to be observed
+// by at least 2*fChainDest+1 oracles, otherwise no prices are returned. Observations with a non-positive native
+// or LINK price are ignored, as execution costs are divided by them.
+func computeCostPricesConsensus(
+	lggr logger.Logger,
+	observations []plugincommon.AttributedObservation[exectypes.Observation],
+	fChainDest int,
+) exectypes.CostPriceObservation {
+	var gasPrices, nativePrices, linkPrices []cciptypes.BigInt
+	for _, obs := range observations {
+		costPrices := obs.Observation.CostPrices
+		if costPrices.IsEmpty() {
+			continue
+		}
+		if !costPrices.NativePriceUSD.IsPositive() || !costPrices.LinkPriceUSD.IsPositive() {
+			lggr.Warnw("ignoring non-positive cost prices", "oracle", obs.OracleID, "costPrices", costPrices)
+			continue
+		}
+		gasPrices = append(gasPrices, obs.Observation.CostPrices.GasPrice)
+		nativePrices = append(nativePrices, obs.Observation.CostPrices.NativePriceUSD)
+		linkPrices = append(linkPrices, obs.Observation.CostPrices.LinkPriceUSD)
+	}
+
+	if consensus.LtTwoFPlusOne(fChainDest, len(gasPrices)) {
+		lggr.Debugw("no consensus on cost prices", "observations", len(gasPrices), "fChainDest", fChainDest)
+		return exectypes.CostPriceObservation{}
+	}
+
+	return exectypes.CostPriceObservation{
+		GasPrice:       consensus.Median(gasPrices, consensus.BigIntComparator),
+		NativePriceUSD: consensus.Median(nativePrices, consensus.BigIntComparator),
+		LinkPriceUSD:   consensus.Median(linkPrices, consensus.BigIntComparator),
+	}
+}
+
+// computeTimestampConsensus computes the median of the observed timestamps. Timestamps need to be observed by at
+// least 2f+1 oracles, otherwise the zero time is returned.
+func computeTimestampConsensus(
+	lggr logger.Logger,
+	observations []plugincommon.AttributedObservation[exectypes.Observation],
+	f int,
+) time.Time {
+	timestamps := make([]time.Time, 0, len(observations))
+	for _, obs := range observations {
+		if !obs.Observation.Timestamp.IsZero() {
+			timestamps = append(timestamps, obs.Observation.Timestamp)
+		}
+	}
+
+	if consensus.LtTwoFPlusOne(f, len(timestamps)) {
+		lggr.Debugw("no consensus on timestamp", "observations", len(timestamps), "f", f)
+		return time.Time{}
+	}
+	return consensus.TimestampsMedian(timestamps)
+}
+
 // computeConsensusObservation aggregates multiple attributed observations to produce a single consensus observation.
 // The provided f is required for computing the consensus on fChain prior to computing the observation consensus.
 func computeConsensusObservation(
@@ -779,6 +955,9 @@ func computeConsensusObservation(
 		dt.Observation{},
 		computeMessageHashesConsensus(lggr, observations, fChain),
 	)
+	consensusObservation.TokenPoolRateLimits = computeTokenPoolRateLimitsConsensus(lggr, observations, destFChain)
+	consensusObservation.CostPrices = computeCostPricesConsensus(lggr, observations, destFChain)
+	consensusObservation.Timestamp = computeTimestampConsensus(lggr, observations, f)
 
 	lggr.Debugw("computeConsensusObservation has finished computing the consensus observation",
 		"fChain", fChain,
@@ -2,6 +2,7 @@ package execute
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
@@ -1699,6 +1701,254 @@ func Test_computeNoncesConsensus(t *testing.T) {
 	}
 }
 
+func Test_computeTokenPoolRateLimitsConsensus(t *testing.T) {
+	lggr := logger.Test(t)
+
+	rateLimit := func(tokens int64) reader.TokenPoolRateLimit {
+		return reader.TokenPoolRateLimit{
+			Pool:     []byte{0x1},
+			Decimals: 18,
+			Inbound: reader.RateLimiterState{
+				Tokens:    big.NewInt(tokens),
+				IsEnabled: true,
+				Capacity:  big.NewInt(1000),
+				Rate:      big.NewInt(1),
+			},
+		}
+	}
+
+	withSettings := func(rateLimit reader.TokenPoolRateLimit, decimals uint8, isEnabled bool) reader.TokenPoolRateLimit {
+		rateLimit.Decimals = decimals
+		rateLimit.Inbound.IsEnabled = isEnabled
+		return rateLimit
+	}
+	withCapacity := func(rateLimit reader.TokenPoolRateLimit, capacity int64) reader.TokenPoolRateLimit {
+		rateLimit.Inbound.Capacity = big.NewInt(capacity)
+		return rateLimit
+	}
+
+	testCases := []struct {
+		name                   string
+		allRateLimits          []exectypes.TokenPoolRateLimitObservations
+		fChain                 int
+		expRateLimitsConsensus exectypes.TokenPoolRateLimitObservations
+	}{
+		{
+			name:                   "empty",
+			allRateLimits:          []exectypes.TokenPoolRateLimitObservations{},
+			fChain:                 1,
+			expRateLimitsConsensus: nil,
+		},
+		{
+			name: "one observation does not reach threshold",
+			allRateLimits: []exectypes.TokenPoolRateLimitObservations{
+				{1: {"0x1": rateLimit(100)}},
+			},
+			fChain:                 1,
+			expRateLimitsConsensus: nil,
+		},
+		{
+			name: "two observations reach threshold",
+			allRateLimits: []exectypes.TokenPoolRateLimitObservations{
+				{1: {"0x1": rateLimit(100)}},
+				{1: {"0x1": rateLimit(100)}},
+			},
+			fChain: 1,
+			expRateLimitsConsensus: exectypes.TokenPoolRateLimitObservations{
+				1: {"0x1": rateLimit(100)},
+			},
+		},
+		{
+			name: "multiple observations with different values unordered",
+			allRateLimits: []exectypes.TokenPoolRateLimitObservations{
+				{1: {"0x1": rateLimit(104)}},
+				{1: {"0x1": rateLimit(100)}},
+				{1: {"0x1": rateLimit(103)}},
+				{1: {"0x1": rateLimit(101)}},
+				{1: {"0x1": rateLimit(102)}},
+			},
+			fChain: 2,
+			expRateLimitsConsensus: exectypes.TokenPoolRateLimitObservations{
+				1: {"0x1": rateLimit(102)},
+			},
+		},
+		{
+			name: "tokens and chains are independent",
+			allRateLimits: []exectypes.TokenPoolRateLimitObservations{
+				{1: {"0x1": rateLimit(100), "0x2": rateLimit(200)}, 2: {"0x1": rateLimit(300)}},
+				{1: {"0x1": rateLimit(100)}, 2: {"0x1": rateLimit(300)}},
+			},
+			fChain: 1,
+			expRateLimitsConsensus: exectypes.TokenPoolRateLimitObservations{
+				1: {"0x1": rateLimit(100)},
+				2: {"0x1": rateLimit(300)},
+			},
+		},
+		{
+			name: "decimals and enabled flag of a faulty oracle are ignored",
+			allRateLimits: []exectypes.TokenPoolRateLimitObservations{
+				{1: {"0x1": withSettings(rateLimit(100), 6, false)}},
+				{1: {"0x1": rateLimit(101)}},
+				{1: {"0x1": rateLimit(102)}},
+			},
+			fChain: 1,
+			expRateLimitsConsensus: exectypes.TokenPoolRateLimitObservations{
+				1: {"0x1": rateLimit(101)},
+			},
+		},
+		{
+			name: "no agreement on decimals",
+			allRateLimits: []exectypes.TokenPoolRateLimitObservations{
+				{1: {"0x1": withSettings(rateLimit(100), 6, true)}},
+				{1: {"0x1": rateLimit(100)}},
+			},
+			fChain:                 1,
+			expRateLimitsConsensus: nil,
+		},
+		{
+			name: "tokens and capacity are taken independently",
+			allRateLimits: []exectypes.TokenPoolRateLimitObservations{
+				{1: {"0x1": withCapacity(rateLimit(100), 3000)}},
+				{1: {"0x1": withCapacity(rateLimit(300), 1000)}},
+				{1: {"0x1": withCapacity(rateLimit(200), 2000)}},
+			},
+			fChain: 1,
+			expRateLimitsConsensus: exectypes.TokenPoolRateLimitObservations{
+				1: {"0x1": withCapacity(rateLimit(200), 2000)},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			observations := make([]plugincommon.AttributedObservation[exectypes.Observation], len(tc.allRateLimits))
+			for i, obs := range tc.allRateLimits {
+				observations[i] = plugincommon.AttributedObservation[exectypes.Observation]{
+					Observation: exectypes.Observation{TokenPoolRateLimits: obs},
+					OracleID:    commontypes.OracleID(i),
+				}
+			}
+			obs := computeTokenPoolRateLimitsConsensus(lggr, observations, tc.fChain)
+			assert.Equal(t, tc.expRateLimitsConsensus, obs)
+		})
+	}
+}
+
+func Test_computeCostPricesConsensus(t *testing.T) {
+	lggr := logger.Test(t)
+
+	prices := func(gas, native, link int64) exectypes.CostPriceObservation {
+		return exectypes.CostPriceObservation{
+			GasPrice:       cciptypes.NewBigIntFromInt64(gas),
+			NativePriceUSD: cciptypes.NewBigIntFromInt64(native),
+			LinkPriceUSD:   cciptypes.NewBigIntFromInt64(link),
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		allPrices    []exectypes.CostPriceObservation
+		fChain       int
+		expConsensus exectypes.CostPriceObservation
+	}{
+		{
+			name:         "empty",
+			allPrices:    []exectypes.CostPriceObservation{},
+			fChain:       1,
+			expConsensus: exectypes.CostPriceObservation{},
+		},
+		{
+			name: "missing prices do not count towards the threshold",
+			allPrices: []exectypes.CostPriceObservation{
+				prices(10, 20, 30),
+				prices(10, 20, 30),
+				{},
+			},
+			fChain:       1,
+			expConsensus: exectypes.CostPriceObservation{},
+		},
+		{
+			name: "non-positive native or LINK prices do not count towards the threshold",
+			allPrices: []exectypes.CostPriceObservation{
+				prices(10, 20, 30),
+				prices(10, 0, 30),
+				prices(10, 20, 0),
+				prices(10, 20, -1),
+			},
+			fChain:       1,
+			expConsensus: exectypes.CostPriceObservation{},
+		},
+		{
+			name: "median of each price",
+			allPrices: []exectypes.CostPriceObservation{
+				prices(10, 25, 30),
+				prices(12, 20, 31),
+				prices(11, 21, 39),
+			},
+			fChain:       1,
+			expConsensus: prices(11, 21, 31),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			observations := make([]plugincommon.AttributedObservation[exectypes.Observation], len(tc.allPrices))
+			for i, obs := range tc.allPrices {
+				observations[i] = plugincommon.AttributedObservation[exectypes.Observation]{
+					Observation: exectypes.Observation{CostPrices: obs},
+					OracleID:    commontypes.OracleID(i),
+				}
+			}
+			obs := computeCostPricesConsensus(lggr, observations, tc.fChain)
+			assert.Equal(t, tc.expConsensus, obs)
+		})
+	}
+}
+
+func Test_computeTimestampConsensus(t *testing.T) {
+	lggr := logger.Test(t)
+	now := time.Now().UTC()
+
+	testCases := []struct {
+		name          string
+		allTimestamps []time.Time
+		f             int
+		expConsensus  time.Time
+	}{
+		{
+			name:          "empty",
+			allTimestamps: []time.Time{},
+			f:             1,
+			expConsensus:  time.Time{},
+		},
+		{
+			name:          "zero timestamps do not count towards the threshold",
+			allTimestamps: []time.Time{now, now, {}},
+			f:             1,
+			expConsensus:  time.Time{},
+		},
+		{
+			name:          "median timestamp",
+			allTimestamps: []time.Time{now.Add(time.Minute), now.Add(-time.Hour), now},
+			f:             1,
+			expConsensus:  now,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			observations := make([]plugincommon.AttributedObservation[exectypes.Observation], len(tc.allTimestamps))
+			for i, ts := range tc.allTimestamps {
+				observations[i] = plugincommon.AttributedObservation[exectypes.Observation]{
+					Observation: exectypes.Observation{Timestamp: ts},
+					OracleID:    commontypes.OracleID(i),
+				}
+			}
+			assert.Equal(t, tc.expConsensus, computeTimestampConsensus(lggr, observations, tc.f))
+		})
+	}
+}
+
 func Test_computeMessageHashesConsensus(t *testing.T) {
 	testCases := []struct {
 		name           string
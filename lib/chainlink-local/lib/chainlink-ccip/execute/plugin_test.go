@@ -43,6 +43,7 @@ import (
 	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
 	reader2 "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
 )
 
 func genRandomChainReports(numReports, numMsgsPerReport int) []cciptypes.ExecutePluginReportSingleChain {
@@ -2133,3 +2134,27 @@ func createCommitData(
 		Timestamp:           timestamp,
 	}
 }
+
+func TestPlugin_feeCheck(t *testing.T) {
+	lggr := logger.Test(t)
+	now := time.Now()
+	prices := func(link int64) exectypes.CostPriceObservation {
+		return exectypes.CostPriceObservation{
+			GasPrice:       cciptypes.NewBigIntFromInt64(10),
+			NativePriceUSD: cciptypes.NewBigIntFromInt64(20),
+			LinkPriceUSD:   cciptypes.NewBigIntFromInt64(link),
+		}
+	}
+
+	p := &Plugin{offchainCfg: pluginconfig.ExecuteOffchainConfig{EnableFeeCheck: true}}
+	assert.NotNil(t, p.feeCheck(lggr, prices(30), now))
+	assert.Nil(t, p.feeCheck(lggr, prices(30), time.Time{}))
+	assert.Nil(t, p.feeCheck(lggr, exectypes.CostPriceObservation{}, now))
+
+	// execution costs are divided by the LINK price, the check is skipped instead.
+	assert.Nil(t, p.feeCheck(lggr, prices(0), now))
+	assert.Nil(t, p.feeCheck(lggr, prices(-1), now))
+
+	p.offchainCfg.EnableFeeCheck = false
+	assert.Nil(t, p.feeCheck(lggr, prices(30), now))
+}
@@ -0,0 +1,147 @@
+package report
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// Batching strategy IDs, configured with ExecuteOffchainConfig.BatchingStrategyID.
+const (
+	BestEffortBatchingStrategyID  = uint32(0)
+	ZKOverflowBatchingStrategyID  = uint32(1)
+	FeePriorityBatchingStrategyID = uint32(2)
+)
+
+// BatchingStrategy decides which of the ready messages of a commit report are tried, in which order, and how
+// many of them can be part of a single chain report. Size, gas and nonce limits are enforced by the builder
+// regardless of the strategy.
+type BatchingStrategy interface {
+	// ID returns the ID of the strategy.
+	ID() uint32
+
+	// Order returns the indices of the ready messages in the order they should be added to the report.
+	Order(report exectypes.CommitData, readyMessages map[int]struct{}) []int
+
+	// MaxMessages is the maximum number of messages in a single chain report, 0 means no limit.
+	MaxMessages() uint64
+}
+
+// NewBatchingStrategy returns the strategy for the given ID.
+func NewBatchingStrategy(id uint32) (BatchingStrategy, error) {
+	switch id {
+	case BestEffortBatchingStrategyID:
+		return BestEffortBatchingStrategy{}, nil
+	case ZKOverflowBatchingStrategyID:
+		return ZKOverflowBatchingStrategy{}, nil
+	case FeePriorityBatchingStrategyID:
+		return FeePriorityBatchingStrategy{}, nil
+	default:
+		return nil, fmt.Errorf("unknown batching strategy ID %d", id)
+	}
+}
+
+// BestEffortBatchingStrategy adds as many ready messages as possible, in sequence number order.
+type BestEffortBatchingStrategy struct{}
+
+func (BestEffortBatchingStrategy) ID() uint32 {
+	return BestEffortBatchingStrategyID
+}
+
+func (BestEffortBatchingStrategy) Order(report exectypes.CommitData, readyMessages map[int]struct{}) []int {
+	return sortedIndices(report, readyMessages)
+}
+
+func (BestEffortBatchingStrategy) MaxMessages() uint64 {
+	return 0
+}
+
+// ZKOverflowBatchingStrategy is used for ZK chains whose circuits overflow under certain conditions. Reports are
+// limited to a single message so that an overflowing message can't block others. Transaction statuses are not
+// looked up, a message whose execution overflowed is retried like any other failed message.
+type ZKOverflowBatchingStrategy struct{}
+
+func (ZKOverflowBatchingStrategy) ID() uint32 {
+	return ZKOverflowBatchingStrategyID
+}
+
+func (ZKOverflowBatchingStrategy) Order(report exectypes.CommitData, readyMessages map[int]struct{}) []int {
+	return sortedIndices(report, readyMessages)
+}
+
+func (ZKOverflowBatchingStrategy) MaxMessages() uint64 {
+	return 1
+}
+
+// FeePriorityBatchingStrategy adds the messages paying the highest fees first, so that they are the ones
+// included when the report limits are reached. Messages from the same sender which are executed in order
+// are never reordered among themselves.
+type FeePriorityBatchingStrategy struct{}
+
+func (FeePriorityBatchingStrategy) ID() uint32 {
+	return FeePriorityBatchingStrategyID
+}
+
+func (FeePriorityBatchingStrategy) Order(report exectypes.CommitData, readyMessages map[int]struct{}) []int {
+	return orderRespectingNonces(report, sortedIndices(report, readyMessages), func(a, b ccipocr3.Message) bool {
+		return feeValue(a).Cmp(feeValue(b)) > 0
+	})
+}
+
+func (FeePriorityBatchingStrategy) MaxMessages() uint64 {
+	return 0
+}
+
+// orderRespectingNonces repeatedly picks the message of indices preferred by better, ties are broken by the
+// position in indices. A message is a candidate unless an earlier message of indices from the same sender is
+// still remaining and both are executed in order, so indices must list the ordered messages of every sender
+// in nonce order.
+func orderRespectingNonces(
+	report exectypes.CommitData, indices []int, better func(a, b ccipocr3.Message) bool,
+) []int {
+	remaining := slices.Clone(indices)
+	order := make([]int, 0, len(remaining))
+
+	for len(remaining) > 0 {
+		blockedSenders := make(map[string]struct{})
+		best := -1
+		for pos, idx := range remaining {
+			msg := report.Messages[idx]
+			if msg.Header.Nonce != 0 {
+				sender := string(msg.Sender)
+				if _, blocked := blockedSenders[sender]; blocked {
+					continue
+				}
+				blockedSenders[sender] = struct{}{}
+			}
+			if best == -1 || better(msg, report.Messages[remaining[best]]) {
+				best = pos
+			}
+		}
+
+		order = append(order, remaining[best])
+		remaining = append(remaining[:best], remaining[best+1:]...)
+	}
+	return order
+}
+
+// sortedIndices returns the indices of the ready messages in increasing order.
+func sortedIndices(report exectypes.CommitData, readyMessages map[int]struct{}) []int {
+	indices := make([]int, 0, len(readyMessages))
+	for i := range report.Messages {
+		if _, ok := readyMessages[i]; ok {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+func feeValue(msg ccipocr3.Message) *big.Int {
+	if msg.FeeValueJuels.Int == nil {
+		return big.NewInt(0)
+	}
+	return msg.FeeValueJuels.Int
+}
@@ -0,0 +1,99 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func Test_NewBatchingStrategy(t *testing.T) {
+	s, err := NewBatchingStrategy(BestEffortBatchingStrategyID)
+	require.NoError(t, err)
+	assert.Equal(t, BestEffortBatchingStrategyID, s.ID())
+
+	s, err = NewBatchingStrategy(ZKOverflowBatchingStrategyID)
+	require.NoError(t, err)
+	assert.Equal(t, ZKOverflowBatchingStrategyID, s.ID())
+	assert.Equal(t, uint64(1), s.MaxMessages())
+
+	s, err = NewBatchingStrategy(FeePriorityBatchingStrategyID)
+	require.NoError(t, err)
+	assert.Equal(t, FeePriorityBatchingStrategyID, s.ID())
+
+	_, err = NewBatchingStrategy(3)
+	require.Error(t, err)
+}
+
+func Test_FeePriorityBatchingStrategy_Order(t *testing.T) {
+	msg := func(sender string, nonce uint64, fee int64) cciptypes.Message {
+		return cciptypes.Message{
+			Header:        cciptypes.RampMessageHeader{Nonce: nonce},
+			Sender:        cciptypes.UnknownAddress(sender),
+			FeeValueJuels: cciptypes.NewBigIntFromInt64(fee),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		messages []cciptypes.Message
+		ready    map[int]struct{}
+		want     []int
+	}{
+		{
+			name:     "out of order messages sorted by fee",
+			messages: []cciptypes.Message{msg("a", 0, 1), msg("b", 0, 3), msg("c", 0, 2)},
+			ready:    map[int]struct{}{0: {}, 1: {}, 2: {}},
+			want:     []int{1, 2, 0},
+		},
+		{
+			name:     "ordered messages keep nonce order",
+			messages: []cciptypes.Message{msg("a", 1, 1), msg("a", 2, 5), msg("b", 0, 3)},
+			ready:    map[int]struct{}{0: {}, 1: {}, 2: {}},
+			want:     []int{2, 0, 1},
+		},
+		{
+			name:     "not ready messages are skipped",
+			messages: []cciptypes.Message{msg("a", 0, 1), msg("b", 0, 3), msg("c", 0, 2)},
+			ready:    map[int]struct{}{0: {}, 2: {}},
+			want:     []int{2, 0},
+		},
+		{
+			name:     "missing fee has the lowest priority",
+			messages: []cciptypes.Message{{}, msg("b", 0, 1)},
+			ready:    map[int]struct{}{0: {}, 1: {}},
+			want:     []int{1, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := FeePriorityBatchingStrategy{}.Order(exectypes.CommitData{Messages: tt.messages}, tt.ready)
+			assert.Equal(t, tt.want, order)
+		})
+	}
+}
+
+func Test_execReportBuilder_maxMessagesPerReport(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxMessages uint64
+		strategy    BatchingStrategy
+		want        uint64
+	}{
+		{name: "no limits", strategy: BestEffortBatchingStrategy{}, want: 0},
+		{name: "configured limit", maxMessages: 5, strategy: BestEffortBatchingStrategy{}, want: 5},
+		{name: "strategy limit", strategy: ZKOverflowBatchingStrategy{}, want: 1},
+		{name: "smallest limit", maxMessages: 5, strategy: ZKOverflowBatchingStrategy{}, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := execReportBuilder{maxMessages: tt.maxMessages, strategy: tt.strategy}
+			assert.Equal(t, tt.want, b.maxMessagesPerReport())
+		})
+	}
+}
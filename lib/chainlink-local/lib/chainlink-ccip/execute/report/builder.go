@@ -56,6 +56,24 @@ func WithExtraMessageCheck(check Check) Option {
 	}
 }
 
+// WithBatchingStrategy configures how messages are batched into single chain reports. Nil keeps the
+// best-effort strategy.
+func WithBatchingStrategy(strategy BatchingStrategy) Option {
+	return func(erb *execReportBuilder) {
+		if strategy != nil {
+			erb.strategy = strategy
+		}
+	}
+}
+
+// WithMessagePrioritizer reorders the messages of the batching strategy by priority. Nil keeps the
+// batching strategy's order.
+func WithMessagePrioritizer(prioritizer *MessagePrioritizer) Option {
+	return func(erb *execReportBuilder) {
+		erb.prioritizer = prioritizer
+	}
+}
+
 func newBuilderInternal(
 	logger logger.Logger,
 	hasher cciptypes.MessageHasher,
@@ -79,6 +97,7 @@ func newBuilderInternal(
 		estimateProvider:  estimateProvider,
 		destChainSelector: destChainSelector,
 		addressCodec:      addressCodec,
+		strategy:          BestEffortBatchingStrategy{},
 	}
 
 	for _, option := range options {
@@ -132,6 +151,8 @@ type execReportBuilder struct {
 	maxGas                uint64
 	maxMessages           uint64
 	maxSingleChainReports uint64
+	strategy              BatchingStrategy
+	prioritizer           *MessagePrioritizer
 
 	// State
 	accumulated validationMetadata
@@ -141,6 +162,15 @@ type execReportBuilder struct {
 	commitReports []exectypes.CommitData
 }
 
+// maxMessagesPerReport is the smallest non-zero limit between the configured one and the batching strategy's.
+func (b *execReportBuilder) maxMessagesPerReport() uint64 {
+	strategyMax := b.strategy.MaxMessages()
+	if b.maxMessages == 0 || (strategyMax != 0 && strategyMax < b.maxMessages) {
+		return strategyMax
+	}
+	return b.maxMessages
+}
+
 // Add an exec report for as many messages as possible in the given commit report.
 // The commit report with updated metadata is returned, it reflects which messages
 // were selected for the exec report.
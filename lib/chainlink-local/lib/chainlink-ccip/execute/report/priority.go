@@ -0,0 +1,121 @@
+package report
+
+import (
+	"slices"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+// MessagePrioritizer computes the priority of messages from the configured priority rules.
+// All methods are safe to call on a nil prioritizer, which keeps the existing order.
+type MessagePrioritizer struct {
+	chainWeights map[ccipocr3.ChainSelector]uint32
+	// senderWeights is keyed by source chain and raw sender address.
+	senderWeights map[ccipocr3.ChainSelector]map[string]uint32
+	tiers         []pluginconfig.TokenValueTier
+}
+
+// NewMessagePrioritizer returns a prioritizer for the rules, or nil if there are no rules.
+func NewMessagePrioritizer(cfg pluginconfig.MessagePriorityConfig) *MessagePrioritizer {
+	if cfg.IsEmpty() {
+		return nil
+	}
+
+	senderWeights := make(map[ccipocr3.ChainSelector]map[string]uint32)
+	for _, s := range cfg.Senders {
+		if _, ok := senderWeights[s.SourceChain]; !ok {
+			senderWeights[s.SourceChain] = make(map[string]uint32)
+		}
+		senderWeights[s.SourceChain][string(s.Sender)] = s.Weight
+	}
+
+	return &MessagePrioritizer{
+		chainWeights:  cfg.SourceChainWeights,
+		senderWeights: senderWeights,
+		tiers:         cfg.TokenValueTiers,
+	}
+}
+
+// Priority returns the sum of the weights of the rules matched by the message.
+func (p *MessagePrioritizer) Priority(msg ccipocr3.Message) uint64 {
+	if p == nil {
+		return 0
+	}
+
+	sourceChain := msg.Header.SourceChainSelector
+	priority := uint64(p.chainWeights[sourceChain])
+	priority += uint64(p.senderWeights[sourceChain][string(msg.Sender)])
+
+	var tierWeight uint32
+	for _, tier := range p.tiers {
+		if tier.SourceChain != sourceChain || tier.Weight <= tierWeight {
+			continue
+		}
+		for _, ta := range msg.TokenAmounts {
+			if ta.Amount.Int != nil && slices.Equal(ta.SourcePoolAddress, tier.SourcePoolAddress) &&
+				ta.Amount.Cmp(tier.MinAmount.Int) >= 0 {
+				tierWeight = tier.Weight
+				break
+			}
+		}
+	}
+	return priority + uint64(tierWeight)
+}
+
+// Order reorders the message indices by decreasing priority, the given order is kept for messages with the
+// same priority and for the ordered messages of a sender.
+func (p *MessagePrioritizer) Order(report exectypes.CommitData, indices []int) []int {
+	if p == nil {
+		return indices
+	}
+	return orderRespectingNonces(report, indices, func(a, b ccipocr3.Message) bool {
+		return p.Priority(a) > p.Priority(b)
+	})
+}
+
+// reportPriority is the highest priority of the messages of the commit report which are not executed yet.
+func (p *MessagePrioritizer) reportPriority(report exectypes.CommitData) uint64 {
+	var priority uint64
+	for _, msg := range report.Messages {
+		if slices.Contains(report.ExecutedMessages, msg.Header.SequenceNumber) {
+			continue
+		}
+		priority = max(priority, p.Priority(msg))
+	}
+	return priority
+}
+
+// SortCommitReportsByPriority returns the commit reports sorted by decreasing priority of their pending
+// messages, the order of reports with the same priority is kept. The input slice is not modified.
+func SortCommitReportsByPriority(
+	p *MessagePrioritizer, reports []exectypes.CommitData,
+) []exectypes.CommitData {
+	if p == nil {
+		return reports
+	}
+
+	priorities := make(map[int]uint64, len(reports))
+	indices := make([]int, len(reports))
+	for i, report := range reports {
+		indices[i] = i
+		priorities[i] = p.reportPriority(report)
+	}
+	slices.SortStableFunc(indices, func(a, b int) int {
+		switch {
+		case priorities[a] > priorities[b]:
+			return -1
+		case priorities[a] < priorities[b]:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	sorted := make([]exectypes.CommitData, len(reports))
+	for i, idx := range indices {
+		sorted[i] = reports[idx]
+	}
+	return sorted
+}
@@ -0,0 +1,109 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+var testPriorityConfig = pluginconfig.MessagePriorityConfig{
+	SourceChainWeights: map[cciptypes.ChainSelector]uint32{1: 1},
+	Senders: []pluginconfig.SenderPriority{
+		{SourceChain: 2, Sender: cciptypes.UnknownAddress("vip"), Weight: 5},
+	},
+	TokenValueTiers: []pluginconfig.TokenValueTier{
+		{SourceChain: 2, SourcePoolAddress: cciptypes.UnknownAddress("pool"),
+			MinAmount: cciptypes.NewBigIntFromInt64(100), Weight: 3},
+		{SourceChain: 2, SourcePoolAddress: cciptypes.UnknownAddress("pool"),
+			MinAmount: cciptypes.NewBigIntFromInt64(1000), Weight: 10},
+	},
+}
+
+func priorityMsg(
+	chain cciptypes.ChainSelector, seqNum cciptypes.SeqNum, sender string, nonce uint64, amount int64,
+) cciptypes.Message {
+	msg := cciptypes.Message{
+		Header: cciptypes.RampMessageHeader{
+			SourceChainSelector: chain,
+			SequenceNumber:      seqNum,
+			Nonce:               nonce,
+		},
+		Sender: cciptypes.UnknownAddress(sender),
+	}
+	if amount > 0 {
+		msg.TokenAmounts = []cciptypes.RampTokenAmount{{
+			SourcePoolAddress: cciptypes.UnknownAddress("pool"),
+			Amount:            cciptypes.NewBigIntFromInt64(amount),
+		}}
+	}
+	return msg
+}
+
+func Test_MessagePrioritizer_Priority(t *testing.T) {
+	require.Nil(t, NewMessagePrioritizer(pluginconfig.MessagePriorityConfig{}))
+
+	p := NewMessagePrioritizer(testPriorityConfig)
+	otherPool := priorityMsg(2, 1, "a", 0, 5000)
+	otherPool.TokenAmounts[0].SourcePoolAddress = cciptypes.UnknownAddress("other")
+
+	tests := []struct {
+		name string
+		p    *MessagePrioritizer
+		msg  cciptypes.Message
+		want uint64
+	}{
+		{name: "source chain weight", p: p, msg: priorityMsg(1, 1, "a", 0, 0), want: 1},
+		{name: "prioritized sender", p: p, msg: priorityMsg(2, 1, "vip", 0, 0), want: 5},
+		{name: "sender on another chain", p: p, msg: priorityMsg(1, 1, "vip", 0, 0), want: 1},
+		{name: "lower token value tier", p: p, msg: priorityMsg(2, 1, "a", 0, 500), want: 3},
+		{name: "highest token value tier", p: p, msg: priorityMsg(2, 1, "a", 0, 5000), want: 10},
+		{name: "weights are added", p: p, msg: priorityMsg(2, 1, "vip", 0, 5000), want: 15},
+		{name: "other token pool", p: p, msg: otherPool, want: 0},
+		{name: "nil prioritizer", p: nil, msg: priorityMsg(2, 1, "vip", 0, 5000), want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.p.Priority(tt.msg))
+		})
+	}
+}
+
+func Test_MessagePrioritizer_Order(t *testing.T) {
+	report := exectypes.CommitData{Messages: []cciptypes.Message{
+		priorityMsg(2, 1, "a", 1, 0),
+		priorityMsg(2, 2, "vip", 0, 0),
+		priorityMsg(2, 3, "a", 2, 5000),
+		priorityMsg(2, 4, "b", 0, 0),
+	}}
+	indices := []int{0, 1, 2, 3}
+
+	var nilPrioritizer *MessagePrioritizer
+	assert.Equal(t, indices, nilPrioritizer.Order(report, indices))
+	// the high value message of sender "a" can't be executed before its earlier ordered message.
+	assert.Equal(t, []int{1, 0, 2, 3}, NewMessagePrioritizer(testPriorityConfig).Order(report, indices))
+}
+
+func Test_SortCommitReportsByPriority(t *testing.T) {
+	reports := []exectypes.CommitData{
+		{SourceChain: 3, Messages: []cciptypes.Message{priorityMsg(3, 1, "a", 0, 0)}},
+		{SourceChain: 1, Messages: []cciptypes.Message{priorityMsg(1, 1, "a", 0, 0)}},
+		{
+			SourceChain:      2,
+			Messages:         []cciptypes.Message{priorityMsg(2, 1, "vip", 0, 0), priorityMsg(2, 2, "a", 0, 0)},
+			ExecutedMessages: []cciptypes.SeqNum{1},
+		},
+		{SourceChain: 2, Messages: []cciptypes.Message{priorityMsg(2, 3, "vip", 0, 0)}},
+	}
+
+	assert.Equal(t, reports, SortCommitReportsByPriority(nil, reports))
+
+	sorted := SortCommitReportsByPriority(NewMessagePrioritizer(testPriorityConfig), reports)
+	assert.Equal(t, []exectypes.CommitData{reports[3], reports[1], reports[0], reports[2]}, sorted)
+	assert.Equal(t, cciptypes.ChainSelector(3), reports[0].SourceChain, "input must not be modified")
+}
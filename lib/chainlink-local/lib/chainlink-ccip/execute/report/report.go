@@ -5,12 +5,16 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"math"
+	"math/big"
 	"slices"
+	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/libs/slicelib"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
@@ -122,27 +126,27 @@ func buildSingleChainReportHelper(
 type messageStatus string
 
 const (
-	None                          messageStatus = ""
-	Error                         messageStatus = "error"
-	ReadyToExecute                messageStatus = "ready_to_execute"
-	AlreadyExecuted               messageStatus = "already_executed"
-	AlreadyInflight               messageStatus = "already_inflight"
-	TokenDataNotReady             messageStatus = "token_data_not_ready" //nolint:gosec // this is not a password
-	PseudoDeleted                 messageStatus = "message_pseudo_deleted"
-	TokenDataFetchError           messageStatus = "token_data_fetch_error"
-	InsufficientRemainingBatchGas messageStatus = "insufficient_remaining_batch_gas"
-	MissingNoncesForChain         messageStatus = "missing_nonces_for_chain"
-	MissingNonce                  messageStatus = "missing_nonce"
-	InvalidNonce                  messageStatus = "invalid_nonce"
+	None                            messageStatus = ""
+	Error                           messageStatus = "error"
+	ReadyToExecute                  messageStatus = "ready_to_execute"
+	AlreadyExecuted                 messageStatus = "already_executed"
+	AlreadyInflight                 messageStatus = "already_inflight"
+	TokenDataNotReady               messageStatus = "token_data_not_ready" //nolint:gosec // this is not a password
+	PseudoDeleted                   messageStatus = "message_pseudo_deleted"
+	TokenDataFetchError             messageStatus = "token_data_fetch_error"
+	InsufficientRemainingBatchGas   messageStatus = "insufficient_remaining_batch_gas"
+	MissingNoncesForChain           messageStatus = "missing_nonces_for_chain"
+	MissingNonce                    messageStatus = "missing_nonce"
+	InvalidNonce                    messageStatus = "invalid_nonce"
+	AggregateTokenValueComputeError messageStatus = "aggregate_token_value_compute_error"
+	AggregateTokenLimitExceeded     messageStatus = "aggregate_token_limit_exceeded"
+	InsufficientRemainingFee        messageStatus = "insufficient_remaining_fee"
 	/*
 		SenderAlreadySkipped                 messageStatus = "sender_already_skipped"
 		MessageMaxGasCalcError               messageStatus = "message_max_gas_calc_error"
 		InsufficientRemainingBatchDataLength messageStatus = "insufficient_remaining_batch_data_length"
-		AggregateTokenValueComputeError      messageStatus = "aggregate_token_value_compute_error"
-		AggregateTokenLimitExceeded          messageStatus = "aggregate_token_limit_exceeded"
 		TokenNotInDestTokenPrices            messageStatus = "token_not_in_dest_token_prices"
 		TokenNotInSrcTokenPrices             messageStatus = "token_not_in_src_token_prices"
-		InsufficientRemainingFee             messageStatus = "insufficient_remaining_fee"
 		AddedToBatch                         messageStatus = "added_to_batch"
 	*/
 )
@@ -317,6 +321,196 @@ func CheckIfInflight(inflight IsInflight) Check {
 	}
 }
 
+// CheckTokenPoolRateLimits skips messages which would exceed the inbound rate limit of a destination token pool
+// and revert on-chain. The check is initialized with the current bucket state of the pools and the messages
+// which are already inflight, their value is deducted from the available tokens before any message is checked.
+// Messages accepted by the check consume tokens so that later messages in the round account for them.
+//
+// NOTE: tokens consumed by a message are not released if the message is later left out of the report
+// (e.g. report size or gas limits), this is conservative and the message is retried in a later round.
+// Tokens without a known rate limit are not checked, the on-chain check still applies.
+func CheckTokenPoolRateLimits(
+	rateLimits map[ccipocr3.ChainSelector]map[string]reader.TokenPoolRateLimit,
+	inflightMessages []ccipocr3.Message,
+) Check {
+	// temporary map to store the tokens consumed in this round.
+	consumed := make(map[ccipocr3.ChainSelector]map[string]*big.Int)
+
+	amounts := func(msg ccipocr3.Message) (map[string]*big.Int, error) {
+		limits := rateLimits[msg.Header.SourceChainSelector]
+		res := make(map[string]*big.Int)
+		for _, tokenAmount := range msg.TokenAmounts {
+			token := tokenAmount.DestTokenAddress.String()
+			limit, ok := limits[token]
+			if !ok || !limit.Inbound.IsEnabled {
+				continue
+			}
+			amount, err := localTokenAmount(tokenAmount.Amount.Int, tokenAmount.ExtraData, limit.Decimals)
+			if err != nil {
+				return nil, fmt.Errorf("token %s: %w", token, err)
+			}
+			if _, ok := res[token]; !ok {
+				res[token] = big.NewInt(0)
+			}
+			res[token].Add(res[token], amount)
+		}
+		return res, nil
+	}
+
+	consume := func(chain ccipocr3.ChainSelector, tokenAmounts map[string]*big.Int) {
+		if _, ok := consumed[chain]; !ok {
+			consumed[chain] = make(map[string]*big.Int)
+		}
+		for token, amount := range tokenAmounts {
+			if _, ok := consumed[chain][token]; !ok {
+				consumed[chain][token] = big.NewInt(0)
+			}
+			consumed[chain][token].Add(consumed[chain][token], amount)
+		}
+	}
+
+	for _, msg := range inflightMessages {
+		// invalid inflight messages are reverted on-chain and do not consume tokens.
+		if tokenAmounts, err := amounts(msg); err == nil {
+			consume(msg.Header.SourceChainSelector, tokenAmounts)
+		}
+	}
+
+	return func(lggr logger.Logger, msg ccipocr3.Message, idx int, report exectypes.CommitData) (messageStatus, error) {
+		if len(msg.TokenAmounts) == 0 {
+			return None, nil
+		}
+
+		tokenAmounts, err := amounts(msg)
+		if err != nil {
+			lggr.Warnw("Skipping message - unable to compute token amounts",
+				"messageID", msg.Header.MessageID,
+				"sourceChain", report.SourceChain,
+				"seqNum", msg.Header.SequenceNumber,
+				"err", err,
+				"messageState", AggregateTokenValueComputeError)
+			return AggregateTokenValueComputeError, nil
+		}
+
+		for token, amount := range tokenAmounts {
+			bucket := rateLimits[report.SourceChain][token].Inbound
+			available := new(big.Int).Set(bucket.Tokens)
+			if used, ok := consumed[report.SourceChain][token]; ok {
+				available.Sub(available, used)
+			}
+			if amount.Cmp(available) > 0 {
+				lggr.Infow("Skipping message - token pool rate limit exceeded",
+					"messageID", msg.Header.MessageID,
+					"sourceChain", report.SourceChain,
+					"seqNum", msg.Header.SequenceNumber,
+					"token", token,
+					"amount", amount,
+					"available", available,
+					"capacity", bucket.Capacity,
+					"exceedsCapacity", amount.Cmp(bucket.Capacity) > 0,
+					"messageState", AggregateTokenLimitExceeded)
+				return AggregateTokenLimitExceeded, nil
+			}
+		}
+
+		consume(report.SourceChain, tokenAmounts)
+		return None, nil
+	}
+}
+
+// ExecCostPrices are the destination chain prices used to convert the execution gas of a message to juels.
+// Token prices are denominated in 1e18 USD per 1e18 of the smallest token denomination.
+type ExecCostPrices struct {
+	// GasPrice is the destination execution gas price in the smallest denomination of the native token.
+	GasPrice *big.Int
+	// NativePriceUSD is the price of the destination wrapped native token.
+	NativePriceUSD *big.Int
+	// LinkPriceUSD is the price of LINK.
+	LinkPriceUSD *big.Int
+}
+
+// execCostJuels returns the cost of the given gas in juels.
+func (p ExecCostPrices) execCostJuels(gas uint64) *big.Int {
+	cost := new(big.Int).SetUint64(gas)
+	cost.Mul(cost, p.GasPrice)
+	cost.Mul(cost, p.NativePriceUSD)
+	return cost.Div(cost, p.LinkPriceUSD)
+}
+
+// CheckFee skips messages whose fee doesn't cover their estimated execution cost on the destination chain.
+// The fee is boosted by relativeBoostPerWaitHour for each hour passed since the message was committed, so
+// underpaid messages are eventually executed when gas prices drop or after waiting long enough.
+func CheckFee(
+	estimateProvider ccipocr3.EstimateProvider,
+	prices ExecCostPrices,
+	relativeBoostPerWaitHour float64,
+	now time.Time,
+) Check {
+	return func(lggr logger.Logger, msg ccipocr3.Message, idx int, report exectypes.CommitData) (messageStatus, error) {
+		fee := big.NewInt(0)
+		if msg.FeeValueJuels.Int != nil {
+			fee = msg.FeeValueJuels.Int
+		}
+		boostedFee := waitBoostedFee(now.Sub(report.Timestamp), fee, relativeBoostPerWaitHour)
+		execCost := prices.execCostJuels(estimateProvider.CalculateMessageMaxGas(msg))
+
+		if boostedFee.Cmp(execCost) < 0 {
+			lggr.Infow("Skipping message - insufficient remaining fee",
+				"messageID", msg.Header.MessageID,
+				"sourceChain", report.SourceChain,
+				"seqNum", msg.Header.SequenceNumber,
+				"fee", fee,
+				"boostedFee", boostedFee,
+				"execCost", execCost,
+				"messageState", InsufficientRemainingFee)
+			return InsufficientRemainingFee, nil
+		}
+		return None, nil
+	}
+}
+
+// waitBoostedFee boosts the fee linearly by relativeBoostPerWaitHour for each hour of waiting.
+func waitBoostedFee(waitTime time.Duration, fee *big.Int, relativeBoostPerWaitHour float64) *big.Int {
+	if waitTime <= 0 || relativeBoostPerWaitHour == 0 {
+		return fee
+	}
+	k := 1.0 + waitTime.Hours()*relativeBoostPerWaitHour
+	boostedFee, _ := new(big.Float).Mul(big.NewFloat(k), new(big.Float).SetInt(fee)).Int(nil)
+	return boostedFee
+}
+
+// localTokenAmount converts a source amount to the destination pool denomination, the same way
+// TokenPool._calculateLocalAmount does. The source decimals are abi encoded in the token extra data,
+// when it is empty both tokens are assumed to have the same decimals.
+func localTokenAmount(amount *big.Int, extraData []byte, localDecimals uint8) (*big.Int, error) {
+	if amount == nil {
+		return nil, fmt.Errorf("token amount is nil")
+	}
+	if len(extraData) == 0 {
+		return amount, nil
+	}
+	if len(extraData) != 32 {
+		return nil, fmt.Errorf("invalid remote decimals encoding, got %d bytes", len(extraData))
+	}
+	remote := new(big.Int).SetBytes(extraData)
+	if !remote.IsUint64() || remote.Uint64() > math.MaxUint8 {
+		return nil, fmt.Errorf("invalid remote decimals %s", remote)
+	}
+
+	remoteDecimals := int64(remote.Uint64())
+	local := int64(localDecimals)
+	switch {
+	case remoteDecimals == local:
+		return amount, nil
+	case remoteDecimals > local:
+		factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(remoteDecimals-local), nil)
+		return new(big.Int).Div(amount, factor), nil
+	default:
+		factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(local-remoteDecimals), nil)
+		return new(big.Int).Mul(amount, factor), nil
+	}
+}
+
 // checkMessages to get a set of which are ready to execute.
 func (b *execReportBuilder) checkMessages(ctx context.Context, report exectypes.CommitData) (map[int]struct{}, error) {
 	readyMessages := make(map[int]struct{})
@@ -336,7 +530,7 @@ func (b *execReportBuilder) checkMessages(ctx context.Context, report exectypes.
 
 // checkMessage for execution readiness.
 func (b *execReportBuilder) checkMessage(
-	_ context.Context, idx int, execReport exectypes.CommitData,
+	ctx context.Context, idx int, execReport exectypes.CommitData,
 ) (exectypes.CommitData, messageStatus, error) {
 	result := execReport
 
@@ -358,8 +552,6 @@ func (b *execReportBuilder) checkMessage(
 		}
 	}
 
-	// TODO: Check txm for too many failures?
-
 	return result, ReadyToExecute, nil
 }
 
@@ -512,7 +704,8 @@ func (b *execReportBuilder) buildSingleChainReport(
 	// report size or gas usage.
 	// In that case, we will execute the loop below to iteratively build a report
 	// with fewer messages until we find a valid report.
-	if b.maxMessages == 0 {
+	maxMessages := b.maxMessagesPerReport()
+	if maxMessages == 0 {
 		finalReport, err :=
 			buildSingleChainReportHelper(b.lggr, commitData, readyMessages)
 		if err != nil {
@@ -550,11 +743,7 @@ func (b *execReportBuilder) buildSingleChainReport(
 	var finalReport ccipocr3.ExecutePluginReportSingleChain
 	var meta validationMetadata
 	msgs := make(map[int]struct{})
-	for i := range commitData.Messages {
-		if _, ok := readyMessages[i]; !ok {
-			continue
-		}
-
+	for _, i := range b.prioritizer.Order(commitData, b.strategy.Order(commitData, readyMessages)) {
 		msgs[i] = struct{}{}
 
 		finalReport2, err := buildSingleChainReportHelper(b.lggr, commitData, msgs)
@@ -584,10 +773,11 @@ func (b *execReportBuilder) buildSingleChainReport(
 				"reportGas", meta.gas,
 			)
 			// Stop searching if we reach the maximum number of messages.
-			if b.maxMessages > 0 && uint64(len(msgs)) >= b.maxMessages {
+			if maxMessages > 0 && uint64(len(msgs)) >= maxMessages {
 				b.lggr.Infow(
 					"reached report builder's max messages, breaking",
-					"maxMessages", b.maxMessages,
+					"maxMessages", maxMessages,
+					"batchingStrategyID", b.strategy.ID(),
 					"numMessages", len(msgs),
 				)
 				break
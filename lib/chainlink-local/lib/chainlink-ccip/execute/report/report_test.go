@@ -4,6 +4,7 @@ import (
 	"context"
 	crand "crypto/rand"
 	"fmt"
+	"math/big"
 	"math/rand"
 	"reflect"
 	"strings"
@@ -26,6 +27,7 @@ import (
 	testhelpersrand "github.com/smartcontractkit/chainlink-ccip/internal/libs/testhelpers/rand"
 	"github.com/smartcontractkit/chainlink-ccip/internal/mocks"
 	gasmock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
@@ -1571,3 +1573,193 @@ func Test_checkSkippedNonces(t *testing.T) {
 		})
 	}
 }
+
+func Test_CheckTokenPoolRateLimits(t *testing.T) {
+	const sourceChain = cciptypes.ChainSelector(1)
+	token1 := cciptypes.UnknownAddress(testhelpersrand.RandomBytes(32))
+	token2 := cciptypes.UnknownAddress(testhelpersrand.RandomBytes(32))
+	unlimited := cciptypes.UnknownAddress(testhelpersrand.RandomBytes(32))
+
+	bucket := func(tokens, capacity int64, enabled bool) reader.TokenPoolRateLimit {
+		return reader.TokenPoolRateLimit{
+			Decimals: 18,
+			Inbound: reader.RateLimiterState{
+				Tokens:    big.NewInt(tokens),
+				IsEnabled: enabled,
+				Capacity:  big.NewInt(capacity),
+				Rate:      big.NewInt(1),
+			},
+		}
+	}
+	rateLimits := map[cciptypes.ChainSelector]map[string]reader.TokenPoolRateLimit{
+		sourceChain: {
+			token1.String(): bucket(100, 1000, true),
+			token2.String(): bucket(10, 10, false),
+		},
+	}
+	amount := func(token cciptypes.UnknownAddress, value int64) cciptypes.RampTokenAmount {
+		return cciptypes.RampTokenAmount{DestTokenAddress: token, Amount: cciptypes.NewBigIntFromInt64(value)}
+	}
+	msg := func(seqNr cciptypes.SeqNum, amounts ...cciptypes.RampTokenAmount) cciptypes.Message {
+		m := makeMessage(sourceChain, seqNr, 0)
+		m.TokenAmounts = amounts
+		return m
+	}
+
+	tests := []struct {
+		name     string
+		inflight []cciptypes.Message
+		msgs     []cciptypes.Message
+		want     []messageStatus
+	}{
+		{
+			name: "no tokens",
+			msgs: []cciptypes.Message{msg(1)},
+			want: []messageStatus{None},
+		},
+		{
+			name: "within limit",
+			msgs: []cciptypes.Message{msg(1, amount(token1, 60)), msg(2, amount(token1, 40))},
+			want: []messageStatus{None, None},
+		},
+		{
+			name: "later message exceeds remaining tokens",
+			msgs: []cciptypes.Message{msg(1, amount(token1, 60)), msg(2, amount(token1, 50)), msg(3, amount(token1, 40))},
+			want: []messageStatus{None, AggregateTokenLimitExceeded, None},
+		},
+		{
+			name: "same token twice in a message",
+			msgs: []cciptypes.Message{msg(1, amount(token1, 60), amount(token1, 60))},
+			want: []messageStatus{AggregateTokenLimitExceeded},
+		},
+		{
+			name:     "inflight messages consume tokens",
+			inflight: []cciptypes.Message{msg(1, amount(token1, 90))},
+			msgs:     []cciptypes.Message{msg(2, amount(token1, 20)), msg(3, amount(token1, 10))},
+			want:     []messageStatus{AggregateTokenLimitExceeded, None},
+		},
+		{
+			name: "disabled and unknown limits are not checked",
+			msgs: []cciptypes.Message{msg(1, amount(token2, 1000), amount(unlimited, 1000))},
+			want: []messageStatus{None},
+		},
+		{
+			name: "rejected message does not consume tokens",
+			msgs: []cciptypes.Message{msg(1, amount(token2, 5), amount(token1, 101)), msg(2, amount(token1, 100))},
+			want: []messageStatus{AggregateTokenLimitExceeded, None},
+		},
+	}
+
+	lggr := logger.Test(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := CheckTokenPoolRateLimits(rateLimits, tt.inflight)
+			report := exectypes.CommitData{SourceChain: sourceChain, Messages: tt.msgs}
+			for i, m := range tt.msgs {
+				status, err := check(lggr, m, i, report)
+				require.NoError(t, err)
+				assert.Equal(t, tt.want[i], status, "message %d", i)
+			}
+		})
+	}
+}
+
+func Test_localTokenAmount(t *testing.T) {
+	decimals := func(d int64) []byte {
+		return slicelib.LeftPadBytes(big.NewInt(d).Bytes(), 32)
+	}
+
+	tests := []struct {
+		name      string
+		extraData []byte
+		local     uint8
+		want      *big.Int
+		wantErr   bool
+	}{
+		{name: "no extra data", local: 6, want: big.NewInt(1e18)},
+		{name: "same decimals", extraData: decimals(18), local: 18, want: big.NewInt(1e18)},
+		{name: "less local decimals", extraData: decimals(18), local: 6, want: big.NewInt(1e6)},
+		{
+			name:      "more local decimals",
+			extraData: decimals(6),
+			local:     18,
+			want:      new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e12)),
+		},
+		{name: "invalid encoding", extraData: []byte{18}, local: 18, wantErr: true},
+		{name: "decimals out of range", extraData: decimals(256), local: 18, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := localTokenAmount(big.NewInt(1e18), tt.extraData, tt.local)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 0, tt.want.Cmp(got), "got %s", got)
+		})
+	}
+}
+
+func Test_CheckFee(t *testing.T) {
+	now := time.Now()
+	// 100_000 gas at 1 gwei with native at 2000 USD and LINK at 20 USD costs 0.01 LINK.
+	prices := ExecCostPrices{
+		GasPrice:       big.NewInt(1e9),
+		NativePriceUSD: new(big.Int).Mul(big.NewInt(2000), big.NewInt(1e18)),
+		LinkPriceUSD:   new(big.Int).Mul(big.NewInt(20), big.NewInt(1e18)),
+	}
+	const execCost = 1e16
+
+	tests := []struct {
+		name      string
+		fee       *big.Int
+		committed time.Duration
+		boost     float64
+		want      messageStatus
+	}{
+		{name: "fee covers cost", fee: big.NewInt(execCost), want: None},
+		{name: "insufficient fee", fee: big.NewInt(execCost - 1), want: InsufficientRemainingFee},
+		{name: "missing fee", want: InsufficientRemainingFee},
+		{name: "no boost without waiting", fee: big.NewInt(execCost / 2), boost: 1, want: InsufficientRemainingFee},
+		{
+			name:      "boosted fee covers cost after waiting",
+			fee:       big.NewInt(execCost / 2),
+			committed: 2 * time.Hour,
+			boost:     0.5,
+			want:      None,
+		},
+		{
+			name:      "boosted fee still insufficient",
+			fee:       big.NewInt(execCost / 2),
+			committed: time.Hour,
+			boost:     0.5,
+			want:      InsufficientRemainingFee,
+		},
+	}
+
+	lggr := logger.Test(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ep := gasmock.NewMockEstimateProvider(t)
+			ep.EXPECT().CalculateMessageMaxGas(mock.Anything).Return(uint64(100_000))
+
+			msg := makeMessage(1, 1, 0)
+			msg.FeeValueJuels = cciptypes.NewBigInt(tt.fee)
+			report := exectypes.CommitData{SourceChain: 1, Timestamp: now.Add(-tt.committed)}
+
+			status, err := CheckFee(ep, prices, tt.boost, now)(lggr, msg, 0, report)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, status)
+		})
+	}
+}
+
+func Test_waitBoostedFee(t *testing.T) {
+	fee := big.NewInt(1000)
+	assert.Equal(t, big.NewInt(1000), waitBoostedFee(0, fee, 0.5))
+	assert.Equal(t, big.NewInt(1000), waitBoostedFee(-time.Hour, fee, 0.5))
+	assert.Equal(t, big.NewInt(1000), waitBoostedFee(time.Hour, fee, 0))
+	assert.Equal(t, big.NewInt(1500), waitBoostedFee(time.Hour, fee, 0.5))
+	assert.Equal(t, big.NewInt(1250), waitBoostedFee(30*time.Minute, fee, 0.5))
+}
@@ -0,0 +1,82 @@
+package execute
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/internal/cache"
+	"github.com/smartcontractkit/chainlink-ccip/execute/metrics"
+	"github.com/smartcontractkit/chainlink-ccip/execute/report"
+	"github.com/smartcontractkit/chainlink-ccip/internal/mocks/inmem"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/ocrrecorder"
+	ocrtypecodec "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1"
+	readerpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+// ReplayConfig configures the plugin used to replay recorded rounds. It should match the configuration of the
+// plugin which recorded them.
+type ReplayConfig struct {
+	DestChain        cciptypes.ChainSelector
+	ReportingCfg     ocr3types.ReportingPluginConfig
+	OffchainCfg      pluginconfig.ExecuteOffchainConfig
+	ReportCodec      cciptypes.ExecutePluginCodec
+	MsgHasher        cciptypes.MessageHasher
+	EstimateProvider cciptypes.EstimateProvider
+	AddrCodec        cciptypes.AddressCodec
+	// CCIPReader is optional, an in-memory reader is used by default.
+	CCIPReader readerpkg.CCIPReader
+}
+
+// SimulateRound replays a recorded round through the consensus functions of the plugin and returns the diff
+// between the recorded and the recomputed outcome, an empty diff means the outcome was reproduced.
+// Contract discovery is skipped as it only binds contracts to the readers.
+func SimulateRound(
+	ctx context.Context, lggr logger.Logger, round ocrrecorder.Round, cfg ReplayConfig,
+) (string, error) {
+	ccipReader := cfg.CCIPReader
+	if ccipReader == nil {
+		ccipReader = inmem.InMemoryCCIPReader{Dest: cfg.DestChain}
+	}
+
+	batchingStrategy, err := report.NewBatchingStrategy(cfg.OffchainCfg.BatchingStrategyID)
+	if err != nil {
+		return "", fmt.Errorf("create batching strategy: %w", err)
+	}
+
+	p := &Plugin{
+		reportingCfg:         cfg.ReportingCfg,
+		offchainCfg:          cfg.OffchainCfg,
+		destChain:            cfg.DestChain,
+		ccipReader:           ccipReader,
+		reportCodec:          cfg.ReportCodec,
+		msgHasher:            cfg.MsgHasher,
+		estimateProvider:     cfg.EstimateProvider,
+		addrCodec:            cfg.AddrCodec,
+		observer:             &metrics.Noop{},
+		lggr:                 logutil.WithComponent(lggr, "ExecuteSimulator"),
+		ocrTypeCodec:         ocrtypecodec.DefaultExecCodec,
+		inflightMessageCache: cache.NewInflightMessageCache(cfg.OffchainCfg.InflightCacheExpiry.Duration()),
+		batchingStrategy:     batchingStrategy,
+	}
+
+	recomputed, err := ocrrecorder.Replay(ctx, p, round)
+	if err != nil {
+		return "", fmt.Errorf("replay round %d: %w", round.SeqNr, err)
+	}
+
+	recordedOutcome, err := p.ocrTypeCodec.DecodeOutcome(round.Outcome)
+	if err != nil {
+		return "", fmt.Errorf("decode recorded outcome: %w", err)
+	}
+	recomputedOutcome, err := p.ocrTypeCodec.DecodeOutcome(recomputed)
+	if err != nil {
+		return "", fmt.Errorf("decode recomputed outcome: %w", err)
+	}
+	return ocrrecorder.Diff(recordedOutcome, recomputedOutcome)
+}
@@ -0,0 +1,148 @@
+package execute
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/execute/internal/cache"
+	"github.com/smartcontractkit/chainlink-ccip/execute/metrics"
+	"github.com/smartcontractkit/chainlink-ccip/execute/report"
+	"github.com/smartcontractkit/chainlink-ccip/internal"
+	"github.com/smartcontractkit/chainlink-ccip/internal/mocks"
+	cciptypesmocks "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/ocrrecorder"
+	ocrtypecodec "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+func TestSimulateRound(t *testing.T) {
+	const destChain = cciptypes.ChainSelector(1)
+	codec := ocrtypecodec.DefaultExecCodec
+
+	obs, err := codec.EncodeObservation(exectypes.Observation{
+		FChain: map[cciptypes.ChainSelector]int{destChain: 1},
+	})
+	require.NoError(t, err)
+
+	round := ocrrecorder.Round{Plugin: "execute", SeqNr: 1}
+	for i := 0; i < 3; i++ {
+		round.Observations = append(round.Observations,
+			ocrrecorder.Observation{Observer: commontypes.OracleID(i), Observation: obs})
+	}
+	cfg := ReplayConfig{
+		DestChain:    destChain,
+		ReportingCfg: ocr3types.ReportingPluginConfig{F: 1},
+	}
+
+	// nothing to execute, the recorded empty outcome is reproduced.
+	diff, err := SimulateRound(context.Background(), logger.Test(t), round, cfg)
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+
+	// a recorded outcome which doesn't match the observations is reported.
+	round.Outcome, err = codec.EncodeOutcome(exectypes.Outcome{State: exectypes.GetCommitReports})
+	require.NoError(t, err)
+	diff, err = SimulateRound(context.Background(), logger.Test(t), round, cfg)
+	require.NoError(t, err)
+	assert.NotEmpty(t, diff)
+}
+
+func TestSimulateRound_BatchingStrategy(t *testing.T) {
+	const (
+		srcChain  = cciptypes.ChainSelector(1)
+		destChain = cciptypes.ChainSelector(2)
+	)
+	ctx := context.Background()
+	lggr := logger.Test(t)
+	codec := ocrtypecodec.DefaultExecCodec
+	msgHasher := mocks.NewMessageHasher()
+
+	commitReport := exectypes.CommitData{
+		SourceChain:         srcChain,
+		SequenceNumberRange: cciptypes.NewSeqNumRange(1, 3),
+	}
+	for i := 1; i <= 3; i++ {
+		msg := NewMessage(i, i, int(srcChain), int(destChain))
+		hash, err := msgHasher.Hash(ctx, msg)
+		require.NoError(t, err)
+		commitReport.Messages = append(commitReport.Messages, msg)
+		commitReport.Hashes = append(commitReport.Hashes, hash)
+		commitReport.MessageTokenData = append(commitReport.MessageTokenData, exectypes.MessageTokenData{})
+	}
+	tree, err := report.ConstructMerkleTree(commitReport, lggr)
+	require.NoError(t, err)
+	commitReport.MerkleRoot = tree.Root()
+
+	// the messages were fetched in the previous round, they are filtered in the replayed one.
+	prevOutcome, err := codec.EncodeOutcome(exectypes.NewOutcome(
+		exectypes.GetMessages, []exectypes.CommitData{commitReport}, cciptypes.ExecutePluginReport{}))
+	require.NoError(t, err)
+	obs, err := codec.EncodeObservation(exectypes.Observation{
+		FChain: map[cciptypes.ChainSelector]int{srcChain: 1, destChain: 1},
+	})
+	require.NoError(t, err)
+
+	round := ocrrecorder.Round{Plugin: "execute", SeqNr: 3, PreviousOutcome: prevOutcome}
+	for i := 0; i < 3; i++ {
+		round.Observations = append(round.Observations,
+			ocrrecorder.Observation{Observer: commontypes.OracleID(i), Observation: obs})
+	}
+
+	ep := cciptypesmocks.NewMockEstimateProvider(t)
+	ep.EXPECT().CalculateMessageMaxGas(mock.Anything).Return(uint64(0)).Maybe()
+	ep.EXPECT().CalculateMerkleTreeGas(mock.Anything).Return(uint64(0)).Maybe()
+	cfg := ReplayConfig{
+		DestChain:        destChain,
+		ReportingCfg:     ocr3types.ReportingPluginConfig{F: 1},
+		OffchainCfg:      pluginconfig.ExecuteOffchainConfig{BatchingStrategyID: report.ZKOverflowBatchingStrategyID},
+		ReportCodec:      mocks.NewExecutePluginJSONReportCodec(),
+		MsgHasher:        msgHasher,
+		EstimateProvider: ep,
+		AddrCodec:        internal.NewMockAddressCodecHex(t),
+	}
+
+	// record the round with a plugin using the ZK overflow strategy, which executes one message per report.
+	strategy, err := report.NewBatchingStrategy(report.ZKOverflowBatchingStrategyID)
+	require.NoError(t, err)
+	recorder := &Plugin{
+		reportingCfg:         cfg.ReportingCfg,
+		offchainCfg:          cfg.OffchainCfg,
+		destChain:            destChain,
+		reportCodec:          cfg.ReportCodec,
+		msgHasher:            msgHasher,
+		estimateProvider:     ep,
+		addrCodec:            cfg.AddrCodec,
+		batchingStrategy:     strategy,
+		observer:             &metrics.Noop{},
+		lggr:                 lggr,
+		ocrTypeCodec:         codec,
+		inflightMessageCache: cache.NewInflightMessageCache(time.Minute),
+	}
+	round.Outcome, err = ocrrecorder.Replay(ctx, recorder, round)
+	require.NoError(t, err)
+	recorded, err := codec.DecodeOutcome(round.Outcome)
+	require.NoError(t, err)
+	require.Len(t, recorded.Report.ChainReports, 1)
+	require.Len(t, recorded.Report.ChainReports[0].Messages, 1)
+
+	diff, err := SimulateRound(ctx, lggr, round, cfg)
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+
+	// replaying with the default strategy executes all messages and doesn't reproduce the outcome.
+	cfg.OffchainCfg.BatchingStrategyID = report.BestEffortBatchingStrategyID
+	diff, err = SimulateRound(ctx, lggr, round, cfg)
+	require.NoError(t, err)
+	assert.NotEmpty(t, diff)
+}
@@ -316,6 +316,7 @@ func (it *IntTest) newNode(
 		it.lggr,
 		&metrics.Noop{},
 		mockCodec,
+		report.BestEffortBatchingStrategy{},
 	)
 
 	// FIXME: Test should not rely on the specific type of the plugin but rather than that on
@@ -164,6 +164,13 @@ func (r InMemoryCCIPReader) Nonces(
 	return nil, nil
 }
 
+func (r InMemoryCCIPReader) GetTokenPoolInboundRateLimits(
+	ctx context.Context,
+	destTokensByChain map[cciptypes.ChainSelector][]cciptypes.UnknownAddress,
+) (map[cciptypes.ChainSelector]map[string]reader.TokenPoolRateLimit, error) {
+	return nil, nil
+}
+
 func (r InMemoryCCIPReader) GetChainsFeeComponents(
 	ctx context.Context,
 	chains []cciptypes.ChainSelector,
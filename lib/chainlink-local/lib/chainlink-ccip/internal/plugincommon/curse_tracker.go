@@ -0,0 +1,284 @@
+package plugincommon
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// maxCurseRecords is the number of completed curse records kept by the CurseTracker.
+const maxCurseRecords = 100
+
+type CurseSubjectKind string
+
+const (
+	CurseSubjectGlobal      CurseSubjectKind = "global"
+	CurseSubjectDestination CurseSubjectKind = "destination"
+	CurseSubjectSourceChain CurseSubjectKind = "source"
+)
+
+// CurseSubject is what an RMN curse applies to. Chain is empty for global curses.
+type CurseSubject struct {
+	Kind  CurseSubjectKind        `json:"kind"`
+	Chain cciptypes.ChainSelector `json:"chain,omitempty"`
+}
+
+func (s CurseSubject) String() string {
+	if s.Kind == CurseSubjectGlobal {
+		return string(s.Kind)
+	}
+	return fmt.Sprintf("%s(%d)", s.Kind, s.Chain)
+}
+
+// affectsLane returns true if the curse blocks the messages from the given source chain.
+func (s CurseSubject) affectsLane(sourceChain cciptypes.ChainSelector) bool {
+	return s.Kind != CurseSubjectSourceChain || s.Chain == sourceChain
+}
+
+// CurseRecord describes a single curse of a subject and the backlog it caused.
+type CurseRecord struct {
+	Subject  CurseSubject `json:"subject"`
+	CursedAt time.Time    `json:"cursedAt"`
+	// UncursedAt is zero while the curse is active.
+	UncursedAt time.Time `json:"uncursedAt"`
+	// BacklogMessages is the number of messages of the affected lanes that were observed
+	// for execution while the backlog was drained after the curse lifted. Messages carry no
+	// send time and the ones sent during a curse are only committed after it lifts, so this
+	// approximates the messages which built up during the curse: messages sent during the
+	// drain period are counted too.
+	BacklogMessages int `json:"backlogMessages"`
+	// BacklogFeeValueJuels is the fee value of the backlog messages.
+	BacklogFeeValueJuels *big.Int `json:"backlogFeeValueJuels"`
+
+	seen map[cciptypes.ChainSelector]map[cciptypes.SeqNum]struct{}
+}
+
+// Duration returns how long the subject was cursed, or has been cursed so far if the curse is active.
+func (r CurseRecord) Duration(now time.Time) time.Duration {
+	if r.UncursedAt.IsZero() {
+		return now.Sub(r.CursedAt)
+	}
+	return r.UncursedAt.Sub(r.CursedAt)
+}
+
+func (r CurseRecord) copy() CurseRecord {
+	r.seen = nil
+	r.BacklogFeeValueJuels = new(big.Int).Set(r.BacklogFeeValueJuels)
+	return r
+}
+
+// CurseReporter tracks curse events.
+type CurseReporter interface {
+	// TrackCurse is called when a subject gets cursed or uncursed, duration is zero when it gets cursed.
+	TrackCurse(subject CurseSubject, cursed bool, duration time.Duration)
+	// TrackCurseBacklog is called once the backlog of a lifted curse has been drained.
+	TrackCurseBacklog(subject CurseSubject, messages int)
+}
+
+// CurseTracker follows the RMN curse info over time. It records when each subject got cursed and uncursed,
+// and after a curse lifts, it marks the affected lanes as draining for drainPeriod so that their backlog
+// can be prioritized and accounted for.
+//
+// The tracker state is local to the node, it must only be used for decisions which do not need to be
+// deterministic across the DON, e.g. the order in which messages are observed.
+//
+// All methods are safe to call on a nil tracker, which tracks nothing.
+type CurseTracker struct {
+	lggr        logger.Logger
+	reporter    CurseReporter
+	destChain   cciptypes.ChainSelector
+	drainPeriod time.Duration
+
+	mu       sync.Mutex
+	active   map[CurseSubject]*CurseRecord
+	draining []*CurseRecord
+	records  []CurseRecord
+}
+
+func NewCurseTracker(
+	lggr logger.Logger,
+	reporter CurseReporter,
+	destChain cciptypes.ChainSelector,
+	drainPeriod time.Duration,
+) *CurseTracker {
+	return &CurseTracker{
+		lggr:        lggr,
+		reporter:    reporter,
+		destChain:   destChain,
+		drainPeriod: drainPeriod,
+		active:      make(map[CurseSubject]*CurseRecord),
+	}
+}
+
+// Update compares the curse info with the previously seen one and records the curse events.
+func (t *CurseTracker) Update(curseInfo reader.CurseInfo, now time.Time) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	cursed := make(map[CurseSubject]struct{})
+	if curseInfo.GlobalCurse {
+		cursed[CurseSubject{Kind: CurseSubjectGlobal}] = struct{}{}
+	}
+	if curseInfo.CursedDestination {
+		cursed[CurseSubject{Kind: CurseSubjectDestination, Chain: t.destChain}] = struct{}{}
+	}
+	for chain, isCursed := range curseInfo.CursedSourceChains {
+		if isCursed {
+			cursed[CurseSubject{Kind: CurseSubjectSourceChain, Chain: chain}] = struct{}{}
+		}
+	}
+
+	for subject := range cursed {
+		if _, ok := t.active[subject]; ok {
+			continue
+		}
+		t.active[subject] = &CurseRecord{
+			Subject:              subject,
+			CursedAt:             now,
+			BacklogFeeValueJuels: big.NewInt(0),
+			seen:                 make(map[cciptypes.ChainSelector]map[cciptypes.SeqNum]struct{}),
+		}
+		t.lggr.Warnw("rmn curse started", "subject", subject.String(), "cursedAt", now)
+		t.reporter.TrackCurse(subject, true, 0)
+	}
+
+	for subject, record := range t.active {
+		if _, ok := cursed[subject]; ok {
+			continue
+		}
+		delete(t.active, subject)
+		record.UncursedAt = now
+		t.draining = append(t.draining, record)
+		t.lggr.Infow("rmn curse lifted, prioritizing backlog",
+			"subject", subject.String(),
+			"cursedAt", record.CursedAt,
+			"duration", record.Duration(now),
+			"drainPeriod", t.drainPeriod,
+		)
+		t.reporter.TrackCurse(subject, false, record.Duration(now))
+	}
+
+	t.completeDrained(now)
+}
+
+// completeDrained moves the records whose drain period is over to the completed records.
+func (t *CurseTracker) completeDrained(now time.Time) {
+	stillDraining := t.draining[:0]
+	for _, record := range t.draining {
+		if now.Sub(record.UncursedAt) < t.drainPeriod {
+			stillDraining = append(stillDraining, record)
+			continue
+		}
+
+		t.lggr.Infow("rmn curse backlog drained",
+			"subject", record.Subject.String(),
+			"cursedAt", record.CursedAt,
+			"uncursedAt", record.UncursedAt,
+			"backlogMessages", record.BacklogMessages,
+			"backlogFeeValueJuels", record.BacklogFeeValueJuels.String(),
+		)
+		t.reporter.TrackCurseBacklog(record.Subject, record.BacklogMessages)
+
+		t.records = append(t.records, record.copy())
+		if len(t.records) > maxCurseRecords {
+			t.records = slices.Clone(t.records[len(t.records)-maxCurseRecords:])
+		}
+	}
+	t.draining = stillDraining
+}
+
+// DrainingLanes returns the source chains, out of the provided ones, whose backlog is being drained
+// because a curse affecting them lifted recently.
+func (t *CurseTracker) DrainingLanes(
+	sourceChains []cciptypes.ChainSelector,
+	now time.Time,
+) map[cciptypes.ChainSelector]bool {
+	draining := make(map[cciptypes.ChainSelector]bool)
+	if t == nil {
+		return draining
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, chain := range sourceChains {
+		if len(t.drainingRecords(chain, now)) > 0 {
+			draining[chain] = true
+		}
+	}
+	return draining
+}
+
+// RecordBacklogMessage accounts the message to the backlog of each draining curse affecting its lane.
+// Messages which are observed multiple times are only accounted once.
+func (t *CurseTracker) RecordBacklogMessage(msg cciptypes.Message, now time.Time) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	chain := msg.Header.SourceChainSelector
+	seqNum := msg.Header.SequenceNumber
+	for _, record := range t.drainingRecords(chain, now) {
+		if _, ok := record.seen[chain]; !ok {
+			record.seen[chain] = make(map[cciptypes.SeqNum]struct{})
+		}
+		if _, ok := record.seen[chain][seqNum]; ok {
+			continue
+		}
+		record.seen[chain][seqNum] = struct{}{}
+		record.BacklogMessages++
+		if msg.FeeValueJuels.Int != nil {
+			record.BacklogFeeValueJuels.Add(record.BacklogFeeValueJuels, msg.FeeValueJuels.Int)
+		}
+	}
+}
+
+func (t *CurseTracker) drainingRecords(chain cciptypes.ChainSelector, now time.Time) []*CurseRecord {
+	var records []*CurseRecord
+	for _, record := range t.draining {
+		if record.Subject.affectsLane(chain) && now.Sub(record.UncursedAt) < t.drainPeriod {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// Records returns the curse records, active and draining curses first followed by the completed ones,
+// most recent first.
+func (t *CurseTracker) Records() []CurseRecord {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var pending []CurseRecord
+	for _, record := range t.active {
+		pending = append(pending, record.copy())
+	}
+	for _, record := range t.draining {
+		pending = append(pending, record.copy())
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		if pending[i].CursedAt.Equal(pending[j].CursedAt) {
+			return pending[i].Subject.String() < pending[j].Subject.String()
+		}
+		return pending[i].CursedAt.After(pending[j].CursedAt)
+	})
+
+	completed := slices.Clone(t.records)
+	slices.Reverse(completed)
+	return append(pending, completed...)
+}
@@ -0,0 +1,113 @@
+package plugincommon
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+type curseReporter struct {
+	cursed    map[CurseSubject]bool
+	durations map[CurseSubject]time.Duration
+	backlogs  map[CurseSubject]int
+}
+
+func (r *curseReporter) TrackCurse(subject CurseSubject, cursed bool, duration time.Duration) {
+	r.cursed[subject] = cursed
+	r.durations[subject] = duration
+}
+
+func (r *curseReporter) TrackCurseBacklog(subject CurseSubject, messages int) {
+	r.backlogs[subject] = messages
+}
+
+func backlogMsg(chain cciptypes.ChainSelector, seqNum cciptypes.SeqNum, fee int64) cciptypes.Message {
+	return cciptypes.Message{
+		Header:        cciptypes.RampMessageHeader{SourceChainSelector: chain, SequenceNumber: seqNum},
+		FeeValueJuels: cciptypes.NewBigIntFromInt64(fee),
+	}
+}
+
+func TestCurseTracker(t *testing.T) {
+	const (
+		destChain = cciptypes.ChainSelector(1)
+		chainA    = cciptypes.ChainSelector(2)
+		chainB    = cciptypes.ChainSelector(3)
+	)
+	drainPeriod := 10 * time.Minute
+	reporter := &curseReporter{
+		cursed:    make(map[CurseSubject]bool),
+		durations: make(map[CurseSubject]time.Duration),
+		backlogs:  make(map[CurseSubject]int),
+	}
+	tracker := NewCurseTracker(logger.Test(t), reporter, destChain, drainPeriod)
+	sourceA := CurseSubject{Kind: CurseSubjectSourceChain, Chain: chainA}
+
+	start := time.Unix(1000, 0)
+	tracker.Update(reader.CurseInfo{CursedSourceChains: map[cciptypes.ChainSelector]bool{chainA: true}}, start)
+	require.True(t, reporter.cursed[sourceA])
+	require.Empty(t, tracker.DrainingLanes([]cciptypes.ChainSelector{chainA, chainB}, start))
+
+	// the curse stays active, it isn't recorded twice.
+	tracker.Update(reader.CurseInfo{CursedSourceChains: map[cciptypes.ChainSelector]bool{chainA: true}},
+		start.Add(time.Minute))
+	require.Len(t, tracker.Records(), 1)
+
+	lifted := start.Add(time.Hour)
+	tracker.Update(reader.CurseInfo{CursedSourceChains: map[cciptypes.ChainSelector]bool{chainA: false}}, lifted)
+	require.False(t, reporter.cursed[sourceA])
+	require.Equal(t, time.Hour, reporter.durations[sourceA])
+	require.Equal(t, map[cciptypes.ChainSelector]bool{chainA: true},
+		tracker.DrainingLanes([]cciptypes.ChainSelector{chainA, chainB}, lifted))
+
+	tracker.RecordBacklogMessage(backlogMsg(chainA, 1, 10), lifted)
+	tracker.RecordBacklogMessage(backlogMsg(chainA, 1, 10), lifted) // seen already
+	tracker.RecordBacklogMessage(backlogMsg(chainA, 2, 5), lifted)
+	tracker.RecordBacklogMessage(backlogMsg(chainB, 1, 100), lifted) // not affected
+
+	records := tracker.Records()
+	require.Len(t, records, 1)
+	require.Equal(t, 2, records[0].BacklogMessages)
+	require.Equal(t, big.NewInt(15), records[0].BacklogFeeValueJuels)
+
+	drained := lifted.Add(drainPeriod)
+	require.Empty(t, tracker.DrainingLanes([]cciptypes.ChainSelector{chainA}, drained))
+	tracker.Update(reader.CurseInfo{}, drained)
+	require.Equal(t, 2, reporter.backlogs[sourceA])
+
+	records = tracker.Records()
+	require.Len(t, records, 1)
+	require.Equal(t, start, records[0].CursedAt)
+	require.Equal(t, lifted, records[0].UncursedAt)
+}
+
+func TestCurseTracker_GlobalCurseAffectsAllLanes(t *testing.T) {
+	reporter := &curseReporter{
+		cursed:    make(map[CurseSubject]bool),
+		durations: make(map[CurseSubject]time.Duration),
+		backlogs:  make(map[CurseSubject]int),
+	}
+	tracker := NewCurseTracker(logger.Test(t), reporter, 1, time.Minute)
+
+	now := time.Unix(1000, 0)
+	tracker.Update(reader.CurseInfo{GlobalCurse: true, CursedDestination: true}, now)
+	require.Len(t, tracker.Records(), 2)
+
+	tracker.Update(reader.CurseInfo{}, now.Add(time.Second))
+	require.Len(t, tracker.DrainingLanes([]cciptypes.ChainSelector{2, 3}, now.Add(time.Second)), 2)
+}
+
+func TestCurseTracker_Nil(t *testing.T) {
+	var tracker *CurseTracker
+	tracker.Update(reader.CurseInfo{GlobalCurse: true}, time.Now())
+	tracker.RecordBacklogMessage(cciptypes.Message{}, time.Now())
+	require.Empty(t, tracker.DrainingLanes([]cciptypes.ChainSelector{1}, time.Now()))
+	require.Empty(t, tracker.Records())
+}
@@ -0,0 +1,31 @@
+package plugincommon
+
+import (
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// nearMaxObservationSizeRatio is the fraction of the max observation length above which an observation is
+// considered close to the OCR limit.
+const nearMaxObservationSizeRatio = 0.8
+
+// ObservationSizeReporter tracks the encoded size of the observations of a plugin.
+type ObservationSizeReporter interface {
+	TrackObservationSize(sizeBytes, maxSizeBytes int)
+}
+
+// IsNearMaxObservationSize returns true when an observation of sizeBytes is close to maxSizeBytes.
+func IsNearMaxObservationSize(sizeBytes, maxSizeBytes int) bool {
+	return float64(sizeBytes) >= nearMaxObservationSizeRatio*float64(maxSizeBytes)
+}
+
+// TrackObservationSize reports the encoded size of an observation and warns when it's close to the max
+// observation length, observations exceeding it are dropped by OCR.
+func TrackObservationSize(lggr logger.Logger, reporter ObservationSizeReporter, sizeBytes, maxSizeBytes int) {
+	reporter.TrackObservationSize(sizeBytes, maxSizeBytes)
+	if IsNearMaxObservationSize(sizeBytes, maxSizeBytes) {
+		lggr.Warnw("observation size is close to the max observation length",
+			"sizeBytes", sizeBytes,
+			"maxSizeBytes", maxSizeBytes,
+		)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
 type MethodType = string
@@ -20,17 +21,30 @@ const (
 type MetricsReporter interface {
 	TrackProcessorOutput(processor string, method MethodType, obs plugintypes.Trackable)
 	TrackProcessorLatency(processor string, method string, latency time.Duration, err error)
+	// TrackProcessorObservationSize tracks the encoded size of the whole observation of a processor.
+	TrackProcessorObservationSize(processor string, sizeBytes int)
+	// TrackProcessorChainObservationSize tracks the encoded size of the data of a source chain in the observation
+	// of a processor.
+	TrackProcessorChainObservationSize(processor string, chain cciptypes.ChainSelector, sizeBytes int)
+	// TrackProcessorChainObservationLatency tracks the time spent observing the data of a source chain.
+	TrackProcessorChainObservationLatency(processor string, chain cciptypes.ChainSelector, latency time.Duration)
 }
 
+// ObservationSizer returns the encoded size of an observation and the encoded size of the data of each chain
+// in the observation.
+type ObservationSizer[Observation any] func(obs Observation) (int, map[cciptypes.ChainSelector]int)
+
 // TrackedProcessor wraps a PluginProcessor and tracks
 // * latencies of most of the perf critical methods (Query, Observation, Outcome)
 // * observations and outcomes (and their stats) of the processor
 // * errors in the tracked methods
+// * encoded sizes of the observations, total and per chain, when an ObservationSizer is set
 type TrackedProcessor[Query any, Observation plugintypes.Trackable, Outcome plugintypes.Trackable] struct {
 	PluginProcessor[Query, Observation, Outcome]
 	lggr          logger.Logger
 	processorName string
 	reporter      MetricsReporter
+	sizer         ObservationSizer[Observation]
 }
 
 func NewTrackedProcessor[Query any, Observation plugintypes.Trackable, Outcome plugintypes.Trackable](
@@ -47,6 +61,18 @@ func NewTrackedProcessor[Query any, Observation plugintypes.Trackable, Outcome p
 	}
 }
 
+// WithObservationSizer sets the sizer used to track the encoded size of the observations of p, when p is a
+// TrackedProcessor. The sizer is injected by the plugins since the processors don't depend on the codec.
+func WithObservationSizer[Query any, Observation plugintypes.Trackable, Outcome plugintypes.Trackable](
+	p PluginProcessor[Query, Observation, Outcome],
+	sizer ObservationSizer[Observation],
+) PluginProcessor[Query, Observation, Outcome] {
+	if tracked, ok := p.(*TrackedProcessor[Query, Observation, Outcome]); ok {
+		tracked.sizer = sizer
+	}
+	return p
+}
+
 func (p *TrackedProcessor[Query, Observation, Outcome]) Query(ctx context.Context, prev Outcome) (Query, error) {
 	return withTrackedMethod[Query](p, QueryMethod, func() (Query, error) {
 		return p.PluginProcessor.Query(ctx, prev)
@@ -63,10 +89,28 @@ func (p *TrackedProcessor[Query, Observation, Outcome]) Observation(
 	})
 	if err == nil {
 		p.reporter.TrackProcessorOutput(p.processorName, ObservationMethod, obs)
+		p.trackObservationSize(obs)
 	}
 	return obs, err
 }
 
+func (p *TrackedProcessor[Query, Observation, Outcome]) trackObservationSize(obs Observation) {
+	if p.sizer == nil {
+		return
+	}
+
+	total, perChain := p.sizer(obs)
+	p.reporter.TrackProcessorObservationSize(p.processorName, total)
+	for chain, size := range perChain {
+		p.reporter.TrackProcessorChainObservationSize(p.processorName, chain, size)
+	}
+	p.lggr.Debugw("tracking processor observation size",
+		"processor", p.processorName,
+		"sizeBytes", total,
+		"chainSizesBytes", perChain,
+	)
+}
+
 func (p *TrackedProcessor[Query, Observation, Outcome]) Outcome(
 	ctx context.Context,
 	prev Outcome,
@@ -106,3 +150,10 @@ type NoopReporter struct{}
 func (n NoopReporter) TrackProcessorLatency(string, string, time.Duration, error) {}
 
 func (n NoopReporter) TrackProcessorOutput(string, MethodType, plugintypes.Trackable) {}
+
+func (n NoopReporter) TrackProcessorObservationSize(string, int) {}
+
+func (n NoopReporter) TrackProcessorChainObservationSize(string, cciptypes.ChainSelector, int) {}
+
+func (n NoopReporter) TrackProcessorChainObservationLatency(string, cciptypes.ChainSelector, time.Duration) {
+}
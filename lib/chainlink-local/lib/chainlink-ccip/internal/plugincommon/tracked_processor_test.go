@@ -0,0 +1,85 @@
+package plugincommon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+type sizedObs map[cciptypes.ChainSelector]int
+
+func (o sizedObs) Stats() map[string]int { return nil }
+
+type sizedObsProcessor struct {
+	PluginProcessor[struct{}, sizedObs, sizedObs]
+	obs sizedObs
+}
+
+func (p sizedObsProcessor) Observation(context.Context, sizedObs, struct{}) (sizedObs, error) {
+	return p.obs, nil
+}
+
+type sizeReporter struct {
+	NoopReporter
+	processorSize int
+	sizes         map[cciptypes.ChainSelector]int
+	size          int
+}
+
+func (r *sizeReporter) TrackProcessorObservationSize(_ string, sizeBytes int) {
+	r.processorSize = sizeBytes
+}
+
+func (r *sizeReporter) TrackProcessorChainObservationSize(_ string, chain cciptypes.ChainSelector, sizeBytes int) {
+	r.sizes[chain] = sizeBytes
+}
+
+func (r *sizeReporter) TrackObservationSize(sizeBytes, _ int) {
+	r.size = sizeBytes
+}
+
+func TestTrackedProcessor_ObservationSize(t *testing.T) {
+	obs := sizedObs{1: 10, 2: 20}
+	sizer := func(obs sizedObs) (int, map[cciptypes.ChainSelector]int) {
+		total := 0
+		for _, size := range obs {
+			total += size
+		}
+		return total, obs
+	}
+
+	reporter := &sizeReporter{sizes: make(map[cciptypes.ChainSelector]int)}
+	p := NewTrackedProcessor[struct{}, sizedObs, sizedObs](
+		logger.Test(t), sizedObsProcessor{obs: obs}, "test", reporter)
+
+	// sizes aren't tracked without a sizer.
+	_, err := p.Observation(context.Background(), nil, struct{}{})
+	require.NoError(t, err)
+	require.Empty(t, reporter.sizes)
+	require.Zero(t, reporter.processorSize)
+
+	tracked := WithObservationSizer[struct{}, sizedObs, sizedObs](p, sizer)
+	_, err = tracked.Observation(context.Background(), nil, struct{}{})
+	require.NoError(t, err)
+	require.Equal(t, 30, reporter.processorSize)
+	require.Equal(t, map[cciptypes.ChainSelector]int{1: 10, 2: 20}, reporter.sizes)
+
+	// processors which aren't tracked are returned as is.
+	untracked := sizedObsProcessor{obs: obs}
+	require.Equal(t, untracked, WithObservationSizer[struct{}, sizedObs, sizedObs](untracked, sizer))
+}
+
+func TestTrackObservationSize(t *testing.T) {
+	reporter := &sizeReporter{}
+	TrackObservationSize(logger.Test(t), reporter, 900, 1000)
+	require.Equal(t, 900, reporter.size)
+
+	require.False(t, IsNearMaxObservationSize(799, 1000))
+	require.True(t, IsNearMaxObservationSize(800, 1000))
+	require.True(t, IsNearMaxObservationSize(1200, 1000))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -64,6 +65,17 @@ type homeChainPoller struct {
 	ccipConfigBoundContract types.BoundContract
 	// How frequently the poller fetches the chain configs
 	pollingDuration time.Duration
+	// history of config changes, guarded by mutex
+	history homeChainConfigHistory
+	// whether the chain configs were set at least once, guarded by mutex
+	chainConfigsSeeded bool
+	// latest OCR configs seen by GetOCRConfigs, guarded by mutex
+	ocrConfigs map[ocrConfigKey]ActiveAndCandidate
+}
+
+type ocrConfigKey struct {
+	donID      uint32
+	pluginType uint8
 }
 
 const MaxFailedPolls = 10
@@ -83,6 +95,7 @@ func NewHomeChainConfigPoller(
 		lggr:                    lggr,
 		pollingDuration:         pollingInterval,
 		ccipConfigBoundContract: ccipConfigBoundContract,
+		ocrConfigs:              make(map[ocrConfigKey]ActiveAndCandidate),
 	}
 }
 
@@ -174,6 +187,11 @@ func (r *homeChainPoller) setState(chainConfigs map[cciptypes.ChainSelector]Chai
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 	s := &r.state
+	if r.chainConfigsSeeded {
+		r.history.record(r.lggr, time.Now().UTC(), chainConfigs, DiffChainConfigs(s.chainConfigs, chainConfigs))
+	}
+	// the first fetch is the baseline, the chains it contains were not added by a config change.
+	r.chainConfigsSeeded = true
 	s.chainConfigs = chainConfigs
 	s.nodeSupportedChains = createNodesSupportedChains(chainConfigs)
 	s.knownSourceChains = createKnownChains(chainConfigs)
@@ -250,10 +268,30 @@ func (r *homeChainPoller) GetOCRConfigs(
 		"activeConfig", activeAndCandidate.ActiveConfig,
 		"candidateConfig", activeAndCandidate.CandidateConfig,
 	)
+	r.recordOCRConfigs(ocrConfigKey{donID: donID, pluginType: pluginType}, activeAndCandidate)
 
 	return activeAndCandidate, nil
 }
 
+func (r *homeChainPoller) recordOCRConfigs(key ocrConfigKey, configs ActiveAndCandidate) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	prev, ok := r.ocrConfigs[key]
+	r.ocrConfigs[key] = configs
+	if !ok {
+		// the first fetch is the baseline, there is nothing to compare against.
+		return
+	}
+	r.history.record(r.lggr, time.Now().UTC(), r.state.chainConfigs, diffOCRConfigs(prev, configs))
+}
+
+// ConfigHistory returns the recorded home chain config snapshots, oldest first.
+func (r *homeChainPoller) ConfigHistory() []HomeChainConfigSnapshot {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return slices.Clone(r.history.snapshots)
+}
+
 func (r *homeChainPoller) Close() error {
 	err := r.sync.StopOnce(r.Name(), func() error {
 		defer r.wg.Wait()
@@ -440,3 +478,4 @@ type ActiveAndCandidate struct {
 }
 
 var _ HomeChain = (*homeChainPoller)(nil)
+var _ HomeChainConfigHistory = (*homeChainPoller)(nil)
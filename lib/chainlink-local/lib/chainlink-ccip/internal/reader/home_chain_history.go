@@ -0,0 +1,254 @@
+package reader
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+
+	libocrtypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/chainconfig"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// maxHomeChainConfigHistory is the number of config snapshots kept by the home chain poller.
+const maxHomeChainConfigHistory = 100
+
+// HomeChainConfigChangedMsg is the message logged for every home chain config change.
+// Log analysis tools rely on it to find the change events.
+const HomeChainConfigChangedMsg = "home chain config changed"
+
+type HomeChainConfigChangeType string
+
+const (
+	ChainAdded                       HomeChainConfigChangeType = "chainAdded"
+	ChainRemoved                     HomeChainConfigChangeType = "chainRemoved"
+	FChainChanged                    HomeChainConfigChangeType = "fChainChanged"
+	ReadersAdded                     HomeChainConfigChangeType = "readersAdded"
+	ReadersRemoved                   HomeChainConfigChangeType = "readersRemoved"
+	GasPriceDeviationChanged         HomeChainConfigChangeType = "gasPriceDeviationChanged"
+	DAGasPriceDeviationChanged       HomeChainConfigChangeType = "daGasPriceDeviationChanged"
+	OptimisticConfirmationsChanged   HomeChainConfigChangeType = "optimisticConfirmationsChanged"
+	ChainFeeDeviationDisabledChanged HomeChainConfigChangeType = "chainFeeDeviationDisabledChanged"
+	OCRActiveConfigChanged           HomeChainConfigChangeType = "ocrActiveConfigChanged"
+	OCRCandidateConfigChanged        HomeChainConfigChangeType = "ocrCandidateConfigChanged"
+)
+
+// HomeChainConfigChange describes a single difference between two home chain configs.
+// Old and New are human-readable representations of the changed value, for readers
+// they contain the peer IDs that were added or removed.
+type HomeChainConfigChange struct {
+	Chain cciptypes.ChainSelector   `json:"chain"`
+	Type  HomeChainConfigChangeType `json:"type"`
+	Old   string                    `json:"old,omitempty"`
+	New   string                    `json:"new,omitempty"`
+}
+
+// HomeChainConfigSnapshot is the home chain config as observed at Timestamp together with the
+// changes since the previous snapshot.
+type HomeChainConfigSnapshot struct {
+	Timestamp time.Time `json:"timestamp"`
+	// Digest is the hash of the canonical encoding of ChainConfigs.
+	Digest       cciptypes.Bytes32                       `json:"digest"`
+	ChainConfigs map[cciptypes.ChainSelector]ChainConfig `json:"chainConfigs"`
+	Changes      []HomeChainConfigChange                 `json:"changes"`
+}
+
+// HomeChainConfigHistory is implemented by home chain readers that keep track of config changes.
+type HomeChainConfigHistory interface {
+	// ConfigHistory returns the recorded config snapshots, oldest first.
+	ConfigHistory() []HomeChainConfigSnapshot
+}
+
+// DiffChainConfigs returns the changes needed to go from the old to the new chain configs,
+// sorted by chain selector.
+func DiffChainConfigs(
+	oldConfigs, newConfigs map[cciptypes.ChainSelector]ChainConfig,
+) []HomeChainConfigChange {
+	chains := mapset.NewSet[cciptypes.ChainSelector]()
+	for chain := range oldConfigs {
+		chains.Add(chain)
+	}
+	for chain := range newConfigs {
+		chains.Add(chain)
+	}
+	sortedChains := chains.ToSlice()
+	slices.Sort(sortedChains)
+
+	var changes []HomeChainConfigChange
+	for _, chain := range sortedChains {
+		oldCfg, hadOld := oldConfigs[chain]
+		newCfg, hasNew := newConfigs[chain]
+		switch {
+		case !hadOld:
+			changes = append(changes, HomeChainConfigChange{
+				Chain: chain, Type: ChainAdded, New: strconv.Itoa(newCfg.FChain),
+			})
+		case !hasNew:
+			changes = append(changes, HomeChainConfigChange{
+				Chain: chain, Type: ChainRemoved, Old: strconv.Itoa(oldCfg.FChain),
+			})
+		default:
+			changes = append(changes, diffChainConfig(chain, oldCfg, newCfg)...)
+		}
+	}
+	return changes
+}
+
+func diffChainConfig(chain cciptypes.ChainSelector, oldCfg, newCfg ChainConfig) []HomeChainConfigChange {
+	var changes []HomeChainConfigChange
+	add := func(typ HomeChainConfigChangeType, oldVal, newVal string) {
+		if oldVal != newVal {
+			changes = append(changes, HomeChainConfigChange{Chain: chain, Type: typ, Old: oldVal, New: newVal})
+		}
+	}
+
+	add(FChainChanged, strconv.Itoa(oldCfg.FChain), strconv.Itoa(newCfg.FChain))
+
+	oldReaders := peerIDSet(oldCfg.SupportedNodes)
+	newReaders := peerIDSet(newCfg.SupportedNodes)
+	if added := newReaders.Difference(oldReaders); added.Cardinality() > 0 {
+		changes = append(changes, HomeChainConfigChange{Chain: chain, Type: ReadersAdded, New: joinPeerIDs(added)})
+	}
+	if removed := oldReaders.Difference(newReaders); removed.Cardinality() > 0 {
+		changes = append(changes, HomeChainConfigChange{Chain: chain, Type: ReadersRemoved, Old: joinPeerIDs(removed)})
+	}
+
+	add(GasPriceDeviationChanged,
+		bigIntString(oldCfg.Config.GasPriceDeviationPPB.Int), bigIntString(newCfg.Config.GasPriceDeviationPPB.Int))
+	add(DAGasPriceDeviationChanged,
+		bigIntString(oldCfg.Config.DAGasPriceDeviationPPB.Int), bigIntString(newCfg.Config.DAGasPriceDeviationPPB.Int))
+	add(OptimisticConfirmationsChanged,
+		strconv.FormatUint(uint64(oldCfg.Config.OptimisticConfirmations), 10),
+		strconv.FormatUint(uint64(newCfg.Config.OptimisticConfirmations), 10))
+	add(ChainFeeDeviationDisabledChanged,
+		strconv.FormatBool(oldCfg.Config.ChainFeeDeviationDisabled),
+		strconv.FormatBool(newCfg.Config.ChainFeeDeviationDisabled))
+
+	return changes
+}
+
+// diffOCRConfigs returns the changes between two OCR configs of the same DON and plugin type.
+func diffOCRConfigs(oldCfgs, newCfgs ActiveAndCandidate) []HomeChainConfigChange {
+	var changes []HomeChainConfigChange
+	if oldCfgs.ActiveConfig.ConfigDigest != newCfgs.ActiveConfig.ConfigDigest {
+		changes = append(changes, HomeChainConfigChange{
+			Chain: newCfgs.ActiveConfig.Config.ChainSelector,
+			Type:  OCRActiveConfigChanged,
+			Old:   hex.EncodeToString(oldCfgs.ActiveConfig.ConfigDigest[:]),
+			New:   hex.EncodeToString(newCfgs.ActiveConfig.ConfigDigest[:]),
+		})
+	}
+	if oldCfgs.CandidateConfig.ConfigDigest != newCfgs.CandidateConfig.ConfigDigest {
+		changes = append(changes, HomeChainConfigChange{
+			Chain: newCfgs.CandidateConfig.Config.ChainSelector,
+			Type:  OCRCandidateConfigChanged,
+			Old:   hex.EncodeToString(oldCfgs.CandidateConfig.ConfigDigest[:]),
+			New:   hex.EncodeToString(newCfgs.CandidateConfig.ConfigDigest[:]),
+		})
+	}
+	return changes
+}
+
+// chainConfigsDigest hashes a canonical encoding of the chain configs, i.e. chains and readers are sorted.
+func chainConfigsDigest(chainConfigs map[cciptypes.ChainSelector]ChainConfig) (cciptypes.Bytes32, error) {
+	type canonicalChainConfig struct {
+		Chain   cciptypes.ChainSelector `json:"chain"`
+		FChain  int                     `json:"fChain"`
+		Readers []string                `json:"readers"`
+		Config  chainconfig.ChainConfig `json:"config"`
+	}
+
+	canonical := make([]canonicalChainConfig, 0, len(chainConfigs))
+	for chain, cfg := range chainConfigs {
+		readers := peerIDSet(cfg.SupportedNodes).ToSlice()
+		sort.Strings(readers)
+		canonical = append(canonical, canonicalChainConfig{
+			Chain: chain, FChain: cfg.FChain, Readers: readers, Config: cfg.Config,
+		})
+	}
+	sort.Slice(canonical, func(i, j int) bool { return canonical[i].Chain < canonical[j].Chain })
+
+	b, err := json.Marshal(canonical)
+	if err != nil {
+		return cciptypes.Bytes32{}, fmt.Errorf("marshal chain configs: %w", err)
+	}
+	return sha256.Sum256(b), nil
+}
+
+// homeChainConfigHistory is a bounded list of config snapshots, it is not thread-safe.
+type homeChainConfigHistory struct {
+	snapshots []HomeChainConfigSnapshot
+}
+
+// record appends a snapshot if there are changes and logs each change.
+func (h *homeChainConfigHistory) record(
+	lggr logger.Logger,
+	ts time.Time,
+	chainConfigs map[cciptypes.ChainSelector]ChainConfig,
+	changes []HomeChainConfigChange,
+) {
+	if len(changes) == 0 {
+		return
+	}
+
+	digest, err := chainConfigsDigest(chainConfigs)
+	if err != nil {
+		lggr.Warnw("failed to compute home chain config digest", "err", err)
+	}
+
+	for _, change := range changes {
+		lggr.Infow(HomeChainConfigChangedMsg,
+			"chain", change.Chain,
+			"changeType", change.Type,
+			"old", change.Old,
+			"new", change.New,
+			"digest", digest.String(),
+		)
+	}
+
+	h.snapshots = append(h.snapshots, HomeChainConfigSnapshot{
+		Timestamp:    ts,
+		Digest:       digest,
+		ChainConfigs: chainConfigs,
+		Changes:      changes,
+	})
+	if len(h.snapshots) > maxHomeChainConfigHistory {
+		h.snapshots = slices.Clone(h.snapshots[len(h.snapshots)-maxHomeChainConfigHistory:])
+	}
+}
+
+func peerIDSet(peers mapset.Set[libocrtypes.PeerID]) mapset.Set[string] {
+	res := mapset.NewSet[string]()
+	if peers == nil {
+		return res
+	}
+	for _, p := range peers.ToSlice() {
+		res.Add(p.String())
+	}
+	return res
+}
+
+func joinPeerIDs(peers mapset.Set[string]) string {
+	s := peers.ToSlice()
+	sort.Strings(s)
+	return strings.Join(s, ",")
+}
+
+func bigIntString(i *big.Int) string {
+	if i == nil {
+		return ""
+	}
+	return i.String()
+}
@@ -0,0 +1,116 @@
+package reader
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/stretchr/testify/require"
+
+	libocrtypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/chainconfig"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func testChainConfig(fChain int, gasDeviation int64, readers ...libocrtypes.PeerID) ChainConfig {
+	return ChainConfig{
+		FChain:         fChain,
+		SupportedNodes: mapset.NewSet(readers...),
+		Config: chainconfig.ChainConfig{
+			GasPriceDeviationPPB:    cciptypes.NewBigIntFromInt64(gasDeviation),
+			OptimisticConfirmations: 1,
+		},
+	}
+}
+
+func TestDiffChainConfigs(t *testing.T) {
+	oldCfgs := map[cciptypes.ChainSelector]ChainConfig{
+		chainA: testChainConfig(1, 100, p2pOracleAId, p2pOracleBId),
+		chainB: testChainConfig(1, 100, p2pOracleAId),
+	}
+	newCfgs := map[cciptypes.ChainSelector]ChainConfig{
+		chainA: testChainConfig(2, 200, p2pOracleBId, p2pOracleCId),
+		chainC: testChainConfig(1, 100, p2pOracleAId),
+	}
+
+	require.Empty(t, DiffChainConfigs(oldCfgs, oldCfgs))
+	require.Equal(t, []HomeChainConfigChange{
+		{Chain: chainA, Type: FChainChanged, Old: "1", New: "2"},
+		{Chain: chainA, Type: ReadersAdded, New: p2pOracleCId.String()},
+		{Chain: chainA, Type: ReadersRemoved, Old: p2pOracleAId.String()},
+		{Chain: chainA, Type: GasPriceDeviationChanged, Old: "100", New: "200"},
+		{Chain: chainB, Type: ChainRemoved, Old: "1"},
+		{Chain: chainC, Type: ChainAdded, New: "1"},
+	}, DiffChainConfigs(oldCfgs, newCfgs))
+}
+
+func Test_chainConfigsDigest(t *testing.T) {
+	cfgs := map[cciptypes.ChainSelector]ChainConfig{
+		chainA: testChainConfig(1, 100, p2pOracleAId, p2pOracleBId, p2pOracleCId),
+		chainB: testChainConfig(1, 100, p2pOracleAId),
+	}
+	digest, err := chainConfigsDigest(cfgs)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		// map and set iteration order must not affect the digest.
+		d, err := chainConfigsDigest(cfgs)
+		require.NoError(t, err)
+		require.Equal(t, digest, d)
+	}
+
+	cfgs[chainB] = testChainConfig(2, 100, p2pOracleAId)
+	changed, err := chainConfigsDigest(cfgs)
+	require.NoError(t, err)
+	require.NotEqual(t, digest, changed)
+}
+
+func TestHomeChainPoller_ConfigHistory(t *testing.T) {
+	poller := &homeChainPoller{
+		lggr:       logger.Test(t),
+		mutex:      &sync.RWMutex{},
+		ocrConfigs: make(map[ocrConfigKey]ActiveAndCandidate),
+	}
+
+	cfgs := map[cciptypes.ChainSelector]ChainConfig{chainA: testChainConfig(1, 100, p2pOracleAId)}
+	poller.setState(cfgs)
+	require.Empty(t, poller.ConfigHistory(), "the first state is the baseline, chains must not be reported as added")
+	poller.setState(cfgs)
+	require.Empty(t, poller.ConfigHistory())
+
+	poller.setState(map[cciptypes.ChainSelector]ChainConfig{
+		chainA: testChainConfig(2, 100, p2pOracleAId),
+		chainB: testChainConfig(1, 100, p2pOracleAId),
+	})
+	history := poller.ConfigHistory()
+	require.Len(t, history, 1)
+	require.Equal(t, []HomeChainConfigChange{
+		{Chain: chainA, Type: FChainChanged, Old: "1", New: "2"},
+		{Chain: chainB, Type: ChainAdded, New: "1"},
+	}, history[0].Changes)
+
+	key := ocrConfigKey{donID: 1, pluginType: 0}
+	active := ActiveAndCandidate{ActiveConfig: OCR3ConfigWithMeta{ConfigDigest: [32]byte{1}}}
+	poller.recordOCRConfigs(key, active)
+	poller.recordOCRConfigs(key, active)
+	require.Len(t, poller.ConfigHistory(), 1)
+
+	poller.recordOCRConfigs(key, ActiveAndCandidate{ActiveConfig: OCR3ConfigWithMeta{ConfigDigest: [32]byte{2}}})
+	history = poller.ConfigHistory()
+	require.Len(t, history, 2)
+	require.Equal(t, OCRActiveConfigChanged, history[1].Changes[0].Type)
+	require.Equal(t, history[0].Digest, history[1].Digest)
+}
+
+func Test_homeChainConfigHistory_bounded(t *testing.T) {
+	var h homeChainConfigHistory
+	lggr := logger.Test(t)
+	for i := 0; i < maxHomeChainConfigHistory+10; i++ {
+		h.record(lggr, time.Unix(int64(i), 0), nil, []HomeChainConfigChange{{Type: FChainChanged}})
+	}
+	require.Len(t, h.snapshots, maxHomeChainConfigHistory)
+	require.Equal(t, time.Unix(10, 0), h.snapshots[0].Timestamp)
+}
@@ -812,6 +812,65 @@ func (_c *MockCCIPReader_GetRmnCurseInfo_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// GetTokenPoolInboundRateLimits provides a mock function with given fields: ctx, destTokensByChain
+func (_m *MockCCIPReader) GetTokenPoolInboundRateLimits(ctx context.Context, destTokensByChain map[ccipocr3.ChainSelector][]ccipocr3.UnknownAddress) (map[ccipocr3.ChainSelector]map[string]reader.TokenPoolRateLimit, error) {
+	ret := _m.Called(ctx, destTokensByChain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenPoolInboundRateLimits")
+	}
+
+	var r0 map[ccipocr3.ChainSelector]map[string]reader.TokenPoolRateLimit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[ccipocr3.ChainSelector][]ccipocr3.UnknownAddress) (map[ccipocr3.ChainSelector]map[string]reader.TokenPoolRateLimit, error)); ok {
+		return rf(ctx, destTokensByChain)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, map[ccipocr3.ChainSelector][]ccipocr3.UnknownAddress) map[ccipocr3.ChainSelector]map[string]reader.TokenPoolRateLimit); ok {
+		r0 = rf(ctx, destTokensByChain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[ccipocr3.ChainSelector]map[string]reader.TokenPoolRateLimit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, map[ccipocr3.ChainSelector][]ccipocr3.UnknownAddress) error); ok {
+		r1 = rf(ctx, destTokensByChain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCCIPReader_GetTokenPoolInboundRateLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokenPoolInboundRateLimits'
+type MockCCIPReader_GetTokenPoolInboundRateLimits_Call struct {
+	*mock.Call
+}
+
+// GetTokenPoolInboundRateLimits is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destTokensByChain map[ccipocr3.ChainSelector][]ccipocr3.UnknownAddress
+func (_e *MockCCIPReader_Expecter) GetTokenPoolInboundRateLimits(ctx interface{}, destTokensByChain interface{}) *MockCCIPReader_GetTokenPoolInboundRateLimits_Call {
+	return &MockCCIPReader_GetTokenPoolInboundRateLimits_Call{Call: _e.mock.On("GetTokenPoolInboundRateLimits", ctx, destTokensByChain)}
+}
+
+func (_c *MockCCIPReader_GetTokenPoolInboundRateLimits_Call) Run(run func(ctx context.Context, destTokensByChain map[ccipocr3.ChainSelector][]ccipocr3.UnknownAddress)) *MockCCIPReader_GetTokenPoolInboundRateLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[ccipocr3.ChainSelector][]ccipocr3.UnknownAddress))
+	})
+	return _c
+}
+
+func (_c *MockCCIPReader_GetTokenPoolInboundRateLimits_Call) Return(_a0 map[ccipocr3.ChainSelector]map[string]reader.TokenPoolRateLimit, _a1 error) *MockCCIPReader_GetTokenPoolInboundRateLimits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCCIPReader_GetTokenPoolInboundRateLimits_Call) RunAndReturn(run func(context.Context, map[ccipocr3.ChainSelector][]ccipocr3.UnknownAddress) (map[ccipocr3.ChainSelector]map[string]reader.TokenPoolRateLimit, error)) *MockCCIPReader_GetTokenPoolInboundRateLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWrappedNativeTokenPriceUSD provides a mock function with given fields: ctx, selectors
 func (_m *MockCCIPReader) GetWrappedNativeTokenPriceUSD(ctx context.Context, selectors []ccipocr3.ChainSelector) map[ccipocr3.ChainSelector]ccipocr3.BigInt {
 	ret := _m.Called(ctx, selectors)
@@ -10,12 +10,15 @@ const (
 	ContractNameCapabilitiesRegistry   = "CapabilitiesRegistry"
 	ContractNameCCIPConfig             = "CCIPHome"
 	ContractNamePriceAggregator        = "AggregatorV3Interface"
+	ContractNameTokenRateGetter        = "TokenRateGetter"
 	ContractNameNonceManager           = "NonceManager"
 	ContractNameRMNHome                = "RMNHome"
 	ContractNameRMNRemote              = "RMNRemote"
 	ContractNameRMNProxy               = "RMNProxy"
 	ContractNameRouter                 = "Router"
 	ContractNameCCTPMessageTransmitter = "MessageTransmitter"
+	ContractNameTokenAdminRegistry     = "TokenAdminRegistry"
+	ContractNameTokenPool              = "TokenPool"
 )
 
 // Method Names
@@ -56,6 +59,9 @@ const (
 	MethodNameGetLatestRoundData = "latestRoundData"
 	MethodNameGetDecimals        = "decimals"
 
+	// Token rate getter methods
+	MethodNameGetRate = "getRate"
+
 	// NonceManager methods
 	MethodNameGetInboundNonce  = "GetInboundNonce"
 	MethodNameGetOutboundNonce = "GetOutboundNonce"
@@ -108,6 +114,13 @@ const (
 
 	// RMNProxy.sol methods
 	MethodNameGetARM = "GetARM"
+
+	// TokenAdminRegistry.sol methods
+	MethodNameGetPool = "GetPool"
+
+	// TokenPool.sol methods
+	MethodNameGetCurrentInboundRateLimiterState = "GetCurrentInboundRateLimiterState"
+	MethodNameGetTokenDecimals                  = "GetTokenDecimals"
 )
 
 // Event Names
@@ -113,10 +113,13 @@ func NewExtendedContractReader(baseContractReader ContractReaderFacade) Extended
 	return &extendedContractReader{
 		reader:                 baseContractReader,
 		contractBindingsByName: make(map[string][]ExtendedBoundContract),
-		// so far this is the only contract that allows multiple bindings
 		// if more contracts are added, this should be moved to a config
-		multiBindAllowed: map[string]bool{consts.ContractNamePriceAggregator: true},
-		mu:               &sync.RWMutex{},
+		multiBindAllowed: map[string]bool{
+			consts.ContractNamePriceAggregator: true,
+			consts.ContractNameTokenPool:       true,
+			consts.ContractNameTokenRateGetter: true,
+		},
+		mu: &sync.RWMutex{},
 	}
 }
 
@@ -0,0 +1,288 @@
+// Package ocrrecorder records the inputs and outputs of OCR rounds so that they can be replayed offline.
+//
+// Queries, observations and outcomes are stored exactly as exchanged between the oracles, i.e. using the
+// ocrtypecodec/v1 protobuf encodings, which allows a recorded round to be fed back into the plugin functions
+// and the recomputed outcome to be compared against the recorded one.
+package ocrrecorder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// maxPendingRounds is the number of rounds kept in memory while waiting for their reports.
+const maxPendingRounds = 16
+
+// DefaultMaxFiles is the number of recorded rounds kept by a FileStore when no retention is configured.
+const DefaultMaxFiles = 10_000
+
+// Round is a recorded OCR round.
+type Round struct {
+	Plugin          string        `json:"plugin"`
+	DonID           uint32        `json:"donID"`
+	ConfigDigest    string        `json:"configDigest"`
+	SeqNr           uint64        `json:"seqNr"`
+	PreviousOutcome []byte        `json:"previousOutcome"`
+	Query           []byte        `json:"query"`
+	Observations    []Observation `json:"observations"`
+	Outcome         []byte        `json:"outcome"`
+	OutcomeErr      string        `json:"outcomeErr,omitempty"`
+	Reports         []Report      `json:"reports,omitempty"`
+}
+
+// Observation is an observation attributed to the oracle which made it.
+type Observation struct {
+	Observer    commontypes.OracleID `json:"observer"`
+	Observation []byte               `json:"observation"`
+}
+
+// Report is a report generated from the outcome of a round.
+type Report struct {
+	Report []byte `json:"report"`
+	Info   []byte `json:"info"`
+}
+
+// OutcomeContext returns the outcome context the round was computed with.
+func (r Round) OutcomeContext() ocr3types.OutcomeContext {
+	return ocr3types.OutcomeContext{SeqNr: r.SeqNr, PreviousOutcome: r.PreviousOutcome}
+}
+
+// AttributedObservations returns the recorded observations in the format expected by the plugins.
+func (r Round) AttributedObservations() []types.AttributedObservation {
+	aos := make([]types.AttributedObservation, 0, len(r.Observations))
+	for _, o := range r.Observations {
+		aos = append(aos, types.AttributedObservation{Observer: o.Observer, Observation: o.Observation})
+	}
+	return aos
+}
+
+// Store persists recorded rounds.
+type Store interface {
+	Write(round Round) error
+}
+
+// Config configures the recording of OCR rounds by a node.
+type Config struct {
+	// Dir is the directory the rounds are written to, rounds are not recorded if it is empty.
+	Dir string `json:"dir"`
+	// MaxFiles is the maximum number of rounds kept in Dir, the oldest are deleted first. Defaults to
+	// DefaultMaxFiles if neither MaxFiles nor MaxAge is set.
+	MaxFiles int `json:"maxFiles"`
+	// MaxAge is the time after which a round is deleted, 0 keeps rounds regardless of their age.
+	MaxAge time.Duration `json:"maxAge"`
+}
+
+// NewStore returns the store configured by cfg, or nil if recording is disabled. The result is meant to be
+// passed as the RoundRecorder of the commit and execute plugin factories.
+func NewStore(cfg Config) (Store, error) {
+	if cfg.Dir == "" {
+		return nil, nil
+	}
+	return NewFileStore(cfg)
+}
+
+// FileStore writes every round to its own JSON file in a directory, and deletes the rounds exceeding the
+// configured retention.
+type FileStore struct {
+	dir      string
+	maxFiles int
+	maxAge   time.Duration
+}
+
+// NewFileStore returns a store writing to cfg.Dir, the directory is created if it doesn't exist.
+func NewFileStore(cfg Config) (*FileStore, error) {
+	if err := os.MkdirAll(cfg.Dir, 0o750); err != nil {
+		return nil, fmt.Errorf("create recorder directory %s: %w", cfg.Dir, err)
+	}
+	maxFiles := cfg.MaxFiles
+	if maxFiles == 0 && cfg.MaxAge == 0 {
+		maxFiles = DefaultMaxFiles
+	}
+	return &FileStore{dir: cfg.Dir, maxFiles: maxFiles, maxAge: cfg.MaxAge}, nil
+}
+
+// Path returns the path of the file storing the given round. Sequence numbers restart with every configuration
+// and are shared by the DONs, so the file name includes the DON ID and the config digest.
+func (s *FileStore) Path(round Round) string {
+	return filepath.Join(s.dir,
+		fmt.Sprintf("%s_%d_%s_%d.json", round.Plugin, round.DonID, round.ConfigDigest, round.SeqNr))
+}
+
+func (s *FileStore) Write(round Round) error {
+	data, err := json.MarshalIndent(round, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal round %d: %w", round.SeqNr, err)
+	}
+	if err := os.WriteFile(s.Path(round), data, 0o600); err != nil {
+		return fmt.Errorf("write round %d: %w", round.SeqNr, err)
+	}
+	return s.prune()
+}
+
+// prune deletes the rounds older than maxAge, and the oldest rounds exceeding maxFiles.
+func (s *FileStore) prune() error {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return fmt.Errorf("read recorder directory: %w", err)
+	}
+
+	type roundFile struct {
+		path    string
+		modTime time.Time
+	}
+	files := make([]roundFile, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			// the file was deleted concurrently.
+			continue
+		}
+		files = append(files, roundFile{path: filepath.Join(s.dir, entry.Name()), modTime: info.ModTime()})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].modTime.Before(files[j].modTime)
+	})
+
+	now := time.Now()
+	for i, f := range files {
+		expired := s.maxAge > 0 && now.Sub(f.modTime) > s.maxAge
+		excess := s.maxFiles > 0 && len(files)-i > s.maxFiles
+		if !expired && !excess {
+			break
+		}
+		if err := os.Remove(f.path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("delete round %s: %w", f.path, err)
+		}
+	}
+	return nil
+}
+
+// ReadRound reads a round written by FileStore.
+func ReadRound(path string) (Round, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Round{}, fmt.Errorf("read round: %w", err)
+	}
+	var round Round
+	if err := json.Unmarshal(data, &round); err != nil {
+		return Round{}, fmt.Errorf("unmarshal round %s: %w", path, err)
+	}
+	return round, nil
+}
+
+// RecordingPlugin records the rounds of the wrapped plugin. A round is written once its outcome is computed,
+// and written again with its reports. Recording is best-effort, failures are logged and never affect the
+// wrapped plugin.
+type RecordingPlugin struct {
+	ocr3types.ReportingPlugin[[]byte]
+	name         string
+	donID        uint32
+	configDigest string
+	lggr         logger.Logger
+	store        Store
+
+	mu      sync.Mutex
+	pending map[uint64]Round
+}
+
+// NewRecordingPlugin wraps the plugin, name, donID and configDigest identify the plugin instance in the
+// recorded rounds.
+func NewRecordingPlugin(
+	plugin ocr3types.ReportingPlugin[[]byte],
+	name string,
+	donID uint32,
+	configDigest types.ConfigDigest,
+	lggr logger.Logger,
+	store Store,
+) ocr3types.ReportingPlugin[[]byte] {
+	return &RecordingPlugin{
+		ReportingPlugin: plugin,
+		name:            name,
+		donID:           donID,
+		configDigest:    configDigest.Hex(),
+		lggr:            lggr,
+		store:           store,
+		pending:         make(map[uint64]Round),
+	}
+}
+
+func (p *RecordingPlugin) Outcome(
+	ctx context.Context, outctx ocr3types.OutcomeContext, query types.Query, aos []types.AttributedObservation,
+) (ocr3types.Outcome, error) {
+	outcome, err := p.ReportingPlugin.Outcome(ctx, outctx, query, aos)
+
+	round := Round{
+		Plugin:          p.name,
+		DonID:           p.donID,
+		ConfigDigest:    p.configDigest,
+		SeqNr:           outctx.SeqNr,
+		PreviousOutcome: outctx.PreviousOutcome,
+		Query:           query,
+		Observations:    make([]Observation, 0, len(aos)),
+		Outcome:         outcome,
+	}
+	for _, ao := range aos {
+		round.Observations = append(round.Observations, Observation{Observer: ao.Observer, Observation: ao.Observation})
+	}
+	if err != nil {
+		round.OutcomeErr = err.Error()
+	}
+
+	p.mu.Lock()
+	for seqNr := range p.pending {
+		if seqNr+maxPendingRounds < outctx.SeqNr {
+			delete(p.pending, seqNr)
+		}
+	}
+	p.pending[outctx.SeqNr] = round
+	p.mu.Unlock()
+
+	p.write(round)
+	return outcome, err
+}
+
+func (p *RecordingPlugin) Reports(
+	ctx context.Context, seqNr uint64, outcome ocr3types.Outcome,
+) ([]ocr3types.ReportPlus[[]byte], error) {
+	reports, err := p.ReportingPlugin.Reports(ctx, seqNr, outcome)
+	if err != nil {
+		return reports, err
+	}
+
+	p.mu.Lock()
+	round, ok := p.pending[seqNr]
+	delete(p.pending, seqNr)
+	p.mu.Unlock()
+	if !ok {
+		// the outcome was computed by a previous instance, only the outcome and reports are known.
+		round = Round{Plugin: p.name, DonID: p.donID, ConfigDigest: p.configDigest, SeqNr: seqNr, Outcome: outcome}
+	}
+
+	for _, r := range reports {
+		round.Reports = append(round.Reports, Report{Report: r.ReportWithInfo.Report, Info: r.ReportWithInfo.Info})
+	}
+	p.write(round)
+	return reports, nil
+}
+
+func (p *RecordingPlugin) write(round Round) {
+	if err := p.store.Write(round); err != nil {
+		p.lggr.Warnw("unable to record OCR round", "plugin", p.name, "seqNr", round.SeqNr, "err", err)
+	}
+}
@@ -0,0 +1,175 @@
+package ocrrecorder
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// echoPlugin returns the query as the outcome and the outcome as the report.
+type echoPlugin struct {
+	ocr3types.ReportingPlugin[[]byte]
+	outcomeErr error
+}
+
+func (p echoPlugin) Outcome(
+	_ context.Context, _ ocr3types.OutcomeContext, query types.Query, _ []types.AttributedObservation,
+) (ocr3types.Outcome, error) {
+	if p.outcomeErr != nil {
+		return nil, p.outcomeErr
+	}
+	return ocr3types.Outcome(query), nil
+}
+
+func (p echoPlugin) Reports(
+	_ context.Context, _ uint64, outcome ocr3types.Outcome,
+) ([]ocr3types.ReportPlus[[]byte], error) {
+	return []ocr3types.ReportPlus[[]byte]{
+		{ReportWithInfo: ocr3types.ReportWithInfo[[]byte]{Report: types.Report(outcome), Info: []byte("info")}},
+	}, nil
+}
+
+type failingStore struct{}
+
+func (failingStore) Write(Round) error {
+	return errors.New("disk full")
+}
+
+func TestRecordingPlugin(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFileStore(Config{Dir: t.TempDir()})
+	require.NoError(t, err)
+
+	digest := types.ConfigDigest{1}
+	p := NewRecordingPlugin(echoPlugin{}, "test", 2, digest, logger.Test(t), store)
+	outctx := ocr3types.OutcomeContext{SeqNr: 7, PreviousOutcome: []byte("prev")}
+	aos := []types.AttributedObservation{{Observer: 1, Observation: []byte("obs1")}, {Observer: 2}}
+
+	outcome, err := p.Outcome(ctx, outctx, []byte("query"), aos)
+	require.NoError(t, err)
+
+	path := store.Path(Round{Plugin: "test", DonID: 2, ConfigDigest: digest.Hex(), SeqNr: 7})
+	round, err := ReadRound(path)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), round.DonID)
+	assert.Equal(t, digest.Hex(), round.ConfigDigest)
+	assert.Equal(t, uint64(7), round.SeqNr)
+	assert.Equal(t, []byte("prev"), round.PreviousOutcome)
+	assert.Equal(t, []byte("query"), round.Query)
+	assert.Equal(t, aos, round.AttributedObservations())
+	assert.Equal(t, []byte(outcome), round.Outcome)
+	assert.Empty(t, round.Reports)
+
+	_, err = p.Reports(ctx, 7, outcome)
+	require.NoError(t, err)
+
+	round, err = ReadRound(path)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("query"), round.Query)
+	require.Len(t, round.Reports, 1)
+	assert.Equal(t, []byte("query"), round.Reports[0].Report)
+	assert.Equal(t, []byte("info"), round.Reports[0].Info)
+
+	// replaying the recorded round reproduces the outcome.
+	replayed, err := Replay(ctx, echoPlugin{}, round)
+	require.NoError(t, err)
+	assert.Equal(t, outcome, replayed)
+}
+
+func TestRecordingPlugin_Errors(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFileStore(Config{Dir: t.TempDir()})
+	require.NoError(t, err)
+
+	// outcome errors are recorded and returned.
+	p := NewRecordingPlugin(
+		echoPlugin{outcomeErr: errors.New("boom")}, "test", 1, types.ConfigDigest{}, logger.Test(t), store)
+	_, err = p.Outcome(ctx, ocr3types.OutcomeContext{SeqNr: 1}, nil, nil)
+	require.Error(t, err)
+	round, err := ReadRound(
+		store.Path(Round{Plugin: "test", DonID: 1, ConfigDigest: types.ConfigDigest{}.Hex(), SeqNr: 1}))
+	require.NoError(t, err)
+	assert.Equal(t, "boom", round.OutcomeErr)
+
+	// recording failures don't affect the plugin.
+	p = NewRecordingPlugin(echoPlugin{}, "test", 1, types.ConfigDigest{}, logger.Test(t), failingStore{})
+	outcome, err := p.Outcome(ctx, ocr3types.OutcomeContext{SeqNr: 1}, []byte("query"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, ocr3types.Outcome("query"), outcome)
+	reports, err := p.Reports(ctx, 1, outcome)
+	require.NoError(t, err)
+	assert.Len(t, reports, 1)
+}
+
+func TestFileStore_Retention(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFileStore(Config{Dir: dir, MaxFiles: 2})
+	require.NoError(t, err)
+
+	// rounds of different configurations with the same sequence number are kept apart.
+	rounds := []Round{
+		{Plugin: "test", DonID: 1, ConfigDigest: "aa", SeqNr: 1},
+		{Plugin: "test", DonID: 1, ConfigDigest: "bb", SeqNr: 1},
+		{Plugin: "test", DonID: 2, ConfigDigest: "bb", SeqNr: 1},
+	}
+	for i, round := range rounds {
+		require.NoError(t, store.Write(round))
+		// make the modification times distinct so that the oldest round is deleted.
+		mtime := time.Now().Add(time.Duration(i-len(rounds)) * time.Minute)
+		require.NoError(t, os.Chtimes(store.Path(round), mtime, mtime))
+	}
+	require.NoError(t, store.Write(rounds[2]))
+
+	_, err = os.Stat(store.Path(rounds[0]))
+	assert.True(t, os.IsNotExist(err))
+	for _, round := range rounds[1:] {
+		_, err = ReadRound(store.Path(round))
+		require.NoError(t, err)
+	}
+
+	// expired rounds are deleted regardless of the number of files.
+	store, err = NewFileStore(Config{Dir: dir, MaxAge: time.Hour})
+	require.NoError(t, err)
+	old := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(store.Path(rounds[1]), old, old))
+	require.NoError(t, store.Write(rounds[2]))
+	_, err = os.Stat(store.Path(rounds[1]))
+	assert.True(t, os.IsNotExist(err))
+	_, err = ReadRound(store.Path(rounds[2]))
+	require.NoError(t, err)
+}
+
+func TestNewStore(t *testing.T) {
+	store, err := NewStore(Config{})
+	require.NoError(t, err)
+	assert.Nil(t, store)
+
+	store, err = NewStore(Config{Dir: t.TempDir()})
+	require.NoError(t, err)
+	assert.NotNil(t, store)
+}
+
+func TestDiff(t *testing.T) {
+	type outcome struct {
+		State string
+		Roots []string
+	}
+
+	diff, err := Diff(outcome{State: "a", Roots: []string{"1", "2"}}, outcome{State: "a", Roots: []string{"1", "2"}})
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+
+	diff, err = Diff(outcome{State: "a", Roots: []string{"1", "2"}}, outcome{State: "b", Roots: []string{"1", "3"}})
+	require.NoError(t, err)
+	assert.Equal(t, "-  \"State\": \"a\",\n+  \"State\": \"b\",\n-    \"2\"\n+    \"3\"\n", diff)
+}
@@ -0,0 +1,67 @@
+package ocrrecorder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+)
+
+// Replay recomputes the outcome of a recorded round with the given plugin. The plugin is expected to be
+// configured like the one which recorded the round, with readers mocked as needed.
+func Replay(ctx context.Context, plugin ocr3types.ReportingPlugin[[]byte], round Round) (ocr3types.Outcome, error) {
+	return plugin.Outcome(ctx, round.OutcomeContext(), round.Query, round.AttributedObservations())
+}
+
+// Diff returns a line based diff between the JSON representations of the recorded and recomputed values,
+// an empty string means they are equal. Removed lines are prefixed with "-" and added ones with "+".
+func Diff(recorded, recomputed any) (string, error) {
+	a, err := json.MarshalIndent(recorded, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal recorded: %w", err)
+	}
+	b, err := json.MarshalIndent(recomputed, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal recomputed: %w", err)
+	}
+	if string(a) == string(b) {
+		return "", nil
+	}
+	return diffLines(strings.Split(string(a), "\n"), strings.Split(string(b), "\n")), nil
+}
+
+// diffLines computes the longest common subsequence of the lines and prints the lines which are not part of it.
+func diffLines(a, b []string) string {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var sb strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			sb.WriteString("-" + a[i] + "\n")
+			i++
+		default:
+			sb.WriteString("+" + b[j] + "\n")
+			j++
+		}
+	}
+	return sb.String()
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon/discovery/discoverytypes"
@@ -48,7 +49,10 @@ func (e *ExecCodecProto) EncodeObservation(observation exectypes.Observation) ([
 				Addresses: e.tr.discoveryAddressesToProto(observation.Contracts.Addresses),
 			},
 		},
-		FChain: e.tr.fChainToProto(observation.FChain),
+		FChain:              e.tr.fChainToProto(observation.FChain),
+		TokenPoolRateLimits: e.tr.tokenPoolRateLimitsToProto(observation.TokenPoolRateLimits),
+		CostPrices:          e.tr.costPricesToProto(observation.CostPrices),
+		Timestamp:           timestamppb.New(observation.Timestamp),
 	}
 
 	return proto.Marshal(pbObs)
@@ -74,7 +78,10 @@ func (e *ExecCodecProto) DecodeObservation(data []byte) (exectypes.Observation,
 			FChain:    e.tr.fChainFromProto(pbObs.Contracts.FChain),
 			Addresses: e.tr.discoveryAddressesFromProto(pbObs.Contracts.ContractNames.Addresses),
 		},
-		FChain: e.tr.fChainFromProto(pbObs.FChain),
+		FChain:              e.tr.fChainFromProto(pbObs.FChain),
+		TokenPoolRateLimits: e.tr.tokenPoolRateLimitsFromProto(pbObs.TokenPoolRateLimits),
+		CostPrices:          e.tr.costPricesFromProto(pbObs.CostPrices),
+		Timestamp:           e.tr.timestampFromProto(pbObs.Timestamp),
 	}, nil
 }
 
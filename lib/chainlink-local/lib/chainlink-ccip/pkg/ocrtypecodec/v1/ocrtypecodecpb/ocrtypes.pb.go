@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.31.0
+// 	protoc-gen-go v1.35.1
 // 	protoc        v5.28.0
 // source: pkg/ocrtypecodec/v1/ocrtypes.proto
 
@@ -31,11 +31,9 @@ type CommitQuery struct {
 
 func (x *CommitQuery) Reset() {
 	*x = CommitQuery{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CommitQuery) String() string {
@@ -46,7 +44,7 @@ func (*CommitQuery) ProtoMessage() {}
 
 func (x *CommitQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -82,11 +80,9 @@ type CommitObservation struct {
 
 func (x *CommitObservation) Reset() {
 	*x = CommitObservation{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CommitObservation) String() string {
@@ -97,7 +93,7 @@ func (*CommitObservation) ProtoMessage() {}
 
 func (x *CommitObservation) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -160,11 +156,9 @@ type CommitOutcome struct {
 
 func (x *CommitOutcome) Reset() {
 	*x = CommitOutcome{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CommitOutcome) String() string {
@@ -175,7 +169,7 @@ func (*CommitOutcome) ProtoMessage() {}
 
 func (x *CommitOutcome) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -228,19 +222,20 @@ type ExecObservation struct {
 	MsgHashes             map[uint64]*SeqNumToBytes      `protobuf:"bytes,3,rep,name=msg_hashes,json=msgHashes,proto3" json:"msg_hashes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`                 // chainSelector to seqNum to bytes32
 	TokenDataObservations *TokenDataObservations         `protobuf:"bytes,4,opt,name=token_data_observations,json=tokenDataObservations,proto3" json:"token_data_observations,omitempty"`
 	// Deprecated: Marked as deprecated in pkg/ocrtypecodec/v1/ocrtypes.proto.
-	CostlyMessages [][]byte                      `protobuf:"bytes,5,rep,name=costly_messages,json=costlyMessages,proto3" json:"costly_messages,omitempty"` // DEPRECATED: Message IDs of costly messages
-	Nonces         map[uint64]*StringAddrToNonce `protobuf:"bytes,6,rep,name=nonces,proto3" json:"nonces,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Contracts      *DiscoveryObservation         `protobuf:"bytes,7,opt,name=contracts,proto3" json:"contracts,omitempty"`
-	FChain         map[uint64]int32              `protobuf:"bytes,8,rep,name=f_chain,json=fChain,proto3" json:"f_chain,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // chainSelector to f
+	CostlyMessages      [][]byte                        `protobuf:"bytes,5,rep,name=costly_messages,json=costlyMessages,proto3" json:"costly_messages,omitempty"` // DEPRECATED: Message IDs of costly messages
+	Nonces              map[uint64]*StringAddrToNonce   `protobuf:"bytes,6,rep,name=nonces,proto3" json:"nonces,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Contracts           *DiscoveryObservation           `protobuf:"bytes,7,opt,name=contracts,proto3" json:"contracts,omitempty"`
+	FChain              map[uint64]int32                `protobuf:"bytes,8,rep,name=f_chain,json=fChain,proto3" json:"f_chain,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`                                           // chainSelector to f
+	TokenPoolRateLimits map[uint64]*TokenPoolRateLimits `protobuf:"bytes,9,rep,name=token_pool_rate_limits,json=tokenPoolRateLimits,proto3" json:"token_pool_rate_limits,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // chainSelector to token pool rate limits
+	CostPrices          *ExecCostPrices                 `protobuf:"bytes,10,opt,name=cost_prices,json=costPrices,proto3" json:"cost_prices,omitempty"`
+	Timestamp           *timestamppb.Timestamp          `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
 func (x *ExecObservation) Reset() {
 	*x = ExecObservation{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ExecObservation) String() string {
@@ -251,7 +246,7 @@ func (*ExecObservation) ProtoMessage() {}
 
 func (x *ExecObservation) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -323,6 +318,27 @@ func (x *ExecObservation) GetFChain() map[uint64]int32 {
 	return nil
 }
 
+func (x *ExecObservation) GetTokenPoolRateLimits() map[uint64]*TokenPoolRateLimits {
+	if x != nil {
+		return x.TokenPoolRateLimits
+	}
+	return nil
+}
+
+func (x *ExecObservation) GetCostPrices() *ExecCostPrices {
+	if x != nil {
+		return x.CostPrices
+	}
+	return nil
+}
+
+func (x *ExecObservation) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
 type ExecOutcome struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -335,11 +351,9 @@ type ExecOutcome struct {
 
 func (x *ExecOutcome) Reset() {
 	*x = ExecOutcome{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ExecOutcome) String() string {
@@ -350,7 +364,7 @@ func (*ExecOutcome) ProtoMessage() {}
 
 func (x *ExecOutcome) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -397,11 +411,9 @@ type MerkleRootQuery struct {
 
 func (x *MerkleRootQuery) Reset() {
 	*x = MerkleRootQuery{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MerkleRootQuery) String() string {
@@ -412,7 +424,7 @@ func (*MerkleRootQuery) ProtoMessage() {}
 
 func (x *MerkleRootQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -452,11 +464,9 @@ type ReportSignatures struct {
 
 func (x *ReportSignatures) Reset() {
 	*x = ReportSignatures{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ReportSignatures) String() string {
@@ -467,7 +477,7 @@ func (*ReportSignatures) ProtoMessage() {}
 
 func (x *ReportSignatures) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -507,11 +517,9 @@ type SignatureEcdsa struct {
 
 func (x *SignatureEcdsa) Reset() {
 	*x = SignatureEcdsa{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SignatureEcdsa) String() string {
@@ -522,7 +530,7 @@ func (*SignatureEcdsa) ProtoMessage() {}
 
 func (x *SignatureEcdsa) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -563,11 +571,9 @@ type DestChainUpdate struct {
 
 func (x *DestChainUpdate) Reset() {
 	*x = DestChainUpdate{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DestChainUpdate) String() string {
@@ -578,7 +584,7 @@ func (*DestChainUpdate) ProtoMessage() {}
 
 func (x *DestChainUpdate) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -629,11 +635,9 @@ type MerkleRootObservation struct {
 
 func (x *MerkleRootObservation) Reset() {
 	*x = MerkleRootObservation{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MerkleRootObservation) String() string {
@@ -644,7 +648,7 @@ func (*MerkleRootObservation) ProtoMessage() {}
 
 func (x *MerkleRootObservation) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -716,11 +720,9 @@ type RmnRemoteConfig struct {
 
 func (x *RmnRemoteConfig) Reset() {
 	*x = RmnRemoteConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RmnRemoteConfig) String() string {
@@ -731,7 +733,7 @@ func (*RmnRemoteConfig) ProtoMessage() {}
 
 func (x *RmnRemoteConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -799,11 +801,9 @@ type RemoteSignerInfo struct {
 
 func (x *RemoteSignerInfo) Reset() {
 	*x = RemoteSignerInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RemoteSignerInfo) String() string {
@@ -814,7 +814,7 @@ func (*RemoteSignerInfo) ProtoMessage() {}
 
 func (x *RemoteSignerInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -856,11 +856,9 @@ type TokenPriceObservation struct {
 
 func (x *TokenPriceObservation) Reset() {
 	*x = TokenPriceObservation{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *TokenPriceObservation) String() string {
@@ -871,7 +869,7 @@ func (*TokenPriceObservation) ProtoMessage() {}
 
 func (x *TokenPriceObservation) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -928,11 +926,9 @@ type ChainFeeObservation struct {
 
 func (x *ChainFeeObservation) Reset() {
 	*x = ChainFeeObservation{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ChainFeeObservation) String() string {
@@ -943,7 +939,7 @@ func (*ChainFeeObservation) ProtoMessage() {}
 
 func (x *ChainFeeObservation) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1004,11 +1000,9 @@ type ChainFeeComponents struct {
 
 func (x *ChainFeeComponents) Reset() {
 	*x = ChainFeeComponents{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ChainFeeComponents) String() string {
@@ -1019,7 +1013,7 @@ func (*ChainFeeComponents) ProtoMessage() {}
 
 func (x *ChainFeeComponents) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1059,11 +1053,9 @@ type ChainFeeUpdate struct {
 
 func (x *ChainFeeUpdate) Reset() {
 	*x = ChainFeeUpdate{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ChainFeeUpdate) String() string {
@@ -1074,7 +1066,7 @@ func (*ChainFeeUpdate) ProtoMessage() {}
 
 func (x *ChainFeeUpdate) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1114,11 +1106,9 @@ type ComponentsUSDPrices struct {
 
 func (x *ComponentsUSDPrices) Reset() {
 	*x = ComponentsUSDPrices{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ComponentsUSDPrices) String() string {
@@ -1129,7 +1119,7 @@ func (*ComponentsUSDPrices) ProtoMessage() {}
 
 func (x *ComponentsUSDPrices) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1169,11 +1159,9 @@ type DiscoveryObservation struct {
 
 func (x *DiscoveryObservation) Reset() {
 	*x = DiscoveryObservation{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DiscoveryObservation) String() string {
@@ -1184,7 +1172,7 @@ func (*DiscoveryObservation) ProtoMessage() {}
 
 func (x *DiscoveryObservation) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1223,11 +1211,9 @@ type ContractNameChainAddresses struct {
 
 func (x *ContractNameChainAddresses) Reset() {
 	*x = ContractNameChainAddresses{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ContractNameChainAddresses) String() string {
@@ -1238,7 +1224,7 @@ func (*ContractNameChainAddresses) ProtoMessage() {}
 
 func (x *ContractNameChainAddresses) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1270,11 +1256,9 @@ type ChainAddressMap struct {
 
 func (x *ChainAddressMap) Reset() {
 	*x = ChainAddressMap{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ChainAddressMap) String() string {
@@ -1285,7 +1269,7 @@ func (*ChainAddressMap) ProtoMessage() {}
 
 func (x *ChainAddressMap) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1324,11 +1308,9 @@ type MerkleRootOutcome struct {
 
 func (x *MerkleRootOutcome) Reset() {
 	*x = MerkleRootOutcome{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MerkleRootOutcome) String() string {
@@ -1339,7 +1321,7 @@ func (*MerkleRootOutcome) ProtoMessage() {}
 
 func (x *MerkleRootOutcome) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1420,11 +1402,9 @@ type TokenPriceOutcome struct {
 
 func (x *TokenPriceOutcome) Reset() {
 	*x = TokenPriceOutcome{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *TokenPriceOutcome) String() string {
@@ -1435,7 +1415,7 @@ func (*TokenPriceOutcome) ProtoMessage() {}
 
 func (x *TokenPriceOutcome) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1467,11 +1447,9 @@ type ChainFeeOutcome struct {
 
 func (x *ChainFeeOutcome) Reset() {
 	*x = ChainFeeOutcome{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ChainFeeOutcome) String() string {
@@ -1482,7 +1460,7 @@ func (*ChainFeeOutcome) ProtoMessage() {}
 
 func (x *ChainFeeOutcome) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1515,11 +1493,9 @@ type GasPriceChain struct {
 
 func (x *GasPriceChain) Reset() {
 	*x = GasPriceChain{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GasPriceChain) String() string {
@@ -1530,7 +1506,7 @@ func (*GasPriceChain) ProtoMessage() {}
 
 func (x *GasPriceChain) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1570,11 +1546,9 @@ type MainOutcome struct {
 
 func (x *MainOutcome) Reset() {
 	*x = MainOutcome{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MainOutcome) String() string {
@@ -1585,7 +1559,7 @@ func (*MainOutcome) ProtoMessage() {}
 
 func (x *MainOutcome) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[24]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1624,11 +1598,9 @@ type CommitObservations struct {
 
 func (x *CommitObservations) Reset() {
 	*x = CommitObservations{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CommitObservations) String() string {
@@ -1639,7 +1611,7 @@ func (*CommitObservations) ProtoMessage() {}
 
 func (x *CommitObservations) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[25]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1682,11 +1654,9 @@ type CommitData struct {
 
 func (x *CommitData) Reset() {
 	*x = CommitData{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CommitData) String() string {
@@ -1697,7 +1667,7 @@ func (*CommitData) ProtoMessage() {}
 
 func (x *CommitData) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1800,11 +1770,9 @@ type MessageTokenData struct {
 
 func (x *MessageTokenData) Reset() {
 	*x = MessageTokenData{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[27]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MessageTokenData) String() string {
@@ -1815,7 +1783,7 @@ func (*MessageTokenData) ProtoMessage() {}
 
 func (x *MessageTokenData) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[27]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1848,11 +1816,9 @@ type TokenData struct {
 
 func (x *TokenData) Reset() {
 	*x = TokenData{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[28]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *TokenData) String() string {
@@ -1863,7 +1829,7 @@ func (*TokenData) ProtoMessage() {}
 
 func (x *TokenData) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[28]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1902,11 +1868,9 @@ type SeqNumToMessage struct {
 
 func (x *SeqNumToMessage) Reset() {
 	*x = SeqNumToMessage{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[29]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SeqNumToMessage) String() string {
@@ -1917,7 +1881,7 @@ func (*SeqNumToMessage) ProtoMessage() {}
 
 func (x *SeqNumToMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1949,11 +1913,9 @@ type SeqNumToBytes struct {
 
 func (x *SeqNumToBytes) Reset() {
 	*x = SeqNumToBytes{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[30]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SeqNumToBytes) String() string {
@@ -1964,7 +1926,7 @@ func (*SeqNumToBytes) ProtoMessage() {}
 
 func (x *SeqNumToBytes) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[30]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1996,11 +1958,9 @@ type TokenDataObservations struct {
 
 func (x *TokenDataObservations) Reset() {
 	*x = TokenDataObservations{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[31]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *TokenDataObservations) String() string {
@@ -2011,7 +1971,7 @@ func (*TokenDataObservations) ProtoMessage() {}
 
 func (x *TokenDataObservations) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[31]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2043,11 +2003,9 @@ type SeqNumToTokenData struct {
 
 func (x *SeqNumToTokenData) Reset() {
 	*x = SeqNumToTokenData{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[32]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SeqNumToTokenData) String() string {
@@ -2058,7 +2016,7 @@ func (*SeqNumToTokenData) ProtoMessage() {}
 
 func (x *SeqNumToTokenData) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[32]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2098,11 +2056,9 @@ type Message struct {
 
 func (x *Message) Reset() {
 	*x = Message{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[33]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Message) String() string {
@@ -2113,7 +2069,7 @@ func (*Message) ProtoMessage() {}
 
 func (x *Message) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[33]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2207,11 +2163,9 @@ type RampMessageHeader struct {
 
 func (x *RampMessageHeader) Reset() {
 	*x = RampMessageHeader{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[34]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RampMessageHeader) String() string {
@@ -2222,7 +2176,7 @@ func (*RampMessageHeader) ProtoMessage() {}
 
 func (x *RampMessageHeader) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[34]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2300,11 +2254,9 @@ type RampTokenAmount struct {
 
 func (x *RampTokenAmount) Reset() {
 	*x = RampTokenAmount{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[35]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RampTokenAmount) String() string {
@@ -2315,7 +2267,7 @@ func (*RampTokenAmount) ProtoMessage() {}
 
 func (x *RampTokenAmount) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[35]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2375,11 +2327,9 @@ type StringAddrToNonce struct {
 
 func (x *StringAddrToNonce) Reset() {
 	*x = StringAddrToNonce{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[36]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *StringAddrToNonce) String() string {
@@ -2390,7 +2340,7 @@ func (*StringAddrToNonce) ProtoMessage() {}
 
 func (x *StringAddrToNonce) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[36]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2412,32 +2362,30 @@ func (x *StringAddrToNonce) GetNonces() map[string]uint64 {
 	return nil
 }
 
-type ExecutePluginReport struct {
+type TokenPoolRateLimits struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainReports []*ChainReport `protobuf:"bytes,1,rep,name=chain_reports,json=chainReports,proto3" json:"chain_reports,omitempty"`
+	RateLimits map[string]*TokenPoolRateLimit `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // dest token address string to rate limit
 }
 
-func (x *ExecutePluginReport) Reset() {
-	*x = ExecutePluginReport{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[37]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *TokenPoolRateLimits) Reset() {
+	*x = TokenPoolRateLimits{}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecutePluginReport) String() string {
+func (x *TokenPoolRateLimits) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutePluginReport) ProtoMessage() {}
+func (*TokenPoolRateLimits) ProtoMessage() {}
 
-func (x *ExecutePluginReport) ProtoReflect() protoreflect.Message {
+func (x *TokenPoolRateLimits) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[37]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2447,48 +2395,44 @@ func (x *ExecutePluginReport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutePluginReport.ProtoReflect.Descriptor instead.
-func (*ExecutePluginReport) Descriptor() ([]byte, []int) {
+// Deprecated: Use TokenPoolRateLimits.ProtoReflect.Descriptor instead.
+func (*TokenPoolRateLimits) Descriptor() ([]byte, []int) {
 	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ExecutePluginReport) GetChainReports() []*ChainReport {
+func (x *TokenPoolRateLimits) GetRateLimits() map[string]*TokenPoolRateLimit {
 	if x != nil {
-		return x.ChainReports
+		return x.RateLimits
 	}
 	return nil
 }
 
-type ChainReport struct {
+type TokenPoolRateLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SourceChainSelector uint64           `protobuf:"varint,1,opt,name=source_chain_selector,json=sourceChainSelector,proto3" json:"source_chain_selector,omitempty"`
-	Messages            []*Message       `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
-	OffchainTokenData   []*RepeatedBytes `protobuf:"bytes,3,rep,name=offchain_token_data,json=offchainTokenData,proto3" json:"offchain_token_data,omitempty"`
-	Proofs              [][]byte         `protobuf:"bytes,4,rep,name=proofs,proto3" json:"proofs,omitempty"`
-	ProofFlagBits       []byte           `protobuf:"bytes,5,opt,name=proof_flag_bits,json=proofFlagBits,proto3" json:"proof_flag_bits,omitempty"`
+	Pool     []byte            `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	Decimals uint32            `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Inbound  *RateLimiterState `protobuf:"bytes,3,opt,name=inbound,proto3" json:"inbound,omitempty"`
 }
 
-func (x *ChainReport) Reset() {
-	*x = ChainReport{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[38]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *TokenPoolRateLimit) Reset() {
+	*x = TokenPoolRateLimit{}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChainReport) String() string {
+func (x *TokenPoolRateLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChainReport) ProtoMessage() {}
+func (*TokenPoolRateLimit) ProtoMessage() {}
 
-func (x *ChainReport) ProtoReflect() protoreflect.Message {
+func (x *TokenPoolRateLimit) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[38]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2498,72 +2442,60 @@ func (x *ChainReport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChainReport.ProtoReflect.Descriptor instead.
-func (*ChainReport) Descriptor() ([]byte, []int) {
+// Deprecated: Use TokenPoolRateLimit.ProtoReflect.Descriptor instead.
+func (*TokenPoolRateLimit) Descriptor() ([]byte, []int) {
 	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ChainReport) GetSourceChainSelector() uint64 {
-	if x != nil {
-		return x.SourceChainSelector
-	}
-	return 0
-}
-
-func (x *ChainReport) GetMessages() []*Message {
-	if x != nil {
-		return x.Messages
-	}
-	return nil
-}
-
-func (x *ChainReport) GetOffchainTokenData() []*RepeatedBytes {
+func (x *TokenPoolRateLimit) GetPool() []byte {
 	if x != nil {
-		return x.OffchainTokenData
+		return x.Pool
 	}
 	return nil
 }
 
-func (x *ChainReport) GetProofs() [][]byte {
+func (x *TokenPoolRateLimit) GetDecimals() uint32 {
 	if x != nil {
-		return x.Proofs
+		return x.Decimals
 	}
-	return nil
+	return 0
 }
 
-func (x *ChainReport) GetProofFlagBits() []byte {
+func (x *TokenPoolRateLimit) GetInbound() *RateLimiterState {
 	if x != nil {
-		return x.ProofFlagBits
+		return x.Inbound
 	}
 	return nil
 }
 
-type RepeatedBytes struct {
+type RateLimiterState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items [][]byte `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Tokens      []byte `protobuf:"bytes,1,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	LastUpdated uint32 `protobuf:"varint,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	IsEnabled   bool   `protobuf:"varint,3,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
+	Capacity    []byte `protobuf:"bytes,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Rate        []byte `protobuf:"bytes,5,opt,name=rate,proto3" json:"rate,omitempty"`
 }
 
-func (x *RepeatedBytes) Reset() {
-	*x = RepeatedBytes{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[39]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *RateLimiterState) Reset() {
+	*x = RateLimiterState{}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RepeatedBytes) String() string {
+func (x *RateLimiterState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepeatedBytes) ProtoMessage() {}
+func (*RateLimiterState) ProtoMessage() {}
 
-func (x *RepeatedBytes) ProtoReflect() protoreflect.Message {
+func (x *RateLimiterState) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[39]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2573,45 +2505,299 @@ func (x *RepeatedBytes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepeatedBytes.ProtoReflect.Descriptor instead.
-func (*RepeatedBytes) Descriptor() ([]byte, []int) {
+// Deprecated: Use RateLimiterState.ProtoReflect.Descriptor instead.
+func (*RateLimiterState) Descriptor() ([]byte, []int) {
 	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *RepeatedBytes) GetItems() [][]byte {
+func (x *RateLimiterState) GetTokens() []byte {
 	if x != nil {
-		return x.Items
+		return x.Tokens
 	}
 	return nil
 }
 
-type SeqNumRange struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *RateLimiterState) GetLastUpdated() uint32 {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return 0
+}
 
-	MinMsgNr uint64 `protobuf:"varint,1,opt,name=min_msg_nr,json=minMsgNr,proto3" json:"min_msg_nr,omitempty"`
-	MaxMsgNr uint64 `protobuf:"varint,2,opt,name=max_msg_nr,json=maxMsgNr,proto3" json:"max_msg_nr,omitempty"`
+func (x *RateLimiterState) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
 }
 
-func (x *SeqNumRange) Reset() {
-	*x = SeqNumRange{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[40]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *RateLimiterState) GetCapacity() []byte {
+	if x != nil {
+		return x.Capacity
 	}
+	return nil
 }
 
-func (x *SeqNumRange) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RateLimiterState) GetRate() []byte {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+type ExecCostPrices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GasPrice       []byte `protobuf:"bytes,1,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	NativePriceUsd []byte `protobuf:"bytes,2,opt,name=native_price_usd,json=nativePriceUsd,proto3" json:"native_price_usd,omitempty"`
+	LinkPriceUsd   []byte `protobuf:"bytes,3,opt,name=link_price_usd,json=linkPriceUsd,proto3" json:"link_price_usd,omitempty"`
+}
+
+func (x *ExecCostPrices) Reset() {
+	*x = ExecCostPrices{}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecCostPrices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecCostPrices) ProtoMessage() {}
+
+func (x *ExecCostPrices) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecCostPrices.ProtoReflect.Descriptor instead.
+func (*ExecCostPrices) Descriptor() ([]byte, []int) {
+	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ExecCostPrices) GetGasPrice() []byte {
+	if x != nil {
+		return x.GasPrice
+	}
+	return nil
+}
+
+func (x *ExecCostPrices) GetNativePriceUsd() []byte {
+	if x != nil {
+		return x.NativePriceUsd
+	}
+	return nil
+}
+
+func (x *ExecCostPrices) GetLinkPriceUsd() []byte {
+	if x != nil {
+		return x.LinkPriceUsd
+	}
+	return nil
+}
+
+type ExecutePluginReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainReports []*ChainReport `protobuf:"bytes,1,rep,name=chain_reports,json=chainReports,proto3" json:"chain_reports,omitempty"`
+}
+
+func (x *ExecutePluginReport) Reset() {
+	*x = ExecutePluginReport{}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutePluginReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutePluginReport) ProtoMessage() {}
+
+func (x *ExecutePluginReport) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutePluginReport.ProtoReflect.Descriptor instead.
+func (*ExecutePluginReport) Descriptor() ([]byte, []int) {
+	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ExecutePluginReport) GetChainReports() []*ChainReport {
+	if x != nil {
+		return x.ChainReports
+	}
+	return nil
+}
+
+type ChainReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceChainSelector uint64           `protobuf:"varint,1,opt,name=source_chain_selector,json=sourceChainSelector,proto3" json:"source_chain_selector,omitempty"`
+	Messages            []*Message       `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	OffchainTokenData   []*RepeatedBytes `protobuf:"bytes,3,rep,name=offchain_token_data,json=offchainTokenData,proto3" json:"offchain_token_data,omitempty"`
+	Proofs              [][]byte         `protobuf:"bytes,4,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	ProofFlagBits       []byte           `protobuf:"bytes,5,opt,name=proof_flag_bits,json=proofFlagBits,proto3" json:"proof_flag_bits,omitempty"`
+}
+
+func (x *ChainReport) Reset() {
+	*x = ChainReport{}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChainReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainReport) ProtoMessage() {}
+
+func (x *ChainReport) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainReport.ProtoReflect.Descriptor instead.
+func (*ChainReport) Descriptor() ([]byte, []int) {
+	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ChainReport) GetSourceChainSelector() uint64 {
+	if x != nil {
+		return x.SourceChainSelector
+	}
+	return 0
+}
+
+func (x *ChainReport) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ChainReport) GetOffchainTokenData() []*RepeatedBytes {
+	if x != nil {
+		return x.OffchainTokenData
+	}
+	return nil
+}
+
+func (x *ChainReport) GetProofs() [][]byte {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+func (x *ChainReport) GetProofFlagBits() []byte {
+	if x != nil {
+		return x.ProofFlagBits
+	}
+	return nil
+}
+
+type RepeatedBytes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items [][]byte `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *RepeatedBytes) Reset() {
+	*x = RepeatedBytes{}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepeatedBytes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedBytes) ProtoMessage() {}
+
+func (x *RepeatedBytes) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedBytes.ProtoReflect.Descriptor instead.
+func (*RepeatedBytes) Descriptor() ([]byte, []int) {
+	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RepeatedBytes) GetItems() [][]byte {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type SeqNumRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinMsgNr uint64 `protobuf:"varint,1,opt,name=min_msg_nr,json=minMsgNr,proto3" json:"min_msg_nr,omitempty"`
+	MaxMsgNr uint64 `protobuf:"varint,2,opt,name=max_msg_nr,json=maxMsgNr,proto3" json:"max_msg_nr,omitempty"`
+}
+
+func (x *SeqNumRange) Reset() {
+	*x = SeqNumRange{}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeqNumRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*SeqNumRange) ProtoMessage() {}
 
 func (x *SeqNumRange) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[40]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[44]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2623,7 +2809,7 @@ func (x *SeqNumRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SeqNumRange.ProtoReflect.Descriptor instead.
 func (*SeqNumRange) Descriptor() ([]byte, []int) {
-	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP(), []int{40}
+	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *SeqNumRange) GetMinMsgNr() uint64 {
@@ -2651,11 +2837,9 @@ type SeqNumChain struct {
 
 func (x *SeqNumChain) Reset() {
 	*x = SeqNumChain{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[41]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SeqNumChain) String() string {
@@ -2665,8 +2849,8 @@ func (x *SeqNumChain) String() string {
 func (*SeqNumChain) ProtoMessage() {}
 
 func (x *SeqNumChain) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[41]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[45]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2678,7 +2862,7 @@ func (x *SeqNumChain) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SeqNumChain.ProtoReflect.Descriptor instead.
 func (*SeqNumChain) Descriptor() ([]byte, []int) {
-	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP(), []int{41}
+	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *SeqNumChain) GetChainSel() uint64 {
@@ -2706,11 +2890,9 @@ type ChainRange struct {
 
 func (x *ChainRange) Reset() {
 	*x = ChainRange{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[42]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ChainRange) String() string {
@@ -2720,8 +2902,8 @@ func (x *ChainRange) String() string {
 func (*ChainRange) ProtoMessage() {}
 
 func (x *ChainRange) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[42]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[46]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2733,7 +2915,7 @@ func (x *ChainRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChainRange.ProtoReflect.Descriptor instead.
 func (*ChainRange) Descriptor() ([]byte, []int) {
-	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP(), []int{42}
+	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *ChainRange) GetChainSel() uint64 {
@@ -2761,11 +2943,9 @@ type SourceChainMeta struct {
 
 func (x *SourceChainMeta) Reset() {
 	*x = SourceChainMeta{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[43]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SourceChainMeta) String() string {
@@ -2775,8 +2955,8 @@ func (x *SourceChainMeta) String() string {
 func (*SourceChainMeta) ProtoMessage() {}
 
 func (x *SourceChainMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[43]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[47]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2788,7 +2968,7 @@ func (x *SourceChainMeta) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SourceChainMeta.ProtoReflect.Descriptor instead.
 func (*SourceChainMeta) Descriptor() ([]byte, []int) {
-	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP(), []int{43}
+	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *SourceChainMeta) GetSourceChainSelector() uint64 {
@@ -2818,11 +2998,9 @@ type MerkleRootChain struct {
 
 func (x *MerkleRootChain) Reset() {
 	*x = MerkleRootChain{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[44]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MerkleRootChain) String() string {
@@ -2832,8 +3010,8 @@ func (x *MerkleRootChain) String() string {
 func (*MerkleRootChain) ProtoMessage() {}
 
 func (x *MerkleRootChain) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[44]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[48]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2845,7 +3023,7 @@ func (x *MerkleRootChain) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MerkleRootChain.ProtoReflect.Descriptor instead.
 func (*MerkleRootChain) Descriptor() ([]byte, []int) {
-	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP(), []int{44}
+	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *MerkleRootChain) GetChainSel() uint64 {
@@ -2887,11 +3065,9 @@ type TimestampedBig struct {
 
 func (x *TimestampedBig) Reset() {
 	*x = TimestampedBig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[45]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *TimestampedBig) String() string {
@@ -2901,8 +3077,8 @@ func (x *TimestampedBig) String() string {
 func (*TimestampedBig) ProtoMessage() {}
 
 func (x *TimestampedBig) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[45]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[49]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2914,7 +3090,7 @@ func (x *TimestampedBig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimestampedBig.ProtoReflect.Descriptor instead.
 func (*TimestampedBig) Descriptor() ([]byte, []int) {
-	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP(), []int{45}
+	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *TimestampedBig) GetTimestamp() *timestamppb.Timestamp {
@@ -2997,7 +3173,7 @@ var file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDesc = []byte{
 	0x6f, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
 	0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e,
 	0x4d, 0x61, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x0b, 0x6d, 0x61, 0x69,
-	0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0xe9, 0x08, 0x0a, 0x0f, 0x45, 0x78, 0x65,
+	0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0xcf, 0x0b, 0x0a, 0x0f, 0x45, 0x78, 0x65,
 	0x63, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x0e,
 	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79,
@@ -3039,611 +3215,673 @@ var file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDesc = []byte{
 	0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
 	0x65, 0x63, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x43,
 	0x68, 0x61, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x1a, 0x69, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f,
-	0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x66, 0x0a, 0x12,
-	0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x73, 0x54, 0x6f, 0x4d, 0x73, 0x67, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70,
-	0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d,
-	0x54, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x60, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x48, 0x61, 0x73, 0x68, 0x65,
+	0x6e, 0x12, 0x72, 0x0a, 0x16, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3d, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x4f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x6f,
+	0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x13, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x0b, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6b, 0x67,
+	0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x43, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x0a, 0x63, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x1a, 0x69, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70,
+	0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x66, 0x0a, 0x12, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x73, 0x54, 0x6f, 0x4d, 0x73, 0x67,
 	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63,
 	0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
-	0x71, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x61, 0x0a, 0x0b, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72,
-	0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x72, 0x54, 0x6f, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0xd6, 0x01, 0x0a, 0x0b, 0x45, 0x78, 0x65, 0x63, 0x4f, 0x75, 0x74,
-	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64,
-	0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12,
-	0x5c, 0x0a, 0x15, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
-	0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65,
-	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x91, 0x01,
-	0x0a, 0x0f, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x72, 0x6d, 0x6e, 0x5f, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x12, 0x72, 0x65, 0x74, 0x72, 0x79, 0x52, 0x6d, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x0e, 0x72, 0x6d, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6b,
+	0x71, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x60, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x48,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x6b,
 	0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x73, 0x52, 0x0d, 0x72, 0x6d, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x73, 0x22, 0xa0, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6b, 0x67,
+	0x31, 0x2e, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x61, 0x0a, 0x0b, 0x4e, 0x6f,
+	0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x6b, 0x67,
 	0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x45, 0x63, 0x64, 0x73, 0x61, 0x52,
-	0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x6c,
-	0x61, 0x6e, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x72, 0x54, 0x6f, 0x4e, 0x6f, 0x6e,
+	0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a,
+	0x0b, 0x46, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x70, 0x0a, 0x18, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74,
+	0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd6, 0x01, 0x0a, 0x0b, 0x45,
+	0x78, 0x65, 0x63, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74,
+	0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x5c, 0x0a, 0x15, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x5f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79,
+	0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x13,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x0f, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f,
+	0x6f, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x74, 0x72, 0x79,
+	0x5f, 0x72, 0x6d, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x72, 0x65, 0x74, 0x72, 0x79, 0x52, 0x6d, 0x6e, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x0e, 0x72, 0x6d, 0x6e,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x0d, 0x72, 0x6d, 0x6e, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0a,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x45, 0x63, 0x64, 0x73, 0x61, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x47, 0x0a, 0x0c, 0x6c, 0x61, 0x6e, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63,
+	0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x6c,
+	0x61, 0x6e, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x0e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x45, 0x63, 0x64, 0x73, 0x61, 0x12, 0x0c, 0x0a, 0x01,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x73,
+	0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x0b,
+	0x6c, 0x61, 0x6e, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63,
-	0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x6c, 0x61, 0x6e, 0x65, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x45, 0x63, 0x64, 0x73, 0x61, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x01, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x01, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x0b, 0x6c, 0x61, 0x6e, 0x65, 0x5f, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6b,
-	0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x65, 0x74,
-	0x61, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x44, 0x0a,
-	0x0d, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79,
-	0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x71, 0x4e, 0x75,
-	0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x52, 0x61,
-	0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x9b, 0x05, 0x0a, 0x15, 0x4d, 0x65, 0x72, 0x6b,
-	0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x47, 0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63,
-	0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
-	0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x0b, 0x6d,
-	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x6e, 0x0a, 0x12, 0x72, 0x6d,
-	0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72,
-	0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72,
-	0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x52, 0x6d, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x72, 0x6d, 0x6e, 0x45, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x50, 0x0a, 0x14, 0x6f, 0x6e,
-	0x5f, 0x72, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75,
-	0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f,
-	0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x71, 0x4e, 0x75, 0x6d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x10, 0x6f, 0x6e, 0x52, 0x61,
-	0x6d, 0x70, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x73, 0x12, 0x54, 0x0a, 0x16,
-	0x6f, 0x66, 0x66, 0x5f, 0x72, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x65,
-	0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70,
-	0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x12,
-	0x6f, 0x66, 0x66, 0x52, 0x61, 0x6d, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x65, 0x71, 0x4e, 0x75,
-	0x6d, 0x73, 0x12, 0x50, 0x0a, 0x11, 0x72, 0x6d, 0x6e, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
-	0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6d, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x0f, 0x72, 0x6d, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x4f, 0x0a, 0x07, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74,
-	0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x6b,
-	0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x46, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x1a, 0x43, 0x0a, 0x15, 0x52, 0x6d, 0x6e, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8e, 0x02, 0x0a, 0x0f, 0x52, 0x6d, 0x6e, 0x52, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x64,
-	0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x07, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6b, 0x67,
+	0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x65, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6b, 0x67,
 	0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x66, 0x5f,
-	0x73, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x66, 0x53, 0x69, 0x67,
-	0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x6d, 0x6e, 0x5f,
-	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x72, 0x6d, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65,
-	0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x6e,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x6f,
-	0x64, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xfd, 0x04, 0x0a, 0x15, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x6b, 0x0a, 0x11, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x70,
+	0x2e, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x73, 0x65,
+	0x71, 0x4e, 0x75, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x9b, 0x05,
+	0x0a, 0x15, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x6b, 0x6c,
+	0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x52, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x73,
+	0x12, 0x6e, 0x0a, 0x12, 0x72, 0x6d, 0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x70,
 	0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x66,
-	0x65, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x7e,
-	0x0a, 0x18, 0x66, 0x65, 0x65, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x45, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f,
-	0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x65,
-	0x51, 0x75, 0x6f, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x66, 0x65, 0x65, 0x51, 0x75, 0x6f, 0x74,
-	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x4f,
-	0x0a, 0x07, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x36, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64,
-	0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12,
-	0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x1a, 0x42, 0x0a, 0x14, 0x46, 0x65, 0x65,
-	0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x6d, 0x6e, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10,
+	0x72, 0x6d, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73,
+	0x12, 0x50, 0x0a, 0x14, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x52, 0x10, 0x6f, 0x6e, 0x52, 0x61, 0x6d, 0x70, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x4e, 0x75,
+	0x6d, 0x73, 0x12, 0x54, 0x0a, 0x16, 0x6f, 0x66, 0x66, 0x5f, 0x72, 0x61, 0x6d, 0x70, 0x5f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x52, 0x12, 0x6f, 0x66, 0x66, 0x52, 0x61, 0x6d, 0x70, 0x4e, 0x65, 0x78,
+	0x74, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x73, 0x12, 0x50, 0x0a, 0x11, 0x72, 0x6d, 0x6e, 0x5f,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6d, 0x6e, 0x52, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0f, 0x72, 0x6d, 0x6e, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4f, 0x0a, 0x07, 0x66, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x6b,
+	0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x1a, 0x43, 0x0a, 0x15, 0x52,
+	0x6d, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8e, 0x02, 0x0a, 0x0f,
+	0x52, 0x6d, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12,
+	0x3f, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73,
+	0x12, 0x15, 0x0a, 0x06, 0x66, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x66, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c,
+	0x0a, 0x12, 0x72, 0x6d, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x72, 0x6d, 0x6e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x10,
+	0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x6f, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xfd, 0x04,
+	0x0a, 0x15, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x11, 0x66, 0x65, 0x65, 0x64, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46,
+	0x65, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x7e, 0x0a, 0x18, 0x66, 0x65, 0x65, 0x5f, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72,
+	0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x66,
+	0x65, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x07, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74,
+	0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x46, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x1a,
+	0x42, 0x0a, 0x14, 0x46, 0x65, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x6d, 0x0a, 0x1a, 0x46, 0x65, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x72,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
 	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x65, 0x64, 0x42, 0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03,
 	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6d, 0x0a,
-	0x1a, 0x46, 0x65, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x39, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70,
-	0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x42, 0x69,
-	0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b,
-	0x46, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xba, 0x06, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x46, 0x65, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x62, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63,
-	0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x73, 0x12, 0x6f, 0x0a, 0x13, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x3f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f,
-	0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x4f,
-	0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4e, 0x61, 0x74, 0x69, 0x76,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x11, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x73, 0x12, 0x69, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65,
-	0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x3d, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64,
-	0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x4f, 0x62,
-	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46,
-	0x65, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12,
-	0x4d, 0x0a, 0x07, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x34, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f,
-	0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x4f,
-	0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x3f,
-	0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x6f, 0x77, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x6f, 0x77, 0x1a,
-	0x69, 0x0a, 0x12, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72,
+	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xba, 0x06,
+	0x0a, 0x13, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x62, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e,
+	0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x4f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x6f, 0x0a, 0x13, 0x6e, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72,
 	0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a, 0x16, 0x4e, 0x61,
-	0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x67, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
+	0x69, 0x6e, 0x46, 0x65, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x69, 0x0a, 0x11, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74,
+	0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x46, 0x65, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x07, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72,
+	0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x46, 0x65, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x46, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x12, 0x3f, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x5f, 0x6e, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x4e, 0x6f, 0x77, 0x1a, 0x69, 0x0a, 0x12, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70,
+	0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x44, 0x0a, 0x16, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x67, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46,
+	0x65, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x39, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x39, 0x0a, 0x0b, 0x46, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6d, 0x0a, 0x12, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x64, 0x61, 0x74, 0x61, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x0e, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x09,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73,
+	0x55, 0x53, 0x44, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x46, 0x65, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x7e, 0x0a,
+	0x13, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x55, 0x53, 0x44, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x73, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x65, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x55, 0x73, 0x64, 0x12, 0x30, 0x0a, 0x15, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x61, 0x76, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x5f, 0x75, 0x73, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x64, 0x61, 0x74, 0x61,
+	0x41, 0x76, 0x46, 0x65, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x55, 0x73, 0x64, 0x22, 0xf9, 0x01,
+	0x0a, 0x14, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x4f, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x07, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63,
+	0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x46, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x56, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52,
+	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x39,
+	0x0a, 0x0b, 0x46, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xde, 0x01, 0x0a, 0x1a, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x5c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x70, 0x6b,
+	0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x62, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
 	0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x70, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb7, 0x01, 0x0a, 0x0f, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x70, 0x12, 0x61,
+	0x0a, 0x0f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63,
+	0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x1a, 0x41, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0x6d, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65,
-	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12,
-	0x32, 0x0a, 0x15, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13,
-	0x64, 0x61, 0x74, 0x61, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x46, 0x65, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
-	0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xdb, 0x05, 0x0a, 0x11, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52,
+	0x6f, 0x6f, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x5c, 0x0a,
+	0x1a, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x17, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x4c, 0x0a, 0x0f, 0x72,
+	0x6f, 0x6f, 0x74, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79,
+	0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c,
+	0x65, 0x52, 0x6f, 0x6f, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x0d, 0x72, 0x6f, 0x6f, 0x74,
+	0x73, 0x54, 0x6f, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x6a, 0x0a, 0x12, 0x72, 0x6d, 0x6e,
+	0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74,
+	0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x6b,
+	0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x2e, 0x52, 0x6d,
+	0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x10, 0x72, 0x6d, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x54, 0x0a, 0x16, 0x6f, 0x66, 0x66, 0x5f, 0x72, 0x61, 0x6d,
+	0x70, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74,
+	0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x71, 0x4e,
+	0x75, 0x6d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x12, 0x6f, 0x66, 0x66, 0x52, 0x61, 0x6d, 0x70,
+	0x4e, 0x65, 0x78, 0x74, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x73, 0x12, 0x4b, 0x0a, 0x22, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x57, 0x0a, 0x15, 0x72, 0x6d, 0x6e, 0x5f,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63,
+	0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x45, 0x63, 0x64, 0x73, 0x61, 0x52, 0x13, 0x72, 0x6d,
+	0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x4a, 0x0a, 0x0e, 0x72, 0x6d, 0x6e, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f,
+	0x63, 0x66, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
 	0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x55, 0x53, 0x44, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x73, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x38, 0x0a,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x7e, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x73, 0x55, 0x53, 0x44, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x35,
-	0x0a, 0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x14, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x55, 0x73, 0x64, 0x12, 0x30, 0x0a, 0x15, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x76,
-	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x64, 0x61, 0x74, 0x61, 0x41, 0x76, 0x46, 0x65, 0x65, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x55, 0x73, 0x64, 0x22, 0xf9, 0x01, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x79, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x4e, 0x0a, 0x07, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x35, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63,
-	0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x79, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x12, 0x56, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f,
-	0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0xde, 0x01, 0x0a, 0x1a, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x12, 0x5c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74,
-	0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x1a, 0x62, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70,
-	0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0xb7, 0x01, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x70, 0x12, 0x61, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x38, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63,
-	0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xdb,
-	0x05, 0x0a, 0x11, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4f, 0x75, 0x74,
-	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x63,
-	0x6f, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x5c, 0x0a, 0x1a, 0x72, 0x61, 0x6e, 0x67, 0x65,
-	0x73, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6b,
-	0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x17, 0x72, 0x61,
-	0x6e, 0x67, 0x65, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x4c, 0x0a, 0x0f, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x5f, 0x74,
-	0x6f, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x52, 0x6d, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x0c, 0x72, 0x6d, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x66, 0x67, 0x1a, 0x43, 0x0a,
+	0x15, 0x52, 0x6d, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xaf, 0x01, 0x0a, 0x11, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x5a, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37,
 	0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65,
-	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x52, 0x0d, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x12, 0x6a, 0x0a, 0x12, 0x72, 0x6d, 0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x3c, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64,
-	0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74,
-	0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x2e, 0x52, 0x6d, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x72,
-	0x6d, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12,
-	0x54, 0x0a, 0x16, 0x6f, 0x66, 0x66, 0x5f, 0x72, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x65, 0x78, 0x74,
-	0x5f, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64,
-	0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x52, 0x12, 0x6f, 0x66, 0x66, 0x52, 0x61, 0x6d, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x65,
-	0x71, 0x4e, 0x75, 0x6d, 0x73, 0x12, 0x4b, 0x0a, 0x22, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x1f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
-	0x74, 0x73, 0x12, 0x57, 0x0a, 0x15, 0x72, 0x6d, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63,
-	0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x45, 0x63, 0x64, 0x73, 0x61, 0x52, 0x13, 0x72, 0x6d, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0e, 0x72,
-	0x6d, 0x6e, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x66, 0x67, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70,
-	0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6d, 0x6e, 0x52, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x72, 0x6d, 0x6e, 0x52, 0x65,
-	0x6d, 0x6f, 0x74, 0x65, 0x43, 0x66, 0x67, 0x1a, 0x43, 0x0a, 0x15, 0x52, 0x6d, 0x6e, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xaf, 0x01, 0x0a,
-	0x11, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x63, 0x6f,
-	0x6d, 0x65, 0x12, 0x5a, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f,
-	0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
-	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x3e,
-	0x0a, 0x10, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x54,
-	0x0a, 0x0f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
-	0x65, 0x12, 0x41, 0x0a, 0x0a, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74,
-	0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x73, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x09, 0x67, 0x61, 0x73, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x0d, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73,
-	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x53,
-	0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22,
-	0x8f, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12,
-	0x4a, 0x0a, 0x22, 0x69, 0x6e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x5f, 0x6f, 0x63, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1e, 0x69, 0x6e, 0x66,
-	0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x63, 0x72, 0x53, 0x65, 0x71,
-	0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x16, 0x72,
-	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63,
-	0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x72, 0x65, 0x6d,
-	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x73, 0x22, 0x56, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x62, 0x73, 0x65, 0x72,
-	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70,
-	0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x22, 0xa6, 0x04, 0x0a, 0x0a, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6f,
-	0x6e, 0x5f, 0x72, 0x61, 0x6d, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6f, 0x6e, 0x52, 0x61, 0x6d, 0x70, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1b, 0x0a,
-	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65,
-	0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x54, 0x0a, 0x15, 0x73,
-	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x72,
-	0x61, 0x6e, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6b, 0x67,
-	0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x13, 0x73, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x04, 0x52, 0x10, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x38,
-	0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f,
-	0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68,
-	0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73,
-	0x12, 0x2b, 0x0a, 0x0f, 0x63, 0x6f, 0x73, 0x74, 0x6c, 0x79, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x63,
-	0x6f, 0x73, 0x74, 0x6c, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x53, 0x0a,
-	0x12, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
-	0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x10, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61,
-	0x74, 0x61, 0x22, 0x51, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6b, 0x67,
-	0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x35, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbc, 0x01, 0x0a,
-	0x0f, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x4e, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65,
-	0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x54,
-	0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x1a, 0x59, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65,
-	0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb1, 0x01, 0x0a, 0x0d,
-	0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x5e, 0x0a,
-	0x10, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63,
-	0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
-	0x71, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x42, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x71, 0x4e,
-	0x75, 0x6d, 0x54, 0x6f, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d,
-	0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x42, 0x79, 0x74, 0x65, 0x73, 0x1a, 0x40, 0x0a,
-	0x12, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0xd7, 0x01, 0x0a, 0x15, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x58, 0x0a, 0x0a, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e,
-	0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x4f, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44,
-	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44,
-	0x61, 0x74, 0x61, 0x1a, 0x64, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72,
-	0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x71,
-	0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xce, 0x01, 0x0a, 0x11, 0x53, 0x65,
-	0x71, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x54, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70,
-	0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d,
-	0x54, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x63, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61,
-	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f,
-	0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xea, 0x02, 0x0a, 0x07, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72,
-	0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6d,
-	0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x1d,
-	0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1b, 0x0a,
-	0x09, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x08, 0x66, 0x65, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65,
-	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x65, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x5f, 0x6a, 0x75, 0x65, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x66,
-	0x65, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4a, 0x75, 0x65, 0x6c, 0x73, 0x12, 0x49, 0x0a, 0x0d,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70,
-	0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6d, 0x70, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x89, 0x02, 0x0a, 0x11, 0x52, 0x61, 0x6d, 0x70,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a,
-	0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x15,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x12, 0x2e, 0x0a, 0x13, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x64,
-	0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65,
-	0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
-	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
-	0x19, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x48, 0x61, 0x73, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x6e,
-	0x5f, 0x72, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6f, 0x6e, 0x52,
-	0x61, 0x6d, 0x70, 0x22, 0xcc, 0x01, 0x0a, 0x0f, 0x52, 0x61, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65, 0x73, 0x74, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x10, 0x64, 0x65, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e,
-	0x64, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x44, 0x61,
-	0x74, 0x61, 0x22, 0x9a, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64,
-	0x72, 0x54, 0x6f, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x6e, 0x6f, 0x6e, 0x63,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x54, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65,
+	0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x67, 0x61, 0x73, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x6b,
+	0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52,
+	0x09, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x0d, 0x47, 0x61,
+	0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x61, 0x73,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x69, 0x6e, 0x4f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x22, 0x69, 0x6e, 0x66, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6f, 0x63, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x1e, 0x69, 0x6e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x4f, 0x63, 0x72, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x14, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0x56, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x22,
+	0xa6, 0x04, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x6d, 0x70, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6f, 0x6e, 0x52, 0x61,
+	0x6d, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f,
+	0x74, 0x12, 0x54, 0x0a, 0x15, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x13, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72,
+	0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06,
+	0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x0f, 0x63, 0x6f, 0x73, 0x74, 0x6c, 0x79,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0c, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x73, 0x74, 0x6c, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x51, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x0a,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x35, 0x0a, 0x09, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0xbc, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4e, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f,
 	0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x72, 0x54, 0x6f, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
-	0x2e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6e, 0x6f,
-	0x6e, 0x63, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x5c, 0x0a, 0x13, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x45, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
-	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x65, 0x71, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x59, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f,
+	0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xb1, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x5e, 0x0a, 0x10, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x74,
+	0x6f, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e,
 	0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
-	0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x8f, 0x02,
-	0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x32, 0x0a,
-	0x15, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x12, 0x38, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70,
-	0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x13, 0x6f,
-	0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x2e, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x1a, 0x40, 0x0a, 0x12, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd7, 0x01, 0x0a, 0x15, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x58, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x64, 0x0a, 0x0e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70,
+	0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xce, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x54, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
+	0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x63, 0x0a, 0x0e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xea, 0x02, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70,
+	0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x61, 0x72,
+	0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x41,
+	0x72, 0x67, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x65, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x65,
+	0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6a, 0x75, 0x65, 0x6c, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4a, 0x75, 0x65,
+	0x6c, 0x73, 0x12, 0x49, 0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
+	0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x61, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x89, 0x02,
+	0x0a, 0x11, 0x52, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x11, 0x64, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x17, 0x0a, 0x07, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x6f, 0x6e, 0x52, 0x61, 0x6d, 0x70, 0x22, 0xcc, 0x01, 0x0a, 0x0f, 0x52, 0x61,
+	0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a,
+	0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a,
+	0x12, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x64, 0x65, 0x73, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74,
+	0x45, 0x78, 0x65, 0x63, 0x44, 0x61, 0x74, 0x61, 0x22, 0x9a, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x72, 0x54, 0x6f, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x4a,
+	0x0a, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32,
+	0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x72, 0x54,
+	0x6f, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4e, 0x6f,
+	0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd8, 0x01, 0x0a, 0x13, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50,
+	0x6f, 0x6f, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x59, 0x0a,
+	0x0b, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f,
+	0x6f, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x2e, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x72, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x1a, 0x66, 0x0a, 0x0f, 0x52, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70,
+	0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x85, 0x01, 0x0a, 0x12, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x3f, 0x0a, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f,
 	0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x11, 0x6f, 0x66,
-	0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52,
-	0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x5f, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0d, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x6c, 0x61, 0x67, 0x42, 0x69, 0x74, 0x73, 0x22,
-	0x25, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x49, 0x0a, 0x0b, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d,
-	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x67,
-	0x5f, 0x6e, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x4d, 0x73,
-	0x67, 0x4e, 0x72, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x6e,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x4d, 0x73, 0x67, 0x4e,
-	0x72, 0x22, 0x43, 0x0a, 0x0b, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x10, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6c, 0x61, 0x73,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x7d, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63, 0x43,
+	0x6f, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x61,
+	0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x55, 0x73, 0x64,
+	0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x75,
+	0x73, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x55, 0x73, 0x64, 0x22, 0x5c, 0x0a, 0x13, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x45, 0x0a,
+	0x0d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79,
+	0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x22, 0x8f, 0x02, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x6b, 0x67,
+	0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0x52, 0x0a, 0x13, 0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x52, 0x11, 0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x62, 0x69, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x6c,
+	0x61, 0x67, 0x42, 0x69, 0x74, 0x73, 0x22, 0x25, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x49, 0x0a,
+	0x0b, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x0a,
+	0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x6e, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x6d, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x4e, 0x72, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x61,
+	0x78, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x6e, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x4d, 0x73, 0x67, 0x4e, 0x72, 0x22, 0x43, 0x0a, 0x0b, 0x53, 0x65, 0x71, 0x4e,
+	0x75, 0x6d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x73, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x53, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x22, 0x6f, 0x0a,
+	0x0a, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x12, 0x44, 0x0a, 0x0d, 0x73, 0x65, 0x71, 0x5f,
+	0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x0b, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x6c,
+	0x0a, 0x0f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x65, 0x74,
+	0x61, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x6e, 0x72, 0x61, 0x6d, 0x70, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6f,
+	0x6e, 0x72, 0x61, 0x6d, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xbf, 0x01, 0x0a,
+	0x0f, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e,
 	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x12, 0x17, 0x0a,
-	0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x22, 0x6f, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52,
-	0x61, 0x6e, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x65,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65,
-	0x6c, 0x12, 0x44, 0x0a, 0x0d, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x61, 0x6e,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f,
-	0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x71, 0x4e, 0x75, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x73, 0x65, 0x71, 0x4e,
-	0x75, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x6c, 0x0a, 0x0f, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x25,
-	0x0a, 0x0e, 0x6f, 0x6e, 0x72, 0x61, 0x6d, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6f, 0x6e, 0x72, 0x61, 0x6d, 0x70, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x0f, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
-	0x52, 0x6f, 0x6f, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x6d,
-	0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0d, 0x6f, 0x6e, 0x52, 0x61, 0x6d, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46,
-	0x0a, 0x0e, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72,
-	0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x71,
-	0x4e, 0x75, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d,
-	0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
-	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6d, 0x65, 0x72,
-	0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x60, 0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x42, 0x69, 0x67, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x13, 0x5a, 0x11, 0x2e, 0x2f, 0x3b,
-	0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x12, 0x26, 0x0a,
+	0x0f, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x6d, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6f, 0x6e, 0x52, 0x61, 0x6d, 0x70, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d,
+	0x73, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x70, 0x6b, 0x67, 0x2e, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x0c, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x60,
+	0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x42, 0x69, 0x67,
+	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x13, 0x5a, 0x11, 0x2e, 0x2f, 0x3b, 0x6f, 0x63, 0x72, 0x74, 0x79, 0x70, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3658,8 +3896,8 @@ func file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescGZIP() []byte {
 	return file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDescData
 }
 
-var file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes = make([]protoimpl.MessageInfo, 71)
-var file_pkg_ocrtypecodec_v1_ocrtypes_proto_goTypes = []interface{}{
+var file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes = make([]protoimpl.MessageInfo, 77)
+var file_pkg_ocrtypecodec_v1_ocrtypes_proto_goTypes = []any{
 	(*CommitQuery)(nil),                // 0: pkg.ocrtypecodec.v1.CommitQuery
 	(*CommitObservation)(nil),          // 1: pkg.ocrtypecodec.v1.CommitObservation
 	(*CommitOutcome)(nil),              // 2: pkg.ocrtypecodec.v1.CommitOutcome
@@ -3697,41 +3935,47 @@ var file_pkg_ocrtypecodec_v1_ocrtypes_proto_goTypes = []interface{}{
 	(*RampMessageHeader)(nil),          // 34: pkg.ocrtypecodec.v1.RampMessageHeader
 	(*RampTokenAmount)(nil),            // 35: pkg.ocrtypecodec.v1.RampTokenAmount
 	(*StringAddrToNonce)(nil),          // 36: pkg.ocrtypecodec.v1.StringAddrToNonce
-	(*ExecutePluginReport)(nil),        // 37: pkg.ocrtypecodec.v1.ExecutePluginReport
-	(*ChainReport)(nil),                // 38: pkg.ocrtypecodec.v1.ChainReport
-	(*RepeatedBytes)(nil),              // 39: pkg.ocrtypecodec.v1.RepeatedBytes
-	(*SeqNumRange)(nil),                // 40: pkg.ocrtypecodec.v1.SeqNumRange
-	(*SeqNumChain)(nil),                // 41: pkg.ocrtypecodec.v1.SeqNumChain
-	(*ChainRange)(nil),                 // 42: pkg.ocrtypecodec.v1.ChainRange
-	(*SourceChainMeta)(nil),            // 43: pkg.ocrtypecodec.v1.SourceChainMeta
-	(*MerkleRootChain)(nil),            // 44: pkg.ocrtypecodec.v1.MerkleRootChain
-	(*TimestampedBig)(nil),             // 45: pkg.ocrtypecodec.v1.TimestampedBig
-	nil,                                // 46: pkg.ocrtypecodec.v1.CommitObservation.FChainEntry
-	nil,                                // 47: pkg.ocrtypecodec.v1.ExecObservation.CommitReportsEntry
-	nil,                                // 48: pkg.ocrtypecodec.v1.ExecObservation.SeqNumsToMsgsEntry
-	nil,                                // 49: pkg.ocrtypecodec.v1.ExecObservation.MsgHashesEntry
-	nil,                                // 50: pkg.ocrtypecodec.v1.ExecObservation.NoncesEntry
-	nil,                                // 51: pkg.ocrtypecodec.v1.ExecObservation.FChainEntry
-	nil,                                // 52: pkg.ocrtypecodec.v1.MerkleRootObservation.RmnEnabledChainsEntry
-	nil,                                // 53: pkg.ocrtypecodec.v1.MerkleRootObservation.FChainEntry
-	nil,                                // 54: pkg.ocrtypecodec.v1.TokenPriceObservation.FeedTokenPricesEntry
-	nil,                                // 55: pkg.ocrtypecodec.v1.TokenPriceObservation.FeeQuoterTokenUpdatesEntry
-	nil,                                // 56: pkg.ocrtypecodec.v1.TokenPriceObservation.FChainEntry
-	nil,                                // 57: pkg.ocrtypecodec.v1.ChainFeeObservation.FeeComponentsEntry
-	nil,                                // 58: pkg.ocrtypecodec.v1.ChainFeeObservation.NativeTokenPricesEntry
-	nil,                                // 59: pkg.ocrtypecodec.v1.ChainFeeObservation.ChainFeeUpdatesEntry
-	nil,                                // 60: pkg.ocrtypecodec.v1.ChainFeeObservation.FChainEntry
-	nil,                                // 61: pkg.ocrtypecodec.v1.DiscoveryObservation.FChainEntry
-	nil,                                // 62: pkg.ocrtypecodec.v1.ContractNameChainAddresses.AddressesEntry
-	nil,                                // 63: pkg.ocrtypecodec.v1.ChainAddressMap.ChainAddressesEntry
-	nil,                                // 64: pkg.ocrtypecodec.v1.MerkleRootOutcome.RmnEnabledChainsEntry
-	nil,                                // 65: pkg.ocrtypecodec.v1.TokenPriceOutcome.TokenPricesEntry
-	nil,                                // 66: pkg.ocrtypecodec.v1.SeqNumToMessage.MessagesEntry
-	nil,                                // 67: pkg.ocrtypecodec.v1.SeqNumToBytes.SeqNumToBytesEntry
-	nil,                                // 68: pkg.ocrtypecodec.v1.TokenDataObservations.TokenDataEntry
-	nil,                                // 69: pkg.ocrtypecodec.v1.SeqNumToTokenData.TokenDataEntry
-	nil,                                // 70: pkg.ocrtypecodec.v1.StringAddrToNonce.NoncesEntry
-	(*timestamppb.Timestamp)(nil),      // 71: google.protobuf.Timestamp
+	(*TokenPoolRateLimits)(nil),        // 37: pkg.ocrtypecodec.v1.TokenPoolRateLimits
+	(*TokenPoolRateLimit)(nil),         // 38: pkg.ocrtypecodec.v1.TokenPoolRateLimit
+	(*RateLimiterState)(nil),           // 39: pkg.ocrtypecodec.v1.RateLimiterState
+	(*ExecCostPrices)(nil),             // 40: pkg.ocrtypecodec.v1.ExecCostPrices
+	(*ExecutePluginReport)(nil),        // 41: pkg.ocrtypecodec.v1.ExecutePluginReport
+	(*ChainReport)(nil),                // 42: pkg.ocrtypecodec.v1.ChainReport
+	(*RepeatedBytes)(nil),              // 43: pkg.ocrtypecodec.v1.RepeatedBytes
+	(*SeqNumRange)(nil),                // 44: pkg.ocrtypecodec.v1.SeqNumRange
+	(*SeqNumChain)(nil),                // 45: pkg.ocrtypecodec.v1.SeqNumChain
+	(*ChainRange)(nil),                 // 46: pkg.ocrtypecodec.v1.ChainRange
+	(*SourceChainMeta)(nil),            // 47: pkg.ocrtypecodec.v1.SourceChainMeta
+	(*MerkleRootChain)(nil),            // 48: pkg.ocrtypecodec.v1.MerkleRootChain
+	(*TimestampedBig)(nil),             // 49: pkg.ocrtypecodec.v1.TimestampedBig
+	nil,                                // 50: pkg.ocrtypecodec.v1.CommitObservation.FChainEntry
+	nil,                                // 51: pkg.ocrtypecodec.v1.ExecObservation.CommitReportsEntry
+	nil,                                // 52: pkg.ocrtypecodec.v1.ExecObservation.SeqNumsToMsgsEntry
+	nil,                                // 53: pkg.ocrtypecodec.v1.ExecObservation.MsgHashesEntry
+	nil,                                // 54: pkg.ocrtypecodec.v1.ExecObservation.NoncesEntry
+	nil,                                // 55: pkg.ocrtypecodec.v1.ExecObservation.FChainEntry
+	nil,                                // 56: pkg.ocrtypecodec.v1.ExecObservation.TokenPoolRateLimitsEntry
+	nil,                                // 57: pkg.ocrtypecodec.v1.MerkleRootObservation.RmnEnabledChainsEntry
+	nil,                                // 58: pkg.ocrtypecodec.v1.MerkleRootObservation.FChainEntry
+	nil,                                // 59: pkg.ocrtypecodec.v1.TokenPriceObservation.FeedTokenPricesEntry
+	nil,                                // 60: pkg.ocrtypecodec.v1.TokenPriceObservation.FeeQuoterTokenUpdatesEntry
+	nil,                                // 61: pkg.ocrtypecodec.v1.TokenPriceObservation.FChainEntry
+	nil,                                // 62: pkg.ocrtypecodec.v1.ChainFeeObservation.FeeComponentsEntry
+	nil,                                // 63: pkg.ocrtypecodec.v1.ChainFeeObservation.NativeTokenPricesEntry
+	nil,                                // 64: pkg.ocrtypecodec.v1.ChainFeeObservation.ChainFeeUpdatesEntry
+	nil,                                // 65: pkg.ocrtypecodec.v1.ChainFeeObservation.FChainEntry
+	nil,                                // 66: pkg.ocrtypecodec.v1.DiscoveryObservation.FChainEntry
+	nil,                                // 67: pkg.ocrtypecodec.v1.ContractNameChainAddresses.AddressesEntry
+	nil,                                // 68: pkg.ocrtypecodec.v1.ChainAddressMap.ChainAddressesEntry
+	nil,                                // 69: pkg.ocrtypecodec.v1.MerkleRootOutcome.RmnEnabledChainsEntry
+	nil,                                // 70: pkg.ocrtypecodec.v1.TokenPriceOutcome.TokenPricesEntry
+	nil,                                // 71: pkg.ocrtypecodec.v1.SeqNumToMessage.MessagesEntry
+	nil,                                // 72: pkg.ocrtypecodec.v1.SeqNumToBytes.SeqNumToBytesEntry
+	nil,                                // 73: pkg.ocrtypecodec.v1.TokenDataObservations.TokenDataEntry
+	nil,                                // 74: pkg.ocrtypecodec.v1.SeqNumToTokenData.TokenDataEntry
+	nil,                                // 75: pkg.ocrtypecodec.v1.StringAddrToNonce.NoncesEntry
+	nil,                                // 76: pkg.ocrtypecodec.v1.TokenPoolRateLimits.RateLimitsEntry
+	(*timestamppb.Timestamp)(nil),      // 77: google.protobuf.Timestamp
 }
 var file_pkg_ocrtypecodec_v1_ocrtypes_proto_depIdxs = []int32{
 	5,  // 0: pkg.ocrtypecodec.v1.CommitQuery.merkle_root_query:type_name -> pkg.ocrtypecodec.v1.MerkleRootQuery
@@ -3739,90 +3983,97 @@ var file_pkg_ocrtypecodec_v1_ocrtypes_proto_depIdxs = []int32{
 	12, // 2: pkg.ocrtypecodec.v1.CommitObservation.token_price_obs:type_name -> pkg.ocrtypecodec.v1.TokenPriceObservation
 	13, // 3: pkg.ocrtypecodec.v1.CommitObservation.chain_fee_obs:type_name -> pkg.ocrtypecodec.v1.ChainFeeObservation
 	17, // 4: pkg.ocrtypecodec.v1.CommitObservation.discovery_obs:type_name -> pkg.ocrtypecodec.v1.DiscoveryObservation
-	46, // 5: pkg.ocrtypecodec.v1.CommitObservation.f_chain:type_name -> pkg.ocrtypecodec.v1.CommitObservation.FChainEntry
+	50, // 5: pkg.ocrtypecodec.v1.CommitObservation.f_chain:type_name -> pkg.ocrtypecodec.v1.CommitObservation.FChainEntry
 	20, // 6: pkg.ocrtypecodec.v1.CommitOutcome.merkle_root_outcome:type_name -> pkg.ocrtypecodec.v1.MerkleRootOutcome
 	21, // 7: pkg.ocrtypecodec.v1.CommitOutcome.token_price_outcome:type_name -> pkg.ocrtypecodec.v1.TokenPriceOutcome
 	22, // 8: pkg.ocrtypecodec.v1.CommitOutcome.chain_fee_outcome:type_name -> pkg.ocrtypecodec.v1.ChainFeeOutcome
 	24, // 9: pkg.ocrtypecodec.v1.CommitOutcome.main_outcome:type_name -> pkg.ocrtypecodec.v1.MainOutcome
-	47, // 10: pkg.ocrtypecodec.v1.ExecObservation.commit_reports:type_name -> pkg.ocrtypecodec.v1.ExecObservation.CommitReportsEntry
-	48, // 11: pkg.ocrtypecodec.v1.ExecObservation.seq_nums_to_msgs:type_name -> pkg.ocrtypecodec.v1.ExecObservation.SeqNumsToMsgsEntry
-	49, // 12: pkg.ocrtypecodec.v1.ExecObservation.msg_hashes:type_name -> pkg.ocrtypecodec.v1.ExecObservation.MsgHashesEntry
+	51, // 10: pkg.ocrtypecodec.v1.ExecObservation.commit_reports:type_name -> pkg.ocrtypecodec.v1.ExecObservation.CommitReportsEntry
+	52, // 11: pkg.ocrtypecodec.v1.ExecObservation.seq_nums_to_msgs:type_name -> pkg.ocrtypecodec.v1.ExecObservation.SeqNumsToMsgsEntry
+	53, // 12: pkg.ocrtypecodec.v1.ExecObservation.msg_hashes:type_name -> pkg.ocrtypecodec.v1.ExecObservation.MsgHashesEntry
 	31, // 13: pkg.ocrtypecodec.v1.ExecObservation.token_data_observations:type_name -> pkg.ocrtypecodec.v1.TokenDataObservations
-	50, // 14: pkg.ocrtypecodec.v1.ExecObservation.nonces:type_name -> pkg.ocrtypecodec.v1.ExecObservation.NoncesEntry
+	54, // 14: pkg.ocrtypecodec.v1.ExecObservation.nonces:type_name -> pkg.ocrtypecodec.v1.ExecObservation.NoncesEntry
 	17, // 15: pkg.ocrtypecodec.v1.ExecObservation.contracts:type_name -> pkg.ocrtypecodec.v1.DiscoveryObservation
-	51, // 16: pkg.ocrtypecodec.v1.ExecObservation.f_chain:type_name -> pkg.ocrtypecodec.v1.ExecObservation.FChainEntry
-	26, // 17: pkg.ocrtypecodec.v1.ExecOutcome.commit_reports:type_name -> pkg.ocrtypecodec.v1.CommitData
-	37, // 18: pkg.ocrtypecodec.v1.ExecOutcome.execute_plugin_report:type_name -> pkg.ocrtypecodec.v1.ExecutePluginReport
-	6,  // 19: pkg.ocrtypecodec.v1.MerkleRootQuery.rmn_signatures:type_name -> pkg.ocrtypecodec.v1.ReportSignatures
-	7,  // 20: pkg.ocrtypecodec.v1.ReportSignatures.signatures:type_name -> pkg.ocrtypecodec.v1.SignatureEcdsa
-	8,  // 21: pkg.ocrtypecodec.v1.ReportSignatures.lane_updates:type_name -> pkg.ocrtypecodec.v1.DestChainUpdate
-	43, // 22: pkg.ocrtypecodec.v1.DestChainUpdate.lane_source:type_name -> pkg.ocrtypecodec.v1.SourceChainMeta
-	40, // 23: pkg.ocrtypecodec.v1.DestChainUpdate.seq_num_range:type_name -> pkg.ocrtypecodec.v1.SeqNumRange
-	44, // 24: pkg.ocrtypecodec.v1.MerkleRootObservation.merkle_roots:type_name -> pkg.ocrtypecodec.v1.MerkleRootChain
-	52, // 25: pkg.ocrtypecodec.v1.MerkleRootObservation.rmn_enabled_chains:type_name -> pkg.ocrtypecodec.v1.MerkleRootObservation.RmnEnabledChainsEntry
-	41, // 26: pkg.ocrtypecodec.v1.MerkleRootObservation.on_ramp_max_seq_nums:type_name -> pkg.ocrtypecodec.v1.SeqNumChain
-	41, // 27: pkg.ocrtypecodec.v1.MerkleRootObservation.off_ramp_next_seq_nums:type_name -> pkg.ocrtypecodec.v1.SeqNumChain
-	10, // 28: pkg.ocrtypecodec.v1.MerkleRootObservation.rmn_remote_config:type_name -> pkg.ocrtypecodec.v1.RmnRemoteConfig
-	53, // 29: pkg.ocrtypecodec.v1.MerkleRootObservation.f_chain:type_name -> pkg.ocrtypecodec.v1.MerkleRootObservation.FChainEntry
-	11, // 30: pkg.ocrtypecodec.v1.RmnRemoteConfig.signers:type_name -> pkg.ocrtypecodec.v1.RemoteSignerInfo
-	54, // 31: pkg.ocrtypecodec.v1.TokenPriceObservation.feed_token_prices:type_name -> pkg.ocrtypecodec.v1.TokenPriceObservation.FeedTokenPricesEntry
-	55, // 32: pkg.ocrtypecodec.v1.TokenPriceObservation.fee_quoter_token_updates:type_name -> pkg.ocrtypecodec.v1.TokenPriceObservation.FeeQuoterTokenUpdatesEntry
-	56, // 33: pkg.ocrtypecodec.v1.TokenPriceObservation.f_chain:type_name -> pkg.ocrtypecodec.v1.TokenPriceObservation.FChainEntry
-	71, // 34: pkg.ocrtypecodec.v1.TokenPriceObservation.timestamp:type_name -> google.protobuf.Timestamp
-	57, // 35: pkg.ocrtypecodec.v1.ChainFeeObservation.fee_components:type_name -> pkg.ocrtypecodec.v1.ChainFeeObservation.FeeComponentsEntry
-	58, // 36: pkg.ocrtypecodec.v1.ChainFeeObservation.native_token_prices:type_name -> pkg.ocrtypecodec.v1.ChainFeeObservation.NativeTokenPricesEntry
-	59, // 37: pkg.ocrtypecodec.v1.ChainFeeObservation.chain_fee_updates:type_name -> pkg.ocrtypecodec.v1.ChainFeeObservation.ChainFeeUpdatesEntry
-	60, // 38: pkg.ocrtypecodec.v1.ChainFeeObservation.f_chain:type_name -> pkg.ocrtypecodec.v1.ChainFeeObservation.FChainEntry
-	71, // 39: pkg.ocrtypecodec.v1.ChainFeeObservation.timestamp_now:type_name -> google.protobuf.Timestamp
-	16, // 40: pkg.ocrtypecodec.v1.ChainFeeUpdate.chain_fee:type_name -> pkg.ocrtypecodec.v1.ComponentsUSDPrices
-	71, // 41: pkg.ocrtypecodec.v1.ChainFeeUpdate.timestamp:type_name -> google.protobuf.Timestamp
-	61, // 42: pkg.ocrtypecodec.v1.DiscoveryObservation.f_chain:type_name -> pkg.ocrtypecodec.v1.DiscoveryObservation.FChainEntry
-	18, // 43: pkg.ocrtypecodec.v1.DiscoveryObservation.contract_names:type_name -> pkg.ocrtypecodec.v1.ContractNameChainAddresses
-	62, // 44: pkg.ocrtypecodec.v1.ContractNameChainAddresses.addresses:type_name -> pkg.ocrtypecodec.v1.ContractNameChainAddresses.AddressesEntry
-	63, // 45: pkg.ocrtypecodec.v1.ChainAddressMap.chain_addresses:type_name -> pkg.ocrtypecodec.v1.ChainAddressMap.ChainAddressesEntry
-	42, // 46: pkg.ocrtypecodec.v1.MerkleRootOutcome.ranges_selected_for_report:type_name -> pkg.ocrtypecodec.v1.ChainRange
-	44, // 47: pkg.ocrtypecodec.v1.MerkleRootOutcome.roots_to_report:type_name -> pkg.ocrtypecodec.v1.MerkleRootChain
-	64, // 48: pkg.ocrtypecodec.v1.MerkleRootOutcome.rmn_enabled_chains:type_name -> pkg.ocrtypecodec.v1.MerkleRootOutcome.RmnEnabledChainsEntry
-	41, // 49: pkg.ocrtypecodec.v1.MerkleRootOutcome.off_ramp_next_seq_nums:type_name -> pkg.ocrtypecodec.v1.SeqNumChain
-	7,  // 50: pkg.ocrtypecodec.v1.MerkleRootOutcome.rmn_report_signatures:type_name -> pkg.ocrtypecodec.v1.SignatureEcdsa
-	10, // 51: pkg.ocrtypecodec.v1.MerkleRootOutcome.rmn_remote_cfg:type_name -> pkg.ocrtypecodec.v1.RmnRemoteConfig
-	65, // 52: pkg.ocrtypecodec.v1.TokenPriceOutcome.token_prices:type_name -> pkg.ocrtypecodec.v1.TokenPriceOutcome.TokenPricesEntry
-	23, // 53: pkg.ocrtypecodec.v1.ChainFeeOutcome.gas_prices:type_name -> pkg.ocrtypecodec.v1.GasPriceChain
-	26, // 54: pkg.ocrtypecodec.v1.CommitObservations.commit_data:type_name -> pkg.ocrtypecodec.v1.CommitData
-	71, // 55: pkg.ocrtypecodec.v1.CommitData.timestamp:type_name -> google.protobuf.Timestamp
-	40, // 56: pkg.ocrtypecodec.v1.CommitData.sequence_number_range:type_name -> pkg.ocrtypecodec.v1.SeqNumRange
-	33, // 57: pkg.ocrtypecodec.v1.CommitData.messages:type_name -> pkg.ocrtypecodec.v1.Message
-	27, // 58: pkg.ocrtypecodec.v1.CommitData.message_token_data:type_name -> pkg.ocrtypecodec.v1.MessageTokenData
-	28, // 59: pkg.ocrtypecodec.v1.MessageTokenData.token_data:type_name -> pkg.ocrtypecodec.v1.TokenData
-	66, // 60: pkg.ocrtypecodec.v1.SeqNumToMessage.messages:type_name -> pkg.ocrtypecodec.v1.SeqNumToMessage.MessagesEntry
-	67, // 61: pkg.ocrtypecodec.v1.SeqNumToBytes.seq_num_to_bytes:type_name -> pkg.ocrtypecodec.v1.SeqNumToBytes.SeqNumToBytesEntry
-	68, // 62: pkg.ocrtypecodec.v1.TokenDataObservations.token_data:type_name -> pkg.ocrtypecodec.v1.TokenDataObservations.TokenDataEntry
-	69, // 63: pkg.ocrtypecodec.v1.SeqNumToTokenData.token_data:type_name -> pkg.ocrtypecodec.v1.SeqNumToTokenData.TokenDataEntry
-	34, // 64: pkg.ocrtypecodec.v1.Message.header:type_name -> pkg.ocrtypecodec.v1.RampMessageHeader
-	35, // 65: pkg.ocrtypecodec.v1.Message.token_amounts:type_name -> pkg.ocrtypecodec.v1.RampTokenAmount
-	70, // 66: pkg.ocrtypecodec.v1.StringAddrToNonce.nonces:type_name -> pkg.ocrtypecodec.v1.StringAddrToNonce.NoncesEntry
-	38, // 67: pkg.ocrtypecodec.v1.ExecutePluginReport.chain_reports:type_name -> pkg.ocrtypecodec.v1.ChainReport
-	33, // 68: pkg.ocrtypecodec.v1.ChainReport.messages:type_name -> pkg.ocrtypecodec.v1.Message
-	39, // 69: pkg.ocrtypecodec.v1.ChainReport.offchain_token_data:type_name -> pkg.ocrtypecodec.v1.RepeatedBytes
-	40, // 70: pkg.ocrtypecodec.v1.ChainRange.seq_num_range:type_name -> pkg.ocrtypecodec.v1.SeqNumRange
-	40, // 71: pkg.ocrtypecodec.v1.MerkleRootChain.seq_nums_range:type_name -> pkg.ocrtypecodec.v1.SeqNumRange
-	71, // 72: pkg.ocrtypecodec.v1.TimestampedBig.timestamp:type_name -> google.protobuf.Timestamp
-	25, // 73: pkg.ocrtypecodec.v1.ExecObservation.CommitReportsEntry.value:type_name -> pkg.ocrtypecodec.v1.CommitObservations
-	29, // 74: pkg.ocrtypecodec.v1.ExecObservation.SeqNumsToMsgsEntry.value:type_name -> pkg.ocrtypecodec.v1.SeqNumToMessage
-	30, // 75: pkg.ocrtypecodec.v1.ExecObservation.MsgHashesEntry.value:type_name -> pkg.ocrtypecodec.v1.SeqNumToBytes
-	36, // 76: pkg.ocrtypecodec.v1.ExecObservation.NoncesEntry.value:type_name -> pkg.ocrtypecodec.v1.StringAddrToNonce
-	45, // 77: pkg.ocrtypecodec.v1.TokenPriceObservation.FeeQuoterTokenUpdatesEntry.value:type_name -> pkg.ocrtypecodec.v1.TimestampedBig
-	14, // 78: pkg.ocrtypecodec.v1.ChainFeeObservation.FeeComponentsEntry.value:type_name -> pkg.ocrtypecodec.v1.ChainFeeComponents
-	15, // 79: pkg.ocrtypecodec.v1.ChainFeeObservation.ChainFeeUpdatesEntry.value:type_name -> pkg.ocrtypecodec.v1.ChainFeeUpdate
-	19, // 80: pkg.ocrtypecodec.v1.ContractNameChainAddresses.AddressesEntry.value:type_name -> pkg.ocrtypecodec.v1.ChainAddressMap
-	33, // 81: pkg.ocrtypecodec.v1.SeqNumToMessage.MessagesEntry.value:type_name -> pkg.ocrtypecodec.v1.Message
-	32, // 82: pkg.ocrtypecodec.v1.TokenDataObservations.TokenDataEntry.value:type_name -> pkg.ocrtypecodec.v1.SeqNumToTokenData
-	27, // 83: pkg.ocrtypecodec.v1.SeqNumToTokenData.TokenDataEntry.value:type_name -> pkg.ocrtypecodec.v1.MessageTokenData
-	84, // [84:84] is the sub-list for method output_type
-	84, // [84:84] is the sub-list for method input_type
-	84, // [84:84] is the sub-list for extension type_name
-	84, // [84:84] is the sub-list for extension extendee
-	0,  // [0:84] is the sub-list for field type_name
+	55, // 16: pkg.ocrtypecodec.v1.ExecObservation.f_chain:type_name -> pkg.ocrtypecodec.v1.ExecObservation.FChainEntry
+	56, // 17: pkg.ocrtypecodec.v1.ExecObservation.token_pool_rate_limits:type_name -> pkg.ocrtypecodec.v1.ExecObservation.TokenPoolRateLimitsEntry
+	40, // 18: pkg.ocrtypecodec.v1.ExecObservation.cost_prices:type_name -> pkg.ocrtypecodec.v1.ExecCostPrices
+	77, // 19: pkg.ocrtypecodec.v1.ExecObservation.timestamp:type_name -> google.protobuf.Timestamp
+	26, // 20: pkg.ocrtypecodec.v1.ExecOutcome.commit_reports:type_name -> pkg.ocrtypecodec.v1.CommitData
+	41, // 21: pkg.ocrtypecodec.v1.ExecOutcome.execute_plugin_report:type_name -> pkg.ocrtypecodec.v1.ExecutePluginReport
+	6,  // 22: pkg.ocrtypecodec.v1.MerkleRootQuery.rmn_signatures:type_name -> pkg.ocrtypecodec.v1.ReportSignatures
+	7,  // 23: pkg.ocrtypecodec.v1.ReportSignatures.signatures:type_name -> pkg.ocrtypecodec.v1.SignatureEcdsa
+	8,  // 24: pkg.ocrtypecodec.v1.ReportSignatures.lane_updates:type_name -> pkg.ocrtypecodec.v1.DestChainUpdate
+	47, // 25: pkg.ocrtypecodec.v1.DestChainUpdate.lane_source:type_name -> pkg.ocrtypecodec.v1.SourceChainMeta
+	44, // 26: pkg.ocrtypecodec.v1.DestChainUpdate.seq_num_range:type_name -> pkg.ocrtypecodec.v1.SeqNumRange
+	48, // 27: pkg.ocrtypecodec.v1.MerkleRootObservation.merkle_roots:type_name -> pkg.ocrtypecodec.v1.MerkleRootChain
+	57, // 28: pkg.ocrtypecodec.v1.MerkleRootObservation.rmn_enabled_chains:type_name -> pkg.ocrtypecodec.v1.MerkleRootObservation.RmnEnabledChainsEntry
+	45, // 29: pkg.ocrtypecodec.v1.MerkleRootObservation.on_ramp_max_seq_nums:type_name -> pkg.ocrtypecodec.v1.SeqNumChain
+	45, // 30: pkg.ocrtypecodec.v1.MerkleRootObservation.off_ramp_next_seq_nums:type_name -> pkg.ocrtypecodec.v1.SeqNumChain
+	10, // 31: pkg.ocrtypecodec.v1.MerkleRootObservation.rmn_remote_config:type_name -> pkg.ocrtypecodec.v1.RmnRemoteConfig
+	58, // 32: pkg.ocrtypecodec.v1.MerkleRootObservation.f_chain:type_name -> pkg.ocrtypecodec.v1.MerkleRootObservation.FChainEntry
+	11, // 33: pkg.ocrtypecodec.v1.RmnRemoteConfig.signers:type_name -> pkg.ocrtypecodec.v1.RemoteSignerInfo
+	59, // 34: pkg.ocrtypecodec.v1.TokenPriceObservation.feed_token_prices:type_name -> pkg.ocrtypecodec.v1.TokenPriceObservation.FeedTokenPricesEntry
+	60, // 35: pkg.ocrtypecodec.v1.TokenPriceObservation.fee_quoter_token_updates:type_name -> pkg.ocrtypecodec.v1.TokenPriceObservation.FeeQuoterTokenUpdatesEntry
+	61, // 36: pkg.ocrtypecodec.v1.TokenPriceObservation.f_chain:type_name -> pkg.ocrtypecodec.v1.TokenPriceObservation.FChainEntry
+	77, // 37: pkg.ocrtypecodec.v1.TokenPriceObservation.timestamp:type_name -> google.protobuf.Timestamp
+	62, // 38: pkg.ocrtypecodec.v1.ChainFeeObservation.fee_components:type_name -> pkg.ocrtypecodec.v1.ChainFeeObservation.FeeComponentsEntry
+	63, // 39: pkg.ocrtypecodec.v1.ChainFeeObservation.native_token_prices:type_name -> pkg.ocrtypecodec.v1.ChainFeeObservation.NativeTokenPricesEntry
+	64, // 40: pkg.ocrtypecodec.v1.ChainFeeObservation.chain_fee_updates:type_name -> pkg.ocrtypecodec.v1.ChainFeeObservation.ChainFeeUpdatesEntry
+	65, // 41: pkg.ocrtypecodec.v1.ChainFeeObservation.f_chain:type_name -> pkg.ocrtypecodec.v1.ChainFeeObservation.FChainEntry
+	77, // 42: pkg.ocrtypecodec.v1.ChainFeeObservation.timestamp_now:type_name -> google.protobuf.Timestamp
+	16, // 43: pkg.ocrtypecodec.v1.ChainFeeUpdate.chain_fee:type_name -> pkg.ocrtypecodec.v1.ComponentsUSDPrices
+	77, // 44: pkg.ocrtypecodec.v1.ChainFeeUpdate.timestamp:type_name -> google.protobuf.Timestamp
+	66, // 45: pkg.ocrtypecodec.v1.DiscoveryObservation.f_chain:type_name -> pkg.ocrtypecodec.v1.DiscoveryObservation.FChainEntry
+	18, // 46: pkg.ocrtypecodec.v1.DiscoveryObservation.contract_names:type_name -> pkg.ocrtypecodec.v1.ContractNameChainAddresses
+	67, // 47: pkg.ocrtypecodec.v1.ContractNameChainAddresses.addresses:type_name -> pkg.ocrtypecodec.v1.ContractNameChainAddresses.AddressesEntry
+	68, // 48: pkg.ocrtypecodec.v1.ChainAddressMap.chain_addresses:type_name -> pkg.ocrtypecodec.v1.ChainAddressMap.ChainAddressesEntry
+	46, // 49: pkg.ocrtypecodec.v1.MerkleRootOutcome.ranges_selected_for_report:type_name -> pkg.ocrtypecodec.v1.ChainRange
+	48, // 50: pkg.ocrtypecodec.v1.MerkleRootOutcome.roots_to_report:type_name -> pkg.ocrtypecodec.v1.MerkleRootChain
+	69, // 51: pkg.ocrtypecodec.v1.MerkleRootOutcome.rmn_enabled_chains:type_name -> pkg.ocrtypecodec.v1.MerkleRootOutcome.RmnEnabledChainsEntry
+	45, // 52: pkg.ocrtypecodec.v1.MerkleRootOutcome.off_ramp_next_seq_nums:type_name -> pkg.ocrtypecodec.v1.SeqNumChain
+	7,  // 53: pkg.ocrtypecodec.v1.MerkleRootOutcome.rmn_report_signatures:type_name -> pkg.ocrtypecodec.v1.SignatureEcdsa
+	10, // 54: pkg.ocrtypecodec.v1.MerkleRootOutcome.rmn_remote_cfg:type_name -> pkg.ocrtypecodec.v1.RmnRemoteConfig
+	70, // 55: pkg.ocrtypecodec.v1.TokenPriceOutcome.token_prices:type_name -> pkg.ocrtypecodec.v1.TokenPriceOutcome.TokenPricesEntry
+	23, // 56: pkg.ocrtypecodec.v1.ChainFeeOutcome.gas_prices:type_name -> pkg.ocrtypecodec.v1.GasPriceChain
+	26, // 57: pkg.ocrtypecodec.v1.CommitObservations.commit_data:type_name -> pkg.ocrtypecodec.v1.CommitData
+	77, // 58: pkg.ocrtypecodec.v1.CommitData.timestamp:type_name -> google.protobuf.Timestamp
+	44, // 59: pkg.ocrtypecodec.v1.CommitData.sequence_number_range:type_name -> pkg.ocrtypecodec.v1.SeqNumRange
+	33, // 60: pkg.ocrtypecodec.v1.CommitData.messages:type_name -> pkg.ocrtypecodec.v1.Message
+	27, // 61: pkg.ocrtypecodec.v1.CommitData.message_token_data:type_name -> pkg.ocrtypecodec.v1.MessageTokenData
+	28, // 62: pkg.ocrtypecodec.v1.MessageTokenData.token_data:type_name -> pkg.ocrtypecodec.v1.TokenData
+	71, // 63: pkg.ocrtypecodec.v1.SeqNumToMessage.messages:type_name -> pkg.ocrtypecodec.v1.SeqNumToMessage.MessagesEntry
+	72, // 64: pkg.ocrtypecodec.v1.SeqNumToBytes.seq_num_to_bytes:type_name -> pkg.ocrtypecodec.v1.SeqNumToBytes.SeqNumToBytesEntry
+	73, // 65: pkg.ocrtypecodec.v1.TokenDataObservations.token_data:type_name -> pkg.ocrtypecodec.v1.TokenDataObservations.TokenDataEntry
+	74, // 66: pkg.ocrtypecodec.v1.SeqNumToTokenData.token_data:type_name -> pkg.ocrtypecodec.v1.SeqNumToTokenData.TokenDataEntry
+	34, // 67: pkg.ocrtypecodec.v1.Message.header:type_name -> pkg.ocrtypecodec.v1.RampMessageHeader
+	35, // 68: pkg.ocrtypecodec.v1.Message.token_amounts:type_name -> pkg.ocrtypecodec.v1.RampTokenAmount
+	75, // 69: pkg.ocrtypecodec.v1.StringAddrToNonce.nonces:type_name -> pkg.ocrtypecodec.v1.StringAddrToNonce.NoncesEntry
+	76, // 70: pkg.ocrtypecodec.v1.TokenPoolRateLimits.rate_limits:type_name -> pkg.ocrtypecodec.v1.TokenPoolRateLimits.RateLimitsEntry
+	39, // 71: pkg.ocrtypecodec.v1.TokenPoolRateLimit.inbound:type_name -> pkg.ocrtypecodec.v1.RateLimiterState
+	42, // 72: pkg.ocrtypecodec.v1.ExecutePluginReport.chain_reports:type_name -> pkg.ocrtypecodec.v1.ChainReport
+	33, // 73: pkg.ocrtypecodec.v1.ChainReport.messages:type_name -> pkg.ocrtypecodec.v1.Message
+	43, // 74: pkg.ocrtypecodec.v1.ChainReport.offchain_token_data:type_name -> pkg.ocrtypecodec.v1.RepeatedBytes
+	44, // 75: pkg.ocrtypecodec.v1.ChainRange.seq_num_range:type_name -> pkg.ocrtypecodec.v1.SeqNumRange
+	44, // 76: pkg.ocrtypecodec.v1.MerkleRootChain.seq_nums_range:type_name -> pkg.ocrtypecodec.v1.SeqNumRange
+	77, // 77: pkg.ocrtypecodec.v1.TimestampedBig.timestamp:type_name -> google.protobuf.Timestamp
+	25, // 78: pkg.ocrtypecodec.v1.ExecObservation.CommitReportsEntry.value:type_name -> pkg.ocrtypecodec.v1.CommitObservations
+	29, // 79: pkg.ocrtypecodec.v1.ExecObservation.SeqNumsToMsgsEntry.value:type_name -> pkg.ocrtypecodec.v1.SeqNumToMessage
+	30, // 80: pkg.ocrtypecodec.v1.ExecObservation.MsgHashesEntry.value:type_name -> pkg.ocrtypecodec.v1.SeqNumToBytes
+	36, // 81: pkg.ocrtypecodec.v1.ExecObservation.NoncesEntry.value:type_name -> pkg.ocrtypecodec.v1.StringAddrToNonce
+	37, // 82: pkg.ocrtypecodec.v1.ExecObservation.TokenPoolRateLimitsEntry.value:type_name -> pkg.ocrtypecodec.v1.TokenPoolRateLimits
+	49, // 83: pkg.ocrtypecodec.v1.TokenPriceObservation.FeeQuoterTokenUpdatesEntry.value:type_name -> pkg.ocrtypecodec.v1.TimestampedBig
+	14, // 84: pkg.ocrtypecodec.v1.ChainFeeObservation.FeeComponentsEntry.value:type_name -> pkg.ocrtypecodec.v1.ChainFeeComponents
+	15, // 85: pkg.ocrtypecodec.v1.ChainFeeObservation.ChainFeeUpdatesEntry.value:type_name -> pkg.ocrtypecodec.v1.ChainFeeUpdate
+	19, // 86: pkg.ocrtypecodec.v1.ContractNameChainAddresses.AddressesEntry.value:type_name -> pkg.ocrtypecodec.v1.ChainAddressMap
+	33, // 87: pkg.ocrtypecodec.v1.SeqNumToMessage.MessagesEntry.value:type_name -> pkg.ocrtypecodec.v1.Message
+	32, // 88: pkg.ocrtypecodec.v1.TokenDataObservations.TokenDataEntry.value:type_name -> pkg.ocrtypecodec.v1.SeqNumToTokenData
+	27, // 89: pkg.ocrtypecodec.v1.SeqNumToTokenData.TokenDataEntry.value:type_name -> pkg.ocrtypecodec.v1.MessageTokenData
+	38, // 90: pkg.ocrtypecodec.v1.TokenPoolRateLimits.RateLimitsEntry.value:type_name -> pkg.ocrtypecodec.v1.TokenPoolRateLimit
+	91, // [91:91] is the sub-list for method output_type
+	91, // [91:91] is the sub-list for method input_type
+	91, // [91:91] is the sub-list for extension type_name
+	91, // [91:91] is the sub-list for extension extendee
+	0,  // [0:91] is the sub-list for field type_name
 }
 
 func init() { file_pkg_ocrtypecodec_v1_ocrtypes_proto_init() }
@@ -3830,567 +4081,13 @@ func file_pkg_ocrtypecodec_v1_ocrtypes_proto_init() {
 	if File_pkg_ocrtypecodec_v1_ocrtypes_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitQuery); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitObservation); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitOutcome); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecObservation); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecOutcome); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MerkleRootQuery); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReportSignatures); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignatureEcdsa); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DestChainUpdate); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MerkleRootObservation); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RmnRemoteConfig); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoteSignerInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenPriceObservation); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainFeeObservation); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainFeeComponents); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainFeeUpdate); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ComponentsUSDPrices); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiscoveryObservation); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContractNameChainAddresses); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainAddressMap); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MerkleRootOutcome); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenPriceOutcome); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainFeeOutcome); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GasPriceChain); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MainOutcome); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitObservations); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageTokenData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SeqNumToMessage); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SeqNumToBytes); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenDataObservations); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SeqNumToTokenData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Message); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RampMessageHeader); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RampTokenAmount); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StringAddrToNonce); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecutePluginReport); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainReport); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedBytes); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SeqNumRange); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SeqNumChain); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainRange); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SourceChainMeta); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MerkleRootChain); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_ocrtypecodec_v1_ocrtypes_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimestampedBig); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_ocrtypecodec_v1_ocrtypes_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   71,
+			NumMessages:   77,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
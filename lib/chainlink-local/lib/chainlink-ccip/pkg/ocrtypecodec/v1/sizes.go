@@ -0,0 +1,181 @@
+package v1
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink-ccip/commit/chainfee"
+	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot"
+	"github.com/smartcontractkit/chainlink-ccip/commit/tokenprice"
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1/ocrtypecodecpb"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// Names of the exec observation parts measured by ExecObservationSizes.
+const (
+	ExecCommitReportsPart = "commitReports"
+	ExecMessagesPart      = "messages"
+	ExecHashesPart        = "hashes"
+	ExecTokenDataPart     = "tokenData"
+	ExecNoncesPart        = "nonces"
+)
+
+var sizeTranslator = newProtoTranslator()
+
+// MerkleRootObservationSizes returns the encoded size of a merkle root observation, and of the data of each chain
+// it contains.
+func MerkleRootObservationSizes(obs merkleroot.Observation) (int, map[cciptypes.ChainSelector]int) {
+	tr := sizeTranslator
+	total := proto.Size(&ocrtypecodecpb.MerkleRootObservation{
+		MerkleRoots:        tr.merkleRootsToProto(obs.MerkleRoots),
+		RmnEnabledChains:   tr.rmnEnabledChainsToProto(obs.RMNEnabledChains),
+		OnRampMaxSeqNums:   tr.seqNumChainToProto(obs.OnRampMaxSeqNums),
+		OffRampNextSeqNums: tr.seqNumChainToProto(obs.OffRampNextSeqNums),
+		RmnRemoteConfig:    tr.rmnRemoteConfigToProto(obs.RMNRemoteConfig),
+		FChain:             tr.fChainToProto(obs.FChain),
+	})
+
+	roots := make(map[cciptypes.ChainSelector][]cciptypes.MerkleRootChain)
+	for _, root := range obs.MerkleRoots {
+		roots[root.ChainSel] = append(roots[root.ChainSel], root)
+	}
+	onRamp := seqNumChainsByChain(obs.OnRampMaxSeqNums)
+	offRamp := seqNumChainsByChain(obs.OffRampNextSeqNums)
+
+	chains := make(map[cciptypes.ChainSelector]struct{})
+	addChains(chains, roots)
+	addChains(chains, onRamp)
+	addChains(chains, offRamp)
+	addChains(chains, obs.RMNEnabledChains)
+	addChains(chains, obs.FChain)
+
+	perChain := make(map[cciptypes.ChainSelector]int, len(chains))
+	for chain := range chains {
+		pbObs := &ocrtypecodecpb.MerkleRootObservation{
+			MerkleRoots:        tr.merkleRootsToProto(roots[chain]),
+			OnRampMaxSeqNums:   tr.seqNumChainToProto(onRamp[chain]),
+			OffRampNextSeqNums: tr.seqNumChainToProto(offRamp[chain]),
+		}
+		if enabled, ok := obs.RMNEnabledChains[chain]; ok {
+			pbObs.RmnEnabledChains = map[uint64]bool{uint64(chain): enabled}
+		}
+		if f, ok := obs.FChain[chain]; ok {
+			pbObs.FChain = tr.fChainToProto(map[cciptypes.ChainSelector]int{chain: f})
+		}
+		perChain[chain] = proto.Size(pbObs)
+	}
+	return total, perChain
+}
+
+// TokenPriceObservationSizes returns the encoded size of a token price observation. Token prices aren't
+// observed per chain, only the total size is returned.
+func TokenPriceObservationSizes(obs tokenprice.Observation) (int, map[cciptypes.ChainSelector]int) {
+	tr := sizeTranslator
+	total := proto.Size(&ocrtypecodecpb.TokenPriceObservation{
+		FeedTokenPrices:       tr.feedTokenPricesToProto(obs.FeedTokenPrices),
+		FeeQuoterTokenUpdates: tr.feeQuoterTokenUpdatesToProto(obs.FeeQuoterTokenUpdates),
+		FChain:                tr.fChainToProto(obs.FChain),
+		Timestamp:             timestamppb.New(obs.Timestamp),
+	})
+	return total, nil
+}
+
+// ChainFeeObservationSizes returns the encoded size of a chain fee observation, and of the data of each chain
+// it contains.
+func ChainFeeObservationSizes(obs chainfee.Observation) (int, map[cciptypes.ChainSelector]int) {
+	tr := sizeTranslator
+	total := proto.Size(&ocrtypecodecpb.ChainFeeObservation{
+		FeeComponents:     tr.feeComponentsToProto(obs.FeeComponents),
+		NativeTokenPrices: tr.nativeTokenPricesToProto(obs.NativeTokenPrices),
+		ChainFeeUpdates:   tr.chainFeeUpdatesToProto(obs.ChainFeeUpdates),
+		FChain:            tr.fChainToProto(obs.FChain),
+		TimestampNow:      timestamppb.New(obs.TimestampNow),
+	})
+
+	chains := make(map[cciptypes.ChainSelector]struct{})
+	addChains(chains, obs.FeeComponents)
+	addChains(chains, obs.NativeTokenPrices)
+	addChains(chains, obs.ChainFeeUpdates)
+	addChains(chains, obs.FChain)
+
+	perChain := make(map[cciptypes.ChainSelector]int, len(chains))
+	for chain := range chains {
+		pbObs := &ocrtypecodecpb.ChainFeeObservation{}
+		if fc, ok := obs.FeeComponents[chain]; ok {
+			pbObs.FeeComponents = tr.feeComponentsToProto(map[cciptypes.ChainSelector]types.ChainFeeComponents{chain: fc})
+		}
+		if price, ok := obs.NativeTokenPrices[chain]; ok {
+			pbObs.NativeTokenPrices = tr.nativeTokenPricesToProto(
+				map[cciptypes.ChainSelector]cciptypes.BigInt{chain: price})
+		}
+		if update, ok := obs.ChainFeeUpdates[chain]; ok {
+			pbObs.ChainFeeUpdates = tr.chainFeeUpdatesToProto(
+				map[cciptypes.ChainSelector]chainfee.Update{chain: update})
+		}
+		if f, ok := obs.FChain[chain]; ok {
+			pbObs.FChain = tr.fChainToProto(map[cciptypes.ChainSelector]int{chain: f})
+		}
+		perChain[chain] = proto.Size(pbObs)
+	}
+	return total, perChain
+}
+
+// ExecObservationSizes returns the encoded size of each part of an exec observation per source chain.
+func ExecObservationSizes(obs exectypes.Observation) map[string]map[cciptypes.ChainSelector]int {
+	tr := sizeTranslator
+	sizes := make(map[string]map[cciptypes.ChainSelector]int)
+	add := func(part string, chain cciptypes.ChainSelector, pbObs *ocrtypecodecpb.ExecObservation) {
+		if _, ok := sizes[part]; !ok {
+			sizes[part] = make(map[cciptypes.ChainSelector]int)
+		}
+		sizes[part][chain] = proto.Size(pbObs)
+	}
+
+	for chain, reports := range obs.CommitReports {
+		add(ExecCommitReportsPart, chain, &ocrtypecodecpb.ExecObservation{
+			CommitReports: tr.commitReportsToProto(exectypes.CommitObservations{chain: reports}),
+		})
+	}
+	for chain, msgs := range obs.Messages {
+		add(ExecMessagesPart, chain, &ocrtypecodecpb.ExecObservation{
+			SeqNumsToMsgs: tr.messageObservationsToProto(exectypes.MessageObservations{chain: msgs}),
+		})
+	}
+	for chain, hashes := range obs.Hashes {
+		add(ExecHashesPart, chain, &ocrtypecodecpb.ExecObservation{
+			MsgHashes: tr.messageHashesToProto(exectypes.MessageHashes{chain: hashes}),
+		})
+	}
+	for chain, tokenData := range obs.TokenData {
+		add(ExecTokenDataPart, chain, &ocrtypecodecpb.ExecObservation{
+			TokenDataObservations: &ocrtypecodecpb.TokenDataObservations{
+				TokenData: tr.tokenDataObservationsToProto(exectypes.TokenDataObservations{chain: tokenData}),
+			},
+		})
+	}
+	for chain, nonces := range obs.Nonces {
+		add(ExecNoncesPart, chain, &ocrtypecodecpb.ExecObservation{
+			Nonces: tr.nonceObservationsToProto(exectypes.NonceObservations{chain: nonces}),
+		})
+	}
+	return sizes
+}
+
+func seqNumChainsByChain(snc []plugintypes.SeqNumChain) map[cciptypes.ChainSelector][]plugintypes.SeqNumChain {
+	byChain := make(map[cciptypes.ChainSelector][]plugintypes.SeqNumChain)
+	for _, s := range snc {
+		byChain[s.ChainSel] = append(byChain[s.ChainSel], s)
+	}
+	return byChain
+}
+
+// addChains adds the chains indexing m to the set.
+func addChains[V any](chains map[cciptypes.ChainSelector]struct{}, m map[cciptypes.ChainSelector]V) {
+	for chain := range m {
+		chains[chain] = struct{}{}
+	}
+}
@@ -0,0 +1,72 @@
+package v1
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink-ccip/commit/chainfee"
+	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot"
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func TestMerkleRootObservationSizes(t *testing.T) {
+	_, perChain := MerkleRootObservationSizes(merkleroot.Observation{})
+	require.Empty(t, perChain)
+
+	obs := merkleroot.Observation{
+		MerkleRoots: []cciptypes.MerkleRootChain{
+			{ChainSel: 1, SeqNumsRange: cciptypes.NewSeqNumRange(1, 10), MerkleRoot: cciptypes.Bytes32{1}},
+			{ChainSel: 2, SeqNumsRange: cciptypes.NewSeqNumRange(1, 10), MerkleRoot: cciptypes.Bytes32{2}},
+		},
+		OnRampMaxSeqNums: []plugintypes.SeqNumChain{{ChainSel: 1, SeqNum: 10}},
+		FChain:           map[cciptypes.ChainSelector]int{1: 1, 2: 1, 3: 1},
+	}
+	total, perChain := MerkleRootObservationSizes(obs)
+	require.Len(t, perChain, 3)
+	require.Greater(t, perChain[1], perChain[2])
+	require.Greater(t, perChain[2], perChain[3])
+	// the rmn remote config isn't attributed to any chain.
+	require.Greater(t, total, perChain[1]+perChain[2]+perChain[3])
+}
+
+func TestChainFeeObservationSizes(t *testing.T) {
+	obs := chainfee.Observation{
+		FeeComponents: map[cciptypes.ChainSelector]types.ChainFeeComponents{
+			1: {ExecutionFee: big.NewInt(1), DataAvailabilityFee: big.NewInt(2)},
+		},
+		NativeTokenPrices: map[cciptypes.ChainSelector]cciptypes.BigInt{1: cciptypes.NewBigIntFromInt64(3)},
+		FChain:            map[cciptypes.ChainSelector]int{1: 1, 2: 1},
+		TimestampNow:      time.Now(),
+	}
+	total, perChain := ChainFeeObservationSizes(obs)
+	require.Len(t, perChain, 2)
+	require.Greater(t, perChain[1], perChain[2])
+	// the timestamp isn't attributed to any chain.
+	require.Greater(t, total, perChain[1]+perChain[2])
+}
+
+func TestExecObservationSizes(t *testing.T) {
+	require.Empty(t, ExecObservationSizes(exectypes.Observation{}))
+
+	msg := cciptypes.Message{
+		Header: cciptypes.RampMessageHeader{SourceChainSelector: 1, SequenceNumber: 1},
+		Data:   make([]byte, 1000),
+	}
+	sizes := ExecObservationSizes(exectypes.Observation{
+		Messages: exectypes.MessageObservations{1: {1: msg}, 2: {}},
+		TokenData: exectypes.TokenDataObservations{
+			1: {1: exectypes.NewMessageTokenData()},
+		},
+	})
+	require.Len(t, sizes, 2)
+	require.Len(t, sizes[ExecMessagesPart], 2)
+	require.Greater(t, sizes[ExecMessagesPart][1], 1000)
+	require.Contains(t, sizes[ExecTokenDataPart], cciptypes.ChainSelector(1))
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"math/big"
+	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -880,6 +881,95 @@ func (t *protoTranslator) nonceObservationsFromProto(
 	return nonces
 }
 
+func (t *protoTranslator) tokenPoolRateLimitsToProto(
+	observations exectypes.TokenPoolRateLimitObservations,
+) map[uint64]*ocrtypecodecpb.TokenPoolRateLimits {
+	var pbRateLimits map[uint64]*ocrtypecodecpb.TokenPoolRateLimits
+	if len(observations) > 0 {
+		pbRateLimits = make(map[uint64]*ocrtypecodecpb.TokenPoolRateLimits, len(observations))
+	}
+
+	for chainSel, rateLimits := range observations {
+		tokenRateLimits := make(map[string]*ocrtypecodecpb.TokenPoolRateLimit, len(rateLimits))
+		for token, rateLimit := range rateLimits {
+			tokenRateLimits[token] = &ocrtypecodecpb.TokenPoolRateLimit{
+				Pool:     rateLimit.Pool,
+				Decimals: uint32(rateLimit.Decimals),
+				Inbound: &ocrtypecodecpb.RateLimiterState{
+					Tokens:      cciptypes.NewBigInt(rateLimit.Inbound.Tokens).Bytes(),
+					LastUpdated: rateLimit.Inbound.LastUpdated,
+					IsEnabled:   rateLimit.Inbound.IsEnabled,
+					Capacity:    cciptypes.NewBigInt(rateLimit.Inbound.Capacity).Bytes(),
+					Rate:        cciptypes.NewBigInt(rateLimit.Inbound.Rate).Bytes(),
+				},
+			}
+		}
+		pbRateLimits[uint64(chainSel)] = &ocrtypecodecpb.TokenPoolRateLimits{RateLimits: tokenRateLimits}
+	}
+
+	return pbRateLimits
+}
+
+func (t *protoTranslator) tokenPoolRateLimitsFromProto(
+	pbRateLimits map[uint64]*ocrtypecodecpb.TokenPoolRateLimits,
+) exectypes.TokenPoolRateLimitObservations {
+	var observations exectypes.TokenPoolRateLimitObservations
+	if len(pbRateLimits) > 0 {
+		observations = make(exectypes.TokenPoolRateLimitObservations, len(pbRateLimits))
+	}
+
+	for chainSel, pbTokenRateLimits := range pbRateLimits {
+		rateLimits := make(map[string]reader.TokenPoolRateLimit, len(pbTokenRateLimits.RateLimits))
+		for token, pbRateLimit := range pbTokenRateLimits.RateLimits {
+			inbound := pbRateLimit.GetInbound()
+			rateLimits[token] = reader.TokenPoolRateLimit{
+				Pool:     pbRateLimit.Pool,
+				Decimals: uint8(pbRateLimit.Decimals),
+				Inbound: reader.RateLimiterState{
+					Tokens:      big.NewInt(0).SetBytes(inbound.GetTokens()),
+					LastUpdated: inbound.GetLastUpdated(),
+					IsEnabled:   inbound.GetIsEnabled(),
+					Capacity:    big.NewInt(0).SetBytes(inbound.GetCapacity()),
+					Rate:        big.NewInt(0).SetBytes(inbound.GetRate()),
+				},
+			}
+		}
+		observations[cciptypes.ChainSelector(chainSel)] = rateLimits
+	}
+
+	return observations
+}
+
+func (t *protoTranslator) costPricesToProto(prices exectypes.CostPriceObservation) *ocrtypecodecpb.ExecCostPrices {
+	if prices.IsEmpty() {
+		return nil
+	}
+	return &ocrtypecodecpb.ExecCostPrices{
+		GasPrice:       prices.GasPrice.Bytes(),
+		NativePriceUsd: prices.NativePriceUSD.Bytes(),
+		LinkPriceUsd:   prices.LinkPriceUSD.Bytes(),
+	}
+}
+
+func (t *protoTranslator) costPricesFromProto(pbPrices *ocrtypecodecpb.ExecCostPrices) exectypes.CostPriceObservation {
+	if pbPrices == nil {
+		return exectypes.CostPriceObservation{}
+	}
+	return exectypes.CostPriceObservation{
+		GasPrice:       cciptypes.NewBigInt(big.NewInt(0).SetBytes(pbPrices.GasPrice)),
+		NativePriceUSD: cciptypes.NewBigInt(big.NewInt(0).SetBytes(pbPrices.NativePriceUsd)),
+		LinkPriceUSD:   cciptypes.NewBigInt(big.NewInt(0).SetBytes(pbPrices.LinkPriceUsd)),
+	}
+}
+
+// timestampFromProto returns the zero time if the timestamp is not set, e.g. by oracles which don't observe it.
+func (t *protoTranslator) timestampFromProto(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
 func (t *protoTranslator) chainReportsToProto(
 	reports []cciptypes.ExecutePluginReportSingleChain,
 ) []*ocrtypecodecpb.ChainReport {
@@ -464,12 +464,26 @@ func (d *dataGenerator) execObservation() exectypes.Observation {
 	tokenDataObservations := make(map[cciptypes.ChainSelector]map[cciptypes.SeqNum]exectypes.MessageTokenData)
 	msgHashObservations := make(map[cciptypes.ChainSelector]map[cciptypes.SeqNum]cciptypes.Bytes32)
 	nonces := make(map[cciptypes.ChainSelector]map[string]uint64)
+	rateLimits := make(exectypes.TokenPoolRateLimitObservations)
 	msgObservations := make(map[cciptypes.ChainSelector]map[cciptypes.SeqNum]cciptypes.Message)
 	for i := 0; i < d.numSourceChains; i++ {
 		chainSel := cciptypes.ChainSelector(rand.Uint64())
 		nonces[chainSel] = map[string]uint64{
 			genRandomString(5): rand.Uint64(),
 		}
+		rateLimits[chainSel] = map[string]reader.TokenPoolRateLimit{
+			genRandomString(5): {
+				Pool:     randomBytes(20),
+				Decimals: uint8(rand.Intn(19)),
+				Inbound: reader.RateLimiterState{
+					Tokens:      randBigInt().Int,
+					LastUpdated: rand.Uint32(),
+					IsEnabled:   rand.Int()%2 == 0,
+					Capacity:    randBigInt().Int,
+					Rate:        randBigInt().Int,
+				},
+			},
+		}
 
 		tokenDataObservations[chainSel] = make(map[cciptypes.SeqNum]exectypes.MessageTokenData, d.numMessagesPerChain)
 		msgHashObservations[chainSel] = make(map[cciptypes.SeqNum]cciptypes.Bytes32, d.numMessagesPerChain)
@@ -526,13 +540,20 @@ func (d *dataGenerator) execObservation() exectypes.Observation {
 	}
 
 	return exectypes.Observation{
-		CommitReports: commitReports,
-		Messages:      msgObservations,
-		Hashes:        msgHashObservations,
-		TokenData:     tokenDataObservations,
-		Nonces:        nonces,
-		Contracts:     discoveryObs,
-		FChain:        discoveryObs.FChain,
+		CommitReports:       commitReports,
+		Messages:            msgObservations,
+		Hashes:              msgHashObservations,
+		TokenData:           tokenDataObservations,
+		Nonces:              nonces,
+		TokenPoolRateLimits: rateLimits,
+		CostPrices: exectypes.CostPriceObservation{
+			GasPrice:       randBigInt(),
+			NativePriceUSD: randBigInt(),
+			LinkPriceUSD:   randBigInt(),
+		},
+		Timestamp: time.Now().UTC(),
+		Contracts: discoveryObs,
+		FChain:    discoveryObs.FChain,
 	}
 }
 
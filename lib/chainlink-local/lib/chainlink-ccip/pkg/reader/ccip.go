@@ -815,6 +815,199 @@ func prepareNoncesInput(
 	return contractInput, responses, nil
 }
 
+// RateLimiterState is the current state of a token pool rate limiter bucket, the tokens value is already
+// refilled up to the time of the read.
+// See: <chainlink repo>/contracts/src/v0.8/ccip/libraries/RateLimiter.sol:TokenBucket
+type RateLimiterState struct {
+	Tokens      *big.Int `json:"tokens"`
+	LastUpdated uint32   `json:"lastUpdated"`
+	IsEnabled   bool     `json:"isEnabled"`
+	Capacity    *big.Int `json:"capacity"`
+	Rate        *big.Int `json:"rate"`
+}
+
+// TokenPoolRateLimit is the inbound rate limit of a destination token pool for a single source chain.
+type TokenPoolRateLimit struct {
+	Pool []byte
+	// Decimals of the token on the destination chain, used to convert source amounts to local amounts.
+	Decimals uint8
+	Inbound  RateLimiterState
+}
+
+// GetTokenPoolInboundRateLimits resolves the destination pools of the provided tokens through the
+// TokenAdminRegistry and returns their current inbound rate limiter state per source chain. Tokens are keyed
+// by their hex encoding (cciptypes.UnknownAddress.String()). Tokens without a pool, or whose pool could not
+// be read, are omitted from the result.
+func (r *ccipChainReader) GetTokenPoolInboundRateLimits(
+	ctx context.Context,
+	destTokensByChain map[cciptypes.ChainSelector][]cciptypes.UnknownAddress,
+) (map[cciptypes.ChainSelector]map[string]TokenPoolRateLimit, error) {
+	lggr := logutil.WithContextValues(ctx, r.lggr)
+	if err := validateExtendedReaderExistence(r.contractReaders, r.destChain); err != nil {
+		return nil, err
+	}
+
+	pools, err := r.getTokenPools(ctx, destTokensByChain)
+	if err != nil {
+		return nil, err
+	}
+	if len(pools) == 0 {
+		return map[cciptypes.ChainSelector]map[string]TokenPoolRateLimit{}, nil
+	}
+
+	type poolRead struct {
+		chain    cciptypes.ChainSelector
+		token    string
+		pool     []byte
+		state    RateLimiterState
+		decimals uint8
+	}
+
+	// sort the input to ensure deterministic results
+	sortedChains := maps.Keys(destTokensByChain)
+	slices.Sort(sortedChains)
+
+	// each pool read is made of two batch reads, readsByPool keeps track of them in request order.
+	request := make(types.BatchGetLatestValuesRequest)
+	readsByPool := make(map[types.BoundContract][]*poolRead)
+	for _, chain := range sortedChains {
+		for _, token := range destTokensByChain[chain] {
+			pool, ok := pools[token.String()]
+			if !ok {
+				continue
+			}
+			contract, err := bindExtendedReaderContract(
+				ctx, lggr, r.contractReaders, r.destChain, consts.ContractNameTokenPool, pool, r.addrCodec)
+			if err != nil {
+				return nil, fmt.Errorf("bind token pool %s: %w", cciptypes.UnknownAddress(pool).String(), err)
+			}
+
+			read := &poolRead{chain: chain, token: token.String(), pool: pool}
+			readsByPool[contract] = append(readsByPool[contract], read)
+			request[contract] = append(request[contract],
+				types.BatchRead{
+					ReadName:  consts.MethodNameGetCurrentInboundRateLimiterState,
+					Params:    map[string]any{"remoteChainSelector": chain},
+					ReturnVal: &read.state,
+				},
+				types.BatchRead{
+					ReadName:  consts.MethodNameGetTokenDecimals,
+					Params:    map[string]any{},
+					ReturnVal: &read.decimals,
+				},
+			)
+		}
+	}
+
+	batchResult, err := r.contractReaders[r.destChain].BatchGetLatestValues(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("batch get token pool rate limits: %w", err)
+	}
+
+	res := make(map[cciptypes.ChainSelector]map[string]TokenPoolRateLimit)
+	for contract, results := range batchResult {
+		reads := readsByPool[contract]
+		if len(results) != 2*len(reads) {
+			lggr.Errorw("unexpected number of token pool results",
+				"pool", contract.Address, "expected", 2*len(reads), "got", len(results))
+			continue
+		}
+		for i, read := range reads {
+			_, stateErr := results[2*i].GetResult()
+			_, decimalsErr := results[2*i+1].GetResult()
+			if err := errors.Join(stateErr, decimalsErr); err != nil {
+				lggr.Errorw("failed to read token pool rate limit",
+					"pool", contract.Address, "sourceChain", read.chain, "err", err)
+				continue
+			}
+			if read.state.Tokens == nil || read.state.Capacity == nil {
+				lggr.Errorw("invalid rate limiter state returned", "pool", contract.Address, "sourceChain", read.chain)
+				continue
+			}
+			if _, ok := res[read.chain]; !ok {
+				res[read.chain] = make(map[string]TokenPoolRateLimit)
+			}
+			res[read.chain][read.token] = TokenPoolRateLimit{
+				Pool:     read.pool,
+				Decimals: read.decimals,
+				Inbound:  read.state,
+			}
+		}
+	}
+	return res, nil
+}
+
+// getTokenPools resolves the destination pools of the provided tokens using the TokenAdminRegistry
+// referenced by the offRamp static config. Pools are keyed by the hex encoded token address.
+func (r *ccipChainReader) getTokenPools(
+	ctx context.Context,
+	destTokensByChain map[cciptypes.ChainSelector][]cciptypes.UnknownAddress,
+) (map[string][]byte, error) {
+	lggr := logutil.WithContextValues(ctx, r.lggr)
+
+	tokens := make(map[string]cciptypes.UnknownAddress)
+	for _, chainTokens := range destTokensByChain {
+		for _, token := range chainTokens {
+			tokens[token.String()] = token
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	config, err := r.configPoller.GetChainConfig(ctx, r.destChain)
+	if err != nil {
+		return nil, fmt.Errorf("get chain config: %w", err)
+	}
+	registryAddress := config.Offramp.StaticConfig.TokenAdminRegistry
+	if len(registryAddress) == 0 {
+		return nil, fmt.Errorf("token admin registry address is empty")
+	}
+
+	registry, err := bindExtendedReaderContract(
+		ctx, lggr, r.contractReaders, r.destChain, consts.ContractNameTokenAdminRegistry, registryAddress, r.addrCodec)
+	if err != nil {
+		return nil, fmt.Errorf("bind token admin registry: %w", err)
+	}
+
+	sortedTokens := maps.Keys(tokens)
+	slices.Sort(sortedTokens)
+	responses := make([][]byte, len(sortedTokens))
+	reads := make([]types.BatchRead, len(sortedTokens))
+	for i, token := range sortedTokens {
+		reads[i] = types.BatchRead{
+			ReadName:  consts.MethodNameGetPool,
+			Params:    map[string]any{"token": []byte(tokens[token])},
+			ReturnVal: &responses[i],
+		}
+	}
+
+	batchResult, err := r.contractReaders[r.destChain].BatchGetLatestValues(
+		ctx, types.BatchGetLatestValuesRequest{registry: reads})
+	if err != nil {
+		return nil, fmt.Errorf("batch get token pools: %w", err)
+	}
+
+	results := batchResult[registry]
+	if len(results) != len(sortedTokens) {
+		return nil, fmt.Errorf("unexpected number of token pools, expected %d, got %d", len(sortedTokens), len(results))
+	}
+
+	pools := make(map[string][]byte, len(sortedTokens))
+	for i, result := range results {
+		if _, err := result.GetResult(); err != nil {
+			lggr.Errorw("failed to get token pool", "token", sortedTokens[i], "err", err)
+			continue
+		}
+		if cciptypes.UnknownAddress(responses[i]).IsZeroOrEmpty() {
+			lggr.Debugw("token has no pool registered", "token", sortedTokens[i])
+			continue
+		}
+		pools[sortedTokens[i]] = responses[i]
+	}
+	return pools, nil
+}
+
 func (r *ccipChainReader) GetChainsFeeComponents(
 	ctx context.Context,
 	chains []cciptypes.ChainSelector,
@@ -209,6 +209,14 @@ type CCIPReader interface {
 		addressesByChain map[cciptypes.ChainSelector][]string,
 	) (map[cciptypes.ChainSelector]map[string]uint64, error)
 
+	// GetTokenPoolInboundRateLimits reads the destination chain and returns the inbound rate limiter state of the
+	// pools of the provided destination tokens, per source chain. Tokens are keyed by their hex encoding, tokens
+	// without a registered pool are omitted.
+	GetTokenPoolInboundRateLimits(
+		ctx context.Context,
+		destTokensByChain map[cciptypes.ChainSelector][]cciptypes.UnknownAddress,
+	) (map[cciptypes.ChainSelector]map[string]TokenPoolRateLimit, error)
+
 	// GetChainsFeeComponents Returns all fee components for given chains if corresponding
 	// chain writer is available.
 	GetChainsFeeComponents(
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"slices"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
@@ -155,7 +156,9 @@ func (pr *priceReader) GetFeeQuoterTokenUpdates(
 	return updateMap, nil
 }
 
-// GetFeedPricesUSD gets USD prices for multiple tokens using batch requests
+// GetFeedPricesUSD gets USD prices for multiple tokens using batch requests.
+// Aggregator and exchange rate sources are read from the feed chain, fixed and token ratio sources are
+// derived from the config and the price of their reference token.
 func (pr *priceReader) GetFeedPricesUSD(
 	ctx context.Context,
 	tokens []ccipocr3.UnknownEncodedAddress,
@@ -167,8 +170,17 @@ func (pr *priceReader) GetFeedPricesUSD(
 		return prices, nil
 	}
 
+	// Token ratio sources need the price of their reference token.
+	tokensToRead := make([]ccipocr3.UnknownEncodedAddress, 0, len(tokens))
+	for _, token := range tokens {
+		tokensToRead = append(tokensToRead, token)
+		if info, ok := pr.tokenInfo[token]; ok && info.SourceType() == pluginconfig.TokenPriceSourceTokenRatio {
+			tokensToRead = append(tokensToRead, info.ReferenceToken)
+		}
+	}
+
 	// Create batch request grouped by contract
-	batchRequest, contractTokenMap := pr.prepareBatchRequest(tokens)
+	batchRequest, contractTokenMap, rateGetterTokenMap := pr.prepareBatchRequest(tokensToRead)
 
 	// Execute batch request
 	results, err := pr.feedChainReader().BatchGetLatestValues(ctx, batchRequest)
@@ -176,6 +188,9 @@ func (pr *priceReader) GetFeedPricesUSD(
 		return nil, fmt.Errorf("batch request failed: %w", err)
 	}
 
+	// USD price of one full token with 18 decimals, by token.
+	fullTokenPrices := make(map[ccipocr3.UnknownEncodedAddress]*big.Int)
+
 	// Process results by contract
 	for boundContract, tokens := range contractTokenMap {
 		contractResults, ok := results[boundContract]
@@ -208,14 +223,67 @@ func (pr *priceReader) GetFeedPricesUSD(
 
 		// Apply the normalized price to all tokens using this contract
 		for _, token := range tokens {
-			tokenInfo := pr.tokenInfo[token]
-			price := calculateUsdPer1e18TokenAmount(normalizedContractPrice, tokenInfo.Decimals)
-			if price == nil {
-				lggr.Errorw("failed to calculate price", "token", token)
+			fullTokenPrices[token] = normalizedContractPrice
+		}
+	}
+
+	// Scale the underlying token prices by the exchange rates.
+	for boundContract, tokens := range rateGetterTokenMap {
+		contractResults, ok := results[boundContract]
+		if !ok || len(contractResults) != 1 {
+			lggr.Errorf("invalid results for rate getter %s", boundContract.Address)
+			for _, token := range tokens {
+				delete(fullTokenPrices, token)
+			}
+			continue
+		}
+
+		rate, err := pr.getRate(contractResults[0], boundContract)
+		for _, token := range tokens {
+			underlyingPrice, ok := fullTokenPrices[token]
+			if !ok {
 				continue
 			}
-			prices[token] = ccipocr3.NewBigInt(price)
+			if err != nil {
+				lggr.Errorw("calling getRate", "token", token, "err", err)
+				delete(fullTokenPrices, token)
+				continue
+			}
+			fullTokenPrices[token] = applyExchangeRate(underlyingPrice, rate, pr.tokenInfo[token].RateDecimals)
+		}
+	}
+
+	// Fixed prices are resolved before the token ratio prices since they can be reference tokens.
+	for _, token := range tokensToRead {
+		info, ok := pr.tokenInfo[token]
+		if ok && info.SourceType() == pluginconfig.TokenPriceSourceFixed {
+			fullTokenPrices[token] = new(big.Int).Set(info.FixedPriceUSD.Int)
+		}
+	}
+	for _, token := range tokensToRead {
+		info, ok := pr.tokenInfo[token]
+		if !ok || info.SourceType() != pluginconfig.TokenPriceSourceTokenRatio {
+			continue
+		}
+		refPrice, ok := fullTokenPrices[info.ReferenceToken]
+		if !ok || pr.tokenInfo[info.ReferenceToken].SourceType() == pluginconfig.TokenPriceSourceTokenRatio {
+			lggr.Errorw("reference token price not available", "token", token, "referenceToken", info.ReferenceToken)
+			continue
+		}
+		fullTokenPrices[token] = applyRatioPPB(refPrice, info.RatioPPB.Int)
+	}
+
+	for _, token := range tokens {
+		fullTokenPrice, ok := fullTokenPrices[token]
+		if !ok {
+			continue
+		}
+		price := calculateUsdPer1e18TokenAmount(fullTokenPrice, pr.tokenInfo[token].Decimals)
+		if price == nil || price.Sign() <= 0 {
+			lggr.Errorw("failed to calculate price", "token", token)
+			continue
 		}
+		prices[token] = ccipocr3.NewBigInt(price)
 	}
 
 	return prices, nil
@@ -239,6 +307,24 @@ func (pr *priceReader) getPriceData(
 	return latestRoundData, nil
 }
 
+func (pr *priceReader) getRate(
+	result commontypes.BatchReadResult,
+	boundContract commontypes.BoundContract,
+) (*big.Int, error) {
+	rateResult, err := result.GetResult()
+	if err != nil {
+		return nil, fmt.Errorf("get rate for contract %s: %w", boundContract.Address, err)
+	}
+	rate, ok := rateResult.(*big.Int)
+	if !ok || rate == nil {
+		return nil, fmt.Errorf("invalid rate data type for contract %s", boundContract.Address)
+	}
+	if rate.Sign() <= 0 {
+		return nil, fmt.Errorf("non positive rate %s for contract %s", rate, boundContract.Address)
+	}
+	return rate, nil
+}
+
 func (pr *priceReader) getDecimals(
 	result commontypes.BatchReadResult,
 	boundContract commontypes.BoundContract,
@@ -257,12 +343,14 @@ func (pr *priceReader) getDecimals(
 	return decimals, nil
 }
 
-// prepareBatchRequest creates a batch request grouped by contract and returns the mapping of contracts to token indices
+// prepareBatchRequest creates a batch request grouped by contract and returns the mapping of aggregator
+// contracts and rate getter contracts to their tokens. Tokens without on-chain price sources are skipped.
 func (pr *priceReader) prepareBatchRequest(
 	tokens []ccipocr3.UnknownEncodedAddress,
-) (commontypes.BatchGetLatestValuesRequest, ContractTokenMap) {
+) (commontypes.BatchGetLatestValuesRequest, ContractTokenMap, ContractTokenMap) {
 	batchRequest := make(commontypes.BatchGetLatestValuesRequest)
 	contractTokenMap := make(ContractTokenMap)
+	rateGetterTokenMap := make(ContractTokenMap)
 
 	for _, token := range tokens {
 		tokenInfo, ok := pr.tokenInfo[token]
@@ -271,6 +359,12 @@ func (pr *priceReader) prepareBatchRequest(
 			continue
 		}
 
+		sourceType := tokenInfo.SourceType()
+		if sourceType != pluginconfig.TokenPriceSourceAggregator &&
+			sourceType != pluginconfig.TokenPriceSourceExchangeRate {
+			continue
+		}
+
 		boundContract := commontypes.BoundContract{
 			Address: string(tokenInfo.AggregatorAddress),
 			Name:    consts.ContractNamePriceAggregator,
@@ -291,11 +385,34 @@ func (pr *priceReader) prepareBatchRequest(
 			}
 		}
 
-		// Track which tokens use this contract
-		contractTokenMap[boundContract] = append(contractTokenMap[boundContract], token)
+		// Track which tokens use this contract, a token can be requested twice when it's also a reference token.
+		if !slices.Contains(contractTokenMap[boundContract], token) {
+			contractTokenMap[boundContract] = append(contractTokenMap[boundContract], token)
+		}
+
+		if sourceType != pluginconfig.TokenPriceSourceExchangeRate {
+			continue
+		}
+
+		rateGetter := commontypes.BoundContract{
+			Address: string(tokenInfo.RateGetterAddress),
+			Name:    consts.ContractNameTokenRateGetter,
+		}
+		if _, exists := batchRequest[rateGetter]; !exists {
+			batchRequest[rateGetter] = commontypes.ContractBatch{
+				{
+					ReadName:  consts.MethodNameGetRate,
+					Params:    nil,
+					ReturnVal: new(big.Int),
+				},
+			}
+		}
+		if !slices.Contains(rateGetterTokenMap[rateGetter], token) {
+			rateGetterTokenMap[rateGetter] = append(rateGetterTokenMap[rateGetter], token)
+		}
 	}
 
-	return batchRequest, contractTokenMap
+	return batchRequest, contractTokenMap, rateGetterTokenMap
 }
 
 func (pr *priceReader) normalizePrice(price *big.Int, decimals uint8) *big.Int {
@@ -313,6 +430,19 @@ func (pr *priceReader) feedChainReader() contractreader.ContractReaderFacade {
 	return pr.chainReaders[pr.feedChain]
 }
 
+// applyExchangeRate converts the USD price of one full underlying token to the price of one full token,
+// given the amount of underlying tokens one token is worth with rateDecimals decimals.
+func applyExchangeRate(underlyingPrice, rate *big.Int, rateDecimals uint8) *big.Int {
+	tmp := new(big.Int).Mul(underlyingPrice, rate)
+	return tmp.Div(tmp, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(rateDecimals)), nil))
+}
+
+// applyRatioPPB scales the price of one full reference token by the ratio in parts per billion.
+func applyRatioPPB(referencePrice, ratioPPB *big.Int) *big.Int {
+	tmp := new(big.Int).Mul(referencePrice, ratioPPB)
+	return tmp.Div(tmp, big.NewInt(1e9))
+}
+
 // Input price is USD per full token, with 18 decimal precision
 // Result price is USD per 1e18 of smallest token denomination, with 18 decimal precision
 // Examples:
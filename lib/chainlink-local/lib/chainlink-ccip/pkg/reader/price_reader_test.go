@@ -161,6 +161,138 @@ func TestOnchainTokenPricesReader_GetTokenPricesUSD(t *testing.T) {
 	}
 }
 
+func TestOnchainTokenPricesReader_GetFeedPricesUSD_PriceSources(t *testing.T) {
+	const (
+		stEthAddr       = cciptypes.UnknownEncodedAddress("0x5100000000000000000000000000000000000000")
+		stEthRateGetter = cciptypes.UnknownEncodedAddress("0x5200000000000000000000000000000000000000")
+		usdxAddr        = cciptypes.UnknownEncodedAddress("0x5300000000000000000000000000000000000000")
+		halfEthAddr     = cciptypes.UnknownEncodedAddress("0x5400000000000000000000000000000000000000")
+		halfUsdxAddr    = cciptypes.UnknownEncodedAddress("0x5500000000000000000000000000000000000000")
+	)
+
+	fixedPrice := cciptypes.NewBigIntFromInt64(1e18)
+	halfRatio := cciptypes.NewBigIntFromInt64(5e8)
+
+	tokenInfo := map[cciptypes.UnknownEncodedAddress]pluginconfig.TokenInfo{
+		EthAddr: EthInfo,
+		stEthAddr: {
+			Source:            pluginconfig.TokenPriceSourceExchangeRate,
+			AggregatorAddress: EthAggregatorAddr,
+			RateGetterAddress: stEthRateGetter,
+			RateDecimals:      18,
+			DeviationPPB:      cciptypes.NewBigIntFromInt64(1e5),
+			Decimals:          Decimals18,
+		},
+		usdxAddr: {
+			Source:        pluginconfig.TokenPriceSourceFixed,
+			FixedPriceUSD: &fixedPrice,
+			DeviationPPB:  cciptypes.NewBigIntFromInt64(1e5),
+			Decimals:      6,
+		},
+		halfEthAddr: {
+			Source:         pluginconfig.TokenPriceSourceTokenRatio,
+			ReferenceToken: EthAddr,
+			RatioPPB:       &halfRatio,
+			DeviationPPB:   cciptypes.NewBigIntFromInt64(1e5),
+			Decimals:       Decimals18,
+		},
+		halfUsdxAddr: {
+			Source:         pluginconfig.TokenPriceSourceTokenRatio,
+			ReferenceToken: usdxAddr,
+			RatioPPB:       &halfRatio,
+			DeviationPPB:   cciptypes.NewBigIntFromInt64(1e5),
+			Decimals:       Decimals18,
+		},
+	}
+
+	aggregator := commontypes.BoundContract{
+		Address: string(EthAggregatorAddr),
+		Name:    consts.ContractNamePriceAggregator,
+	}
+	rateGetter := commontypes.BoundContract{
+		Address: string(stEthRateGetter),
+		Name:    consts.ContractNameTokenRateGetter,
+	}
+
+	testCases := []struct {
+		name        string
+		inputTokens []cciptypes.UnknownEncodedAddress
+		rate        *big.Int
+		rateErr     error
+		want        cciptypes.TokenPriceMap
+	}{
+		{
+			name:        "all sources",
+			inputTokens: []cciptypes.UnknownEncodedAddress{stEthAddr, usdxAddr, halfEthAddr},
+			rate:        big.NewInt(11e17),
+			want: cciptypes.TokenPriceMap{
+				stEthAddr:   cciptypes.NewBigIntFromInt64(77e17),
+				usdxAddr:    cciptypes.NewBigInt(new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e12))),
+				halfEthAddr: cciptypes.NewBigIntFromInt64(35e17),
+			},
+		},
+		{
+			name:        "token ratio referencing a fixed price token",
+			inputTokens: []cciptypes.UnknownEncodedAddress{halfUsdxAddr, stEthAddr},
+			rate:        big.NewInt(11e17),
+			want: cciptypes.TokenPriceMap{
+				halfUsdxAddr: cciptypes.NewBigIntFromInt64(5e17),
+				stEthAddr:    cciptypes.NewBigIntFromInt64(77e17),
+			},
+		},
+		{
+			name:        "rate getter error only drops the exchange rate token",
+			inputTokens: []cciptypes.UnknownEncodedAddress{stEthAddr, EthAddr, halfEthAddr},
+			rateErr:     fmt.Errorf("error"),
+			want: cciptypes.TokenPriceMap{
+				EthAddr:     cciptypes.NewBigInt(EthPrice),
+				halfEthAddr: cciptypes.NewBigIntFromInt64(35e17),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			contractReader := readermock.NewMockContractReaderFacade(t)
+
+			priceResult := commontypes.BatchReadResult{ReadName: consts.MethodNameGetLatestRoundData}
+			priceResult.SetResult(&LatestRoundData{Answer: EthPrice}, nil)
+			decimalsResult := commontypes.BatchReadResult{ReadName: consts.MethodNameGetDecimals}
+			decimals := Decimals18
+			decimalsResult.SetResult(&decimals, nil)
+			rateResult := commontypes.BatchReadResult{ReadName: consts.MethodNameGetRate}
+			rateResult.SetResult(tc.rate, tc.rateErr)
+
+			contractReader.On("BatchGetLatestValues",
+				mock.Anything,
+				mock.MatchedBy(func(req commontypes.BatchGetLatestValuesRequest) bool {
+					return len(req) == 2 &&
+						len(req[aggregator]) == priceReaderOperationCount &&
+						len(req[rateGetter]) == 1 &&
+						req[rateGetter][0].ReadName == consts.MethodNameGetRate
+				}),
+			).Return(commontypes.BatchGetLatestValuesResult{
+				aggregator: {priceResult, decimalsResult},
+				rateGetter: {rateResult},
+			}, nil).Once()
+
+			feedChain := cciptypes.ChainSelector(1)
+			tokenPricesReader := priceReader{
+				lggr: logger.Test(t),
+				chainReaders: map[cciptypes.ChainSelector]contractreader.ContractReaderFacade{
+					feedChain: contractReader,
+				},
+				tokenInfo: tokenInfo,
+				feedChain: feedChain,
+			}
+
+			result, err := tokenPricesReader.GetFeedPricesUSD(context.Background(), tc.inputTokens)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, result)
+		})
+	}
+}
+
 func TestPriceService_calculateUsdPer1e18TokenAmount(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"math/big"
 	"strings"
 	"time"
 
@@ -30,10 +29,49 @@ const (
 	defaultAsyncObserverSyncTimeout           = 10 * time.Second
 )
 
+// TokenPriceSourceType is the type of source the USD price of a token is derived from.
+type TokenPriceSourceType string
+
+const (
+	// TokenPriceSourceAggregator reads the price from the TOKEN/USD aggregator at AggregatorAddress.
+	TokenPriceSourceAggregator TokenPriceSourceType = "aggregator"
+	// TokenPriceSourceExchangeRate reads the price of the underlying token from the aggregator at
+	// AggregatorAddress and multiplies it by the exchange rate returned by the rate getter at RateGetterAddress.
+	TokenPriceSourceExchangeRate TokenPriceSourceType = "exchangeRate"
+	// TokenPriceSourceFixed uses FixedPriceUSD as the price.
+	TokenPriceSourceFixed TokenPriceSourceType = "fixed"
+	// TokenPriceSourceTokenRatio uses the price of ReferenceToken multiplied by RatioPPB.
+	TokenPriceSourceTokenRatio TokenPriceSourceType = "tokenRatio"
+)
+
 type TokenInfo struct {
+	// Source is the type of source the price is derived from, aggregator if not set.
+	// All the sources are on the PriceFeedChainSelector chain.
+	Source TokenPriceSourceType `json:"source,omitempty"`
+
 	// AggregatorAddress is the address of the price feed TOKEN/USD aggregator on the feed chain.
+	// For exchange rate sources it is the aggregator of the underlying token.
 	AggregatorAddress cciptypes.UnknownEncodedAddress `json:"aggregatorAddress"`
 
+	// RateGetterAddress is the address of the contract returning the exchange rate between the token and its
+	// underlying token on the feed chain, only used by exchange rate sources.
+	RateGetterAddress cciptypes.UnknownEncodedAddress `json:"rateGetterAddress,omitempty"`
+
+	// RateDecimals is the number of decimals of the exchange rate, only used by exchange rate sources.
+	RateDecimals uint8 `json:"rateDecimals,omitempty"`
+
+	// FixedPriceUSD is the USD price of one full token with 18 decimals, only used by fixed sources.
+	//	1 USD = 1e18
+	FixedPriceUSD *cciptypes.BigInt `json:"fixedPriceUSD,omitempty"`
+
+	// ReferenceToken is the token whose price is scaled by RatioPPB, only used by token ratio sources.
+	// It must be configured in the TokenInfo map with an aggregator, exchange rate or fixed source.
+	ReferenceToken cciptypes.UnknownEncodedAddress `json:"referenceToken,omitempty"`
+
+	// RatioPPB is the price of one full token relative to one full ReferenceToken in parts per billion,
+	// only used by token ratio sources.
+	RatioPPB *cciptypes.BigInt `json:"ratioPPB,omitempty"`
+
 	// DeviationPPB is the deviation in parts per billion that the price feed is allowed to deviate
 	// from the last written price on-chain before we write a new price.
 	DeviationPPB cciptypes.BigInt `json:"deviationPPB"`
@@ -42,21 +80,43 @@ type TokenInfo struct {
 	Decimals uint8 `json:"decimals"`
 }
 
-func (a TokenInfo) Validate() error {
-	if a.AggregatorAddress == "" {
-		return errors.New("aggregatorAddress not set")
+// SourceType returns the price source type, defaulting to aggregator.
+func (a TokenInfo) SourceType() TokenPriceSourceType {
+	if a.Source == "" {
+		return TokenPriceSourceAggregator
 	}
+	return a.Source
+}
 
-	// aggregator must be an ethereum address
-	decoded, err := hex.DecodeString(strings.ToLower(strings.TrimPrefix(string(a.AggregatorAddress), "0x")))
-	if err != nil {
-		return fmt.Errorf("aggregatorAddress must be a valid ethereum address (i.e hex encoded 20 bytes): %w", err)
-	}
-	if len(decoded) != 20 {
-		return fmt.Errorf("aggregatorAddress must be a valid ethereum address, got %d bytes expected 20", len(decoded))
+func (a TokenInfo) Validate() error {
+	switch a.SourceType() {
+	case TokenPriceSourceAggregator:
+		if err := validateEthereumAddress("aggregatorAddress", a.AggregatorAddress); err != nil {
+			return err
+		}
+	case TokenPriceSourceExchangeRate:
+		if err := validateEthereumAddress("aggregatorAddress", a.AggregatorAddress); err != nil {
+			return err
+		}
+		if err := validateEthereumAddress("rateGetterAddress", a.RateGetterAddress); err != nil {
+			return err
+		}
+	case TokenPriceSourceFixed:
+		if a.FixedPriceUSD == nil || !a.FixedPriceUSD.IsPositive() {
+			return errors.New("fixedPriceUSD not set or negative, must be positive")
+		}
+	case TokenPriceSourceTokenRatio:
+		if a.ReferenceToken == "" {
+			return errors.New("referenceToken not set")
+		}
+		if a.RatioPPB == nil || !a.RatioPPB.IsPositive() {
+			return errors.New("ratioPPB not set or negative, must be positive")
+		}
+	default:
+		return fmt.Errorf("unknown price source %q", a.Source)
 	}
 
-	if a.DeviationPPB.Int.Cmp(big.NewInt(0)) <= 0 {
+	if !a.DeviationPPB.IsPositive() {
 		return errors.New("deviationPPB not set or negative, must be positive")
 	}
 
@@ -67,6 +127,22 @@ func (a TokenInfo) Validate() error {
 	return nil
 }
 
+func validateEthereumAddress(field string, addr cciptypes.UnknownEncodedAddress) error {
+	if addr == "" {
+		return fmt.Errorf("%s not set", field)
+	}
+
+	// must be an ethereum address
+	decoded, err := hex.DecodeString(strings.ToLower(strings.TrimPrefix(string(addr), "0x")))
+	if err != nil {
+		return fmt.Errorf("%s must be a valid ethereum address (i.e hex encoded 20 bytes): %w", field, err)
+	}
+	if len(decoded) != 20 {
+		return fmt.Errorf("%s must be a valid ethereum address, got %d bytes expected 20", field, len(decoded))
+	}
+	return nil
+}
+
 // CommitOffchainConfig is the OCR offchainConfig for the commit plugin.
 // This is posted onchain as part of the OCR configuration process of the commit plugin.
 // Every plugin is provided this configuration in its encoded form in the NewReportingPlugin
@@ -254,6 +330,18 @@ func (c *CommitOffchainConfig) Validate() error {
 		if err := tokenInfo.Validate(); err != nil {
 			return fmt.Errorf("invalid token info for token %s: %w", token, err)
 		}
+		if tokenInfo.SourceType() != TokenPriceSourceTokenRatio {
+			continue
+		}
+		ref, ok := c.TokenInfo[tokenInfo.ReferenceToken]
+		if !ok {
+			return fmt.Errorf("invalid token info for token %s: reference token %s has no token info",
+				token, tokenInfo.ReferenceToken)
+		}
+		if ref.SourceType() == TokenPriceSourceTokenRatio {
+			return fmt.Errorf("invalid token info for token %s: reference token %s can't be a token ratio source",
+				token, tokenInfo.ReferenceToken)
+		}
 	}
 
 	if c.NewMsgScanBatchSize == 0 {
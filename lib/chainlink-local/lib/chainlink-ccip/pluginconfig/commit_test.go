@@ -114,6 +114,100 @@ func TestArbitrumPriceSource_Validate(t *testing.T) {
 	}
 }
 
+func TestTokenInfo_Validate_PriceSources(t *testing.T) {
+	const (
+		aggregator = cciptypes.UnknownEncodedAddress("0x2e03388D351BF87CF2409EFf18C45Df59775Fbb2")
+		rateGetter = cciptypes.UnknownEncodedAddress("0x1e03388D351BF87CF2409EFf18C45Df59775Fbb2")
+	)
+	one := cciptypes.NewBigIntFromInt64(1)
+	zero := cciptypes.NewBigIntFromInt64(0)
+
+	tests := []struct {
+		name    string
+		info    TokenInfo
+		wantErr string
+	}{
+		{
+			name: "exchange rate",
+			info: TokenInfo{Source: TokenPriceSourceExchangeRate, AggregatorAddress: aggregator,
+				RateGetterAddress: rateGetter, RateDecimals: 18},
+		},
+		{
+			name:    "exchange rate without rate getter",
+			info:    TokenInfo{Source: TokenPriceSourceExchangeRate, AggregatorAddress: aggregator},
+			wantErr: "rateGetterAddress not set",
+		},
+		{
+			name: "fixed",
+			info: TokenInfo{Source: TokenPriceSourceFixed, FixedPriceUSD: &one},
+		},
+		{
+			name:    "fixed without price",
+			info:    TokenInfo{Source: TokenPriceSourceFixed, FixedPriceUSD: &zero},
+			wantErr: "fixedPriceUSD not set",
+		},
+		{
+			name: "token ratio",
+			info: TokenInfo{Source: TokenPriceSourceTokenRatio, ReferenceToken: "0x1", RatioPPB: &one},
+		},
+		{
+			name:    "token ratio without reference",
+			info:    TokenInfo{Source: TokenPriceSourceTokenRatio, RatioPPB: &one},
+			wantErr: "referenceToken not set",
+		},
+		{
+			name:    "token ratio without ratio",
+			info:    TokenInfo{Source: TokenPriceSourceTokenRatio, ReferenceToken: "0x1"},
+			wantErr: "ratioPPB not set",
+		},
+		{
+			name:    "unknown source",
+			info:    TokenInfo{Source: "oracle"},
+			wantErr: "unknown price source",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.info.DeviationPPB = one
+			tt.info.Decimals = 18
+			err := tt.info.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommitOffchainConfig_Validate_ReferenceTokens(t *testing.T) {
+	one := cciptypes.NewBigIntFromInt64(1)
+	cfg := CommitOffchainConfig{
+		RemoteGasPriceBatchWriteFrequency:  *commonconfig.MustNewDuration(1),
+		TokenPriceBatchWriteFrequency:      *commonconfig.MustNewDuration(1),
+		PriceFeedChainSelector:             1,
+		NewMsgScanBatchSize:                256,
+		MaxReportTransmissionCheckAttempts: 10,
+		MaxMerkleTreeSize:                  1000,
+		SignObservationPrefix:              "chainlink ccip 1.6 rmn observation",
+		TokenInfo: map[cciptypes.UnknownEncodedAddress]TokenInfo{
+			"0x1": {Source: TokenPriceSourceFixed, FixedPriceUSD: &one, DeviationPPB: one, Decimals: 18},
+			"0x2": {Source: TokenPriceSourceTokenRatio, ReferenceToken: "0x1", RatioPPB: &one,
+				DeviationPPB: one, Decimals: 18},
+		},
+	}
+	cfg.applyDefaults()
+	require.NoError(t, cfg.Validate())
+
+	cfg.TokenInfo["0x3"] = TokenInfo{Source: TokenPriceSourceTokenRatio, ReferenceToken: "0x2", RatioPPB: &one,
+		DeviationPPB: one, Decimals: 18}
+	require.ErrorContains(t, cfg.Validate(), "can't be a token ratio source")
+
+	cfg.TokenInfo["0x3"] = TokenInfo{Source: TokenPriceSourceTokenRatio, ReferenceToken: "0x4", RatioPPB: &one,
+		DeviationPPB: one, Decimals: 18}
+	require.ErrorContains(t, cfg.Validate(), "has no token info")
+}
+
 func TestCommitOffchainConfig_Validate(t *testing.T) {
 	type fields struct {
 		RemoteGasPriceBatchWriteFrequency  commonconfig.Duration
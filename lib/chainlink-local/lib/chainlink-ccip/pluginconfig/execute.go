@@ -3,9 +3,12 @@ package pluginconfig
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
 // ExecuteOffchainConfig is the OCR offchainConfig for the exec plugin.
@@ -28,8 +31,9 @@ type ExecuteOffchainConfig struct {
 	// MessageVisibilityInterval is the time interval for which the messages are visible by the plugin.
 	MessageVisibilityInterval commonconfig.Duration `json:"messageVisibilityInterval"`
 
-	// BatchingStrategyID is the strategy to use for batching messages.
-	// Deprecated: this is replaced by MaxReportMessages and MaxSingleChainReports.
+	// BatchingStrategyID is the strategy to use for batching messages: 0 is best-effort, 1 is ZK overflow aware
+	// (one message per report) and 2 is fee priority (messages paying higher fees are added first).
+	// MaxReportMessages and MaxSingleChainReports apply to all of them.
 	BatchingStrategyID uint32 `json:"batchingStrategyID"`
 
 	// TokenDataObservers registers different strategies for processing token data.
@@ -45,6 +49,91 @@ type ExecuteOffchainConfig struct {
 	// MaxSingleChainReports is the maximum number of single chain reports that can be included in a report.
 	// When set to 0, this setting is ignored.
 	MaxSingleChainReports uint64 `json:"maxSingleChainReports"`
+
+	// EnableFeeCheck skips messages whose fee, boosted by RelativeBoostPerWaitHour, doesn't cover
+	// their estimated execution cost on the destination chain.
+	EnableFeeCheck bool `json:"enableFeeCheck"`
+
+	// RelativeBoostPerWaitHour is how much a message fee is boosted for each hour the message is
+	// waiting to be executed, e.g. 0.5 doubles the fee after two hours. Only used with EnableFeeCheck.
+	RelativeBoostPerWaitHour float64 `json:"relativeBoostPerWaitHour"`
+
+	// MessagePriority configures which messages are executed first when there is a backlog.
+	// When empty, messages are executed in the order of the batching strategy.
+	MessagePriority MessagePriorityConfig `json:"messagePriority"`
+
+	// CurseBacklogDrainPeriod is how long the lanes affected by an RMN curse are prioritized after it lifts.
+	// Defaults to 30 minutes and must not exceed MessageVisibilityInterval.
+	CurseBacklogDrainPeriod commonconfig.Duration `json:"curseBacklogDrainPeriod"`
+}
+
+// MessagePriorityConfig assigns weights to messages, the priority of a message is the sum of the weights
+// of the rules it matches. Commit reports with higher priority messages are executed first and, within a
+// commit report, messages with a higher priority are added to the report first. Messages from the same
+// sender which are executed in order are never reordered among themselves.
+type MessagePriorityConfig struct {
+	// SourceChainWeights is the weight of every message from the source chain.
+	SourceChainWeights map[cciptypes.ChainSelector]uint32 `json:"sourceChainWeights,omitempty"`
+
+	// Senders is the allowlist of prioritized senders.
+	Senders []SenderPriority `json:"senders,omitempty"`
+
+	// TokenValueTiers prioritize token transfers by the transferred amount. A message gets the weight of
+	// the highest tier matched by any of its token amounts.
+	TokenValueTiers []TokenValueTier `json:"tokenValueTiers,omitempty"`
+}
+
+// SenderPriority is the weight of the messages sent by Sender on SourceChain.
+type SenderPriority struct {
+	SourceChain cciptypes.ChainSelector  `json:"sourceChain"`
+	Sender      cciptypes.UnknownAddress `json:"sender"`
+	Weight      uint32                   `json:"weight"`
+}
+
+// TokenValueTier is the weight of the messages transferring at least MinAmount of the tokens of the
+// SourcePoolAddress pool on SourceChain. MinAmount is in the smallest denomination of the source token.
+type TokenValueTier struct {
+	SourceChain       cciptypes.ChainSelector  `json:"sourceChain"`
+	SourcePoolAddress cciptypes.UnknownAddress `json:"sourcePoolAddress"`
+	MinAmount         cciptypes.BigInt         `json:"minAmount"`
+	Weight            uint32                   `json:"weight"`
+}
+
+// IsEmpty returns true if no priority rules are configured.
+func (c MessagePriorityConfig) IsEmpty() bool {
+	return len(c.SourceChainWeights) == 0 && len(c.Senders) == 0 && len(c.TokenValueTiers) == 0
+}
+
+func (c MessagePriorityConfig) Validate() error {
+	senders := make(map[cciptypes.ChainSelector]map[string]struct{})
+	for _, s := range c.Senders {
+		if s.SourceChain == 0 {
+			return errors.New("prioritized sender source chain not set")
+		}
+		if s.Sender.IsZeroOrEmpty() {
+			return fmt.Errorf("prioritized sender not set for source chain %d", s.SourceChain)
+		}
+		if _, ok := senders[s.SourceChain]; !ok {
+			senders[s.SourceChain] = make(map[string]struct{})
+		}
+		if _, exists := senders[s.SourceChain][string(s.Sender)]; exists {
+			return fmt.Errorf("duplicate prioritized sender %s for source chain %d", s.Sender, s.SourceChain)
+		}
+		senders[s.SourceChain][string(s.Sender)] = struct{}{}
+	}
+
+	for _, tier := range c.TokenValueTiers {
+		if tier.SourceChain == 0 {
+			return errors.New("token value tier source chain not set")
+		}
+		if tier.SourcePoolAddress.IsZeroOrEmpty() {
+			return fmt.Errorf("token value tier source pool not set for source chain %d", tier.SourceChain)
+		}
+		if !tier.MinAmount.IsPositive() {
+			return fmt.Errorf("token value tier min amount of pool %s must be positive", tier.SourcePoolAddress)
+		}
+	}
+	return nil
 }
 
 func (e *ExecuteOffchainConfig) ApplyDefaultsAndValidate() error {
@@ -52,10 +141,20 @@ func (e *ExecuteOffchainConfig) ApplyDefaultsAndValidate() error {
 	return e.Validate()
 }
 
+const (
+	// maxBatchingStrategyID is the highest BatchingStrategyID supported by the exec plugin.
+	maxBatchingStrategyID = 2
+
+	defaultCurseBacklogDrainPeriod = 30 * time.Minute
+)
+
 func (e *ExecuteOffchainConfig) applyDefaults() {
 	if e.TransmissionDelayMultiplier == 0 {
 		e.TransmissionDelayMultiplier = defaultTransmissionDelayMultiplier
 	}
+	if e.CurseBacklogDrainPeriod.Duration() == 0 {
+		e.CurseBacklogDrainPeriod = *commonconfig.MustNewDuration(defaultCurseBacklogDrainPeriod)
+	}
 }
 
 func (e *ExecuteOffchainConfig) Validate() error {
@@ -78,6 +177,23 @@ func (e *ExecuteOffchainConfig) Validate() error {
 		return errors.New("MessageVisibilityInterval not set")
 	}
 
+	if e.BatchingStrategyID > maxBatchingStrategyID {
+		return fmt.Errorf("unknown BatchingStrategyID %d", e.BatchingStrategyID)
+	}
+
+	if e.RelativeBoostPerWaitHour < 0 {
+		return errors.New("RelativeBoostPerWaitHour must not be negative")
+	}
+
+	if e.CurseBacklogDrainPeriod.Duration() > e.MessageVisibilityInterval.Duration() {
+		return fmt.Errorf("CurseBacklogDrainPeriod %s exceeds MessageVisibilityInterval %s",
+			e.CurseBacklogDrainPeriod.Duration(), e.MessageVisibilityInterval.Duration())
+	}
+
+	if err := e.MessagePriority.Validate(); err != nil {
+		return fmt.Errorf("invalid MessagePriority: %w", err)
+	}
+
 	set := make(map[string]struct{})
 	for _, ob := range e.TokenDataObservers {
 		if err := ob.Validate(); err != nil {
@@ -2,10 +2,13 @@ package pluginconfig
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
 func TestExecuteOffchainConfig_Validate(t *testing.T) {
@@ -15,6 +18,9 @@ func TestExecuteOffchainConfig_Validate(t *testing.T) {
 		RootSnoozeTime            commonconfig.Duration
 		MessageVisibilityInterval commonconfig.Duration
 		BatchingStrategyID        uint32
+		RelativeBoostPerWaitHour  float64
+		MessagePriority           MessagePriorityConfig
+		CurseBacklogDrainPeriod   commonconfig.Duration
 	}
 	tests := []struct {
 		name    string
@@ -76,6 +82,110 @@ func TestExecuteOffchainConfig_Validate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid, fee priority batching strategy",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				BatchingStrategyID:        2,
+			},
+			false,
+		},
+		{
+			"invalid, unknown BatchingStrategyID",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				BatchingStrategyID:        3,
+			},
+			true,
+		},
+		{
+			"invalid, negative RelativeBoostPerWaitHour",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				RelativeBoostPerWaitHour:  -0.5,
+			},
+			true,
+		},
+		{
+			"valid, message priority",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				MessagePriority: MessagePriorityConfig{
+					SourceChainWeights: map[cciptypes.ChainSelector]uint32{1: 2},
+					Senders:            []SenderPriority{{SourceChain: 1, Sender: cciptypes.UnknownAddress{1}, Weight: 1}},
+					TokenValueTiers: []TokenValueTier{{
+						SourceChain:       1,
+						SourcePoolAddress: cciptypes.UnknownAddress{2},
+						MinAmount:         cciptypes.NewBigIntFromInt64(1),
+						Weight:            1,
+					}},
+				},
+			},
+			false,
+		},
+		{
+			"invalid, duplicate prioritized sender",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				MessagePriority: MessagePriorityConfig{
+					Senders: []SenderPriority{
+						{SourceChain: 1, Sender: cciptypes.UnknownAddress{1}, Weight: 1},
+						{SourceChain: 1, Sender: cciptypes.UnknownAddress{1}, Weight: 2},
+					},
+				},
+			},
+			true,
+		},
+		{
+			"valid, curse backlog drain period within the message visibility interval",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(time.Hour),
+				CurseBacklogDrainPeriod:   *commonconfig.MustNewDuration(time.Hour),
+			},
+			false,
+		},
+		{
+			"invalid, curse backlog drain period exceeds the message visibility interval",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(time.Hour),
+				CurseBacklogDrainPeriod:   *commonconfig.MustNewDuration(2 * time.Hour),
+			},
+			true,
+		},
+		{
+			"invalid, token value tier without min amount",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				MessagePriority: MessagePriorityConfig{
+					TokenValueTiers: []TokenValueTier{{SourceChain: 1, SourcePoolAddress: cciptypes.UnknownAddress{2}}},
+				},
+			},
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -85,6 +195,9 @@ func TestExecuteOffchainConfig_Validate(t *testing.T) {
 				RootSnoozeTime:            tt.fields.RootSnoozeTime,
 				MessageVisibilityInterval: tt.fields.MessageVisibilityInterval,
 				BatchingStrategyID:        tt.fields.BatchingStrategyID,
+				RelativeBoostPerWaitHour:  tt.fields.RelativeBoostPerWaitHour,
+				MessagePriority:           tt.fields.MessagePriority,
+				CurseBacklogDrainPeriod:   tt.fields.CurseBacklogDrainPeriod,
 			}
 			if err := e.Validate(); (err != nil) != tt.wantErr {
 				t.Errorf("ExecuteOffchainConfig.Validate() error = %v, wantErr %v", err, tt.wantErr)
@@ -93,6 +206,31 @@ func TestExecuteOffchainConfig_Validate(t *testing.T) {
 	}
 }
 
+func TestExecuteOffchainConfig_ApplyDefaultsAndValidate(t *testing.T) {
+	cfg := ExecuteOffchainConfig{
+		BatchGasLimit:             1,
+		InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+		RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+		MessageVisibilityInterval: *commonconfig.MustNewDuration(8 * time.Hour),
+	}
+	require.NoError(t, cfg.ApplyDefaultsAndValidate())
+	require.Equal(t, defaultTransmissionDelayMultiplier, cfg.TransmissionDelayMultiplier)
+	require.Equal(t, defaultCurseBacklogDrainPeriod, cfg.CurseBacklogDrainPeriod.Duration())
+
+	cfg.CurseBacklogDrainPeriod = *commonconfig.MustNewDuration(time.Hour)
+	require.NoError(t, cfg.ApplyDefaultsAndValidate())
+	require.Equal(t, time.Hour, cfg.CurseBacklogDrainPeriod.Duration())
+
+	// the default drain period exceeds a short message visibility interval.
+	cfg = ExecuteOffchainConfig{
+		BatchGasLimit:             1,
+		InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+		RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+		MessageVisibilityInterval: *commonconfig.MustNewDuration(time.Minute),
+	}
+	require.Error(t, cfg.ApplyDefaultsAndValidate())
+}
+
 func TestExecuteOffchainConfig_EncodeDecode(t *testing.T) {
 	type fields struct {
 		BatchGasLimit             uint64